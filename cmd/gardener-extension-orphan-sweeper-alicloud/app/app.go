@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/sweeper"
+)
+
+const (
+	// EnvAccessKeyID is the environment variable containing the access key id used by the sweeper.
+	EnvAccessKeyID = "ALIBABA_CLOUD_ACCESS_KEY_ID"
+	// EnvAccessKeySecret is the environment variable containing the access key secret used by the sweeper.
+	EnvAccessKeySecret = "ALIBABA_CLOUD_ACCESS_KEY_SECRET"
+	// EnvRoleARN is the environment variable containing the optional ARN of a RAM role assumed by the sweeper.
+	EnvRoleARN = "ALIBABA_CLOUD_ROLE_ARN"
+)
+
+var log = logf.Log.WithName("gardener-extension-orphan-sweeper-alicloud")
+
+// Options are the options of the orphan sweeper command.
+type Options struct {
+	// Kubeconfigs are the paths of the kubeconfigs of all seeds whose shoots use the account and region.
+	Kubeconfigs []string
+	// GardenKubeconfig is the optional path of the kubeconfig of the garden cluster. If it is set, the namespaces of all
+	// shoots in the garden are considered to be alive.
+	GardenKubeconfig string
+	// Region is the region to sweep.
+	Region string
+	// Delete enables the deletion of the orphaned resources, otherwise they are only reported.
+	Delete bool
+	// MinAge is the minimum time the resources of a namespace must have been found orphaned before they are deleted.
+	MinAge time.Duration
+}
+
+// NewOrphanSweeperCommand creates a new command for finding and deleting the resources of deleted shoots.
+func NewOrphanSweeperCommand(ctx context.Context) *cobra.Command {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use:   "orphan-sweeper-alicloud",
+		Short: "Finds and deletes the Alicloud resources of deleted shoots",
+		Long: `Finds the resources tagged with kubernetes.io/cluster/<namespace> and the load balancers of shoots in the
+given region of the account whose namespace has neither an Infrastructure, a Namespace nor a Cluster in any of the given
+seeds and, if --garden-kubeconfig is given, no Shoot in the garden.
+Orphaned resources are tagged with ` + sweeper.TagKeyOrphanedSince + ` when they are found for the first time.
+The resources are only reported unless --delete is given, in which case the resources of the namespaces which have been
+found orphaned for at least --min-age are deleted.
+The credentials are read from the ` + EnvAccessKeyID + ` and ` + EnvAccessKeySecret + ` environment variables,
+the RAM role given in ` + EnvRoleARN + ` is assumed with them if set.`,
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verflag.PrintAndExitIfRequested()
+			return run(ctx, cmd, opts)
+		},
+	}
+
+	verflag.AddFlags(cmd.Flags())
+	cmd.Flags().StringArrayVar(&opts.Kubeconfigs, "kubeconfig", nil, "path of the kubeconfig of a seed using the account, can be given multiple times")
+	cmd.Flags().StringVar(&opts.GardenKubeconfig, "garden-kubeconfig", "", "path of the kubeconfig of the garden cluster, the namespaces of its shoots are never swept")
+	cmd.Flags().StringVar(&opts.Region, "region", "", "region to sweep")
+	cmd.Flags().BoolVar(&opts.Delete, "delete", false, "delete the orphaned resources instead of only reporting them")
+	cmd.Flags().DurationVar(&opts.MinAge, "min-age", 24*time.Hour, "minimum time the resources of a namespace must have been found orphaned before they are deleted")
+
+	return cmd
+}
+
+func run(ctx context.Context, cmd *cobra.Command, opts *Options) error {
+	if len(opts.Kubeconfigs) == 0 {
+		return errors.New("at least one --kubeconfig is required")
+	}
+	if opts.Region == "" {
+		return errors.New("--region is required")
+	}
+	if opts.MinAge < 0 {
+		return errors.New("--min-age must not be negative")
+	}
+	credentials := &alicloud.Credentials{
+		AccessKeyID:     os.Getenv(EnvAccessKeyID),
+		AccessKeySecret: os.Getenv(EnvAccessKeySecret),
+		RoleARN:         os.Getenv(EnvRoleARN),
+	}
+	if credentials.AccessKeyID == "" || credentials.AccessKeySecret == "" {
+		return fmt.Errorf("the environment variables %s and %s are required", EnvAccessKeyID, EnvAccessKeySecret)
+	}
+
+	existingNamespaces, err := getSeedNamespaces(ctx, opts.Kubeconfigs)
+	if err != nil {
+		return err
+	}
+	if opts.GardenKubeconfig != "" {
+		shootNamespaces, err := getShootNamespaces(ctx, opts.GardenKubeconfig)
+		if err != nil {
+			return err
+		}
+		existingNamespaces = existingNamespaces.Union(shootNamespaces)
+	}
+
+	actor, err := aliclient.NewActor(credentials, opts.Region)
+	if err != nil {
+		return err
+	}
+	slbClient, err := alicloudclient.NewClientFactory().NewSLBClient(opts.Region, credentials)
+	if err != nil {
+		return err
+	}
+	s := sweeper.New(log, actor, slbClient, opts.Region)
+
+	resources, err := s.FindOrphanedResources(ctx, existingNamespaces)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := s.Mark(ctx, resources, now); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tTYPE\tID\tNAME\tORPHANED SINCE")
+	for _, resource := range resources {
+		orphanedSince := ""
+		if resource.OrphanedSince != nil {
+			orphanedSince = resource.OrphanedSince.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", resource.Namespace, resource.Type, resource.ID, resource.Name, orphanedSince)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if !opts.Delete {
+		return nil
+	}
+	expired := sweeper.FilterExpired(resources, opts.MinAge, now)
+	if skipped := len(resources) - len(expired); skipped > 0 {
+		log.Info("Skipping resources which have not been orphaned for the minimum age yet", "count", skipped, "minAge", opts.MinAge)
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	return s.Delete(ctx, expired)
+}
+
+// getSeedNamespaces returns the namespaces of all shoots in the seeds of the given kubeconfigs. A shoot is considered to
+// be in a seed as long as its namespace, its Cluster or its Infrastructure exists, so that neither a shoot in deletion
+// nor a shoot which is migrated to another seed is considered orphaned.
+func getSeedNamespaces(ctx context.Context, kubeconfigs []string) (sets.Set[string], error) {
+	scheme := runtime.NewScheme()
+	if err := extensionsv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	namespaces := sets.New[string]()
+	for _, kubeconfig := range kubeconfigs {
+		c, err := newClient(kubeconfig, scheme)
+		if err != nil {
+			return nil, err
+		}
+
+		namespaceList := &corev1.NamespaceList{}
+		if err := c.List(ctx, namespaceList); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces of seed %s: %w", kubeconfig, err)
+		}
+		for _, namespace := range namespaceList.Items {
+			if strings.HasPrefix(namespace.Name, v1beta1constants.TechnicalIDPrefix) {
+				namespaces.Insert(namespace.Name)
+			}
+		}
+
+		clusters := &extensionsv1alpha1.ClusterList{}
+		if err := c.List(ctx, clusters); err != nil {
+			return nil, fmt.Errorf("failed to list clusters of seed %s: %w", kubeconfig, err)
+		}
+		for _, cluster := range clusters.Items {
+			namespaces.Insert(cluster.Name)
+		}
+
+		infrastructures := &extensionsv1alpha1.InfrastructureList{}
+		if err := c.List(ctx, infrastructures); err != nil {
+			return nil, fmt.Errorf("failed to list infrastructures of seed %s: %w", kubeconfig, err)
+		}
+		for _, infra := range infrastructures.Items {
+			namespaces.Insert(infra.Namespace)
+		}
+	}
+	return namespaces, nil
+}
+
+// getShootNamespaces returns the namespaces of all shoots in the garden of the given kubeconfig, i.e. their technical ids.
+func getShootNamespaces(ctx context.Context, kubeconfig string) (sets.Set[string], error) {
+	scheme := runtime.NewScheme()
+	if err := gardencorev1beta1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	c, err := newClient(kubeconfig, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	shoots := &gardencorev1beta1.ShootList{}
+	if err := c.List(ctx, shoots); err != nil {
+		return nil, fmt.Errorf("failed to list shoots of garden %s: %w", kubeconfig, err)
+	}
+	namespaces := sets.New[string]()
+	for _, shoot := range shoots.Items {
+		if shoot.Status.TechnicalID != "" {
+			namespaces.Insert(shoot.Status.TechnicalID)
+		}
+	}
+	return namespaces, nil
+}
+
+func newClient(kubeconfig string, scheme *runtime.Scheme) (client.Client, error) {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig %s: %w", kubeconfig, err)
+	}
+	return client.New(restConfig, client.Options{Scheme: scheme})
+}
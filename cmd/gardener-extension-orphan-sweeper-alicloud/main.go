@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	"github.com/gardener/gardener/pkg/logger"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/cmd/gardener-extension-orphan-sweeper-alicloud/app"
+)
+
+func main() {
+	logf.SetLogger(logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText))
+	cmd := app.NewOrphanSweeperCommand(signals.SetupSignalHandler())
+
+	if err := cmd.Execute(); err != nil {
+		logf.Log.Error(err, "error executing the orphan sweeper command")
+		os.Exit(1)
+	}
+}
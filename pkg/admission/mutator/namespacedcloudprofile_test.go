@@ -89,7 +89,7 @@ var _ = Describe("NamespacedCloudProfile Mutator", func() {
 "kind":"CloudProfileConfig",
 "machineImages":[
   {"name":"image-1","versions":[{"version":"1.1","regions":[{"name":"eu2","id":"id-124"}]}]},
-  {"name":"image-2","versions":[{"version":"2.0","regions":[{"name":"eu3","id":"id-125"}]}]}
+  {"name":"image-2","versions":[{"version":"2.0","regions":[{"name":"eu3","id":"id-125"},{"name":"eu3","id":"id-126","architecture":"arm64"}]}]}
 ]}`)}
 
 				Expect(namespacedCloudProfileMutator.Mutate(ctx, namespacedCloudProfile, nil)).To(Succeed())
@@ -100,13 +100,18 @@ var _ = Describe("NamespacedCloudProfile Mutator", func() {
 					MatchFields(IgnoreExtras, Fields{
 						"Name": Equal("image-1"),
 						"Versions": ContainElements(
-							api.MachineImageVersion{Version: "1.0", Regions: []api.RegionIDMapping{{Name: "eu1", ID: "id-123"}}},
-							api.MachineImageVersion{Version: "1.1", Regions: []api.RegionIDMapping{{Name: "eu2", ID: "id-124"}}},
+							api.MachineImageVersion{Version: "1.0", Regions: []api.RegionIDMapping{{Name: "eu1", ID: "id-123", Architecture: ptr.To("amd64")}}},
+							api.MachineImageVersion{Version: "1.1", Regions: []api.RegionIDMapping{{Name: "eu2", ID: "id-124", Architecture: ptr.To("amd64")}}},
 						),
 					}),
 					MatchFields(IgnoreExtras, Fields{
-						"Name":     Equal("image-2"),
-						"Versions": ContainElements(api.MachineImageVersion{Version: "2.0", Regions: []api.RegionIDMapping{{Name: "eu3", ID: "id-125"}}}),
+						"Name": Equal("image-2"),
+						"Versions": ContainElements(
+							api.MachineImageVersion{Version: "2.0", Regions: []api.RegionIDMapping{
+								{Name: "eu3", ID: "id-125", Architecture: ptr.To("amd64")},
+								{Name: "eu3", ID: "id-126", Architecture: ptr.To("arm64")},
+							}},
+						),
 					}),
 				))
 			})
@@ -195,6 +195,7 @@ func (s *shootMutator) decodeNetworkConfig(network *runtime.RawExtension) (map[s
 func (s *shootMutator) setDefaultForEncryptedDisk(ctx context.Context, shoot *corev1beta1.Shoot, worker *corev1beta1.Worker) error {
 	imageName := worker.Machine.Image.Name
 	imageVersion := worker.Machine.Image.Version
+	architecture := ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
 	logger.Info("Check ImageName: " + imageName + "; ImageVesion: " + *imageVersion)
 	if worker.DataVolumes != nil {
 		for i := range worker.DataVolumes {
@@ -207,7 +208,7 @@ func (s *shootMutator) setDefaultForEncryptedDisk(ctx context.Context, shoot *co
 	}
 	if worker.Volume != nil && worker.Volume.Encrypted == nil {
 		//don't set encrypted disk by default if image is system image
-		isCustomizeImage, err := s.isCustomizedImage(ctx, shoot, imageName, imageVersion)
+		isCustomizeImage, err := s.isCustomizedImage(ctx, shoot, imageName, imageVersion, architecture)
 		if err != nil {
 			return err
 		}
@@ -220,7 +221,7 @@ func (s *shootMutator) setDefaultForEncryptedDisk(ctx context.Context, shoot *co
 	return nil
 }
 
-func (s *shootMutator) isCustomizedImage(ctx context.Context, shoot *corev1beta1.Shoot, imageName string, imageVersion *string) (bool, error) {
+func (s *shootMutator) isCustomizedImage(ctx context.Context, shoot *corev1beta1.Shoot, imageName string, imageVersion *string, architecture string) (bool, error) {
 	cloudProfile, err := gardener.GetCloudProfile(ctx, s.client, shoot)
 	if err != nil {
 		return false, err
@@ -230,7 +231,7 @@ func (s *shootMutator) isCustomizedImage(ctx context.Context, shoot *corev1beta1
 	}
 	region := shoot.Spec.Region
 	logger.Info("Checking in cloudProfile", "CloudProfile", client.ObjectKeyFromObject(cloudProfile), "Region", region)
-	imageId, err := s.getImageId(ctx, imageName, imageVersion, region, cloudProfile)
+	imageId, err := s.getImageId(ctx, imageName, imageVersion, architecture, region, cloudProfile)
 	if err != nil || imageId == "" {
 		return false, err
 	}
@@ -265,15 +266,11 @@ func (s *shootMutator) isOwnedByAliCloud(ctx context.Context, shoot *corev1beta1
 	if err := s.apiReader.Get(ctx, secretKey, secret); err != nil {
 		return false, err
 	}
-	accessKeyID, ok := secret.Data[alicloud.AccessKeyID]
-	if !ok {
-		return false, fmt.Errorf("missing %q field in secret %s", alicloud.AccessKeyID, secret.Name)
-	}
-	accessKeySecret, ok := secret.Data[alicloud.AccessKeySecret]
-	if !ok {
-		return false, fmt.Errorf("missing %q field in secret %s", alicloud.AccessKeySecret, secret.Name)
+	credentials, err := alicloud.ReadSecretCredentials(secret, false)
+	if err != nil {
+		return false, err
 	}
-	shootECSClient, err := s.alicloudClientFactory.NewECSClient(region, string(accessKeyID), string(accessKeySecret))
+	shootECSClient, err := s.alicloudClientFactory.NewECSClient(region, credentials)
 	if err != nil {
 		return false, err
 	}
@@ -285,12 +282,12 @@ func (s *shootMutator) isOwnedByAliCloud(ctx context.Context, shoot *corev1beta1
 	return false, nil
 }
 
-func (s *shootMutator) getImageId(_ context.Context, imageName string, imageVersion *string, imageRegion string, cloudProfileSpec *corev1beta1.CloudProfile) (string, error) {
+func (s *shootMutator) getImageId(_ context.Context, imageName string, imageVersion *string, architecture, imageRegion string, cloudProfileSpec *corev1beta1.CloudProfile) (string, error) {
 	cloudProfileConfig, err := s.getCloudProfileConfig(cloudProfileSpec)
 	if err != nil {
 		return "", err
 	}
-	return helper.FindImageForRegionFromCloudProfile(cloudProfileConfig, imageName, *imageVersion, imageRegion)
+	return helper.FindImageForRegionFromCloudProfile(cloudProfileConfig, imageName, *imageVersion, imageRegion, architecture)
 }
 
 func (s *shootMutator) getCloudProfileConfig(cloudProfile *corev1beta1.CloudProfile) (*api.CloudProfileConfig, error) {
@@ -374,7 +371,7 @@ func getVolumeByName(dataVolumes []corev1beta1.DataVolume, volumeName string) *c
 }
 
 func (s *shootMutator) mutateForEncryptedSystemDiskChange(shoot, oldShoot *corev1beta1.Shoot) {
-	if requireNewEncryptedImage(shoot.Spec.Provider.Workers, oldShoot.Spec.Provider.Workers) {
+	if requireNewEncryptedImage(shoot.Spec.Provider.Workers, oldShoot.Spec.Provider.Workers, s.systemDiskKMSKeyIDFunc(shoot), s.systemDiskKMSKeyIDFunc(oldShoot)) {
 		logger.Info("Need to reconcile infra as new encrypted system disk found in workers", "name", shoot.Name, "namespace", shoot.Namespace)
 		if shoot.Annotations == nil {
 			shoot.Annotations = make(map[string]string)
@@ -384,14 +381,40 @@ func (s *shootMutator) mutateForEncryptedSystemDiskChange(shoot, oldShoot *corev
 	}
 }
 
+// systemDiskKMSKeyIDFunc returns a function which determines the KMS key the system disk of a worker of the given shoot
+// is encrypted with. Provider configs which cannot be decoded are treated as unset, they are rejected by the validator.
+func (s *shootMutator) systemDiskKMSKeyIDFunc(shoot *corev1beta1.Shoot) func(corev1beta1.Worker) string {
+	controlPlaneConfig := &api.ControlPlaneConfig{}
+	if shoot.Spec.Provider.ControlPlaneConfig != nil {
+		if _, _, err := s.codec.Decode(shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, controlPlaneConfig); err != nil {
+			controlPlaneConfig = nil
+		}
+	}
+
+	return func(worker corev1beta1.Worker) string {
+		workerConfig := &api.WorkerConfig{}
+		if worker.ProviderConfig != nil {
+			if _, _, err := s.codec.Decode(worker.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				workerConfig = nil
+			}
+		}
+		return helper.SystemDiskKMSKeyID(workerConfig, controlPlaneConfig)
+	}
+}
+
 // Check encrypted flag in new workers' volumes. If it is changed to be true, check for old workers
-// if there is already a volume is set to be encrypted and also the OS version is the same.
-func requireNewEncryptedImage(newWorkers, oldWorkers []corev1beta1.Worker) bool {
-	var imagesEncrypted []*corev1beta1.ShootMachineImage
+// if there is already a volume is set to be encrypted with the same KMS key and also the OS version is the same.
+func requireNewEncryptedImage(newWorkers, oldWorkers []corev1beta1.Worker, newKMSKeyID, oldKMSKeyID func(corev1beta1.Worker) string) bool {
+	type encryptedImage struct {
+		image    *corev1beta1.ShootMachineImage
+		kmsKeyID string
+	}
+
+	var imagesEncrypted []encryptedImage
 	for _, w := range oldWorkers {
 		if w.Volume != nil && w.Volume.Encrypted != nil && *w.Volume.Encrypted {
 			if w.Machine.Image != nil {
-				imagesEncrypted = append(imagesEncrypted, w.Machine.Image)
+				imagesEncrypted = append(imagesEncrypted, encryptedImage{image: w.Machine.Image, kmsKeyID: oldKMSKeyID(w)})
 			}
 		}
 	}
@@ -399,9 +422,10 @@ func requireNewEncryptedImage(newWorkers, oldWorkers []corev1beta1.Worker) bool
 	for _, w := range newWorkers {
 		if w.Volume != nil && w.Volume.Encrypted != nil && *w.Volume.Encrypted {
 			if w.Machine.Image != nil {
+				kmsKeyID := newKMSKeyID(w)
 				found := false
-				for _, image := range imagesEncrypted {
-					if w.Machine.Image.Name == image.Name && reflect.DeepEqual(w.Machine.Image.Version, image.Version) {
+				for _, encrypted := range imagesEncrypted {
+					if w.Machine.Image.Name == encrypted.image.Name && reflect.DeepEqual(w.Machine.Image.Version, encrypted.image.Version) && kmsKeyID == encrypted.kmsKeyID {
 						found = true
 						break
 					}
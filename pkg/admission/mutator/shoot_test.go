@@ -246,7 +246,7 @@ var _ = Describe("Mutating Shoot", func() {
 					},
 				),
 
-				alicloudClientFactory.EXPECT().NewECSClient(regionId, accessKeyID, accessKeySecret).Return(ecsClient, nil),
+				alicloudClientFactory.EXPECT().NewECSClient(regionId, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil),
 				ecsClient.EXPECT().CheckIfImageExists(imageId).Return(false, nil),
 			)
 			err := mutator.Mutate(ctx, newShoot, nil)
@@ -291,7 +291,7 @@ var _ = Describe("Mutating Shoot", func() {
 					},
 				),
 
-				alicloudClientFactory.EXPECT().NewECSClient(regionId, accessKeyID, accessKeySecret).Return(ecsClient, nil),
+				alicloudClientFactory.EXPECT().NewECSClient(regionId, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil),
 				ecsClient.EXPECT().CheckIfImageExists(imageId).Return(false, nil),
 				//ecsClient.EXPECT().CheckIfImageOwnedByAliCloud(imageId).Return(false, nil)
 			)
@@ -322,7 +322,7 @@ var _ = Describe("Mutating Shoot", func() {
 					},
 				),
 
-				alicloudClientFactory.EXPECT().NewECSClient(regionId, accessKeyID, accessKeySecret).Return(ecsClient, nil),
+				alicloudClientFactory.EXPECT().NewECSClient(regionId, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil),
 				ecsClient.EXPECT().CheckIfImageExists(imageId).Return(true, nil),
 				ecsClient.EXPECT().CheckIfImageOwnedByAliCloud(imageId).Return(true, nil),
 			)
@@ -369,7 +369,7 @@ var _ = Describe("Mutating Shoot", func() {
 					},
 				),
 
-				alicloudClientFactory.EXPECT().NewECSClient(regionId, accessKeyID, accessKeySecret).Return(ecsClient, nil),
+				alicloudClientFactory.EXPECT().NewECSClient(regionId, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil),
 				ecsClient.EXPECT().CheckIfImageExists(imageId).Return(false, nil),
 			)
 			err := mutator.Mutate(ctx, newShoot, oldShoot)
@@ -461,6 +461,21 @@ var _ = Describe("Mutating Shoot", func() {
 			Expect(controllerutils.HasTask(newShoot.Annotations, v1beta1constants.ShootTaskDeployInfrastructure)).To(BeTrue())
 		})
 
+		It("should reconcile infra if the KMS key of an encrypted system disk is changed", func() {
+			oldShoot.Spec.Provider.Workers[0].Volume.Encrypted = ptr.To(true)
+			newShoot.Spec.Provider.Workers[0].Volume.Encrypted = ptr.To(true)
+			workerConfig, err := encodingjson.Marshal(&apisalicloudv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: apisalicloudv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+				Volume:   &apisalicloudv1alpha1.Volume{KMSKeyID: ptr.To("key-1")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			newShoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: workerConfig}
+
+			err = mutator.Mutate(ctx, newShoot, oldShoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controllerutils.HasTask(newShoot.Annotations, v1beta1constants.ShootTaskDeployInfrastructure)).To(BeTrue())
+		})
+
 		It("should reconcile infra if machine is changed to be encrypted", func() {
 			oldShoot.Spec.Provider.Workers[0].Volume.Encrypted = nil
 			newShoot.Spec.Provider.Workers[0].Volume.Encrypted = ptr.To(true)
@@ -555,7 +570,7 @@ var _ = Describe("Mutating Shoot", func() {
 					},
 				),
 
-				alicloudClientFactory.EXPECT().NewECSClient(regionId, accessKeyID, accessKeySecret).Return(ecsClient, nil),
+				alicloudClientFactory.EXPECT().NewECSClient(regionId, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil),
 				ecsClient.EXPECT().CheckIfImageExists(imageId).Return(true, nil),
 				ecsClient.EXPECT().CheckIfImageOwnedByAliCloud(imageId).Return(true, nil),
 			)
@@ -601,7 +616,7 @@ var _ = Describe("Mutating Shoot", func() {
 					},
 				),
 
-				alicloudClientFactory.EXPECT().NewECSClient(regionId, accessKeyID, accessKeySecret).Return(ecsClient, nil),
+				alicloudClientFactory.EXPECT().NewECSClient(regionId, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil),
 				ecsClient.EXPECT().CheckIfImageExists(imageId).Return(true, nil),
 				ecsClient.EXPECT().CheckIfImageOwnedByAliCloud(imageId).Return(true, nil),
 			)
@@ -701,7 +716,7 @@ var _ = Describe("Mutating Shoot", func() {
 					},
 				),
 
-				alicloudClientFactory.EXPECT().NewECSClient(regionId, accessKeyID, accessKeySecret).Return(ecsClient, nil),
+				alicloudClientFactory.EXPECT().NewECSClient(regionId, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil),
 				ecsClient.EXPECT().CheckIfImageExists(imageId).Return(true, nil),
 				ecsClient.EXPECT().CheckIfImageOwnedByAliCloud(imageId).Return(true, nil),
 			)
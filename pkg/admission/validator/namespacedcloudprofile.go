@@ -7,6 +7,7 @@ package validator
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -17,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -103,12 +105,16 @@ func (p *namespacedCloudProfile) validateMachineImages(providerConfig *api.Cloud
 		}
 		for _, version := range machineImage.Versions {
 			_, existsInParent := parentImages.GetImageVersion(machineImage.Name, version.Version)
-			_, exists := providerImages.GetImageVersion(machineImage.Name, version.Version)
+			providerVersion, exists := providerImages.GetImageVersion(machineImage.Name, version.Version)
 			if !existsInParent && !exists {
 				allErrs = append(allErrs, field.Required(
 					field.NewPath("spec.providerConfig.machineImages"),
 					fmt.Sprintf("machine image version %s@%s is not defined in the NamespacedCloudProfile providerConfig", machineImage.Name, version.Version),
 				))
+				continue
+			}
+			if exists {
+				allErrs = append(allErrs, validateImageVersionArchitectures(machineImage.Name, version, providerVersion)...)
 			}
 		}
 	}
@@ -146,6 +152,29 @@ func (p *namespacedCloudProfile) validateMachineImages(providerConfig *api.Cloud
 	return allErrs
 }
 
+// validateImageVersionArchitectures checks that the providerConfig contains a region mapping for every architecture
+// the machine image version is offered for.
+func validateImageVersionArchitectures(imageName string, version core.MachineImageVersion, providerVersion api.MachineImageVersion) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	architectures := version.Architectures
+	if len(architectures) == 0 {
+		architectures = []string{constants.ArchitectureAMD64}
+	}
+	for _, architecture := range architectures {
+		if !slices.ContainsFunc(providerVersion.Regions, func(region api.RegionIDMapping) bool {
+			return ptr.Deref(region.Architecture, constants.ArchitectureAMD64) == architecture
+		}) {
+			allErrs = append(allErrs, field.Required(
+				field.NewPath("spec.providerConfig.machineImages"),
+				fmt.Sprintf("machine image version %s@%s has no region mapping for architecture %s in the NamespacedCloudProfile providerConfig", imageName, version.Version, architecture),
+			))
+		}
+	}
+
+	return allErrs
+}
+
 func newProviderImagesContext(providerImages []api.MachineImages) *gutil.ImagesContext[api.MachineImages, api.MachineImageVersion] {
 	return gutil.NewImagesContext(
 		utils.CreateMapFromSlice(providerImages, func(mi api.MachineImages) string { return mi.Name }),
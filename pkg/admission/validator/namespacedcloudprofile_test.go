@@ -199,10 +199,10 @@ var _ = Describe("NamespacedCloudProfile Validator", func() {
   {"name":"image-1","versions":[
 	{"version":"1.1-regions","regions":[
       {"name":"image-region-1","id":"id-img-reg-1"},
-      {"name":"image-region-2","id":"id-img-reg-2"}
+      {"name":"image-region-2","id":"id-img-reg-2","architecture":"arm64"}
     ]},
     {"version":"1.1-fallback","regions":[
-      {"name":"image-region-2","id":"id-img-reg-2"}
+      {"name":"image-region-2","id":"id-img-reg-2","architecture":"arm64"}
     ]}
   ]}
 ]
@@ -228,6 +228,33 @@ var _ = Describe("NamespacedCloudProfile Validator", func() {
 			}))))
 		})
 
+		It("should fail for NamespacedCloudProfile specifying an architecture without a region mapping in the provider config", func() {
+			namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"machineImages":[
+  {"name":"image-1","versions":[{"version":"1.1","regions":[{"name":"eu1","id":"id-123"}]}]}
+]
+}`)}
+			namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
+				{
+					Name: "image-1",
+					Versions: []core.MachineImageVersion{
+						{ExpirableVersion: core.ExpirableVersion{Version: "1.1"}, Architectures: []string{"amd64", "arm64"}},
+					},
+				},
+			}
+
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			err := namespacedCloudProfileValidator.Validate(ctx, namespacedCloudProfile, nil)
+			Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("spec.providerConfig.machineImages"),
+				"Detail": Equal("machine image version image-1@1.1 has no region mapping for architecture arm64 in the NamespacedCloudProfile providerConfig"),
+			}))))
+		})
+
 		It("should fail for NamespacedCloudProfile specifying new spec.machineImages without the according version in the provider config", func() {
 			namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
 "apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1",
@@ -42,6 +42,15 @@ func checkAndDecodeInfrastructureConfig(decoder runtime.Decoder, config *runtime
 	return infraConfig, nil
 }
 
+func decodeWorkerConfig(decoder runtime.Decoder, worker *runtime.RawExtension, fldPath *field.Path) (*apisali.WorkerConfig, error) {
+	workerConfig := &apisali.WorkerConfig{}
+	if err := util.Decode(decoder, worker.Raw, workerConfig); err != nil {
+		return nil, field.Invalid(fldPath, string(worker.Raw), "isn't a supported version")
+	}
+
+	return workerConfig, nil
+}
+
 func decodeCloudProfileConfig(decoder runtime.Decoder, config *runtime.RawExtension) (*apisali.CloudProfileConfig, error) {
 	cloudProfileConfig := &apisali.CloudProfileConfig{}
 	if err := util.Decode(decoder, config.Raw, cloudProfileConfig); err != nil {
@@ -8,10 +8,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -75,17 +79,59 @@ func (s *shoot) Validate(ctx context.Context, newObject, oldObject client.Object
 	return s.validateShootCreation(ctx, shoot)
 }
 
-func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot, infraConfig *alicloud.InfrastructureConfig, cpConfig *alicloud.ControlPlaneConfig) error {
+func (s *shoot) validateShoot(ctx context.Context, shoot *core.Shoot, infraConfig *alicloud.InfrastructureConfig, cpConfig *alicloud.ControlPlaneConfig) error {
 	if infraConfig != nil {
 		// Provider validation
 		if errList := alicloudvalidation.ValidateInfrastructureConfig(infraConfig, shoot.Spec.Networking); len(errList) != 0 {
 			return errList.ToAggregate()
 		}
+		if errList := alicloudvalidation.ValidateTagAnnotations(shoot.Annotations, infraConfig, field.NewPath("metadata", "annotations")); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
+		if shoot.Spec.Networking != nil {
+			if errList := alicloudvalidation.ValidateIPFamilies(shoot.Spec.Networking.IPFamilies, infraConfig, networkingFldPath.Child("ipFamilies")); len(errList) != 0 {
+				return errList.ToAggregate()
+			}
+		}
 		// Shoot workers
 		if errList := alicloudvalidation.ValidateWorkers(shoot.Spec.Provider.Workers, infraConfig.Networks.Zones, workersFldPath); len(errList) != 0 {
 			return errList.ToAggregate()
 		}
 	}
+
+	// The cloud profile config is only fetched if a worker pool requests a RAM role.
+	var cloudProfileConfig *alicloud.CloudProfileConfig
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.ProviderConfig == nil {
+			continue
+		}
+
+		workerConfigFldPath := workersFldPath.Index(i).Child("providerConfig")
+		workerConfig, err := decodeWorkerConfig(s.decoder, worker.ProviderConfig, workerConfigFldPath)
+		if err != nil {
+			return err
+		}
+		if errList := alicloudvalidation.ValidateWorkerConfig(workerConfig, workerConfigFldPath); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
+		if placement := workerConfig.Placement; placement != nil && (placement.DedicatedHostID != nil || placement.DedicatedHostClusterID != nil) && len(worker.Zones) > 1 {
+			return field.Invalid(workersFldPath.Index(i).Child("zones"), worker.Zones, "worker pools placed on a dedicated host or host cluster must use exactly one zone")
+		}
+		if idx := slices.Index(workerConfig.FallbackInstanceTypes, worker.Machine.Type); idx >= 0 {
+			return field.Invalid(workerConfigFldPath.Child("fallbackInstanceTypes").Index(idx), worker.Machine.Type, "must differ from the machine type of the worker pool")
+		}
+		if errList := alicloudvalidation.ValidateWorkerConfigVolumes(workerConfig, worker.Volume, worker.DataVolumes, workerConfigFldPath); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
+		if workerConfig.RAMRoleName != nil && cloudProfileConfig == nil {
+			if cloudProfileConfig, err = s.getCloudProfileConfig(ctx, shoot); err != nil {
+				return err
+			}
+		}
+		if errList := alicloudvalidation.ValidateWorkerConfigRAMRoleName(workerConfig, cloudProfileConfig, workerConfigFldPath); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
+	}
 	if cpConfig != nil {
 		if errList := alicloudvalidation.ValidateControlPlaneConfig(cpConfig, shoot.Spec.Kubernetes.Version, cpConfigFldPath); len(errList) != 0 {
 			return errList.ToAggregate()
@@ -95,6 +141,36 @@ func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot, infraConfig
 	return nil
 }
 
+func (s *shoot) getCloudProfileConfig(ctx context.Context, shoot *core.Shoot) (*alicloud.CloudProfileConfig, error) {
+	cloudProfileReference := gardenerutils.BuildCoreCloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return nil, fmt.Errorf("could not determine cloud profile of shoot %q", client.ObjectKeyFromObject(shoot))
+	}
+
+	var cloudProfileSpec gardencorev1beta1.CloudProfileSpec
+	switch cloudProfileReference.Kind {
+	case v1beta1constants.CloudProfileReferenceKindCloudProfile:
+		cloudProfile := &gardencorev1beta1.CloudProfile{}
+		if err := s.client.Get(ctx, client.ObjectKey{Name: cloudProfileReference.Name}, cloudProfile); err != nil {
+			return nil, fmt.Errorf("could not get cloud profile %q: %w", cloudProfileReference.Name, err)
+		}
+		cloudProfileSpec = cloudProfile.Spec
+	case v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile:
+		namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+		if err := s.client.Get(ctx, client.ObjectKey{Name: cloudProfileReference.Name, Namespace: shoot.Namespace}, namespacedCloudProfile); err != nil {
+			return nil, fmt.Errorf("could not get namespaced cloud profile %q: %w", cloudProfileReference.Name, err)
+		}
+		cloudProfileSpec = namespacedCloudProfile.Status.CloudProfileSpec
+	default:
+		return nil, fmt.Errorf("unsupported cloud profile kind %q", cloudProfileReference.Kind)
+	}
+
+	if cloudProfileSpec.ProviderConfig == nil {
+		return &alicloud.CloudProfileConfig{}, nil
+	}
+	return decodeCloudProfileConfig(s.lenientDecoder, cloudProfileSpec.ProviderConfig)
+}
+
 func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.Shoot) error {
 	// Decode the new infrastructure config
 	infraConfig, err := checkAndDecodeInfrastructureConfig(s.decoder, shoot.Spec.Provider.InfrastructureConfig, infraConfigFldPath)
@@ -132,6 +208,30 @@ func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.S
 		return errList.ToAggregate()
 	}
 
+	for i, worker := range shoot.Spec.Provider.Workers {
+		idx := slices.IndexFunc(oldShoot.Spec.Provider.Workers, func(oldWorker core.Worker) bool { return oldWorker.Name == worker.Name })
+		if idx < 0 {
+			continue
+		}
+		oldWorker := oldShoot.Spec.Provider.Workers[idx]
+
+		workerFldPath := workersFldPath.Index(i)
+		var oldWorkerConfig, workerConfig *alicloud.WorkerConfig
+		if oldWorker.ProviderConfig != nil {
+			if oldWorkerConfig, err = decodeWorkerConfig(s.lenientDecoder, oldWorker.ProviderConfig, workerFldPath.Child("providerConfig")); err != nil {
+				return err
+			}
+		}
+		if worker.ProviderConfig != nil {
+			if workerConfig, err = decodeWorkerConfig(s.decoder, worker.ProviderConfig, workerFldPath.Child("providerConfig")); err != nil {
+				return err
+			}
+		}
+		if errList := alicloudvalidation.ValidateWorkerConfigUpdate(oldWorker, worker, oldWorkerConfig, workerConfig, workerFldPath); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
+	}
+
 	if errList := alicloudvalidation.ValidateNetworkingUpdate(oldShoot.Spec.Networking, shoot.Spec.Networking, networkingFldPath); len(errList) != 0 {
 		return errList.ToAggregate()
 	}
@@ -13,9 +13,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/privatelink"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/pvtz"
 	ram "github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
@@ -63,9 +67,17 @@ func NewClientFactoryWithRateLimit(limit rate.Limit, burst int, waitTimeout time
 	}
 }
 
-// NewOSSClient creates an new OSS client with given endpoint, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewOSSClient(endpoint, accessKeyID, accessKeySecret string) (OSS, error) {
-	client, err := oss.New(endpoint, accessKeyID, accessKeySecret)
+// NewOSSClient creates an new OSS client with given endpoint and credentials.
+func (f *clientFactory) NewOSSClient(endpoint string, credentials *alicloud.Credentials) (OSS, error) {
+	var options []oss.ClientOption
+	if credentials.RoleARN != "" {
+		provider, err := newOSSCredentialsProvider(credentials)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, oss.SetCredentialsProvider(provider))
+	}
+	client, err := oss.New(endpoint, credentials.AccessKeyID, credentials.AccessKeySecret, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +94,7 @@ func (f *clientFactory) NewOSSClientFromSecretRef(ctx context.Context, client cl
 		return nil, err
 	}
 
-	return f.NewOSSClient(ComputeStorageEndpoint(region), credentials.AccessKeyID, credentials.AccessKeySecret)
+	return f.NewOSSClient(ComputeStorageEndpoint(region), credentials)
 }
 
 // DeleteObjectsWithPrefix deletes the OSS objects with the specific <prefix> from <bucketName>.
@@ -247,9 +259,9 @@ func (c *ossClient) DeleteBucketIfExists(ctx context.Context, bucketName string)
 	return nil
 }
 
-// NewECSClient creates a new ECS client with given region, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewECSClient(region, accessKeyID, accessKeySecret string) (ECS, error) {
-	client, err := ecs.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+// NewECSClient creates a new ECS client with given region and credentials.
+func (f *clientFactory) NewECSClient(region string, credentials *alicloud.Credentials) (ECS, error) {
+	client, err := ecs.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
 	if err != nil {
 		return nil, err
 	}
@@ -484,9 +496,9 @@ func (c *ecsClient) DetachECSInstancesFromSSHKeyPair(keyName string) error {
 	return nil
 }
 
-// NewSTSClient creates a new STS client with given region, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewSTSClient(region, accessKeyID, accessKeySecret string) (STS, error) {
-	client, err := sts.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+// NewSTSClient creates a new STS client with given region and credentials.
+func (f *clientFactory) NewSTSClient(region string, credentials *alicloud.Credentials) (STS, error) {
+	client, err := sts.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
 	if err != nil {
 		return nil, err
 	}
@@ -507,9 +519,9 @@ func (c *stsClient) GetAccountIDFromCallerIdentity(_ context.Context) (string, e
 	return response.AccountId, nil
 }
 
-// NewSLBClient creates a new SLB client with given region, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewSLBClient(region, accessKeyID, accessKeySecret string) (SLB, error) {
-	client, err := slb.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+// NewSLBClient creates a new SLB client with given region and credentials.
+func (f *clientFactory) NewSLBClient(region string, credentials *alicloud.Credentials) (SLB, error) {
+	client, err := slb.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
 	if err != nil {
 		return nil, err
 	}
@@ -592,9 +604,38 @@ func (c *slbClient) SetLoadBalancerDeleteProtection(_ context.Context, region, l
 	return err
 }
 
-// NewSLBClient creates a new SLB client with given region, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewVPCClient(region, accessKeyID, accessKeySecret string) (VPC, error) {
-	client, err := vpc.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+// TagLoadBalancer adds the given tags to the load balancer with given region and loadBalancerID. Existing tags with the
+// same keys are overwritten.
+func (c *slbClient) TagLoadBalancer(_ context.Context, region, loadBalancerID string, tags map[string]string) error {
+	request := slb.CreateTagResourcesRequest()
+	request.SetScheme("HTTPS")
+	request.RegionId = region
+	request.ResourceType = "instance"
+	request.ResourceId = &[]string{loadBalancerID}
+	var requestTags []slb.TagResourcesTag
+	for key, value := range tags {
+		requestTags = append(requestTags, slb.TagResourcesTag{Key: key, Value: value})
+	}
+	request.Tag = &requestTags
+	_, err := c.Client.TagResources(request)
+	return err
+}
+
+// UntagLoadBalancer removes the tags with the given keys from the load balancer with given region and loadBalancerID.
+func (c *slbClient) UntagLoadBalancer(_ context.Context, region, loadBalancerID string, tagKeys []string) error {
+	request := slb.CreateUntagResourcesRequest()
+	request.SetScheme("HTTPS")
+	request.RegionId = region
+	request.ResourceType = "instance"
+	request.ResourceId = &[]string{loadBalancerID}
+	request.TagKey = &tagKeys
+	_, err := c.Client.UntagResources(request)
+	return err
+}
+
+// NewVPCClient creates a new VPC client with given region and credentials.
+func (f *clientFactory) NewVPCClient(region string, credentials *alicloud.Credentials) (VPC, error) {
+	client, err := vpc.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
 	if err != nil {
 		return nil, err
 	}
@@ -761,9 +802,9 @@ func (c *vpcClient) FetchEIPInternetChargeType(ctx context.Context, natGateway *
 	return eip[0].InternetChargeType, nil
 }
 
-// NewRAMClient creates a new RAM client with given region, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewRAMClient(region, accessKeyID, accessKeySecret string) (RAM, error) {
-	client, err := ram.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+// NewRAMClient creates a new RAM client with given region and credentials.
+func (f *clientFactory) NewRAMClient(region string, credentials *alicloud.Credentials) (RAM, error) {
+	client, err := ram.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
 	if err != nil {
 		return nil, err
 	}
@@ -773,9 +814,45 @@ func (f *clientFactory) NewRAMClient(region, accessKeyID, accessKeySecret string
 	}, nil
 }
 
-// NewROSClient creates a new ROS client with given region, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewROSClient(region, accessKeyID, accessKeySecret string) (ROS, error) {
-	return ros.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+// NewROSClient creates a new ROS client with given region and credentials.
+func (f *clientFactory) NewROSClient(region string, credentials *alicloud.Credentials) (ROS, error) {
+	return ros.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
+}
+
+// NewCBNClient creates a new CBN client with given region and credentials.
+func (f *clientFactory) NewCBNClient(region string, credentials *alicloud.Credentials) (CBN, error) {
+	client, err := cbn.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cbnClient{
+		*client,
+	}, nil
+}
+
+// NewPrivateLinkClient creates a new PrivateLink client with given region and credentials.
+func (f *clientFactory) NewPrivateLinkClient(region string, credentials *alicloud.Credentials) (PrivateLink, error) {
+	client, err := privatelink.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
+	if err != nil {
+		return nil, err
+	}
+
+	return &privateLinkClient{
+		*client,
+	}, nil
+}
+
+// NewPVTZClient creates a new PrivateZone client with given region and credentials.
+func (f *clientFactory) NewPVTZClient(region string, credentials *alicloud.Credentials) (PVTZ, error) {
+	client, err := pvtz.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pvtzClient{
+		*client,
+	}, nil
 }
 
 // GetServiceLinkedRole returns service linked role from Alicloud SDK calls with given role name.
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
@@ -35,40 +36,42 @@ func (e *RateLimiterWaitError) Error() string {
 	return fmt.Sprintf("could not wait for client-side aliyun dns rate limiter: %+v", e.Cause)
 }
 
-// NewDNSClient creates a new DNS client with given region, accessKeyID, and accessKeySecret.
-func (f *clientFactory) NewDNSClient(region, accessKeyID, accessKeySecret string) (DNS, error) {
-	client, err := alidns.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+// NewDNSClient creates a new DNS client with given region and credentials.
+func (f *clientFactory) NewDNSClient(region string, credentials *alicloud.Credentials) (DNS, error) {
+	client, err := alidns.NewClientWithOptions(region, sdk.NewConfig(), newCredential(credentials))
 	if err != nil {
 		return nil, err
 	}
 
+	// the account is identified by the access key and the assumed role, if any
+	account := credentials.AccessKeyID + "/" + credentials.RoleARN
 	return &dnsClient{
 		Client:                 *client,
-		accessKeyID:            accessKeyID,
+		account:                account,
 		domainsCache:           f.domainsCache,
 		domainsCacheMutex:      &f.domainsCacheMutex,
-		RateLimiter:            f.getRateLimiter(accessKeyID),
+		RateLimiter:            f.getRateLimiter(account),
 		RateLimiterWaitTimeout: f.waitTimeout,
 		Logger:                 log.Log.WithName("ali-dnsclient"),
 	}, nil
 }
-func (f *clientFactory) getRateLimiter(accessKeyID string) *rate.Limiter {
+func (f *clientFactory) getRateLimiter(account string) *rate.Limiter {
 	// cache.Expiring Get and Set methods are concurrency-safe
 	// However, if f rate limiter is not present in the cache, it may happen that multiple rate limiters are created
-	// at the same time for the same access key id, and the desired QPS is exceeded, so use f mutex to guard against this
+	// at the same time for the same account, and the desired QPS is exceeded, so use f mutex to guard against this
 
 	f.rateLimitersMutex.Lock()
 	defer f.rateLimitersMutex.Unlock()
 
 	// Get f rate limiter from the cache, or create f new one if not present
 	var rateLimiter *rate.Limiter
-	if v, ok := f.rateLimiters.Get(accessKeyID); ok {
+	if v, ok := f.rateLimiters.Get(account); ok {
 		rateLimiter = v.(*rate.Limiter)
 	} else {
 		rateLimiter = rate.NewLimiter(f.limit, f.burst)
 	}
 	// Set should be called on every Get with cache.Expiring to refresh the TTL
-	f.rateLimiters.Set(accessKeyID, rateLimiter, rateLimiterCacheTTL)
+	f.rateLimiters.Set(account, rateLimiter, rateLimiterCacheTTL)
 	return rateLimiter
 }
 
@@ -159,22 +162,22 @@ func (d *dnsClient) DeleteDomainRecords(ctx context.Context, domainName, name, r
 
 func (d *dnsClient) getDomainsWithCache(ctx context.Context) (map[string]alidns.Domain, error) {
 	// cache.Expiring Get and Set methods are concurrency-safe.
-	// However, if an accessKeyID is not present in the cache and multiple DNSRecords are reconciled at the same time,
+	// However, if an account is not present in the cache and multiple DNSRecords are reconciled at the same time,
 	// it may happen that getDomains is called multiple times instead of just one, so use a mutex to guard against this.
-	// It is ok to use a shared mutex here as far as the number of accessKeyIDs using custom domains is low.
-	// This may need to be revisited with a larger number of such accessKeyIDs to avoid them blocking each other
+	// It is ok to use a shared mutex here as far as the number of accounts using custom domains is low.
+	// This may need to be revisited with a larger number of such accounts to avoid them blocking each other
 	// during the (potentially long-running) call to getDomains.
 	d.domainsCacheMutex.Lock()
 	defer d.domainsCacheMutex.Unlock()
 
-	if v, ok := d.domainsCache.Get(d.accessKeyID); ok {
+	if v, ok := d.domainsCache.Get(d.account); ok {
 		return v.(map[string]alidns.Domain), nil
 	}
 	domains, err := d.getDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
-	d.domainsCache.Set(d.accessKeyID, domains, domainsCacheTTL)
+	d.domainsCache.Set(d.account, domains, domainsCacheTTL)
 	return domains, nil
 }
 
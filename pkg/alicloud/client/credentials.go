@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"sync"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	sdkcredentials "github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/signers"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+)
+
+// RoleSessionName is the name of the sessions of assumed RAM roles.
+const RoleSessionName = "gardener-extension-provider-alicloud"
+
+// newCredential returns the SDK credential for the given credentials. If a RAM role is given, the SDK clients assume
+// it with the access key and refresh the temporary credentials before they expire.
+func newCredential(credentials *alicloud.Credentials) auth.Credential {
+	if credentials.RoleARN == "" {
+		return sdkcredentials.NewAccessKeyCredential(credentials.AccessKeyID, credentials.AccessKeySecret)
+	}
+	return sdkcredentials.NewRamRoleArnWithPolicyAndExternalIdCredential(
+		credentials.AccessKeyID,
+		credentials.AccessKeySecret,
+		credentials.RoleARN,
+		RoleSessionName,
+		"",
+		credentials.ExternalID,
+		int(credentials.SessionDuration.Seconds()),
+	)
+}
+
+// ossCredentialsProvider provides the temporary credentials of an assumed RAM role to OSS clients, as the OSS SDK
+// cannot assume roles itself.
+type ossCredentialsProvider struct {
+	mutex  sync.Mutex
+	signer *signers.RamRoleArnSigner
+}
+
+var _ oss.CredentialsProvider = &ossCredentialsProvider{}
+
+func newOSSCredentialsProvider(credentials *alicloud.Credentials) (*ossCredentialsProvider, error) {
+	// the client is only used to call STS with the access key
+	stsClient, err := sdk.NewClientWithAccessKey("", credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := signers.NewRamRoleArnSigner(newCredential(credentials).(*sdkcredentials.RamRoleArnCredential), stsClient.ProcessCommonRequestWithSigner)
+	if err != nil {
+		return nil, err
+	}
+	return &ossCredentialsProvider{signer: signer}, nil
+}
+
+// GetCredentials returns the temporary credentials of the role, refreshing them if they are about to expire.
+func (p *ossCredentialsProvider) GetCredentials() oss.Credentials {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, err := p.signer.GetAccessKeyId(); err != nil {
+		// the request fails with the missing or expired credentials, so the error is only logged here
+		log.Log.WithName("ali-ossclient").Error(err, "Failed to assume RAM role")
+	}
+	credentials := &ossCredentials{}
+	if session := p.signer.GetSessionCredential(); session != nil {
+		credentials.accessKeyID, credentials.accessKeySecret, credentials.securityToken = session.AccessKeyId, session.AccessKeySecret, session.StsToken
+	}
+	return credentials
+}
+
+type ossCredentials struct {
+	accessKeyID     string
+	accessKeySecret string
+	securityToken   string
+}
+
+func (c *ossCredentials) GetAccessKeyID() string {
+	return c.accessKeyID
+}
+
+func (c *ossCredentials) GetAccessKeySecret() string {
+	return c.accessKeySecret
+}
+
+func (c *ossCredentials) GetSecurityToken() string {
+	return c.securityToken
+}
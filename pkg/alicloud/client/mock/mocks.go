@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	alicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	client "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
@@ -125,49 +126,64 @@ func (m *MockClientFactory) EXPECT() *MockClientFactoryMockRecorder {
 	return m.recorder
 }
 
+// NewCBNClient mocks base method.
+func (m *MockClientFactory) NewCBNClient(region string, credentials *alicloud.Credentials) (client.CBN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCBNClient", region, credentials)
+	ret0, _ := ret[0].(client.CBN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewCBNClient indicates an expected call of NewCBNClient.
+func (mr *MockClientFactoryMockRecorder) NewCBNClient(region, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCBNClient", reflect.TypeOf((*MockClientFactory)(nil).NewCBNClient), region, credentials)
+}
+
 // NewDNSClient mocks base method.
-func (m *MockClientFactory) NewDNSClient(region, accessKeyID, accessKeySecret string) (client.DNS, error) {
+func (m *MockClientFactory) NewDNSClient(region string, credentials *alicloud.Credentials) (client.DNS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewDNSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewDNSClient", region, credentials)
 	ret0, _ := ret[0].(client.DNS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewDNSClient indicates an expected call of NewDNSClient.
-func (mr *MockClientFactoryMockRecorder) NewDNSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewDNSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDNSClient", reflect.TypeOf((*MockClientFactory)(nil).NewDNSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDNSClient", reflect.TypeOf((*MockClientFactory)(nil).NewDNSClient), region, credentials)
 }
 
 // NewECSClient mocks base method.
-func (m *MockClientFactory) NewECSClient(region, accessKeyID, accessKeySecret string) (client.ECS, error) {
+func (m *MockClientFactory) NewECSClient(region string, credentials *alicloud.Credentials) (client.ECS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewECSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewECSClient", region, credentials)
 	ret0, _ := ret[0].(client.ECS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewECSClient indicates an expected call of NewECSClient.
-func (mr *MockClientFactoryMockRecorder) NewECSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewECSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewECSClient", reflect.TypeOf((*MockClientFactory)(nil).NewECSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewECSClient", reflect.TypeOf((*MockClientFactory)(nil).NewECSClient), region, credentials)
 }
 
 // NewOSSClient mocks base method.
-func (m *MockClientFactory) NewOSSClient(endpoint, accessKeyID, accessKeySecret string) (client.OSS, error) {
+func (m *MockClientFactory) NewOSSClient(endpoint string, credentials *alicloud.Credentials) (client.OSS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewOSSClient", endpoint, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewOSSClient", endpoint, credentials)
 	ret0, _ := ret[0].(client.OSS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewOSSClient indicates an expected call of NewOSSClient.
-func (mr *MockClientFactoryMockRecorder) NewOSSClient(endpoint, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewOSSClient(endpoint, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOSSClient", reflect.TypeOf((*MockClientFactory)(nil).NewOSSClient), endpoint, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOSSClient", reflect.TypeOf((*MockClientFactory)(nil).NewOSSClient), endpoint, credentials)
 }
 
 // NewOSSClientFromSecretRef mocks base method.
@@ -185,77 +201,107 @@ func (mr *MockClientFactoryMockRecorder) NewOSSClientFromSecretRef(ctx, c, secre
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOSSClientFromSecretRef", reflect.TypeOf((*MockClientFactory)(nil).NewOSSClientFromSecretRef), ctx, c, secretRef, region)
 }
 
+// NewPVTZClient mocks base method.
+func (m *MockClientFactory) NewPVTZClient(region string, credentials *alicloud.Credentials) (client.PVTZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPVTZClient", region, credentials)
+	ret0, _ := ret[0].(client.PVTZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewPVTZClient indicates an expected call of NewPVTZClient.
+func (mr *MockClientFactoryMockRecorder) NewPVTZClient(region, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPVTZClient", reflect.TypeOf((*MockClientFactory)(nil).NewPVTZClient), region, credentials)
+}
+
+// NewPrivateLinkClient mocks base method.
+func (m *MockClientFactory) NewPrivateLinkClient(region string, credentials *alicloud.Credentials) (client.PrivateLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPrivateLinkClient", region, credentials)
+	ret0, _ := ret[0].(client.PrivateLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewPrivateLinkClient indicates an expected call of NewPrivateLinkClient.
+func (mr *MockClientFactoryMockRecorder) NewPrivateLinkClient(region, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPrivateLinkClient", reflect.TypeOf((*MockClientFactory)(nil).NewPrivateLinkClient), region, credentials)
+}
+
 // NewRAMClient mocks base method.
-func (m *MockClientFactory) NewRAMClient(region, accessKeyID, accessKeySecret string) (client.RAM, error) {
+func (m *MockClientFactory) NewRAMClient(region string, credentials *alicloud.Credentials) (client.RAM, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewRAMClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewRAMClient", region, credentials)
 	ret0, _ := ret[0].(client.RAM)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewRAMClient indicates an expected call of NewRAMClient.
-func (mr *MockClientFactoryMockRecorder) NewRAMClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewRAMClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRAMClient", reflect.TypeOf((*MockClientFactory)(nil).NewRAMClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRAMClient", reflect.TypeOf((*MockClientFactory)(nil).NewRAMClient), region, credentials)
 }
 
 // NewROSClient mocks base method.
-func (m *MockClientFactory) NewROSClient(region, accessKeyID, accessKeySecret string) (client.ROS, error) {
+func (m *MockClientFactory) NewROSClient(region string, credentials *alicloud.Credentials) (client.ROS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewROSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewROSClient", region, credentials)
 	ret0, _ := ret[0].(client.ROS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewROSClient indicates an expected call of NewROSClient.
-func (mr *MockClientFactoryMockRecorder) NewROSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewROSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewROSClient", reflect.TypeOf((*MockClientFactory)(nil).NewROSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewROSClient", reflect.TypeOf((*MockClientFactory)(nil).NewROSClient), region, credentials)
 }
 
 // NewSLBClient mocks base method.
-func (m *MockClientFactory) NewSLBClient(region, accessKeyID, accessKeySecret string) (client.SLB, error) {
+func (m *MockClientFactory) NewSLBClient(region string, credentials *alicloud.Credentials) (client.SLB, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSLBClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewSLBClient", region, credentials)
 	ret0, _ := ret[0].(client.SLB)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewSLBClient indicates an expected call of NewSLBClient.
-func (mr *MockClientFactoryMockRecorder) NewSLBClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewSLBClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLBClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLBClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLBClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLBClient), region, credentials)
 }
 
 // NewSTSClient mocks base method.
-func (m *MockClientFactory) NewSTSClient(region, accessKeyID, accessKeySecret string) (client.STS, error) {
+func (m *MockClientFactory) NewSTSClient(region string, credentials *alicloud.Credentials) (client.STS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSTSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewSTSClient", region, credentials)
 	ret0, _ := ret[0].(client.STS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewSTSClient indicates an expected call of NewSTSClient.
-func (mr *MockClientFactoryMockRecorder) NewSTSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewSTSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSTSClient", reflect.TypeOf((*MockClientFactory)(nil).NewSTSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSTSClient", reflect.TypeOf((*MockClientFactory)(nil).NewSTSClient), region, credentials)
 }
 
 // NewVPCClient mocks base method.
-func (m *MockClientFactory) NewVPCClient(region, accessKeyID, accessKeySecret string) (client.VPC, error) {
+func (m *MockClientFactory) NewVPCClient(region string, credentials *alicloud.Credentials) (client.VPC, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewVPCClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewVPCClient", region, credentials)
 	ret0, _ := ret[0].(client.VPC)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewVPCClient indicates an expected call of NewVPCClient.
-func (mr *MockClientFactoryMockRecorder) NewVPCClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewVPCClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVPCClient", reflect.TypeOf((*MockClientFactory)(nil).NewVPCClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVPCClient", reflect.TypeOf((*MockClientFactory)(nil).NewVPCClient), region, credentials)
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 )
 
 // Client is the sdk client struct, each func corresponds to an OpenAPI
@@ -37,3 +38,12 @@ func NewClientWithAccessKey(regionId, accessKeyId, accessKeySecret string) (clie
 	SetEndpointDataToClient(client)
 	return
 }
+
+// NewClientWithOptions creates a sdk client with regionId/sdkConfig/credential
+// this is the common api to create a sdk client
+func NewClientWithOptions(regionId string, config *sdk.Config, credential auth.Credential) (client *Client, err error) {
+	client = &Client{}
+	err = client.InitWithOptions(regionId, config, credential)
+	SetEndpointDataToClient(client)
+	return
+}
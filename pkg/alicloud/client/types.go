@@ -10,7 +10,10 @@ import (
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/privatelink"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/pvtz"
 	ram "github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
@@ -22,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	ros "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/ros"
 )
 
@@ -52,15 +56,18 @@ const (
 
 // ClientFactory is the new factory to instantiate Alicloud clients.
 type ClientFactory interface {
-	NewECSClient(region, accessKeyID, accessKeySecret string) (ECS, error)
-	NewSTSClient(region, accessKeyID, accessKeySecret string) (STS, error)
-	NewSLBClient(region, accessKeyID, accessKeySecret string) (SLB, error)
-	NewVPCClient(region, accessKeyID, accessKeySecret string) (VPC, error)
-	NewRAMClient(region, accessKeyID, accessKeySecret string) (RAM, error)
-	NewROSClient(region, accessKeyID, accessKeySecret string) (ROS, error)
-	NewOSSClient(endpoint, accessKeyID, accessKeySecret string) (OSS, error)
+	NewECSClient(region string, credentials *alicloud.Credentials) (ECS, error)
+	NewSTSClient(region string, credentials *alicloud.Credentials) (STS, error)
+	NewSLBClient(region string, credentials *alicloud.Credentials) (SLB, error)
+	NewVPCClient(region string, credentials *alicloud.Credentials) (VPC, error)
+	NewRAMClient(region string, credentials *alicloud.Credentials) (RAM, error)
+	NewROSClient(region string, credentials *alicloud.Credentials) (ROS, error)
+	NewCBNClient(region string, credentials *alicloud.Credentials) (CBN, error)
+	NewPrivateLinkClient(region string, credentials *alicloud.Credentials) (PrivateLink, error)
+	NewPVTZClient(region string, credentials *alicloud.Credentials) (PVTZ, error)
+	NewOSSClient(endpoint string, credentials *alicloud.Credentials) (OSS, error)
 	NewOSSClientFromSecretRef(ctx context.Context, c client.Client, secretRef *corev1.SecretReference, region string) (OSS, error)
-	NewDNSClient(region, accessKeyID, accessKeySecret string) (DNS, error)
+	NewDNSClient(region string, credentials *alicloud.Credentials) (DNS, error)
 }
 
 // ecsClient implements the ECS interface.
@@ -78,11 +85,13 @@ type ECS interface {
 	GetSecurityGroupWithID(id string) (*ecs.DescribeSecurityGroupsResponse, error)
 	DescribeSecurityGroups(request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error)
 	DescribeSecurityGroupAttribute(request *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error)
+	DescribeInstances(request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error)
 	DescribeKeyPairs(request *ecs.DescribeKeyPairsRequest) (*ecs.DescribeKeyPairsResponse, error)
 	DetachECSInstancesFromSSHKeyPair(keyName string) error
 	GetInstances(name string) (*ecs.DescribeInstancesResponse, error)
 	GetAvailableInstanceType(core int, zoneID string) (*ecs.DescribeAvailableResourceResponse, error)
 	ListAllInstanceType() (*ecs.DescribeInstanceTypesResponse, error)
+	DescribeInstanceTypes(request *ecs.DescribeInstanceTypesRequest) (response *ecs.DescribeInstanceTypesResponse, err error)
 	CreateInstances(instanceName, securityGroupID, imageID, vSwitchId, zoneID, instanceTypeID, userData string) (*ecs.RunInstancesResponse, error)
 	DeleteInstances(id string, force bool) error
 	CreateSecurityGroups(vpcId, name string) (*ecs.CreateSecurityGroupResponse, error)
@@ -103,6 +112,12 @@ type ECS interface {
 	ListTagResources(request *ecs.ListTagResourcesRequest) (response *ecs.ListTagResourcesResponse, err error)
 	TagResources(request *ecs.TagResourcesRequest) (response *ecs.TagResourcesResponse, err error)
 	UntagResources(request *ecs.UntagResourcesRequest) (response *ecs.UntagResourcesResponse, err error)
+
+	CreateDeploymentSet(request *ecs.CreateDeploymentSetRequest) (response *ecs.CreateDeploymentSetResponse, err error)
+	DescribeDeploymentSets(request *ecs.DescribeDeploymentSetsRequest) (response *ecs.DescribeDeploymentSetsResponse, err error)
+	DeleteDeploymentSet(request *ecs.DeleteDeploymentSetRequest) (response *ecs.DeleteDeploymentSetResponse, err error)
+
+	JoinResourceGroup(request *ecs.JoinResourceGroupRequest) (response *ecs.JoinResourceGroupResponse, err error)
 }
 
 // stsClient implements the STS interface.
@@ -126,6 +141,8 @@ type SLB interface {
 	GetFirstVServerGroupName(ctx context.Context, region, loadBalancerID string) (string, error)
 	DeleteLoadBalancer(ctx context.Context, region, loadBalancerID string) error
 	SetLoadBalancerDeleteProtection(ctx context.Context, region, loadBalancerID string, protection bool) error
+	TagLoadBalancer(ctx context.Context, region, loadBalancerID string, tags map[string]string) error
+	UntagLoadBalancer(ctx context.Context, region, loadBalancerID string, tagKeys []string) error
 }
 
 // vpcClient implements the VPC interface.
@@ -150,24 +167,91 @@ type VPC interface {
 	TagResources(request *vpc.TagResourcesRequest) (response *vpc.TagResourcesResponse, err error)
 	UnTagResources(request *vpc.UnTagResourcesRequest) (response *vpc.UnTagResourcesResponse, err error)
 	ListTagResources(request *vpc.ListTagResourcesRequest) (response *vpc.ListTagResourcesResponse, err error)
+	DescribeTagKeys(request *vpc.DescribeTagKeysRequest) (response *vpc.DescribeTagKeysResponse, err error)
 	DeleteVpc(request *vpc.DeleteVpcRequest) (response *vpc.DeleteVpcResponse, err error)
+	ModifyVpcAttribute(request *vpc.ModifyVpcAttributeRequest) (response *vpc.ModifyVpcAttributeResponse, err error)
 	CreateVSwitch(request *vpc.CreateVSwitchRequest) (response *vpc.CreateVSwitchResponse, err error)
 	DescribeVSwitches(request *vpc.DescribeVSwitchesRequest) (response *vpc.DescribeVSwitchesResponse, err error)
 	GetVSwitchesInfoByID(name string) (*VSwitchInfo, error)
 	DeleteVSwitch(request *vpc.DeleteVSwitchRequest) (response *vpc.DeleteVSwitchResponse, err error)
+	ModifyVSwitchAttribute(request *vpc.ModifyVSwitchAttributeRequest) (response *vpc.ModifyVSwitchAttributeResponse, err error)
+	CreateIpv6Gateway(request *vpc.CreateIpv6GatewayRequest) (response *vpc.CreateIpv6GatewayResponse, err error)
+	DescribeIpv6Gateways(request *vpc.DescribeIpv6GatewaysRequest) (response *vpc.DescribeIpv6GatewaysResponse, err error)
+	DeleteIpv6Gateway(request *vpc.DeleteIpv6GatewayRequest) (response *vpc.DeleteIpv6GatewayResponse, err error)
 	CreateNatGateway(request *vpc.CreateNatGatewayRequest) (response *vpc.CreateNatGatewayResponse, err error)
 	DescribeNatGateways(request *vpc.DescribeNatGatewaysRequest) (response *vpc.DescribeNatGatewaysResponse, err error)
 	DeleteNatGateway(request *vpc.DeleteNatGatewayRequest) (response *vpc.DeleteNatGatewayResponse, err error)
+	ModifyNatGatewaySpec(request *vpc.ModifyNatGatewaySpecRequest) (response *vpc.ModifyNatGatewaySpecResponse, err error)
 	DescribeSnatTableEntries(request *vpc.DescribeSnatTableEntriesRequest) (response *vpc.DescribeSnatTableEntriesResponse, err error)
 	DescribeEipAddresses(request *vpc.DescribeEipAddressesRequest) (response *vpc.DescribeEipAddressesResponse, err error)
+	DescribeRouteTableList(request *vpc.DescribeRouteTableListRequest) (response *vpc.DescribeRouteTableListResponse, err error)
+	CreateVpcGatewayEndpoint(request *vpc.CreateVpcGatewayEndpointRequest) (response *vpc.CreateVpcGatewayEndpointResponse, err error)
+	ListVpcGatewayEndpoints(request *vpc.ListVpcGatewayEndpointsRequest) (response *vpc.ListVpcGatewayEndpointsResponse, err error)
+	AssociateRouteTablesWithVpcGatewayEndpoint(request *vpc.AssociateRouteTablesWithVpcGatewayEndpointRequest) (response *vpc.AssociateRouteTablesWithVpcGatewayEndpointResponse, err error)
+	DissociateRouteTablesFromVpcGatewayEndpoint(request *vpc.DissociateRouteTablesFromVpcGatewayEndpointRequest) (response *vpc.DissociateRouteTablesFromVpcGatewayEndpointResponse, err error)
+	DeleteVpcGatewayEndpoint(request *vpc.DeleteVpcGatewayEndpointRequest) (response *vpc.DeleteVpcGatewayEndpointResponse, err error)
 
 	AllocateEipAddress(request *vpc.AllocateEipAddressRequest) (response *vpc.AllocateEipAddressResponse, err error)
 	ReleaseEipAddress(request *vpc.ReleaseEipAddressRequest) (response *vpc.ReleaseEipAddressResponse, err error)
 	ModifyEipAddressAttribute(request *vpc.ModifyEipAddressAttributeRequest) (response *vpc.ModifyEipAddressAttributeResponse, err error)
 	AssociateEipAddress(request *vpc.AssociateEipAddressRequest) (response *vpc.AssociateEipAddressResponse, err error)
 	UnassociateEipAddress(request *vpc.UnassociateEipAddressRequest) (response *vpc.UnassociateEipAddressResponse, err error)
+	AddCommonBandwidthPackageIp(request *vpc.AddCommonBandwidthPackageIpRequest) (response *vpc.AddCommonBandwidthPackageIpResponse, err error)
+	RemoveCommonBandwidthPackageIp(request *vpc.RemoveCommonBandwidthPackageIpRequest) (response *vpc.RemoveCommonBandwidthPackageIpResponse, err error)
 	CreateSnatEntry(request *vpc.CreateSnatEntryRequest) (response *vpc.CreateSnatEntryResponse, err error)
 	DeleteSnatEntry(request *vpc.DeleteSnatEntryRequest) (response *vpc.DeleteSnatEntryResponse, err error)
+	MoveResourceGroup(request *vpc.MoveResourceGroupRequest) (response *vpc.MoveResourceGroupResponse, err error)
+}
+
+// cbnClient implements the CBN interface.
+type cbnClient struct {
+	cbn.Client
+}
+
+// CBN is an interface which declares Cloud Enterprise Network related methods.
+type CBN interface {
+	AttachCenChildInstance(request *cbn.AttachCenChildInstanceRequest) (response *cbn.AttachCenChildInstanceResponse, err error)
+	DetachCenChildInstance(request *cbn.DetachCenChildInstanceRequest) (response *cbn.DetachCenChildInstanceResponse, err error)
+	DescribeCenAttachedChildInstances(request *cbn.DescribeCenAttachedChildInstancesRequest) (response *cbn.DescribeCenAttachedChildInstancesResponse, err error)
+	CreateTransitRouterVpcAttachment(request *cbn.CreateTransitRouterVpcAttachmentRequest) (response *cbn.CreateTransitRouterVpcAttachmentResponse, err error)
+	ListTransitRouterVpcAttachments(request *cbn.ListTransitRouterVpcAttachmentsRequest) (response *cbn.ListTransitRouterVpcAttachmentsResponse, err error)
+	DeleteTransitRouterVpcAttachment(request *cbn.DeleteTransitRouterVpcAttachmentRequest) (response *cbn.DeleteTransitRouterVpcAttachmentResponse, err error)
+	UpdateTransitRouterVpcAttachmentZones(request *cbn.UpdateTransitRouterVpcAttachmentZonesRequest) (response *cbn.UpdateTransitRouterVpcAttachmentZonesResponse, err error)
+	CreateTransitRouterRouteEntry(request *cbn.CreateTransitRouterRouteEntryRequest) (response *cbn.CreateTransitRouterRouteEntryResponse, err error)
+	ListTransitRouterRouteEntries(request *cbn.ListTransitRouterRouteEntriesRequest) (response *cbn.ListTransitRouterRouteEntriesResponse, err error)
+	DeleteTransitRouterRouteEntry(request *cbn.DeleteTransitRouterRouteEntryRequest) (response *cbn.DeleteTransitRouterRouteEntryResponse, err error)
+}
+
+// privateLinkClient implements the PrivateLink interface.
+type privateLinkClient struct {
+	privatelink.Client
+}
+
+// PrivateLink is an interface which declares PrivateLink (VPC endpoint) related methods.
+type PrivateLink interface {
+	CreateVpcEndpoint(request *privatelink.CreateVpcEndpointRequest) (response *privatelink.CreateVpcEndpointResponse, err error)
+	ListVpcEndpoints(request *privatelink.ListVpcEndpointsRequest) (response *privatelink.ListVpcEndpointsResponse, err error)
+	DeleteVpcEndpoint(request *privatelink.DeleteVpcEndpointRequest) (response *privatelink.DeleteVpcEndpointResponse, err error)
+	ListVpcEndpointZones(request *privatelink.ListVpcEndpointZonesRequest) (response *privatelink.ListVpcEndpointZonesResponse, err error)
+	AddZoneToVpcEndpoint(request *privatelink.AddZoneToVpcEndpointRequest) (response *privatelink.AddZoneToVpcEndpointResponse, err error)
+}
+
+// pvtzClient implements the PVTZ interface.
+type pvtzClient struct {
+	pvtz.Client
+}
+
+// PVTZ is an interface which declares PrivateZone (private DNS) related methods.
+type PVTZ interface {
+	AddZone(request *pvtz.AddZoneRequest) (response *pvtz.AddZoneResponse, err error)
+	DescribeZones(request *pvtz.DescribeZonesRequest) (response *pvtz.DescribeZonesResponse, err error)
+	DescribeZoneInfo(request *pvtz.DescribeZoneInfoRequest) (response *pvtz.DescribeZoneInfoResponse, err error)
+	BindZoneVpc(request *pvtz.BindZoneVpcRequest) (response *pvtz.BindZoneVpcResponse, err error)
+	AddZoneRecord(request *pvtz.AddZoneRecordRequest) (response *pvtz.AddZoneRecordResponse, err error)
+	DescribeZoneRecords(request *pvtz.DescribeZoneRecordsRequest) (response *pvtz.DescribeZoneRecordsResponse, err error)
+	UpdateZoneRecord(request *pvtz.UpdateZoneRecordRequest) (response *pvtz.UpdateZoneRecordResponse, err error)
+	TagResources(request *pvtz.TagResourcesRequest) (response *pvtz.TagResourcesResponse, err error)
+	DeleteZone(request *pvtz.DeleteZoneRequest) (response *pvtz.DeleteZoneResponse, err error)
 }
 
 // ramClient implements the RAM interface.
@@ -225,7 +309,7 @@ type VSwitchInfo struct {
 // dnsClient implements the DNS interface.
 type dnsClient struct {
 	alidns.Client
-	accessKeyID            string
+	account                string
 	domainsCache           *cache.Expiring
 	domainsCacheMutex      *sync.Mutex
 	RateLimiter            *rate.Limiter
@@ -7,6 +7,7 @@ package alicloud
 import (
 	"context"
 	"fmt"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	corev1 "k8s.io/api/core/v1"
@@ -19,6 +20,13 @@ type Credentials struct {
 	AccessKeyID     string
 	AccessKeySecret string
 	CredentialsFile string
+	// RoleARN is the ARN of a RAM role which is assumed with the access key. If set, the clients use the temporary
+	// credentials of the role instead of the access key.
+	RoleARN string
+	// ExternalID is the external id passed to STS when assuming the role.
+	ExternalID string
+	// SessionDuration is the duration of the sessions of the assumed role.
+	SessionDuration time.Duration
 }
 
 const (
@@ -28,6 +36,18 @@ const (
 	AccessKeySecret = "accessKeySecret"
 	// CredentialsFile is a constant for the key in cloud provider secret that holds the Alibaba Cloud credentials file.
 	CredentialsFile = "credentialsFile"
+	// RoleARN is the data field in a secret where the ARN of the RAM role to be assumed is stored at.
+	RoleARN = "roleARN"
+	// ExternalID is the data field in a secret where the external id for assuming the RAM role is stored at.
+	ExternalID = "externalID"
+	// SessionDuration is the data field in a secret where the duration of the sessions of the assumed RAM role is
+	// stored at, e.g. `30m`.
+	SessionDuration = "sessionDuration"
+
+	// MinSessionDuration is the minimum duration of the sessions of an assumed RAM role.
+	MinSessionDuration = 15 * time.Minute
+	// MaxSessionDuration is the maximum duration of the sessions of an assumed RAM role supported by the Alicloud SDK.
+	MaxSessionDuration = time.Hour
 
 	// dnsAccessKeyID is the data field in a DNS secret where the access key id is stored at.
 	dnsAccessKeyID = "ACCESS_KEY_ID"
@@ -56,19 +76,42 @@ func ReadSecretCredentials(secret *corev1.Secret, allowDNSKeys bool) (*Credentia
 		return nil, fmt.Errorf("secret %s/%s has no access key secret", secret.Namespace, secret.Name)
 	}
 
-	credentialsFile, ok := getSecretDataValue(secret, CredentialsFile, nil)
-	if ok {
-		return &Credentials{
-			AccessKeyID:     string(accessKeyID),
-			AccessKeySecret: string(accessKeySecret),
-			CredentialsFile: string(credentialsFile),
-		}, nil
-	}
-
-	return &Credentials{
+	credentials := &Credentials{
 		AccessKeyID:     string(accessKeyID),
 		AccessKeySecret: string(accessKeySecret),
-	}, nil
+	}
+	if credentialsFile, ok := getSecretDataValue(secret, CredentialsFile, nil); ok {
+		credentials.CredentialsFile = string(credentialsFile)
+	}
+	if err := readRoleCredentials(secret, credentials); err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+func readRoleCredentials(secret *corev1.Secret, credentials *Credentials) error {
+	if roleARN, ok := getSecretDataValue(secret, RoleARN, nil); ok {
+		credentials.RoleARN = string(roleARN)
+	}
+	if externalID, ok := getSecretDataValue(secret, ExternalID, nil); ok {
+		credentials.ExternalID = string(externalID)
+	}
+	if sessionDuration, ok := getSecretDataValue(secret, SessionDuration, nil); ok {
+		duration, err := time.ParseDuration(string(sessionDuration))
+		if err != nil {
+			return fmt.Errorf("secret %s/%s has an invalid session duration: %w", secret.Namespace, secret.Name, err)
+		}
+		if duration < MinSessionDuration || duration > MaxSessionDuration {
+			return fmt.Errorf("secret %s/%s has a session duration outside of [%s, %s]", secret.Namespace, secret.Name, MinSessionDuration, MaxSessionDuration)
+		}
+		credentials.SessionDuration = duration
+	}
+
+	if credentials.RoleARN == "" && (credentials.ExternalID != "" || credentials.SessionDuration != 0) {
+		return fmt.Errorf("secret %s/%s has an external id or session duration but no role ARN", secret.Namespace, secret.Name)
+	}
+	return nil
 }
 
 // ReadCredentialsFromSecretRef reads the credentials from the secret referred by given <secretRef>.
@@ -5,6 +5,8 @@
 package alicloud
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -100,6 +102,67 @@ var _ = Describe("Alicloud Suite", func() {
 			})
 		})
 
+		Context("RAM role", func() {
+			It("should read the role fields", func() {
+				creds, err := ReadSecretCredentials(&corev1.Secret{
+					Data: map[string][]byte{
+						AccessKeyID:     []byte(accessKeyID),
+						AccessKeySecret: []byte(accessKeySecret),
+						RoleARN:         []byte("acs:ram::1234567890123456:role/gardener"),
+						ExternalID:      []byte("abcd1234"),
+						SessionDuration: []byte("30m"),
+					},
+				}, false)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(creds).To(Equal(&Credentials{
+					AccessKeyID:     accessKeyID,
+					AccessKeySecret: accessKeySecret,
+					RoleARN:         "acs:ram::1234567890123456:role/gardener",
+					ExternalID:      "abcd1234",
+					SessionDuration: 30 * time.Minute,
+				}))
+			})
+
+			It("should fail if the session duration is invalid", func() {
+				_, err := ReadSecretCredentials(&corev1.Secret{
+					Data: map[string][]byte{
+						AccessKeyID:     []byte(accessKeyID),
+						AccessKeySecret: []byte(accessKeySecret),
+						RoleARN:         []byte("acs:ram::1234567890123456:role/gardener"),
+						SessionDuration: []byte("30"),
+					},
+				}, false)
+
+				Expect(err).To(MatchError(ContainSubstring("invalid session duration")))
+			})
+
+			It("should fail if the session duration is out of range", func() {
+				_, err := ReadSecretCredentials(&corev1.Secret{
+					Data: map[string][]byte{
+						AccessKeyID:     []byte(accessKeyID),
+						AccessKeySecret: []byte(accessKeySecret),
+						RoleARN:         []byte("acs:ram::1234567890123456:role/gardener"),
+						SessionDuration: []byte("5m"),
+					},
+				}, false)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail if an external id is given without role ARN", func() {
+				_, err := ReadSecretCredentials(&corev1.Secret{
+					Data: map[string][]byte{
+						AccessKeyID:     []byte(accessKeyID),
+						AccessKeySecret: []byte(accessKeySecret),
+						ExternalID:      []byte("abcd1234"),
+					},
+				}, false)
+
+				Expect(err).To(MatchError(ContainSubstring("no role ARN")))
+			})
+		})
+
 		It("should fail if the data section is nil", func() {
 			_, err := ReadSecretCredentials(&corev1.Secret{}, false)
 			Expect(err).To(HaveOccurred())
@@ -14,6 +14,15 @@ const (
 	SeedAnnotationKeyUseFlow = AnnotationKeyUseFlow
 	// SeedAnnotationUseFlowValueNew is the value to restrict flow reconciliation to new shoot clusters
 	SeedAnnotationUseFlowValueNew = "new"
+	// AnnotationKeyPrefixTag is the prefix of shoot annotations whose values are added as user-defined tags to all
+	// resources of the shoot. The tag key is the remainder of the annotation key, e.g. the annotation
+	// `tags.alicloud.provider.extensions.gardener.cloud/CostCenter: "4711"` results in the tag `CostCenter: 4711`.
+	AnnotationKeyPrefixTag = "tags.alicloud.provider.extensions.gardener.cloud/"
+	// AnnotationKeyPlan is the annotation key used to reconcile an Infrastructure in plan mode. If its value is `true`,
+	// the flow reconciler only computes the changes it would apply and stores them in the provider status without
+	// modifying the infrastructure. The reconciliation is not completed afterwards, so that the Infrastructure does not
+	// become ready.
+	AnnotationKeyPlan = "alicloud.provider.extensions.gardener.cloud/plan"
 )
 
 var (
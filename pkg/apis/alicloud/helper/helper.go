@@ -6,7 +6,9 @@ package helper
 
 import (
 	"fmt"
+	"strings"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
@@ -36,6 +38,36 @@ func FindVSwitchForPurpose(vswitches []api.VSwitch, purpose api.Purpose) (*api.V
 	return nil, fmt.Errorf("no vswitch with purpose %q found", purpose)
 }
 
+// GetWorkersIPv6Index returns the index of the IPv6 CIDR block of the workers vswitch of the given zone, which is the
+// configured index or, if not configured, the position of the zone in the list of zones.
+func GetWorkersIPv6Index(zone api.Zone, position int) int32 {
+	return ptr.Deref(zone.WorkersIPv6Index, int32(position)) // #nosec: G115
+}
+
+// GetEIPAllocationIDs returns the IDs of the existing EIPs configured for the given NAT gateway config. It returns nil
+// if the EIPs are managed by Gardener.
+func GetEIPAllocationIDs(natGateway *api.NatGatewayConfig) []string {
+	if natGateway == nil {
+		return nil
+	}
+	if natGateway.EIPAllocationID != nil {
+		return []string{*natGateway.EIPAllocationID}
+	}
+	return natGateway.EIPAllocationIDs
+}
+
+// GetManagedEIPCount returns the number of EIPs Gardener has to create for the given NAT gateway config, which is zero
+// if existing EIPs are configured and the configured count (defaulting to 1) otherwise.
+func GetManagedEIPCount(natGateway *api.NatGatewayConfig) int {
+	if len(GetEIPAllocationIDs(natGateway)) > 0 {
+		return 0
+	}
+	if natGateway == nil || natGateway.EIPCount == nil {
+		return 1
+	}
+	return int(*natGateway.EIPCount)
+}
+
 // FindSecurityGroupByPurpose takes a list of security groups and tries to find the first entry
 // whose purpose matches with the given purpose. If no such entry is found then an error will be
 // returned.
@@ -58,39 +90,130 @@ func matchEncryptedFlag(encrypted *bool, expectEncrypted bool) bool {
 }
 
 // FindMachineImage takes a list of machine images and tries to find the first entry
-// whose name, version and encrypted flag matches with the given name, version and encrypted flag.
+// whose name, version, encrypted flag, KMS key and architecture matches with the given name, version, encrypted flag,
+// KMS key and architecture. Images without architecture are considered to be `amd64` images, an empty KMS key denotes
+// images which are not encrypted or encrypted with the default key.
 // If no such entry is found then an error will be returned.
-func FindMachineImage(machineImages []api.MachineImage, imageName, imageVersion string, encrypted bool) (*api.MachineImage, error) {
+func FindMachineImage(machineImages []api.MachineImage, imageName, imageVersion string, encrypted bool, kmsKeyID, architecture string) (*api.MachineImage, error) {
 	for _, machineImage := range machineImages {
-		if machineImage.Name == imageName && machineImage.Version == imageVersion && matchEncryptedFlag(machineImage.Encrypted, encrypted) {
+		if machineImage.Name == imageName && machineImage.Version == imageVersion && matchEncryptedFlag(machineImage.Encrypted, encrypted) &&
+			ptr.Deref(machineImage.KMSKeyID, "") == kmsKeyID && matchArchitecture(machineImage.Architecture, architecture) {
 			return &machineImage, nil
 		}
 	}
 
+	if encrypted && kmsKeyID != "" {
+		return nil, fmt.Errorf("no machine image name %q in version %q for architecture %q encrypted with KMS key %q found", imageName, imageVersion, architecture, kmsKeyID)
+	}
 	if encrypted {
-		return nil, fmt.Errorf("no encrypted machine image name %q in version %q found", imageName, imageVersion)
+		return nil, fmt.Errorf("no encrypted machine image name %q in version %q for architecture %q found", imageName, imageVersion, architecture)
 	}
-	return nil, fmt.Errorf("no machine image name %q in version %q found", imageName, imageVersion)
+	return nil, fmt.Errorf("no machine image name %q in version %q for architecture %q found", imageName, imageVersion, architecture)
 }
 
 // AppendMachineImage will append a given MachineImage to an existing image list.
-// If a same image (by checking name, version and encrypted flag) already exists, nothing happens
+// If a same image (by checking name, version, encrypted flag, KMS key and architecture) already exists, nothing happens
 func AppendMachineImage(machineImages []api.MachineImage, machineImage api.MachineImage) []api.MachineImage {
 	expectEncripted := machineImage.Encrypted
 	if expectEncripted == nil {
 		expectEncripted = ptr.To(false)
 	}
-	if _, err := FindMachineImage(machineImages, machineImage.Name, machineImage.Version, *expectEncripted); err != nil {
+	architecture := ptr.Deref(machineImage.Architecture, v1beta1constants.ArchitectureAMD64)
+	if _, err := FindMachineImage(machineImages, machineImage.Name, machineImage.Version, *expectEncripted, ptr.Deref(machineImage.KMSKeyID, ""), architecture); err != nil {
 		return append(machineImages, machineImage)
 	}
 
 	return machineImages
 }
 
-// FindImageForRegionFromCloudProfile takes a list of machine images, and the desired image name, version, and region.
-// It tries to find the image with the given name and version in the desired region.
+// SystemDiskKMSKeyID returns the ID of the KMS key the system disk of a worker pool is encrypted with. A key configured
+// for the worker pool takes precedence over the shoot-wide default. An empty string denotes the default key.
+func SystemDiskKMSKeyID(workerConfig *api.WorkerConfig, controlPlaneConfig *api.ControlPlaneConfig) string {
+	if workerConfig != nil && workerConfig.Volume != nil && workerConfig.Volume.KMSKeyID != nil {
+		return *workerConfig.Volume.KMSKeyID
+	}
+	return DefaultKMSKeyID(controlPlaneConfig)
+}
+
+// WorkerResourceGroupID returns the ID of the resource group the resources of a worker pool are placed in. A resource
+// group configured for the worker pool takes precedence over the one of the infrastructure. An empty string denotes the
+// default resource group of the account.
+func WorkerResourceGroupID(workerConfig *api.WorkerConfig, infrastructureStatus *api.InfrastructureStatus) string {
+	if workerConfig != nil && workerConfig.ResourceGroupID != nil {
+		return *workerConfig.ResourceGroupID
+	}
+	if infrastructureStatus != nil {
+		return infrastructureStatus.ResourceGroupID
+	}
+	return ""
+}
+
+// VPCEndpointType returns the type of the given VPC endpoint, which defaults to `Interface`.
+func VPCEndpointType(endpoint api.VPCEndpoint) api.VPCEndpointType {
+	if endpoint.Type != nil {
+		return *endpoint.Type
+	}
+	return api.VPCEndpointTypeInterface
+}
+
+// UserTags returns the user-defined tags for the resources of a shoot. They are inherited from the shoot annotations
+// with the prefix `AnnotationKeyPrefixTag`, and the tags of the infrastructure config take precedence over them.
+func UserTags(infrastructureConfig *api.InfrastructureConfig, shootAnnotations map[string]string) map[string]string {
+	tags := map[string]string{}
+	for key, value := range shootAnnotations {
+		if tagKey, ok := strings.CutPrefix(key, api.AnnotationKeyPrefixTag); ok && tagKey != "" {
+			tags[tagKey] = value
+		}
+	}
+	if infrastructureConfig != nil {
+		for key, value := range infrastructureConfig.Tags {
+			tags[key] = value
+		}
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// DataDiskKMSKeyID returns the ID of the KMS key the data disk with the given name of a worker pool is encrypted with.
+// A key configured for the data disk takes precedence over the shoot-wide default. An empty string denotes the default
+// key.
+func DataDiskKMSKeyID(workerConfig *api.WorkerConfig, controlPlaneConfig *api.ControlPlaneConfig, name string) string {
+	if dataVolume := FindDataVolumeConfig(workerConfig, name); dataVolume != nil && dataVolume.KMSKeyID != nil {
+		return *dataVolume.KMSKeyID
+	}
+	return DefaultKMSKeyID(controlPlaneConfig)
+}
+
+// FindDataVolumeConfig returns the configuration of the data volume with the given name of a worker pool, or nil if
+// there is none.
+func FindDataVolumeConfig(workerConfig *api.WorkerConfig, name string) *api.DataVolume {
+	if workerConfig == nil {
+		return nil
+	}
+	for i := range workerConfig.DataVolumes {
+		if workerConfig.DataVolumes[i].Name == name {
+			return &workerConfig.DataVolumes[i]
+		}
+	}
+	return nil
+}
+
+// DefaultKMSKeyID returns the ID of the shoot-wide default KMS key for the encryption of disks. An empty string denotes
+// the default key.
+func DefaultKMSKeyID(controlPlaneConfig *api.ControlPlaneConfig) string {
+	if controlPlaneConfig == nil || controlPlaneConfig.DiskEncryption == nil {
+		return ""
+	}
+	return ptr.Deref(controlPlaneConfig.DiskEncryption.KMSKeyID, "")
+}
+
+// FindImageForRegionFromCloudProfile takes a list of machine images, and the desired image name, version, region and
+// architecture. It tries to find the image with the given name and version for the architecture in the desired region.
+// Region mappings without architecture are considered to be `amd64` images.
 // If no image is found then an error is returned.
-func FindImageForRegionFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, imageName, imageVersion, regionName string) (string, error) {
+func FindImageForRegionFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, imageName, imageVersion, regionName, architecture string) (string, error) {
 	if cloudProfileConfig != nil {
 		for _, machineImage := range cloudProfileConfig.MachineImages {
 			if machineImage.Name != imageName {
@@ -101,7 +224,7 @@ func FindImageForRegionFromCloudProfile(cloudProfileConfig *api.CloudProfileConf
 					continue
 				}
 				for _, mapping := range version.Regions {
-					if regionName == mapping.Name {
+					if regionName == mapping.Name && matchArchitecture(mapping.Architecture, architecture) {
 						return mapping.ID, nil
 					}
 				}
@@ -109,5 +232,9 @@ func FindImageForRegionFromCloudProfile(cloudProfileConfig *api.CloudProfileConf
 		}
 	}
 
-	return "", fmt.Errorf("could not find an image for name %q in version %q", imageName, imageVersion)
+	return "", fmt.Errorf("could not find an image for name %q in version %q for architecture %q", imageName, imageVersion, architecture)
+}
+
+func matchArchitecture(architecture *string, expectedArchitecture string) bool {
+	return ptr.Deref(architecture, v1beta1constants.ArchitectureAMD64) == expectedArchitecture
 }
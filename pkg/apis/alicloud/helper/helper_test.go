@@ -57,9 +57,31 @@ var _ = Describe("Helper", func() {
 		Entry("entry exists", []api.SecurityGroup{{ID: "bar", Purpose: purpose}}, purpose, &api.SecurityGroup{ID: "bar", Purpose: purpose}, false),
 	)
 
+	DescribeTable("#GetEIPAllocationIDs",
+		func(natGateway *api.NatGatewayConfig, expected []string) {
+			Expect(GetEIPAllocationIDs(natGateway)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, nil),
+		Entry("no eip configured", &api.NatGatewayConfig{EIPCount: ptr.To[int32](2)}, nil),
+		Entry("single eip configured", &api.NatGatewayConfig{EIPAllocationID: ptr.To("eip-1")}, []string{"eip-1"}),
+		Entry("multiple eips configured", &api.NatGatewayConfig{EIPAllocationIDs: []string{"eip-1", "eip-2"}}, []string{"eip-1", "eip-2"}),
+	)
+
+	DescribeTable("#GetManagedEIPCount",
+		func(natGateway *api.NatGatewayConfig, expected int) {
+			Expect(GetManagedEIPCount(natGateway)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, 1),
+		Entry("count not set", &api.NatGatewayConfig{EIPBandwidth: ptr.To[int32](10)}, 1),
+		Entry("count set", &api.NatGatewayConfig{EIPCount: ptr.To[int32](3)}, 3),
+		Entry("existing eips configured", &api.NatGatewayConfig{EIPAllocationIDs: []string{"eip-1"}}, 0),
+	)
+
 	DescribeTable("#FindMachineImage",
 		func(machineImage []api.MachineImage, name, version string, encrypted bool, expectedMachineImage *api.MachineImage, expectErr bool) {
-			found, err := FindMachineImage(machineImage, name, version, encrypted)
+			found, err := FindMachineImage(machineImage, name, version, encrypted, "", "amd64")
 			expectResults(found, expectedMachineImage, err, expectErr)
 		},
 
@@ -72,8 +94,97 @@ var _ = Describe("Helper", func() {
 
 		Entry("entry exists (encrypted value exists)", []api.MachineImage{{Name: "bar", Version: "1.2.3", ID: "id123", Encrypted: ptr.To(true)}}, "bar", "1.2.3", true, &api.MachineImage{Name: "bar", Version: "1.2.3", ID: "id123", Encrypted: ptr.To(true)}, false),
 		Entry("entry exists (empty encrypted value)", []api.MachineImage{{Name: "bar", Version: "1.2.3", ID: "id123"}}, "bar", "1.2.3", false, &api.MachineImage{Name: "bar", Version: "1.2.3", ID: "id123"}, false),
+		Entry("entry not found (other architecture)", []api.MachineImage{{Name: "bar", Version: "1.2.3", ID: "id123", Architecture: ptr.To("arm64")}}, "bar", "1.2.3", false, nil, true),
+		Entry("entry exists (matching architecture)", []api.MachineImage{{Name: "bar", Version: "1.2.3", ID: "id123", Architecture: ptr.To("arm64")}, {Name: "bar", Version: "1.2.3", ID: "id456", Architecture: ptr.To("amd64")}}, "bar", "1.2.3", false, &api.MachineImage{Name: "bar", Version: "1.2.3", ID: "id456", Architecture: ptr.To("amd64")}, false),
 	)
 
+	It("#FindMachineImage should distinguish images encrypted with customer-managed KMS keys", func() {
+		machineImages := []api.MachineImage{
+			{Name: "bar", Version: "1.2.3", ID: "id123", Encrypted: ptr.To(true)},
+			{Name: "bar", Version: "1.2.3", ID: "id456", Encrypted: ptr.To(true), KMSKeyID: ptr.To("key-1")},
+		}
+
+		found, err := FindMachineImage(machineImages, "bar", "1.2.3", true, "", "amd64")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found.ID).To(Equal("id123"))
+
+		found, err = FindMachineImage(machineImages, "bar", "1.2.3", true, "key-1", "amd64")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found.ID).To(Equal("id456"))
+
+		_, err = FindMachineImage(machineImages, "bar", "1.2.3", true, "key-2", "amd64")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("#KMSKeyID", func() {
+		var (
+			controlPlaneConfig = &api.ControlPlaneConfig{DiskEncryption: &api.DiskEncryption{KMSKeyID: ptr.To("key-default")}}
+			workerConfig       = &api.WorkerConfig{
+				Volume:      &api.Volume{KMSKeyID: ptr.To("key-system")},
+				DataVolumes: []api.DataVolume{{Name: "data1", KMSKeyID: ptr.To("key-data1")}, {Name: "data2"}},
+			}
+		)
+
+		It("should return an empty key if nothing is configured", func() {
+			Expect(SystemDiskKMSKeyID(&api.WorkerConfig{}, &api.ControlPlaneConfig{})).To(BeEmpty())
+			Expect(DataDiskKMSKeyID(&api.WorkerConfig{}, nil, "data1")).To(BeEmpty())
+		})
+
+		It("should fall back to the default key of the shoot", func() {
+			Expect(SystemDiskKMSKeyID(&api.WorkerConfig{}, controlPlaneConfig)).To(Equal("key-default"))
+			Expect(DataDiskKMSKeyID(workerConfig, controlPlaneConfig, "data2")).To(Equal("key-default"))
+		})
+
+		It("should prefer the keys of the worker pool", func() {
+			Expect(SystemDiskKMSKeyID(workerConfig, controlPlaneConfig)).To(Equal("key-system"))
+			Expect(DataDiskKMSKeyID(workerConfig, controlPlaneConfig, "data1")).To(Equal("key-data1"))
+		})
+	})
+
+	Describe("#WorkerResourceGroupID", func() {
+		It("should return the resource group of the worker pool", func() {
+			Expect(WorkerResourceGroupID(&api.WorkerConfig{ResourceGroupID: ptr.To("rg-pool")}, &api.InfrastructureStatus{ResourceGroupID: "rg-infra"})).To(Equal("rg-pool"))
+		})
+
+		It("should fall back to the resource group of the infrastructure", func() {
+			Expect(WorkerResourceGroupID(&api.WorkerConfig{}, &api.InfrastructureStatus{ResourceGroupID: "rg-infra"})).To(Equal("rg-infra"))
+			Expect(WorkerResourceGroupID(nil, nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("#VPCEndpointType", func() {
+		It("should default to interface endpoints", func() {
+			Expect(VPCEndpointType(api.VPCEndpoint{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.kms"})).To(Equal(api.VPCEndpointTypeInterface))
+		})
+
+		It("should return the type of the endpoint", func() {
+			Expect(VPCEndpointType(api.VPCEndpoint{Type: ptr.To(api.VPCEndpointTypeGateway), ServiceName: "com.aliyun.cn-hangzhou.oss"})).To(Equal(api.VPCEndpointTypeGateway))
+		})
+	})
+
+	Describe("#UserTags", func() {
+		It("should merge the tags of the shoot annotations and the infrastructure config", func() {
+			infrastructureConfig := &api.InfrastructureConfig{Tags: map[string]string{"CostCenter": "4711", "Team": "infra"}}
+			annotations := map[string]string{
+				"tags.alicloud.provider.extensions.gardener.cloud/CostCenter": "0815",
+				"tags.alicloud.provider.extensions.gardener.cloud/Project":    "garden",
+				"tags.alicloud.provider.extensions.gardener.cloud/":           "ignored",
+				"gardener.cloud/operation":                                    "reconcile",
+			}
+
+			Expect(UserTags(infrastructureConfig, annotations)).To(Equal(map[string]string{
+				"CostCenter": "4711",
+				"Project":    "garden",
+				"Team":       "infra",
+			}))
+		})
+
+		It("should return nil if there are no tags", func() {
+			Expect(UserTags(&api.InfrastructureConfig{}, map[string]string{"gardener.cloud/operation": "reconcile"})).To(BeNil())
+			Expect(UserTags(nil, nil)).To(BeNil())
+		})
+	})
+
 	Describe("#AppendMachineImage",
 		func() {
 
@@ -93,13 +204,21 @@ var _ = Describe("Helper", func() {
 				Expect(existingImages).To(HaveLen(1))
 				Expect(existingImages[0]).To(Equal(imageExisting))
 			})
+
+			It("should append the image for another architecture", func() {
+				imageToInsert := api.MachineImage{Name: "bar", Version: "1.2.3", ID: "id456", Architecture: ptr.To("arm64")}
+				existingImages := []api.MachineImage{{Name: "bar", Version: "1.2.3", ID: "id123"}}
+				existingImages = AppendMachineImage(existingImages, imageToInsert)
+				Expect(existingImages).To(HaveLen(2))
+				Expect(existingImages).To(ContainElement(imageToInsert))
+			})
 		})
 
 	DescribeTable("#FindImageForRegion",
-		func(profileImages []api.MachineImages, imageName, version, region string, expectedImage string) {
+		func(profileImages []api.MachineImages, imageName, version, region, architecture string, expectedImage string) {
 			cfg := &api.CloudProfileConfig{}
 			cfg.MachineImages = profileImages
-			image, err := FindImageForRegionFromCloudProfile(cfg, imageName, version, region)
+			image, err := FindImageForRegionFromCloudProfile(cfg, imageName, version, region, architecture)
 
 			Expect(image).To(Equal(expectedImage))
 			if expectedImage != "" {
@@ -109,13 +228,21 @@ var _ = Describe("Helper", func() {
 			}
 		},
 
-		Entry("list is nil", nil, "ubuntu", "1", "china", ""),
+		Entry("list is nil", nil, "ubuntu", "1", "china", "amd64", ""),
 
-		Entry("profile empty list", []api.MachineImages{}, "ubuntu", "1", "china", ""),
-		Entry("profile entry not found (image does not exist)", makeProfileMachineImages("debian", "1", "china"), "ubuntu", "1", "china", ""),
-		Entry("profile entry not found (version does not exist)", makeProfileMachineImages("ubuntu", "2", "china"), "ubuntu", "1", "china", ""),
-		Entry("profile entry", makeProfileMachineImages("ubuntu", "1", "china"), "ubuntu", "1", "china", profileImageID),
-		Entry("profile non matching region", makeProfileMachineImages("ubuntu", "1", "china"), "ubuntu", "1", "eu", ""),
+		Entry("profile empty list", []api.MachineImages{}, "ubuntu", "1", "china", "amd64", ""),
+		Entry("profile entry not found (image does not exist)", makeProfileMachineImages("debian", "1", "china"), "ubuntu", "1", "china", "amd64", ""),
+		Entry("profile entry not found (version does not exist)", makeProfileMachineImages("ubuntu", "2", "china"), "ubuntu", "1", "china", "amd64", ""),
+		Entry("profile entry", makeProfileMachineImages("ubuntu", "1", "china"), "ubuntu", "1", "china", "amd64", profileImageID),
+		Entry("profile non matching region", makeProfileMachineImages("ubuntu", "1", "china"), "ubuntu", "1", "eu", "amd64", ""),
+		Entry("profile non matching architecture", makeProfileMachineImages("ubuntu", "1", "china"), "ubuntu", "1", "china", "arm64", ""),
+		Entry("profile entry for architecture", append(makeProfileMachineImages("ubuntu", "1", "china"), api.MachineImages{
+			Name: "ubuntu",
+			Versions: []api.MachineImageVersion{{
+				Version: "1",
+				Regions: []api.RegionIDMapping{{Name: "china", ID: "id-arm", Architecture: ptr.To("arm64")}},
+			}},
+		}), "ubuntu", "1", "china", "arm64", "id-arm"),
 	)
 })
 
@@ -77,3 +77,14 @@ func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfi
 	}
 	return cloudProfileConfig, nil
 }
+
+// ControlPlaneConfigFromCluster decodes the provider specific control plane configuration of the shoot of a cluster.
+func ControlPlaneConfigFromCluster(cluster *controller.Cluster) (*api.ControlPlaneConfig, error) {
+	controlPlaneConfig := &api.ControlPlaneConfig{}
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, controlPlaneConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane of shoot '%s': %w", client.ObjectKeyFromObject(cluster.Shoot), err)
+		}
+	}
+	return controlPlaneConfig, nil
+}
@@ -39,6 +39,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
+		&WorkerConfig{},
 		&WorkerStatus{},
 		&BackupBucketConfig{},
 	)
@@ -17,6 +17,8 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages
+	// AllowedRAMRoleNames is the list of names of RAM roles which can be attached to the instances of worker pools.
+	AllowedRAMRoleNames []string
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
@@ -41,4 +43,6 @@ type RegionIDMapping struct {
 	Name string
 	// ID is the id of the image.
 	ID string
+	// Architecture is the CPU architecture of the machine image.
+	Architecture *string
 }
@@ -19,6 +19,9 @@ type ControlPlaneConfig struct {
 
 	// CSI is the config for CSI plugin components.
 	CSI *CSI
+
+	// DiskEncryption contains the shoot-wide defaults for the encryption of disks.
+	DiskEncryption *DiskEncryption
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -32,3 +35,11 @@ type CSI struct {
 	// EnableADController enables disks to be attached/detached from controller server of CSI Plugin.
 	EnableADController *bool
 }
+
+// DiskEncryption contains the shoot-wide defaults for the encryption of disks.
+type DiskEncryption struct {
+	// KMSKeyID is the ID of the customer-managed KMS key used to encrypt the system and data disks of the workers as
+	// well as the volumes provisioned with the default storage class. Keys configured for the volumes of a worker pool
+	// take precedence.
+	KMSKeyID *string
+}
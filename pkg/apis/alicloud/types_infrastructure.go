@@ -16,6 +16,10 @@ type InfrastructureConfig struct {
 
 	// Networks specifies the networks for an infrastructure.
 	Networks Networks
+	// ResourceGroupID is the ID of the resource group the resources of the infrastructure are placed in.
+	ResourceGroupID *string
+	// Tags are user-defined tags which are added to all resources of the shoot.
+	Tags map[string]string
 }
 
 // Networks specifies the networks for an infrastructure.
@@ -25,6 +29,82 @@ type Networks struct {
 
 	// Zones are the network zones for an infrastructure.
 	Zones []Zone
+
+	// NodePortSourceCIDRs are the source CIDRs which are allowed to access the NodePort range of the shoot nodes.
+	// Defaults to `0.0.0.0/0`.
+	// +optional
+	NodePortSourceCIDRs []string
+
+	// SecurityGroupRules are additional rules of the security group of the shoot nodes.
+	// +optional
+	SecurityGroupRules []SecurityGroupRule
+
+	// IPv6 contains the IPv6 settings of the infrastructure. If set, the VPC and the vSwitches are created dual-stack.
+	// +optional
+	IPv6 *IPv6
+
+	// CEN contains the settings to attach the VPC to a Cloud Enterprise Network. If set, the VPC is attached to the
+	// CEN instance or its transit router and the nodes CIDR is routed to the VPC.
+	// +optional
+	CEN *CEN
+
+	// VPCEndpoints are the VPC endpoints created in the VPC, e.g. to reach Alicloud services like OSS, the container
+	// registry or KMS without passing the NAT gateway.
+	// +optional
+	VPCEndpoints []VPCEndpoint
+}
+
+// VPCEndpoint contains the settings of a VPC endpoint.
+type VPCEndpoint struct {
+	// Type is the type of the endpoint, either `Interface` (default) for a PrivateLink interface endpoint or `Gateway`
+	// for a VPC gateway endpoint, which is routed via the route tables of the VPC and is only offered for OSS.
+	// +optional
+	Type *VPCEndpointType
+	// ServiceName is the name of the endpoint service, e.g. `com.aliyuncs.privatelink.cn-hangzhou.kms` for an interface
+	// endpoint or `com.aliyun.cn-hangzhou.oss` for a gateway endpoint.
+	ServiceName string
+	// PrivateDNSName is the domain name resolved to the interface endpoint in the VPC, e.g. `kms.cn-hangzhou.aliyuncs.com`.
+	// If set, a PrivateZone with this name is created and bound to the VPC, which resolves the name to the domain of the
+	// endpoint. It can only be set for interface endpoints.
+	// +optional
+	PrivateDNSName *string
+}
+
+// VPCEndpointType is the type of a VPC endpoint.
+type VPCEndpointType string
+
+const (
+	// VPCEndpointTypeInterface is the type of PrivateLink interface endpoints.
+	VPCEndpointTypeInterface VPCEndpointType = "Interface"
+	// VPCEndpointTypeGateway is the type of VPC gateway endpoints.
+	VPCEndpointTypeGateway VPCEndpointType = "Gateway"
+)
+
+// CEN contains the settings to attach the VPC to a Cloud Enterprise Network (CEN).
+type CEN struct {
+	// ID is the ID of the CEN instance.
+	ID string
+	// TransitRouter contains the settings of the transit router the VPC is attached to. If not set, the VPC is attached
+	// to the CEN instance directly, which is only supported by CEN basic edition.
+	// +optional
+	TransitRouter *TransitRouter
+}
+
+// TransitRouter contains the settings of a transit router of a CEN instance.
+type TransitRouter struct {
+	// ID is the ID of the transit router in the region of the shoot.
+	ID string
+	// RouteTableID is the ID of the transit router route table the route entry for the nodes CIDR is maintained in.
+	RouteTableID string
+}
+
+// IPv6 contains the IPv6 settings of the infrastructure.
+type IPv6 struct {
+	// AllowNodePortIPv6 specifies that the security group of the shoot nodes opens the NodePort range for IPv6 traffic
+	// from outside of the VPC if no NodePortSourceCIDRs are configured.
+	// Defaults to false.
+	// +optional
+	AllowNodePortIPv6 *bool
 }
 
 // VPC contains information about whether to create a new or use an existing VPC.
@@ -39,6 +119,20 @@ type VPC struct {
 	// This will only take effect if VPC ID is set.
 	// +optional
 	GardenerManagedNATGateway *bool
+	// NatGateway contains the configuration of the NAT gateway which is created by Gardener.
+	// +optional
+	NatGateway *ManagedNatGatewayConfig
+}
+
+// ManagedNatGatewayConfig contains the configuration of the NAT gateway which is created by Gardener.
+type ManagedNatGatewayConfig struct {
+	// InternetChargeType is the billing method of the NAT gateway, either `PayByLcu` (pay-by-CU) or `PayBySpec`
+	// (pay-by-specification). It cannot be changed once the NAT gateway has been created.
+	// Defaults to the default billing method of Alicloud.
+	InternetChargeType *string
+	// Spec is the specification of the NAT gateway, one of `Small`, `Middle`, `Large` or `XLarge.1`.
+	// It can only be set for the `PayBySpec` billing method.
+	Spec *string
 }
 
 // VPCStatus contains output information about the VPC.
@@ -49,6 +143,31 @@ type VPCStatus struct {
 	VSwitches []VSwitch
 	// SecurityGroups is a list of security groups.
 	SecurityGroups []SecurityGroup
+	// IPv6CIDR is the IPv6 CIDR block of the VPC.
+	// +optional
+	IPv6CIDR string
+	// IPv6GatewayID is the ID of the IPv6 gateway of the VPC.
+	// +optional
+	IPv6GatewayID string
+	// VPCEndpoints are the VPC endpoints of the VPC.
+	// +optional
+	VPCEndpoints []VPCEndpointStatus
+}
+
+// VPCEndpointStatus contains information about a VPC endpoint.
+type VPCEndpointStatus struct {
+	// Type is the type of the endpoint.
+	Type VPCEndpointType
+	// ServiceName is the name of the endpoint service.
+	ServiceName string
+	// ID is the ID of the endpoint.
+	ID string
+	// Domain is the domain name the endpoint service can be reached with. It is only set for interface endpoints.
+	// +optional
+	Domain string
+	// PrivateZoneID is the ID of the PrivateZone resolving the private DNS name of the endpoint.
+	// +optional
+	PrivateZoneID string
 }
 
 // Purpose is a purpose of a subnet.
@@ -69,6 +188,9 @@ type VSwitch struct {
 	ID string
 	// Zone is the name of the zone.
 	Zone string
+	// IPv6CIDR is the IPv6 CIDR block of the vswitch.
+	// +optional
+	IPv6CIDR string
 }
 
 // SecurityGroup contains information about a security group.
@@ -90,12 +212,40 @@ type Zone struct {
 	Workers string
 	// NatGatewayConfig specifies configuration for the NAT gateway in this zone.
 	NatGateway *NatGatewayConfig
+	// WorkersIPv6Index is the index of the /64 IPv6 CIDR block of the workers vSwitch within the /56 IPv6 CIDR block of
+	// the VPC, between 0 and 255. It can only be set if IPv6 is enabled.
+	// Defaults to the position of the zone in the list of zones.
+	// +optional
+	WorkersIPv6Index *int32
+	// WorkersVSwitchID is the ID of an existing vSwitch used for the workers of this zone instead of creating a new one.
+	// It can only be set if `networks.vpc.id` is set, and the vSwitch must belong to this VPC and zone and have the
+	// `workers` CIDR. The vSwitch is neither modified nor deleted by Gardener.
+	WorkersVSwitchID *string
 }
 
 // NatGatewayConfig specifies configuration for the NAT gateway in this zone.
 type NatGatewayConfig struct {
 	// EIPAllocationID specifies the EIP to bind on NatGateway.
 	EIPAllocationID *string
+	// EIPAllocationIDs specifies multiple existing EIPs which are bound as SNAT IP pool on the NAT gateway.
+	// It cannot be combined with `eipAllocationID`.
+	EIPAllocationIDs []string
+	// EIPCount is the number of EIPs created by Gardener, which are bound as SNAT IP pool on the NAT gateway.
+	// It cannot be combined with `eipAllocationID` or `eipAllocationIDs`.
+	// Defaults to 1.
+	EIPCount *int32
+	// EIPBandwidth is the maximum bandwidth of the EIPs created by Gardener in Mbit/s.
+	// It cannot be combined with `eipAllocationID`, `eipAllocationIDs` or `eipBandwidthPackageID`.
+	// Defaults to 100.
+	EIPBandwidth *int32
+	// EIPISP is the line type of the EIPs created by Gardener, e.g. `BGP` or `BGP_PRO`.
+	// It cannot be combined with `eipAllocationID` or `eipAllocationIDs` and cannot be changed once the EIPs have been
+	// created.
+	// Defaults to the default line type of Alicloud.
+	EIPISP *string
+	// EIPBandwidthPackageID is the ID of an existing EIP bandwidth plan (common bandwidth package) the EIPs created by
+	// Gardener join. It cannot be combined with `eipAllocationID` or `eipAllocationIDs`.
+	EIPBandwidthPackageID *string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -117,4 +267,28 @@ type InfrastructureStatus struct {
 	// it cannot reconcile anymore existing `Infrastructure` resources that are still using this version. Hence, it stores
 	// the used versions in the provider status to ensure reconciliation is possible.
 	MachineImages []MachineImage
+	// ResourceGroupID is the ID of the resource group the resources of the infrastructure are placed in.
+	ResourceGroupID string
+	// Tags are the user-defined tags which are added to all resources of the shoot.
+	Tags map[string]string
+	// Plan contains the changes the last reconciliation in plan mode has computed for the infrastructure.
+	Plan *InfrastructurePlan
+}
+
+// InfrastructurePlan contains the changes a reconciliation would apply to the infrastructure.
+type InfrastructurePlan struct {
+	// Changes are the planned changes in the order they would be applied.
+	Changes []PlannedChange
+}
+
+// PlannedChange is a change of a single infrastructure resource.
+type PlannedChange struct {
+	// Action is the kind of the change, i.e. Create, Update or Delete.
+	Action string
+	// ResourceType is the type of the resource, e.g. VPC or VSwitch.
+	ResourceType string
+	// ID is the ID of the resource. For a resource to be created it is a placeholder.
+	ID string
+	// Description describes the change.
+	Description string
 }
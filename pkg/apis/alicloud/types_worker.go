@@ -8,6 +8,246 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// InstanceChargeType is the billing method of an instance.
+type InstanceChargeType string
+
+const (
+	// InstanceChargeTypePostPaid bills the instances on a pay-as-you-go basis.
+	InstanceChargeTypePostPaid InstanceChargeType = "PostPaid"
+	// InstanceChargeTypePrePaid bills the instances on a subscription basis.
+	InstanceChargeTypePrePaid InstanceChargeType = "PrePaid"
+)
+
+// PeriodUnit is the unit of a subscription period.
+type PeriodUnit string
+
+const (
+	// PeriodUnitWeek is a subscription period in weeks.
+	PeriodUnitWeek PeriodUnit = "Week"
+	// PeriodUnitMonth is a subscription period in months.
+	PeriodUnitMonth PeriodUnit = "Month"
+)
+
+// InternetChargeType is the billing method for the public network bandwidth of an instance.
+type InternetChargeType string
+
+const (
+	// InternetChargeTypePayByTraffic bills the public network bandwidth by the used traffic.
+	InternetChargeTypePayByTraffic InternetChargeType = "PayByTraffic"
+	// InternetChargeTypePayByBandwidth bills the public network bandwidth by the reserved bandwidth.
+	InternetChargeTypePayByBandwidth InternetChargeType = "PayByBandwidth"
+)
+
+// SpotStrategy is the bidding strategy for spot instances.
+type SpotStrategy string
+
+const (
+	// SpotStrategySpotAsPriceGo creates spot instances for which the market price is automatically used as bid price.
+	SpotStrategySpotAsPriceGo SpotStrategy = "SpotAsPriceGo"
+	// SpotStrategySpotWithPriceLimit creates spot instances with a maximum hourly price.
+	SpotStrategySpotWithPriceLimit SpotStrategy = "SpotWithPriceLimit"
+)
+
+// DeploymentSetStrategy is the deployment strategy of an ECS deployment set.
+type DeploymentSetStrategy string
+
+const (
+	// DeploymentSetStrategyAvailability distributes the instances of a deployment set across different physical servers.
+	DeploymentSetStrategyAvailability DeploymentSetStrategy = "Availability"
+	// DeploymentSetStrategyAvailabilityGroup distributes the instance groups of a deployment set across different
+	// physical servers.
+	DeploymentSetStrategyAvailabilityGroup DeploymentSetStrategy = "AvailabilityGroup"
+	// DeploymentSetStrategyLowLatency places the instances of a deployment set close to each other to reduce the
+	// network latency between them.
+	DeploymentSetStrategyLowLatency DeploymentSetStrategy = "LowLatency"
+)
+
+// HTTPTokensValue specifies whether a token is required to access the instance metadata service.
+type HTTPTokensValue string
+
+const (
+	// HTTPTokensOptional allows the access to the instance metadata service with and without a token.
+	HTTPTokensOptional HTTPTokensValue = "optional"
+	// HTTPTokensRequired only allows the access to the instance metadata service with a token.
+	HTTPTokensRequired HTTPTokensValue = "required"
+)
+
+// PerformanceLevel is the performance level of an ESSD disk.
+type PerformanceLevel string
+
+const (
+	// PerformanceLevelPL0 is the performance level with up to 10,000 IOPS per disk.
+	PerformanceLevelPL0 PerformanceLevel = "PL0"
+	// PerformanceLevelPL1 is the performance level with up to 50,000 IOPS per disk.
+	PerformanceLevelPL1 PerformanceLevel = "PL1"
+	// PerformanceLevelPL2 is the performance level with up to 100,000 IOPS per disk.
+	PerformanceLevelPL2 PerformanceLevel = "PL2"
+	// PerformanceLevelPL3 is the performance level with up to 1,000,000 IOPS per disk.
+	PerformanceLevelPL3 PerformanceLevel = "PL3"
+)
+
+// SecurityGroupRuleDirection is the direction of a security group rule.
+type SecurityGroupRuleDirection string
+
+const (
+	// SecurityGroupRuleDirectionIngress is a rule for inbound traffic.
+	SecurityGroupRuleDirectionIngress SecurityGroupRuleDirection = "ingress"
+	// SecurityGroupRuleDirectionEgress is a rule for outbound traffic.
+	SecurityGroupRuleDirectionEgress SecurityGroupRuleDirection = "egress"
+)
+
+// SecurityGroupRulePolicy is the action of a security group rule.
+type SecurityGroupRulePolicy string
+
+const (
+	// SecurityGroupRulePolicyAccept allows the matching traffic.
+	SecurityGroupRulePolicyAccept SecurityGroupRulePolicy = "Accept"
+	// SecurityGroupRulePolicyDrop denies the matching traffic.
+	SecurityGroupRulePolicyDrop SecurityGroupRulePolicy = "Drop"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// InstanceChargeType is the billing method of the instances.
+	InstanceChargeType *InstanceChargeType
+	// Subscription contains the settings for subscription instances.
+	Subscription *SubscriptionConfig
+	// InternetChargeType is the billing method for the public network bandwidth of the instances.
+	InternetChargeType *InternetChargeType
+	// InternetMaxBandwidthIn is the maximum inbound public bandwidth of the instances in Mbit/s.
+	InternetMaxBandwidthIn *int32
+	// InternetMaxBandwidthOut is the maximum outbound public bandwidth of the instances in Mbit/s.
+	// A value greater than 0 assigns a public IP address to the instances.
+	InternetMaxBandwidthOut *int32
+	// Spot contains the configuration for creating the instances as spot (preemptible) instances.
+	Spot *SpotConfig
+	// Placement contains the settings for placing the instances on dedicated hosts or in deployment sets.
+	Placement *PlacementConfig
+	// AdditionalSecurityGroupIDs is a list of IDs of existing security groups the instances are attached to in
+	// addition to the nodes security group of the shoot.
+	AdditionalSecurityGroupIDs []string
+	// SecurityGroupRules is a list of rules of a security group that is created by the extension for the worker pool.
+	SecurityGroupRules []SecurityGroupRule
+	// FallbackInstanceTypes is an ordered list of instance types the worker pool falls back to in a zone if the
+	// machine type of the pool is out of stock there.
+	FallbackInstanceTypes []string
+	// Volume contains settings for the system disk of the instances.
+	Volume *Volume
+	// DataVolumes contains settings for the data disks of the instances.
+	DataVolumes []DataVolume
+	// InstanceMetadataOptions contains the settings for the access to the instance metadata service.
+	InstanceMetadataOptions *InstanceMetadataOptions
+	// RAMRoleName is the name of an existing RAM role that is attached to the instances. It must be allowed by the
+	// cloud profile.
+	RAMRoleName *string
+	// ResourceGroupID is the ID of the resource group the instances and the security group of the worker pool are
+	// placed in.
+	ResourceGroupID *string
+}
+
+// InstanceMetadataOptions contains the settings for the access to the instance metadata service.
+type InstanceMetadataOptions struct {
+	// HTTPTokens specifies whether a token is required to access the instance metadata service, either `optional` or
+	// `required` (hardened mode).
+	// Defaults to `optional`.
+	HTTPTokens *HTTPTokensValue
+	// HTTPPutResponseHopLimit is the maximum number of hops a token request to the instance metadata service can
+	// travel, between 1 and 64. Containers in pods which do not use the host network need a hop limit of at least 2.
+	// Defaults to 1.
+	HTTPPutResponseHopLimit *int32
+}
+
+// Volume contains settings for the system disk of the instances of a worker pool.
+type Volume struct {
+	// KMSKeyID is the ID of the customer-managed KMS key used to encrypt the system disk. It is only used if the
+	// system disk is encrypted and takes precedence over the default key of the shoot.
+	KMSKeyID *string
+	// PerformanceLevel is the performance level of an ESSD (`cloud_essd`) disk, one of `PL0`, `PL1`, `PL2` or `PL3`.
+	// Defaults to `PL1`.
+	PerformanceLevel *PerformanceLevel
+	// ProvisionedIOPS is the IOPS provisioned in addition to the baseline performance of an ESSD AutoPL (`cloud_auto`)
+	// disk.
+	ProvisionedIOPS *int64
+	// BurstingEnabled enables performance bursting of an ESSD AutoPL (`cloud_auto`) disk.
+	BurstingEnabled *bool
+	// AutoSnapshotPolicyID is the ID of an existing automatic snapshot policy that is applied to the disk.
+	AutoSnapshotPolicyID *string
+}
+
+// DataVolume contains settings for a data disk of the instances of a worker pool.
+type DataVolume struct {
+	// Name is the name of the data volume this configuration applies to.
+	Name string
+	// KMSKeyID is the ID of the customer-managed KMS key used to encrypt the data disk. It is only used if the
+	// data disk is encrypted and takes precedence over the default key of the shoot.
+	KMSKeyID *string
+	// PerformanceLevel is the performance level of an ESSD (`cloud_essd`) disk, one of `PL0`, `PL1`, `PL2` or `PL3`.
+	// Defaults to `PL1`.
+	PerformanceLevel *PerformanceLevel
+	// ProvisionedIOPS is the IOPS provisioned in addition to the baseline performance of an ESSD AutoPL (`cloud_auto`)
+	// disk.
+	ProvisionedIOPS *int64
+	// BurstingEnabled enables performance bursting of an ESSD AutoPL (`cloud_auto`) disk.
+	BurstingEnabled *bool
+	// AutoSnapshotPolicyID is the ID of an existing automatic snapshot policy that is applied to the disk.
+	AutoSnapshotPolicyID *string
+}
+
+// SpotConfig contains the configuration for spot instances.
+type SpotConfig struct {
+	// Strategy is the bidding strategy for the spot instances.
+	Strategy SpotStrategy
+	// PriceLimit is the maximum hourly price for the spot instances.
+	PriceLimit *string
+	// Duration is the protection period of the spot instances in hours.
+	Duration *int32
+}
+
+// SecurityGroupRule is a rule of a security group.
+type SecurityGroupRule struct {
+	// Direction is the direction of the rule.
+	Direction SecurityGroupRuleDirection
+	// Protocol is the IP protocol of the rule.
+	Protocol string
+	// PortRange is the range of ports of the rule in the format `<from>/<to>`.
+	PortRange string
+	// CIDR is the source CIDR of an ingress rule or the destination CIDR of an egress rule.
+	CIDR string
+	// Policy is the action of the rule.
+	Policy *SecurityGroupRulePolicy
+	// Priority is the priority of the rule.
+	Priority *int32
+}
+
+// PlacementConfig contains the placement settings for the instances of a worker pool.
+type PlacementConfig struct {
+	// DeploymentSetID is the ID of an existing deployment set the instances are added to.
+	DeploymentSetID *string
+	// DeploymentSetStrategy is the strategy of the deployment sets that are managed by the extension for each zone of
+	// the worker pool.
+	DeploymentSetStrategy *DeploymentSetStrategy
+	// DedicatedHostID is the ID of the dedicated host the instances are created on.
+	DedicatedHostID *string
+	// DedicatedHostClusterID is the ID of the dedicated host cluster the instances are created in.
+	DedicatedHostClusterID *string
+}
+
+// SubscriptionConfig contains the settings for subscription instances.
+type SubscriptionConfig struct {
+	// Period is the subscription duration of the instances in units of the period unit.
+	Period int32
+	// PeriodUnit is the unit of the subscription period.
+	PeriodUnit *PeriodUnit
+	// AutoRenew specifies whether the subscription of the instances is automatically renewed when it expires.
+	AutoRenew *bool
+	// AutoRenewPeriod is the duration of an automatic renewal in units of the period unit.
+	AutoRenewPeriod *int32
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // WorkerStatus contains information about created worker resources.
@@ -20,6 +260,18 @@ type WorkerStatus struct {
 	// resources that are still using this version. Hence, it stores the used versions in the provider status to ensure
 	// reconciliation is possible.
 	MachineImages []MachineImage
+	// DeploymentSets is a list of deployment sets that have been created by the extension for the worker pools.
+	DeploymentSets []DeploymentSet
+	// SecurityGroups is a list of security groups that have been created by the extension for the worker pools.
+	SecurityGroups []PoolSecurityGroup
+	// InstanceTypeFallbacks is a list of fallback instance types that are used by the worker pools in zones in which
+	// their machine type is out of stock.
+	InstanceTypeFallbacks []InstanceTypeFallback
+	// Tags are the user-defined tags which have been applied to the instances and disks of the worker pools.
+	Tags map[string]string
+	// InstanceTypeArchitectures maps the instance types of the worker pools to their CPU architectures, so that the
+	// instance types are only described if they are used for the first time.
+	InstanceTypeArchitectures map[string]string
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -32,4 +284,41 @@ type MachineImage struct {
 	ID string
 	// Encrypted is a flag to specify whether this image is encrypted or not
 	Encrypted *bool
+	// Architecture is the CPU architecture of the machine image.
+	Architecture *string
+	// KMSKeyID is the ID of the KMS key the machine image is encrypted with. It is not set for images encrypted with
+	// the default key.
+	KMSKeyID *string
+}
+
+// DeploymentSet contains information about a deployment set created for a worker pool in a zone.
+type DeploymentSet struct {
+	// PoolName is the name of the worker pool the deployment set was created for.
+	PoolName string
+	// Zone is the zone the deployment set was created for.
+	Zone string
+	// ID is the ID of the deployment set.
+	ID string
+	// Strategy is the deployment strategy of the deployment set.
+	Strategy DeploymentSetStrategy
+}
+
+// PoolSecurityGroup contains information about a security group created for a worker pool.
+type PoolSecurityGroup struct {
+	// PoolName is the name of the worker pool the security group was created for.
+	PoolName string
+	// ID is the ID of the security group.
+	ID string
+}
+
+// InstanceTypeFallback contains information about a fallback instance type used by a worker pool in a zone.
+type InstanceTypeFallback struct {
+	// PoolName is the name of the worker pool.
+	PoolName string
+	// Zone is the zone in which the fallback instance type is used.
+	Zone string
+	// InstanceType is the fallback instance type.
+	InstanceType string
+	// LastUpdateTime is the time at which the worker pool switched to the fallback instance type.
+	LastUpdateTime metav1.Time
 }
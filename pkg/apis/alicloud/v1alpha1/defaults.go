@@ -5,9 +5,48 @@
 package v1alpha1
 
 import (
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_WorkerConfig sets defaults for the WorkerConfig.
+func SetDefaults_WorkerConfig(obj *WorkerConfig) {
+	if obj.InstanceChargeType == nil {
+		obj.InstanceChargeType = ptr.To(InstanceChargeTypePostPaid)
+	}
+	if obj.InternetChargeType == nil {
+		obj.InternetChargeType = ptr.To(InternetChargeTypePayByTraffic)
+	}
+	if obj.InternetMaxBandwidthIn == nil {
+		obj.InternetMaxBandwidthIn = ptr.To[int32](5)
+	}
+}
+
+// SetDefaults_SubscriptionConfig sets defaults for the SubscriptionConfig.
+func SetDefaults_SubscriptionConfig(obj *SubscriptionConfig) {
+	if obj.PeriodUnit == nil {
+		obj.PeriodUnit = ptr.To(PeriodUnitMonth)
+	}
+}
+
+// SetDefaults_SecurityGroupRule sets defaults for the SecurityGroupRule.
+func SetDefaults_SecurityGroupRule(obj *SecurityGroupRule) {
+	if obj.Policy == nil {
+		obj.Policy = ptr.To(SecurityGroupRulePolicyAccept)
+	}
+	if obj.Priority == nil {
+		obj.Priority = ptr.To[int32](1)
+	}
+}
+
+// SetDefaults_RegionIDMapping sets defaults for the RegionIDMapping of a machine image version.
+func SetDefaults_RegionIDMapping(obj *RegionIDMapping) {
+	if obj.Architecture == nil {
+		obj.Architecture = ptr.To(v1beta1constants.ArchitectureAMD64)
+	}
+}
@@ -42,6 +42,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
+		&WorkerConfig{},
 		&WorkerStatus{},
 		&BackupBucketConfig{},
 	)
@@ -18,6 +18,9 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages `json:"machineImages"`
+	// AllowedRAMRoleNames is the list of names of RAM roles which can be attached to the instances of worker pools.
+	// +optional
+	AllowedRAMRoleNames []string `json:"allowedRAMRoleNames,omitempty"`
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
@@ -42,4 +45,8 @@ type RegionIDMapping struct {
 	Name string `json:"name"`
 	// ID is the id of the image.
 	ID string `json:"id"`
+	// Architecture is the CPU architecture of the machine image.
+	// Defaults to `amd64`.
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
 }
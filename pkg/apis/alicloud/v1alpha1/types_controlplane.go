@@ -22,6 +22,10 @@ type ControlPlaneConfig struct {
 	// CSI is the config for CSI plugin components.
 	// +optional
 	CSI *CSI `json:"csi,omitempty"`
+
+	// DiskEncryption contains the shoot-wide defaults for the encryption of disks.
+	// +optional
+	DiskEncryption *DiskEncryption `json:"diskEncryption,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -37,3 +41,12 @@ type CSI struct {
 	// +optional
 	EnableADController *bool `json:"enableADController,omitempty"`
 }
+
+// DiskEncryption contains the shoot-wide defaults for the encryption of disks.
+type DiskEncryption struct {
+	// KMSKeyID is the ID of the customer-managed KMS key used to encrypt the system and data disks of the workers as
+	// well as the volumes provisioned with the default storage class. Keys configured for the volumes of a worker pool
+	// take precedence.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+}
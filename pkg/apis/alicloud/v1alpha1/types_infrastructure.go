@@ -17,6 +17,16 @@ type InfrastructureConfig struct {
 
 	// Networks specifies the networks for an infrastructure.
 	Networks Networks `json:"networks"`
+	// ResourceGroupID is the ID of the resource group the resources of the infrastructure are placed in.
+	// Defaults to the resource group configured for the seed, if any, or otherwise to the default resource group of the
+	// account.
+	// +optional
+	ResourceGroupID *string `json:"resourceGroupID,omitempty"`
+	// Tags are user-defined tags which are added to all resources of the shoot, i.e. the resources of the
+	// infrastructure, the instances and disks of the workers and the load balancers. They take precedence over the tags
+	// inherited from the annotations of the shoot.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // Networks specifies the networks for an infrastructure.
@@ -26,6 +36,82 @@ type Networks struct {
 
 	// Zones are the network zones for an infrastructure.
 	Zones []Zone `json:"zones"`
+
+	// NodePortSourceCIDRs are the source CIDRs which are allowed to access the NodePort range of the shoot nodes.
+	// Defaults to `0.0.0.0/0`.
+	// +optional
+	NodePortSourceCIDRs []string `json:"nodePortSourceCIDRs,omitempty"`
+
+	// SecurityGroupRules are additional rules of the security group of the shoot nodes.
+	// +optional
+	SecurityGroupRules []SecurityGroupRule `json:"securityGroupRules,omitempty"`
+
+	// IPv6 contains the IPv6 settings of the infrastructure. If set, the VPC and the vSwitches are created dual-stack.
+	// +optional
+	IPv6 *IPv6 `json:"ipv6,omitempty"`
+
+	// CEN contains the settings to attach the VPC to a Cloud Enterprise Network. If set, the VPC is attached to the
+	// CEN instance or its transit router and the nodes CIDR is routed to the VPC.
+	// +optional
+	CEN *CEN `json:"cen,omitempty"`
+
+	// VPCEndpoints are the VPC endpoints created in the VPC, e.g. to reach Alicloud services like OSS, the container
+	// registry or KMS without passing the NAT gateway.
+	// +optional
+	VPCEndpoints []VPCEndpoint `json:"vpcEndpoints,omitempty"`
+}
+
+// VPCEndpoint contains the settings of a VPC endpoint.
+type VPCEndpoint struct {
+	// Type is the type of the endpoint, either `Interface` (default) for a PrivateLink interface endpoint or `Gateway`
+	// for a VPC gateway endpoint, which is routed via the route tables of the VPC and is only offered for OSS.
+	// +optional
+	Type *VPCEndpointType `json:"type,omitempty"`
+	// ServiceName is the name of the endpoint service, e.g. `com.aliyuncs.privatelink.cn-hangzhou.kms` for an interface
+	// endpoint or `com.aliyun.cn-hangzhou.oss` for a gateway endpoint.
+	ServiceName string `json:"serviceName"`
+	// PrivateDNSName is the domain name resolved to the interface endpoint in the VPC, e.g. `kms.cn-hangzhou.aliyuncs.com`.
+	// If set, a PrivateZone with this name is created and bound to the VPC, which resolves the name to the domain of the
+	// endpoint. It can only be set for interface endpoints.
+	// +optional
+	PrivateDNSName *string `json:"privateDNSName,omitempty"`
+}
+
+// VPCEndpointType is the type of a VPC endpoint.
+type VPCEndpointType string
+
+const (
+	// VPCEndpointTypeInterface is the type of PrivateLink interface endpoints.
+	VPCEndpointTypeInterface VPCEndpointType = "Interface"
+	// VPCEndpointTypeGateway is the type of VPC gateway endpoints.
+	VPCEndpointTypeGateway VPCEndpointType = "Gateway"
+)
+
+// CEN contains the settings to attach the VPC to a Cloud Enterprise Network (CEN).
+type CEN struct {
+	// ID is the ID of the CEN instance.
+	ID string `json:"id"`
+	// TransitRouter contains the settings of the transit router the VPC is attached to. If not set, the VPC is attached
+	// to the CEN instance directly, which is only supported by CEN basic edition.
+	// +optional
+	TransitRouter *TransitRouter `json:"transitRouter,omitempty"`
+}
+
+// TransitRouter contains the settings of a transit router of a CEN instance.
+type TransitRouter struct {
+	// ID is the ID of the transit router in the region of the shoot.
+	ID string `json:"id"`
+	// RouteTableID is the ID of the transit router route table the route entry for the nodes CIDR is maintained in.
+	RouteTableID string `json:"routeTableID"`
+}
+
+// IPv6 contains the IPv6 settings of the infrastructure.
+type IPv6 struct {
+	// AllowNodePortIPv6 specifies that the security group of the shoot nodes opens the NodePort range for IPv6 traffic
+	// from outside of the VPC if no NodePortSourceCIDRs are configured.
+	// Defaults to false.
+	// +optional
+	AllowNodePortIPv6 *bool `json:"allowNodePortIPv6,omitempty"`
 }
 
 // VPC contains information about whether to create a new or use an existing VPC.
@@ -40,6 +126,22 @@ type VPC struct {
 	// This will only take effect if VPC ID is set.
 	// +optional
 	GardenerManagedNATGateway *bool `json:"gardenerManagedNATGateway,omitempty"`
+	// NatGateway contains the configuration of the NAT gateway which is created by Gardener.
+	// +optional
+	NatGateway *ManagedNatGatewayConfig `json:"natGateway,omitempty"`
+}
+
+// ManagedNatGatewayConfig contains the configuration of the NAT gateway which is created by Gardener.
+type ManagedNatGatewayConfig struct {
+	// InternetChargeType is the billing method of the NAT gateway, either `PayByLcu` (pay-by-CU) or `PayBySpec`
+	// (pay-by-specification). It cannot be changed once the NAT gateway has been created.
+	// Defaults to the default billing method of Alicloud.
+	// +optional
+	InternetChargeType *string `json:"internetChargeType,omitempty"`
+	// Spec is the specification of the NAT gateway, one of `Small`, `Middle`, `Large` or `XLarge.1`.
+	// It can only be set for the `PayBySpec` billing method.
+	// +optional
+	Spec *string `json:"spec,omitempty"`
 }
 
 // VPCStatus contains output information about the VPC.
@@ -50,6 +152,31 @@ type VPCStatus struct {
 	VSwitches []VSwitch `json:"vswitches"`
 	// SecurityGroups is a list of security groups.
 	SecurityGroups []SecurityGroup `json:"securityGroups"`
+	// IPv6CIDR is the IPv6 CIDR block of the VPC.
+	// +optional
+	IPv6CIDR string `json:"ipv6CIDR,omitempty"`
+	// IPv6GatewayID is the ID of the IPv6 gateway of the VPC.
+	// +optional
+	IPv6GatewayID string `json:"ipv6GatewayID,omitempty"`
+	// VPCEndpoints are the VPC endpoints of the VPC.
+	// +optional
+	VPCEndpoints []VPCEndpointStatus `json:"vpcEndpoints,omitempty"`
+}
+
+// VPCEndpointStatus contains information about a VPC endpoint.
+type VPCEndpointStatus struct {
+	// Type is the type of the endpoint.
+	Type VPCEndpointType `json:"type"`
+	// ServiceName is the name of the endpoint service.
+	ServiceName string `json:"serviceName"`
+	// ID is the ID of the endpoint.
+	ID string `json:"id"`
+	// Domain is the domain name the endpoint service can be reached with. It is only set for interface endpoints.
+	// +optional
+	Domain string `json:"domain,omitempty"`
+	// PrivateZoneID is the ID of the PrivateZone resolving the private DNS name of the endpoint.
+	// +optional
+	PrivateZoneID string `json:"privateZoneID,omitempty"`
 }
 
 // Purpose is a purpose of a subnet.
@@ -70,6 +197,9 @@ type VSwitch struct {
 	ID string `json:"id"`
 	// Zone is the name of the zone.
 	Zone string `json:"zone"`
+	// IPv6CIDR is the IPv6 CIDR block of the vswitch.
+	// +optional
+	IPv6CIDR string `json:"ipv6CIDR,omitempty"`
 }
 
 // SecurityGroup contains information about a security group.
@@ -92,6 +222,16 @@ type Zone struct {
 	// NatGatewayConfig specifies configuration for the NAT gateway in this zone.
 	// +optional
 	NatGateway *NatGatewayConfig `json:"natGateway,omitempty"`
+	// WorkersIPv6Index is the index of the /64 IPv6 CIDR block of the workers vSwitch within the /56 IPv6 CIDR block of
+	// the VPC, between 0 and 255. It can only be set if IPv6 is enabled.
+	// Defaults to the position of the zone in the list of zones.
+	// +optional
+	WorkersIPv6Index *int32 `json:"workersIPv6Index,omitempty"`
+	// WorkersVSwitchID is the ID of an existing vSwitch used for the workers of this zone instead of creating a new one.
+	// It can only be set if `networks.vpc.id` is set, and the vSwitch must belong to this VPC and zone and have the
+	// `workers` CIDR. The vSwitch is neither modified nor deleted by Gardener.
+	// +optional
+	WorkersVSwitchID *string `json:"workersVSwitchID,omitempty"`
 }
 
 // NatGatewayConfig specifies configuration for the NAT gateway in this zone.
@@ -99,6 +239,30 @@ type NatGatewayConfig struct {
 	// EIPAllocationID specifies the EIP id to bind on NatGateway.
 	// +optional
 	EIPAllocationID *string `json:"eipAllocationID,omitempty"`
+	// EIPAllocationIDs specifies multiple existing EIPs which are bound as SNAT IP pool on the NAT gateway.
+	// It cannot be combined with `eipAllocationID`.
+	// +optional
+	EIPAllocationIDs []string `json:"eipAllocationIDs,omitempty"`
+	// EIPCount is the number of EIPs created by Gardener, which are bound as SNAT IP pool on the NAT gateway.
+	// It cannot be combined with `eipAllocationID` or `eipAllocationIDs`.
+	// Defaults to 1.
+	// +optional
+	EIPCount *int32 `json:"eipCount,omitempty"`
+	// EIPBandwidth is the maximum bandwidth of the EIPs created by Gardener in Mbit/s.
+	// It cannot be combined with `eipAllocationID`, `eipAllocationIDs` or `eipBandwidthPackageID`.
+	// Defaults to 100.
+	// +optional
+	EIPBandwidth *int32 `json:"eipBandwidth,omitempty"`
+	// EIPISP is the line type of the EIPs created by Gardener, e.g. `BGP` or `BGP_PRO`.
+	// It cannot be combined with `eipAllocationID` or `eipAllocationIDs` and cannot be changed once the EIPs have been
+	// created.
+	// Defaults to the default line type of Alicloud.
+	// +optional
+	EIPISP *string `json:"eipISP,omitempty"`
+	// EIPBandwidthPackageID is the ID of an existing EIP bandwidth plan (common bandwidth package) the EIPs created by
+	// Gardener join. It cannot be combined with `eipAllocationID` or `eipAllocationIDs`.
+	// +optional
+	EIPBandwidthPackageID *string `json:"eipBandwidthPackageID,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -121,4 +285,32 @@ type InfrastructureStatus struct {
 	// the used versions in the provider status to ensure reconciliation is possible.
 	// +optional
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
+	// ResourceGroupID is the ID of the resource group the resources of the infrastructure are placed in.
+	// +optional
+	ResourceGroupID string `json:"resourceGroupID,omitempty"`
+	// Tags are the user-defined tags which are added to all resources of the shoot.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// Plan contains the changes the last reconciliation in plan mode has computed for the infrastructure.
+	// +optional
+	Plan *InfrastructurePlan `json:"plan,omitempty"`
+}
+
+// InfrastructurePlan contains the changes a reconciliation would apply to the infrastructure.
+type InfrastructurePlan struct {
+	// Changes are the planned changes in the order they would be applied.
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+}
+
+// PlannedChange is a change of a single infrastructure resource.
+type PlannedChange struct {
+	// Action is the kind of the change, i.e. Create, Update or Delete.
+	Action string `json:"action"`
+	// ResourceType is the type of the resource, e.g. VPC or VSwitch.
+	ResourceType string `json:"resourceType"`
+	// ID is the ID of the resource. For a resource to be created it is a placeholder.
+	ID string `json:"id"`
+	// Description describes the change.
+	Description string `json:"description"`
 }
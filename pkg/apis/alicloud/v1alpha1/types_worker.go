@@ -8,6 +8,303 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// InstanceChargeType is the billing method of an instance.
+type InstanceChargeType string
+
+const (
+	// InstanceChargeTypePostPaid bills the instances on a pay-as-you-go basis.
+	InstanceChargeTypePostPaid InstanceChargeType = "PostPaid"
+	// InstanceChargeTypePrePaid bills the instances on a subscription basis.
+	InstanceChargeTypePrePaid InstanceChargeType = "PrePaid"
+)
+
+// PeriodUnit is the unit of a subscription period.
+type PeriodUnit string
+
+const (
+	// PeriodUnitWeek is a subscription period in weeks.
+	PeriodUnitWeek PeriodUnit = "Week"
+	// PeriodUnitMonth is a subscription period in months.
+	PeriodUnitMonth PeriodUnit = "Month"
+)
+
+// InternetChargeType is the billing method for the public network bandwidth of an instance.
+type InternetChargeType string
+
+const (
+	// InternetChargeTypePayByTraffic bills the public network bandwidth by the used traffic.
+	InternetChargeTypePayByTraffic InternetChargeType = "PayByTraffic"
+	// InternetChargeTypePayByBandwidth bills the public network bandwidth by the reserved bandwidth.
+	InternetChargeTypePayByBandwidth InternetChargeType = "PayByBandwidth"
+)
+
+// SpotStrategy is the bidding strategy for spot instances.
+type SpotStrategy string
+
+const (
+	// SpotStrategySpotAsPriceGo creates spot instances for which the market price is automatically used as bid price.
+	SpotStrategySpotAsPriceGo SpotStrategy = "SpotAsPriceGo"
+	// SpotStrategySpotWithPriceLimit creates spot instances with a maximum hourly price.
+	SpotStrategySpotWithPriceLimit SpotStrategy = "SpotWithPriceLimit"
+)
+
+// DeploymentSetStrategy is the deployment strategy of an ECS deployment set.
+type DeploymentSetStrategy string
+
+const (
+	// DeploymentSetStrategyAvailability distributes the instances of a deployment set across different physical servers.
+	DeploymentSetStrategyAvailability DeploymentSetStrategy = "Availability"
+	// DeploymentSetStrategyAvailabilityGroup distributes the instance groups of a deployment set across different
+	// physical servers.
+	DeploymentSetStrategyAvailabilityGroup DeploymentSetStrategy = "AvailabilityGroup"
+	// DeploymentSetStrategyLowLatency places the instances of a deployment set close to each other to reduce the
+	// network latency between them.
+	DeploymentSetStrategyLowLatency DeploymentSetStrategy = "LowLatency"
+)
+
+// HTTPTokensValue specifies whether a token is required to access the instance metadata service.
+type HTTPTokensValue string
+
+const (
+	// HTTPTokensOptional allows the access to the instance metadata service with and without a token.
+	HTTPTokensOptional HTTPTokensValue = "optional"
+	// HTTPTokensRequired only allows the access to the instance metadata service with a token.
+	HTTPTokensRequired HTTPTokensValue = "required"
+)
+
+// PerformanceLevel is the performance level of an ESSD disk.
+type PerformanceLevel string
+
+const (
+	// PerformanceLevelPL0 is the performance level with up to 10,000 IOPS per disk.
+	PerformanceLevelPL0 PerformanceLevel = "PL0"
+	// PerformanceLevelPL1 is the performance level with up to 50,000 IOPS per disk.
+	PerformanceLevelPL1 PerformanceLevel = "PL1"
+	// PerformanceLevelPL2 is the performance level with up to 100,000 IOPS per disk.
+	PerformanceLevelPL2 PerformanceLevel = "PL2"
+	// PerformanceLevelPL3 is the performance level with up to 1,000,000 IOPS per disk.
+	PerformanceLevelPL3 PerformanceLevel = "PL3"
+)
+
+// SecurityGroupRuleDirection is the direction of a security group rule.
+type SecurityGroupRuleDirection string
+
+const (
+	// SecurityGroupRuleDirectionIngress is a rule for inbound traffic.
+	SecurityGroupRuleDirectionIngress SecurityGroupRuleDirection = "ingress"
+	// SecurityGroupRuleDirectionEgress is a rule for outbound traffic.
+	SecurityGroupRuleDirectionEgress SecurityGroupRuleDirection = "egress"
+)
+
+// SecurityGroupRulePolicy is the action of a security group rule.
+type SecurityGroupRulePolicy string
+
+const (
+	// SecurityGroupRulePolicyAccept allows the matching traffic.
+	SecurityGroupRulePolicyAccept SecurityGroupRulePolicy = "Accept"
+	// SecurityGroupRulePolicyDrop denies the matching traffic.
+	SecurityGroupRulePolicyDrop SecurityGroupRulePolicy = "Drop"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// InstanceChargeType is the billing method of the instances, either `PostPaid` or `PrePaid`.
+	// Defaults to `PostPaid`.
+	// +optional
+	InstanceChargeType *InstanceChargeType `json:"instanceChargeType,omitempty"`
+	// Subscription contains the settings for subscription instances. It must be set if, and only if, the instance
+	// charge type is `PrePaid`.
+	// +optional
+	Subscription *SubscriptionConfig `json:"subscription,omitempty"`
+	// InternetChargeType is the billing method for the public network bandwidth of the instances.
+	// Defaults to `PayByTraffic`.
+	// +optional
+	InternetChargeType *InternetChargeType `json:"internetChargeType,omitempty"`
+	// InternetMaxBandwidthIn is the maximum inbound public bandwidth of the instances in Mbit/s.
+	// Defaults to 5.
+	// +optional
+	InternetMaxBandwidthIn *int32 `json:"internetMaxBandwidthIn,omitempty"`
+	// InternetMaxBandwidthOut is the maximum outbound public bandwidth of the instances in Mbit/s.
+	// A value greater than 0 assigns a public IP address to the instances. Defaults to 5.
+	// +optional
+	InternetMaxBandwidthOut *int32 `json:"internetMaxBandwidthOut,omitempty"`
+	// Spot contains the configuration for creating the instances as spot (preemptible) instances.
+	// If not set, regular pay-as-you-go instances are created.
+	// +optional
+	Spot *SpotConfig `json:"spot,omitempty"`
+	// Placement contains the settings for placing the instances on dedicated hosts or in deployment sets.
+	// +optional
+	Placement *PlacementConfig `json:"placement,omitempty"`
+	// AdditionalSecurityGroupIDs is a list of IDs of existing security groups the instances are attached to in
+	// addition to the nodes security group of the shoot.
+	// +optional
+	AdditionalSecurityGroupIDs []string `json:"additionalSecurityGroupIDs,omitempty"`
+	// SecurityGroupRules is a list of rules of a security group that is created by the extension for the worker pool.
+	// The instances are attached to this security group in addition to the nodes security group of the shoot.
+	// +optional
+	SecurityGroupRules []SecurityGroupRule `json:"securityGroupRules,omitempty"`
+	// FallbackInstanceTypes is an ordered list of instance types the worker pool falls back to in a zone if the
+	// machine type of the pool is out of stock there.
+	// +optional
+	FallbackInstanceTypes []string `json:"fallbackInstanceTypes,omitempty"`
+	// Volume contains settings for the system disk of the instances.
+	// +optional
+	Volume *Volume `json:"volume,omitempty"`
+	// DataVolumes contains settings for the data disks of the instances.
+	// +optional
+	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+	// InstanceMetadataOptions contains the settings for the access to the instance metadata service.
+	// +optional
+	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+	// RAMRoleName is the name of an existing RAM role that is attached to the instances. It must be allowed by the
+	// cloud profile.
+	// +optional
+	RAMRoleName *string `json:"ramRoleName,omitempty"`
+	// ResourceGroupID is the ID of the resource group the instances and the security group of the worker pool are
+	// placed in.
+	// Defaults to the resource group of the infrastructure.
+	// +optional
+	ResourceGroupID *string `json:"resourceGroupID,omitempty"`
+}
+
+// InstanceMetadataOptions contains the settings for the access to the instance metadata service.
+type InstanceMetadataOptions struct {
+	// HTTPTokens specifies whether a token is required to access the instance metadata service, either `optional` or
+	// `required` (hardened mode).
+	// Defaults to `optional`.
+	// +optional
+	HTTPTokens *HTTPTokensValue `json:"httpTokens,omitempty"`
+	// HTTPPutResponseHopLimit is the maximum number of hops a token request to the instance metadata service can
+	// travel, between 1 and 64. Containers in pods which do not use the host network need a hop limit of at least 2.
+	// Defaults to 1.
+	// +optional
+	HTTPPutResponseHopLimit *int32 `json:"httpPutResponseHopLimit,omitempty"`
+}
+
+// Volume contains settings for the system disk of the instances of a worker pool.
+type Volume struct {
+	// KMSKeyID is the ID of the customer-managed KMS key used to encrypt the system disk. It is only used if the
+	// system disk is encrypted and takes precedence over the default key of the shoot.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+	// PerformanceLevel is the performance level of an ESSD (`cloud_essd`) disk, one of `PL0`, `PL1`, `PL2` or `PL3`.
+	// Defaults to `PL1`.
+	// +optional
+	PerformanceLevel *PerformanceLevel `json:"performanceLevel,omitempty"`
+	// ProvisionedIOPS is the IOPS provisioned in addition to the baseline performance of an ESSD AutoPL (`cloud_auto`)
+	// disk.
+	// +optional
+	ProvisionedIOPS *int64 `json:"provisionedIOPS,omitempty"`
+	// BurstingEnabled enables performance bursting of an ESSD AutoPL (`cloud_auto`) disk.
+	// +optional
+	BurstingEnabled *bool `json:"burstingEnabled,omitempty"`
+	// AutoSnapshotPolicyID is the ID of an existing automatic snapshot policy that is applied to the disk.
+	// +optional
+	AutoSnapshotPolicyID *string `json:"autoSnapshotPolicyID,omitempty"`
+}
+
+// DataVolume contains settings for a data disk of the instances of a worker pool.
+type DataVolume struct {
+	// Name is the name of the data volume this configuration applies to.
+	Name string `json:"name"`
+	// KMSKeyID is the ID of the customer-managed KMS key used to encrypt the data disk. It is only used if the
+	// data disk is encrypted and takes precedence over the default key of the shoot.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+	// PerformanceLevel is the performance level of an ESSD (`cloud_essd`) disk, one of `PL0`, `PL1`, `PL2` or `PL3`.
+	// Defaults to `PL1`.
+	// +optional
+	PerformanceLevel *PerformanceLevel `json:"performanceLevel,omitempty"`
+	// ProvisionedIOPS is the IOPS provisioned in addition to the baseline performance of an ESSD AutoPL (`cloud_auto`)
+	// disk.
+	// +optional
+	ProvisionedIOPS *int64 `json:"provisionedIOPS,omitempty"`
+	// BurstingEnabled enables performance bursting of an ESSD AutoPL (`cloud_auto`) disk.
+	// +optional
+	BurstingEnabled *bool `json:"burstingEnabled,omitempty"`
+	// AutoSnapshotPolicyID is the ID of an existing automatic snapshot policy that is applied to the disk.
+	// +optional
+	AutoSnapshotPolicyID *string `json:"autoSnapshotPolicyID,omitempty"`
+}
+
+// SpotConfig contains the configuration for spot instances.
+type SpotConfig struct {
+	// Strategy is the bidding strategy for the spot instances, either `SpotAsPriceGo` or `SpotWithPriceLimit`.
+	Strategy SpotStrategy `json:"strategy"`
+	// PriceLimit is the maximum hourly price for the spot instances. It must be set if, and only if, the strategy is
+	// `SpotWithPriceLimit`.
+	// +optional
+	PriceLimit *string `json:"priceLimit,omitempty"`
+	// Duration is the protection period of the spot instances in hours. Within this period the instances are not
+	// released. A value of 0 means that there is no protection period.
+	// +optional
+	Duration *int32 `json:"duration,omitempty"`
+}
+
+// SecurityGroupRule is a rule of a security group.
+type SecurityGroupRule struct {
+	// Direction is the direction of the rule, either `ingress` or `egress`.
+	Direction SecurityGroupRuleDirection `json:"direction"`
+	// Protocol is the IP protocol of the rule, either `TCP`, `UDP`, `ICMP`, `GRE` or `ALL`.
+	Protocol string `json:"protocol"`
+	// PortRange is the range of ports of the rule in the format `<from>/<to>`, e.g. `30000/32767`. It must be `-1/-1`
+	// for protocols other than `TCP` and `UDP`.
+	PortRange string `json:"portRange"`
+	// CIDR is the source CIDR of an ingress rule or the destination CIDR of an egress rule.
+	CIDR string `json:"cidr"`
+	// Policy is the action of the rule, either `Accept` or `Drop`.
+	// Defaults to `Accept`.
+	// +optional
+	Policy *SecurityGroupRulePolicy `json:"policy,omitempty"`
+	// Priority is the priority of the rule between 1 (highest) and 100 (lowest).
+	// Defaults to 1.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// PlacementConfig contains the placement settings for the instances of a worker pool.
+type PlacementConfig struct {
+	// DeploymentSetID is the ID of an existing deployment set the instances are added to. It cannot be combined with
+	// `deploymentSetStrategy`.
+	// +optional
+	DeploymentSetID *string `json:"deploymentSetID,omitempty"`
+	// DeploymentSetStrategy is the strategy of the deployment sets that are created and deleted by the extension for
+	// each zone of the worker pool, either `Availability`, `AvailabilityGroup` or `LowLatency`. It cannot be combined
+	// with `deploymentSetID`.
+	// +optional
+	DeploymentSetStrategy *DeploymentSetStrategy `json:"deploymentSetStrategy,omitempty"`
+	// DedicatedHostID is the ID of the dedicated host the instances are created on. It cannot be combined with
+	// `dedicatedHostClusterID`.
+	// +optional
+	DedicatedHostID *string `json:"dedicatedHostID,omitempty"`
+	// DedicatedHostClusterID is the ID of the dedicated host cluster the instances are created in. It cannot be
+	// combined with `dedicatedHostID`.
+	// +optional
+	DedicatedHostClusterID *string `json:"dedicatedHostClusterID,omitempty"`
+}
+
+// SubscriptionConfig contains the settings for subscription instances.
+type SubscriptionConfig struct {
+	// Period is the subscription duration of the instances in units of the period unit.
+	Period int32 `json:"period"`
+	// PeriodUnit is the unit of the subscription period, either `Week` or `Month`.
+	// Defaults to `Month`.
+	// +optional
+	PeriodUnit *PeriodUnit `json:"periodUnit,omitempty"`
+	// AutoRenew specifies whether the subscription of the instances is automatically renewed when it expires.
+	// +optional
+	AutoRenew *bool `json:"autoRenew,omitempty"`
+	// AutoRenewPeriod is the duration of an automatic renewal in units of the period unit. It can only be set if
+	// automatic renewal is enabled.
+	// +optional
+	AutoRenewPeriod *int32 `json:"autoRenewPeriod,omitempty"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -22,6 +319,23 @@ type WorkerStatus struct {
 	// reconciliation is possible.
 	// +optional
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
+	// DeploymentSets is a list of deployment sets that have been created by the extension for the worker pools.
+	// +optional
+	DeploymentSets []DeploymentSet `json:"deploymentSets,omitempty"`
+	// SecurityGroups is a list of security groups that have been created by the extension for the worker pools.
+	// +optional
+	SecurityGroups []PoolSecurityGroup `json:"securityGroups,omitempty"`
+	// InstanceTypeFallbacks is a list of fallback instance types that are used by the worker pools in zones in which
+	// their machine type is out of stock.
+	// +optional
+	InstanceTypeFallbacks []InstanceTypeFallback `json:"instanceTypeFallbacks,omitempty"`
+	// Tags are the user-defined tags which have been applied to the instances and disks of the worker pools.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// InstanceTypeArchitectures maps the instance types of the worker pools to their CPU architectures, so that the
+	// instance types are only described if they are used for the first time.
+	// +optional
+	InstanceTypeArchitectures map[string]string `json:"instanceTypeArchitectures,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -35,4 +349,43 @@ type MachineImage struct {
 	// Encrypted is a flag to specify whether this image is encrypted or not
 	// +optional
 	Encrypted *bool `json:"encrypted,omitempty"`
+	// Architecture is the CPU architecture of the machine image.
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
+	// KMSKeyID is the ID of the KMS key the machine image is encrypted with. It is not set for images encrypted with
+	// the default key.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+}
+
+// DeploymentSet contains information about a deployment set created for a worker pool in a zone.
+type DeploymentSet struct {
+	// PoolName is the name of the worker pool the deployment set was created for.
+	PoolName string `json:"poolName"`
+	// Zone is the zone the deployment set was created for.
+	Zone string `json:"zone"`
+	// ID is the ID of the deployment set.
+	ID string `json:"id"`
+	// Strategy is the deployment strategy of the deployment set.
+	Strategy DeploymentSetStrategy `json:"strategy"`
+}
+
+// PoolSecurityGroup contains information about a security group created for a worker pool.
+type PoolSecurityGroup struct {
+	// PoolName is the name of the worker pool the security group was created for.
+	PoolName string `json:"poolName"`
+	// ID is the ID of the security group.
+	ID string `json:"id"`
+}
+
+// InstanceTypeFallback contains information about a fallback instance type used by a worker pool in a zone.
+type InstanceTypeFallback struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Zone is the zone in which the fallback instance type is used.
+	Zone string `json:"zone"`
+	// InstanceType is the fallback instance type.
+	InstanceType string `json:"instanceType"`
+	// LastUpdateTime is the time at which the worker pool switched to the fallback instance type.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
@@ -34,6 +34,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CEN)(nil), (*alicloud.CEN)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CEN_To_alicloud_CEN(a.(*CEN), b.(*alicloud.CEN), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.CEN)(nil), (*CEN)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_CEN_To_v1alpha1_CEN(a.(*alicloud.CEN), b.(*CEN), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CSI)(nil), (*alicloud.CSI)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CSI_To_alicloud_CSI(a.(*CSI), b.(*alicloud.CSI), scope)
 	}); err != nil {
@@ -74,6 +84,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*alicloud.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataVolume_To_alicloud_DataVolume(a.(*DataVolume), b.(*alicloud.DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.DataVolume)(nil), (*DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_DataVolume_To_v1alpha1_DataVolume(a.(*alicloud.DataVolume), b.(*DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeploymentSet)(nil), (*alicloud.DeploymentSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeploymentSet_To_alicloud_DeploymentSet(a.(*DeploymentSet), b.(*alicloud.DeploymentSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.DeploymentSet)(nil), (*DeploymentSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_DeploymentSet_To_v1alpha1_DeploymentSet(a.(*alicloud.DeploymentSet), b.(*DeploymentSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskEncryption)(nil), (*alicloud.DiskEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskEncryption_To_alicloud_DiskEncryption(a.(*DiskEncryption), b.(*alicloud.DiskEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.DiskEncryption)(nil), (*DiskEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_DiskEncryption_To_v1alpha1_DiskEncryption(a.(*alicloud.DiskEncryption), b.(*DiskEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPv6)(nil), (*alicloud.IPv6)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPv6_To_alicloud_IPv6(a.(*IPv6), b.(*alicloud.IPv6), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.IPv6)(nil), (*IPv6)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_IPv6_To_v1alpha1_IPv6(a.(*alicloud.IPv6), b.(*IPv6), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImmutableConfig)(nil), (*alicloud.ImmutableConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImmutableConfig_To_alicloud_ImmutableConfig(a.(*ImmutableConfig), b.(*alicloud.ImmutableConfig), scope)
 	}); err != nil {
@@ -94,6 +144,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InfrastructurePlan)(nil), (*alicloud.InfrastructurePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructurePlan_To_alicloud_InfrastructurePlan(a.(*InfrastructurePlan), b.(*alicloud.InfrastructurePlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.InfrastructurePlan)(nil), (*InfrastructurePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(a.(*alicloud.InfrastructurePlan), b.(*InfrastructurePlan), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureStatus)(nil), (*alicloud.InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureStatus_To_alicloud_InfrastructureStatus(a.(*InfrastructureStatus), b.(*alicloud.InfrastructureStatus), scope)
 	}); err != nil {
@@ -104,6 +164,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstanceMetadataOptions)(nil), (*alicloud.InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceMetadataOptions_To_alicloud_InstanceMetadataOptions(a.(*InstanceMetadataOptions), b.(*alicloud.InstanceMetadataOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.InstanceMetadataOptions)(nil), (*InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(a.(*alicloud.InstanceMetadataOptions), b.(*InstanceMetadataOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstanceTypeFallback)(nil), (*alicloud.InstanceTypeFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceTypeFallback_To_alicloud_InstanceTypeFallback(a.(*InstanceTypeFallback), b.(*alicloud.InstanceTypeFallback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.InstanceTypeFallback)(nil), (*InstanceTypeFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_InstanceTypeFallback_To_v1alpha1_InstanceTypeFallback(a.(*alicloud.InstanceTypeFallback), b.(*InstanceTypeFallback), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*alicloud.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_alicloud_MachineImage(a.(*MachineImage), b.(*alicloud.MachineImage), scope)
 	}); err != nil {
@@ -134,6 +214,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedNatGatewayConfig)(nil), (*alicloud.ManagedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedNatGatewayConfig_To_alicloud_ManagedNatGatewayConfig(a.(*ManagedNatGatewayConfig), b.(*alicloud.ManagedNatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.ManagedNatGatewayConfig)(nil), (*ManagedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_ManagedNatGatewayConfig_To_v1alpha1_ManagedNatGatewayConfig(a.(*alicloud.ManagedNatGatewayConfig), b.(*ManagedNatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NatGatewayConfig)(nil), (*alicloud.NatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NatGatewayConfig_To_alicloud_NatGatewayConfig(a.(*NatGatewayConfig), b.(*alicloud.NatGatewayConfig), scope)
 	}); err != nil {
@@ -154,6 +244,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PlacementConfig)(nil), (*alicloud.PlacementConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlacementConfig_To_alicloud_PlacementConfig(a.(*PlacementConfig), b.(*alicloud.PlacementConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.PlacementConfig)(nil), (*PlacementConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_PlacementConfig_To_v1alpha1_PlacementConfig(a.(*alicloud.PlacementConfig), b.(*PlacementConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlannedChange)(nil), (*alicloud.PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedChange_To_alicloud_PlannedChange(a.(*PlannedChange), b.(*alicloud.PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.PlannedChange)(nil), (*PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_PlannedChange_To_v1alpha1_PlannedChange(a.(*alicloud.PlannedChange), b.(*PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PoolSecurityGroup)(nil), (*alicloud.PoolSecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PoolSecurityGroup_To_alicloud_PoolSecurityGroup(a.(*PoolSecurityGroup), b.(*alicloud.PoolSecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.PoolSecurityGroup)(nil), (*PoolSecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_PoolSecurityGroup_To_v1alpha1_PoolSecurityGroup(a.(*alicloud.PoolSecurityGroup), b.(*PoolSecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegionIDMapping)(nil), (*alicloud.RegionIDMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegionIDMapping_To_alicloud_RegionIDMapping(a.(*RegionIDMapping), b.(*alicloud.RegionIDMapping), scope)
 	}); err != nil {
@@ -174,6 +294,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupRule)(nil), (*alicloud.SecurityGroupRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroupRule_To_alicloud_SecurityGroupRule(a.(*SecurityGroupRule), b.(*alicloud.SecurityGroupRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.SecurityGroupRule)(nil), (*SecurityGroupRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_SecurityGroupRule_To_v1alpha1_SecurityGroupRule(a.(*alicloud.SecurityGroupRule), b.(*SecurityGroupRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SpotConfig)(nil), (*alicloud.SpotConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SpotConfig_To_alicloud_SpotConfig(a.(*SpotConfig), b.(*alicloud.SpotConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.SpotConfig)(nil), (*SpotConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_SpotConfig_To_v1alpha1_SpotConfig(a.(*alicloud.SpotConfig), b.(*SpotConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SubscriptionConfig)(nil), (*alicloud.SubscriptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubscriptionConfig_To_alicloud_SubscriptionConfig(a.(*SubscriptionConfig), b.(*alicloud.SubscriptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.SubscriptionConfig)(nil), (*SubscriptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_SubscriptionConfig_To_v1alpha1_SubscriptionConfig(a.(*alicloud.SubscriptionConfig), b.(*SubscriptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TransitRouter)(nil), (*alicloud.TransitRouter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TransitRouter_To_alicloud_TransitRouter(a.(*TransitRouter), b.(*alicloud.TransitRouter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.TransitRouter)(nil), (*TransitRouter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_TransitRouter_To_v1alpha1_TransitRouter(a.(*alicloud.TransitRouter), b.(*TransitRouter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*alicloud.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VPC_To_alicloud_VPC(a.(*VPC), b.(*alicloud.VPC), scope)
 	}); err != nil {
@@ -184,6 +344,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VPCEndpoint)(nil), (*alicloud.VPCEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCEndpoint_To_alicloud_VPCEndpoint(a.(*VPCEndpoint), b.(*alicloud.VPCEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.VPCEndpoint)(nil), (*VPCEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_VPCEndpoint_To_v1alpha1_VPCEndpoint(a.(*alicloud.VPCEndpoint), b.(*VPCEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCEndpointStatus)(nil), (*alicloud.VPCEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCEndpointStatus_To_alicloud_VPCEndpointStatus(a.(*VPCEndpointStatus), b.(*alicloud.VPCEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.VPCEndpointStatus)(nil), (*VPCEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(a.(*alicloud.VPCEndpointStatus), b.(*VPCEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VPCStatus)(nil), (*alicloud.VPCStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VPCStatus_To_alicloud_VPCStatus(a.(*VPCStatus), b.(*alicloud.VPCStatus), scope)
 	}); err != nil {
@@ -204,6 +384,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*alicloud.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_alicloud_Volume(a.(*Volume), b.(*alicloud.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_Volume_To_v1alpha1_Volume(a.(*alicloud.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*alicloud.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(a.(*WorkerConfig), b.(*alicloud.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*alicloud.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*alicloud.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(a.(*WorkerStatus), b.(*alicloud.WorkerStatus), scope)
 	}); err != nil {
@@ -247,6 +447,28 @@ func Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *alic
 	return autoConvert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CEN_To_alicloud_CEN(in *CEN, out *alicloud.CEN, s conversion.Scope) error {
+	out.ID = in.ID
+	out.TransitRouter = (*alicloud.TransitRouter)(unsafe.Pointer(in.TransitRouter))
+	return nil
+}
+
+// Convert_v1alpha1_CEN_To_alicloud_CEN is an autogenerated conversion function.
+func Convert_v1alpha1_CEN_To_alicloud_CEN(in *CEN, out *alicloud.CEN, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CEN_To_alicloud_CEN(in, out, s)
+}
+
+func autoConvert_alicloud_CEN_To_v1alpha1_CEN(in *alicloud.CEN, out *CEN, s conversion.Scope) error {
+	out.ID = in.ID
+	out.TransitRouter = (*TransitRouter)(unsafe.Pointer(in.TransitRouter))
+	return nil
+}
+
+// Convert_alicloud_CEN_To_v1alpha1_CEN is an autogenerated conversion function.
+func Convert_alicloud_CEN_To_v1alpha1_CEN(in *alicloud.CEN, out *CEN, s conversion.Scope) error {
+	return autoConvert_alicloud_CEN_To_v1alpha1_CEN(in, out, s)
+}
+
 func autoConvert_v1alpha1_CSI_To_alicloud_CSI(in *CSI, out *alicloud.CSI, s conversion.Scope) error {
 	out.EnableADController = (*bool)(unsafe.Pointer(in.EnableADController))
 	return nil
@@ -289,6 +511,7 @@ func Convert_alicloud_CloudControllerManagerConfig_To_v1alpha1_CloudControllerMa
 
 func autoConvert_v1alpha1_CloudProfileConfig_To_alicloud_CloudProfileConfig(in *CloudProfileConfig, out *alicloud.CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]alicloud.MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.AllowedRAMRoleNames = *(*[]string)(unsafe.Pointer(&in.AllowedRAMRoleNames))
 	return nil
 }
 
@@ -299,6 +522,7 @@ func Convert_v1alpha1_CloudProfileConfig_To_alicloud_CloudProfileConfig(in *Clou
 
 func autoConvert_alicloud_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *alicloud.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.AllowedRAMRoleNames = *(*[]string)(unsafe.Pointer(&in.AllowedRAMRoleNames))
 	return nil
 }
 
@@ -310,6 +534,7 @@ func Convert_alicloud_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *alic
 func autoConvert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *ControlPlaneConfig, out *alicloud.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*alicloud.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.CSI = (*alicloud.CSI)(unsafe.Pointer(in.CSI))
+	out.DiskEncryption = (*alicloud.DiskEncryption)(unsafe.Pointer(in.DiskEncryption))
 	return nil
 }
 
@@ -321,6 +546,7 @@ func Convert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *Cont
 func autoConvert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *alicloud.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.CSI = (*CSI)(unsafe.Pointer(in.CSI))
+	out.DiskEncryption = (*DiskEncryption)(unsafe.Pointer(in.DiskEncryption))
 	return nil
 }
 
@@ -329,6 +555,102 @@ func Convert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *alic
 	return autoConvert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataVolume_To_alicloud_DataVolume(in *DataVolume, out *alicloud.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	out.PerformanceLevel = (*alicloud.PerformanceLevel)(unsafe.Pointer(in.PerformanceLevel))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.BurstingEnabled = (*bool)(unsafe.Pointer(in.BurstingEnabled))
+	out.AutoSnapshotPolicyID = (*string)(unsafe.Pointer(in.AutoSnapshotPolicyID))
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_alicloud_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_alicloud_DataVolume(in *DataVolume, out *alicloud.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_alicloud_DataVolume(in, out, s)
+}
+
+func autoConvert_alicloud_DataVolume_To_v1alpha1_DataVolume(in *alicloud.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	out.PerformanceLevel = (*PerformanceLevel)(unsafe.Pointer(in.PerformanceLevel))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.BurstingEnabled = (*bool)(unsafe.Pointer(in.BurstingEnabled))
+	out.AutoSnapshotPolicyID = (*string)(unsafe.Pointer(in.AutoSnapshotPolicyID))
+	return nil
+}
+
+// Convert_alicloud_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_alicloud_DataVolume_To_v1alpha1_DataVolume(in *alicloud.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_alicloud_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeploymentSet_To_alicloud_DeploymentSet(in *DeploymentSet, out *alicloud.DeploymentSet, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.ID = in.ID
+	out.Strategy = alicloud.DeploymentSetStrategy(in.Strategy)
+	return nil
+}
+
+// Convert_v1alpha1_DeploymentSet_To_alicloud_DeploymentSet is an autogenerated conversion function.
+func Convert_v1alpha1_DeploymentSet_To_alicloud_DeploymentSet(in *DeploymentSet, out *alicloud.DeploymentSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeploymentSet_To_alicloud_DeploymentSet(in, out, s)
+}
+
+func autoConvert_alicloud_DeploymentSet_To_v1alpha1_DeploymentSet(in *alicloud.DeploymentSet, out *DeploymentSet, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.ID = in.ID
+	out.Strategy = DeploymentSetStrategy(in.Strategy)
+	return nil
+}
+
+// Convert_alicloud_DeploymentSet_To_v1alpha1_DeploymentSet is an autogenerated conversion function.
+func Convert_alicloud_DeploymentSet_To_v1alpha1_DeploymentSet(in *alicloud.DeploymentSet, out *DeploymentSet, s conversion.Scope) error {
+	return autoConvert_alicloud_DeploymentSet_To_v1alpha1_DeploymentSet(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskEncryption_To_alicloud_DiskEncryption(in *DiskEncryption, out *alicloud.DiskEncryption, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_DiskEncryption_To_alicloud_DiskEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_DiskEncryption_To_alicloud_DiskEncryption(in *DiskEncryption, out *alicloud.DiskEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskEncryption_To_alicloud_DiskEncryption(in, out, s)
+}
+
+func autoConvert_alicloud_DiskEncryption_To_v1alpha1_DiskEncryption(in *alicloud.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_alicloud_DiskEncryption_To_v1alpha1_DiskEncryption is an autogenerated conversion function.
+func Convert_alicloud_DiskEncryption_To_v1alpha1_DiskEncryption(in *alicloud.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	return autoConvert_alicloud_DiskEncryption_To_v1alpha1_DiskEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPv6_To_alicloud_IPv6(in *IPv6, out *alicloud.IPv6, s conversion.Scope) error {
+	out.AllowNodePortIPv6 = (*bool)(unsafe.Pointer(in.AllowNodePortIPv6))
+	return nil
+}
+
+// Convert_v1alpha1_IPv6_To_alicloud_IPv6 is an autogenerated conversion function.
+func Convert_v1alpha1_IPv6_To_alicloud_IPv6(in *IPv6, out *alicloud.IPv6, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPv6_To_alicloud_IPv6(in, out, s)
+}
+
+func autoConvert_alicloud_IPv6_To_v1alpha1_IPv6(in *alicloud.IPv6, out *IPv6, s conversion.Scope) error {
+	out.AllowNodePortIPv6 = (*bool)(unsafe.Pointer(in.AllowNodePortIPv6))
+	return nil
+}
+
+// Convert_alicloud_IPv6_To_v1alpha1_IPv6 is an autogenerated conversion function.
+func Convert_alicloud_IPv6_To_v1alpha1_IPv6(in *alicloud.IPv6, out *IPv6, s conversion.Scope) error {
+	return autoConvert_alicloud_IPv6_To_v1alpha1_IPv6(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImmutableConfig_To_alicloud_ImmutableConfig(in *ImmutableConfig, out *alicloud.ImmutableConfig, s conversion.Scope) error {
 	out.RetentionType = alicloud.RetentionType(in.RetentionType)
 	out.RetentionPeriod = in.RetentionPeriod
@@ -357,6 +679,8 @@ func autoConvert_v1alpha1_InfrastructureConfig_To_alicloud_InfrastructureConfig(
 	if err := Convert_v1alpha1_Networks_To_alicloud_Networks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.ResourceGroupID = (*string)(unsafe.Pointer(in.ResourceGroupID))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	return nil
 }
 
@@ -369,6 +693,8 @@ func autoConvert_alicloud_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(
 	if err := Convert_alicloud_Networks_To_v1alpha1_Networks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.ResourceGroupID = (*string)(unsafe.Pointer(in.ResourceGroupID))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	return nil
 }
 
@@ -377,12 +703,35 @@ func Convert_alicloud_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *
 	return autoConvert_alicloud_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_InfrastructurePlan_To_alicloud_InfrastructurePlan(in *InfrastructurePlan, out *alicloud.InfrastructurePlan, s conversion.Scope) error {
+	out.Changes = *(*[]alicloud.PlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructurePlan_To_alicloud_InfrastructurePlan is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructurePlan_To_alicloud_InfrastructurePlan(in *InfrastructurePlan, out *alicloud.InfrastructurePlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructurePlan_To_alicloud_InfrastructurePlan(in, out, s)
+}
+
+func autoConvert_alicloud_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(in *alicloud.InfrastructurePlan, out *InfrastructurePlan, s conversion.Scope) error {
+	out.Changes = *(*[]PlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_alicloud_InfrastructurePlan_To_v1alpha1_InfrastructurePlan is an autogenerated conversion function.
+func Convert_alicloud_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(in *alicloud.InfrastructurePlan, out *InfrastructurePlan, s conversion.Scope) error {
+	return autoConvert_alicloud_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureStatus_To_alicloud_InfrastructureStatus(in *InfrastructureStatus, out *alicloud.InfrastructureStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VPCStatus_To_alicloud_VPCStatus(&in.VPC, &out.VPC, s); err != nil {
 		return err
 	}
 	out.KeyPairName = in.KeyPairName
 	out.MachineImages = *(*[]alicloud.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.ResourceGroupID = in.ResourceGroupID
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Plan = (*alicloud.InfrastructurePlan)(unsafe.Pointer(in.Plan))
 	return nil
 }
 
@@ -397,6 +746,9 @@ func autoConvert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(
 	}
 	out.KeyPairName = in.KeyPairName
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.ResourceGroupID = in.ResourceGroupID
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Plan = (*InfrastructurePlan)(unsafe.Pointer(in.Plan))
 	return nil
 }
 
@@ -405,11 +757,61 @@ func Convert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *
 	return autoConvert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstanceMetadataOptions_To_alicloud_InstanceMetadataOptions(in *InstanceMetadataOptions, out *alicloud.InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*alicloud.HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int32)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	return nil
+}
+
+// Convert_v1alpha1_InstanceMetadataOptions_To_alicloud_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceMetadataOptions_To_alicloud_InstanceMetadataOptions(in *InstanceMetadataOptions, out *alicloud.InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceMetadataOptions_To_alicloud_InstanceMetadataOptions(in, out, s)
+}
+
+func autoConvert_alicloud_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *alicloud.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int32)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	return nil
+}
+
+// Convert_alicloud_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_alicloud_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *alicloud.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_alicloud_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstanceTypeFallback_To_alicloud_InstanceTypeFallback(in *InstanceTypeFallback, out *alicloud.InstanceTypeFallback, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.InstanceType = in.InstanceType
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1alpha1_InstanceTypeFallback_To_alicloud_InstanceTypeFallback is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceTypeFallback_To_alicloud_InstanceTypeFallback(in *InstanceTypeFallback, out *alicloud.InstanceTypeFallback, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceTypeFallback_To_alicloud_InstanceTypeFallback(in, out, s)
+}
+
+func autoConvert_alicloud_InstanceTypeFallback_To_v1alpha1_InstanceTypeFallback(in *alicloud.InstanceTypeFallback, out *InstanceTypeFallback, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.InstanceType = in.InstanceType
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_alicloud_InstanceTypeFallback_To_v1alpha1_InstanceTypeFallback is an autogenerated conversion function.
+func Convert_alicloud_InstanceTypeFallback_To_v1alpha1_InstanceTypeFallback(in *alicloud.InstanceTypeFallback, out *InstanceTypeFallback, s conversion.Scope) error {
+	return autoConvert_alicloud_InstanceTypeFallback_To_v1alpha1_InstanceTypeFallback(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_alicloud_MachineImage(in *MachineImage, out *alicloud.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
 	out.ID = in.ID
 	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
 	return nil
 }
 
@@ -423,6 +825,8 @@ func autoConvert_alicloud_MachineImage_To_v1alpha1_MachineImage(in *alicloud.Mac
 	out.Version = in.Version
 	out.ID = in.ID
 	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
 	return nil
 }
 
@@ -475,8 +879,35 @@ func Convert_alicloud_MachineImages_To_v1alpha1_MachineImages(in *alicloud.Machi
 	return autoConvert_alicloud_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_ManagedNatGatewayConfig_To_alicloud_ManagedNatGatewayConfig(in *ManagedNatGatewayConfig, out *alicloud.ManagedNatGatewayConfig, s conversion.Scope) error {
+	out.InternetChargeType = (*string)(unsafe.Pointer(in.InternetChargeType))
+	out.Spec = (*string)(unsafe.Pointer(in.Spec))
+	return nil
+}
+
+// Convert_v1alpha1_ManagedNatGatewayConfig_To_alicloud_ManagedNatGatewayConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedNatGatewayConfig_To_alicloud_ManagedNatGatewayConfig(in *ManagedNatGatewayConfig, out *alicloud.ManagedNatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedNatGatewayConfig_To_alicloud_ManagedNatGatewayConfig(in, out, s)
+}
+
+func autoConvert_alicloud_ManagedNatGatewayConfig_To_v1alpha1_ManagedNatGatewayConfig(in *alicloud.ManagedNatGatewayConfig, out *ManagedNatGatewayConfig, s conversion.Scope) error {
+	out.InternetChargeType = (*string)(unsafe.Pointer(in.InternetChargeType))
+	out.Spec = (*string)(unsafe.Pointer(in.Spec))
+	return nil
+}
+
+// Convert_alicloud_ManagedNatGatewayConfig_To_v1alpha1_ManagedNatGatewayConfig is an autogenerated conversion function.
+func Convert_alicloud_ManagedNatGatewayConfig_To_v1alpha1_ManagedNatGatewayConfig(in *alicloud.ManagedNatGatewayConfig, out *ManagedNatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_ManagedNatGatewayConfig_To_v1alpha1_ManagedNatGatewayConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_NatGatewayConfig_To_alicloud_NatGatewayConfig(in *NatGatewayConfig, out *alicloud.NatGatewayConfig, s conversion.Scope) error {
 	out.EIPAllocationID = (*string)(unsafe.Pointer(in.EIPAllocationID))
+	out.EIPAllocationIDs = *(*[]string)(unsafe.Pointer(&in.EIPAllocationIDs))
+	out.EIPCount = (*int32)(unsafe.Pointer(in.EIPCount))
+	out.EIPBandwidth = (*int32)(unsafe.Pointer(in.EIPBandwidth))
+	out.EIPISP = (*string)(unsafe.Pointer(in.EIPISP))
+	out.EIPBandwidthPackageID = (*string)(unsafe.Pointer(in.EIPBandwidthPackageID))
 	return nil
 }
 
@@ -487,6 +918,11 @@ func Convert_v1alpha1_NatGatewayConfig_To_alicloud_NatGatewayConfig(in *NatGatew
 
 func autoConvert_alicloud_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *alicloud.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
 	out.EIPAllocationID = (*string)(unsafe.Pointer(in.EIPAllocationID))
+	out.EIPAllocationIDs = *(*[]string)(unsafe.Pointer(&in.EIPAllocationIDs))
+	out.EIPCount = (*int32)(unsafe.Pointer(in.EIPCount))
+	out.EIPBandwidth = (*int32)(unsafe.Pointer(in.EIPBandwidth))
+	out.EIPISP = (*string)(unsafe.Pointer(in.EIPISP))
+	out.EIPBandwidthPackageID = (*string)(unsafe.Pointer(in.EIPBandwidthPackageID))
 	return nil
 }
 
@@ -500,6 +936,11 @@ func autoConvert_v1alpha1_Networks_To_alicloud_Networks(in *Networks, out *alicl
 		return err
 	}
 	out.Zones = *(*[]alicloud.Zone)(unsafe.Pointer(&in.Zones))
+	out.NodePortSourceCIDRs = *(*[]string)(unsafe.Pointer(&in.NodePortSourceCIDRs))
+	out.SecurityGroupRules = *(*[]alicloud.SecurityGroupRule)(unsafe.Pointer(&in.SecurityGroupRules))
+	out.IPv6 = (*alicloud.IPv6)(unsafe.Pointer(in.IPv6))
+	out.CEN = (*alicloud.CEN)(unsafe.Pointer(in.CEN))
+	out.VPCEndpoints = *(*[]alicloud.VPCEndpoint)(unsafe.Pointer(&in.VPCEndpoints))
 	return nil
 }
 
@@ -513,6 +954,11 @@ func autoConvert_alicloud_Networks_To_v1alpha1_Networks(in *alicloud.Networks, o
 		return err
 	}
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.NodePortSourceCIDRs = *(*[]string)(unsafe.Pointer(&in.NodePortSourceCIDRs))
+	out.SecurityGroupRules = *(*[]SecurityGroupRule)(unsafe.Pointer(&in.SecurityGroupRules))
+	out.IPv6 = (*IPv6)(unsafe.Pointer(in.IPv6))
+	out.CEN = (*CEN)(unsafe.Pointer(in.CEN))
+	out.VPCEndpoints = *(*[]VPCEndpoint)(unsafe.Pointer(&in.VPCEndpoints))
 	return nil
 }
 
@@ -521,9 +967,84 @@ func Convert_alicloud_Networks_To_v1alpha1_Networks(in *alicloud.Networks, out *
 	return autoConvert_alicloud_Networks_To_v1alpha1_Networks(in, out, s)
 }
 
+func autoConvert_v1alpha1_PlacementConfig_To_alicloud_PlacementConfig(in *PlacementConfig, out *alicloud.PlacementConfig, s conversion.Scope) error {
+	out.DeploymentSetID = (*string)(unsafe.Pointer(in.DeploymentSetID))
+	out.DeploymentSetStrategy = (*alicloud.DeploymentSetStrategy)(unsafe.Pointer(in.DeploymentSetStrategy))
+	out.DedicatedHostID = (*string)(unsafe.Pointer(in.DedicatedHostID))
+	out.DedicatedHostClusterID = (*string)(unsafe.Pointer(in.DedicatedHostClusterID))
+	return nil
+}
+
+// Convert_v1alpha1_PlacementConfig_To_alicloud_PlacementConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PlacementConfig_To_alicloud_PlacementConfig(in *PlacementConfig, out *alicloud.PlacementConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlacementConfig_To_alicloud_PlacementConfig(in, out, s)
+}
+
+func autoConvert_alicloud_PlacementConfig_To_v1alpha1_PlacementConfig(in *alicloud.PlacementConfig, out *PlacementConfig, s conversion.Scope) error {
+	out.DeploymentSetID = (*string)(unsafe.Pointer(in.DeploymentSetID))
+	out.DeploymentSetStrategy = (*DeploymentSetStrategy)(unsafe.Pointer(in.DeploymentSetStrategy))
+	out.DedicatedHostID = (*string)(unsafe.Pointer(in.DedicatedHostID))
+	out.DedicatedHostClusterID = (*string)(unsafe.Pointer(in.DedicatedHostClusterID))
+	return nil
+}
+
+// Convert_alicloud_PlacementConfig_To_v1alpha1_PlacementConfig is an autogenerated conversion function.
+func Convert_alicloud_PlacementConfig_To_v1alpha1_PlacementConfig(in *alicloud.PlacementConfig, out *PlacementConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_PlacementConfig_To_v1alpha1_PlacementConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlannedChange_To_alicloud_PlannedChange(in *PlannedChange, out *alicloud.PlannedChange, s conversion.Scope) error {
+	out.Action = in.Action
+	out.ResourceType = in.ResourceType
+	out.ID = in.ID
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha1_PlannedChange_To_alicloud_PlannedChange is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedChange_To_alicloud_PlannedChange(in *PlannedChange, out *alicloud.PlannedChange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedChange_To_alicloud_PlannedChange(in, out, s)
+}
+
+func autoConvert_alicloud_PlannedChange_To_v1alpha1_PlannedChange(in *alicloud.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	out.Action = in.Action
+	out.ResourceType = in.ResourceType
+	out.ID = in.ID
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_alicloud_PlannedChange_To_v1alpha1_PlannedChange is an autogenerated conversion function.
+func Convert_alicloud_PlannedChange_To_v1alpha1_PlannedChange(in *alicloud.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	return autoConvert_alicloud_PlannedChange_To_v1alpha1_PlannedChange(in, out, s)
+}
+
+func autoConvert_v1alpha1_PoolSecurityGroup_To_alicloud_PoolSecurityGroup(in *PoolSecurityGroup, out *alicloud.PoolSecurityGroup, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_PoolSecurityGroup_To_alicloud_PoolSecurityGroup is an autogenerated conversion function.
+func Convert_v1alpha1_PoolSecurityGroup_To_alicloud_PoolSecurityGroup(in *PoolSecurityGroup, out *alicloud.PoolSecurityGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PoolSecurityGroup_To_alicloud_PoolSecurityGroup(in, out, s)
+}
+
+func autoConvert_alicloud_PoolSecurityGroup_To_v1alpha1_PoolSecurityGroup(in *alicloud.PoolSecurityGroup, out *PoolSecurityGroup, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_alicloud_PoolSecurityGroup_To_v1alpha1_PoolSecurityGroup is an autogenerated conversion function.
+func Convert_alicloud_PoolSecurityGroup_To_v1alpha1_PoolSecurityGroup(in *alicloud.PoolSecurityGroup, out *PoolSecurityGroup, s conversion.Scope) error {
+	return autoConvert_alicloud_PoolSecurityGroup_To_v1alpha1_PoolSecurityGroup(in, out, s)
+}
+
 func autoConvert_v1alpha1_RegionIDMapping_To_alicloud_RegionIDMapping(in *RegionIDMapping, out *alicloud.RegionIDMapping, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ID = in.ID
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -535,6 +1056,7 @@ func Convert_v1alpha1_RegionIDMapping_To_alicloud_RegionIDMapping(in *RegionIDMa
 func autoConvert_alicloud_RegionIDMapping_To_v1alpha1_RegionIDMapping(in *alicloud.RegionIDMapping, out *RegionIDMapping, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ID = in.ID
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -565,10 +1087,113 @@ func Convert_alicloud_SecurityGroup_To_v1alpha1_SecurityGroup(in *alicloud.Secur
 	return autoConvert_alicloud_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecurityGroupRule_To_alicloud_SecurityGroupRule(in *SecurityGroupRule, out *alicloud.SecurityGroupRule, s conversion.Scope) error {
+	out.Direction = alicloud.SecurityGroupRuleDirection(in.Direction)
+	out.Protocol = in.Protocol
+	out.PortRange = in.PortRange
+	out.CIDR = in.CIDR
+	out.Policy = (*alicloud.SecurityGroupRulePolicy)(unsafe.Pointer(in.Policy))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroupRule_To_alicloud_SecurityGroupRule is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroupRule_To_alicloud_SecurityGroupRule(in *SecurityGroupRule, out *alicloud.SecurityGroupRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroupRule_To_alicloud_SecurityGroupRule(in, out, s)
+}
+
+func autoConvert_alicloud_SecurityGroupRule_To_v1alpha1_SecurityGroupRule(in *alicloud.SecurityGroupRule, out *SecurityGroupRule, s conversion.Scope) error {
+	out.Direction = SecurityGroupRuleDirection(in.Direction)
+	out.Protocol = in.Protocol
+	out.PortRange = in.PortRange
+	out.CIDR = in.CIDR
+	out.Policy = (*SecurityGroupRulePolicy)(unsafe.Pointer(in.Policy))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	return nil
+}
+
+// Convert_alicloud_SecurityGroupRule_To_v1alpha1_SecurityGroupRule is an autogenerated conversion function.
+func Convert_alicloud_SecurityGroupRule_To_v1alpha1_SecurityGroupRule(in *alicloud.SecurityGroupRule, out *SecurityGroupRule, s conversion.Scope) error {
+	return autoConvert_alicloud_SecurityGroupRule_To_v1alpha1_SecurityGroupRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_SpotConfig_To_alicloud_SpotConfig(in *SpotConfig, out *alicloud.SpotConfig, s conversion.Scope) error {
+	out.Strategy = alicloud.SpotStrategy(in.Strategy)
+	out.PriceLimit = (*string)(unsafe.Pointer(in.PriceLimit))
+	out.Duration = (*int32)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1alpha1_SpotConfig_To_alicloud_SpotConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SpotConfig_To_alicloud_SpotConfig(in *SpotConfig, out *alicloud.SpotConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SpotConfig_To_alicloud_SpotConfig(in, out, s)
+}
+
+func autoConvert_alicloud_SpotConfig_To_v1alpha1_SpotConfig(in *alicloud.SpotConfig, out *SpotConfig, s conversion.Scope) error {
+	out.Strategy = SpotStrategy(in.Strategy)
+	out.PriceLimit = (*string)(unsafe.Pointer(in.PriceLimit))
+	out.Duration = (*int32)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_alicloud_SpotConfig_To_v1alpha1_SpotConfig is an autogenerated conversion function.
+func Convert_alicloud_SpotConfig_To_v1alpha1_SpotConfig(in *alicloud.SpotConfig, out *SpotConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_SpotConfig_To_v1alpha1_SpotConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_SubscriptionConfig_To_alicloud_SubscriptionConfig(in *SubscriptionConfig, out *alicloud.SubscriptionConfig, s conversion.Scope) error {
+	out.Period = in.Period
+	out.PeriodUnit = (*alicloud.PeriodUnit)(unsafe.Pointer(in.PeriodUnit))
+	out.AutoRenew = (*bool)(unsafe.Pointer(in.AutoRenew))
+	out.AutoRenewPeriod = (*int32)(unsafe.Pointer(in.AutoRenewPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_SubscriptionConfig_To_alicloud_SubscriptionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SubscriptionConfig_To_alicloud_SubscriptionConfig(in *SubscriptionConfig, out *alicloud.SubscriptionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubscriptionConfig_To_alicloud_SubscriptionConfig(in, out, s)
+}
+
+func autoConvert_alicloud_SubscriptionConfig_To_v1alpha1_SubscriptionConfig(in *alicloud.SubscriptionConfig, out *SubscriptionConfig, s conversion.Scope) error {
+	out.Period = in.Period
+	out.PeriodUnit = (*PeriodUnit)(unsafe.Pointer(in.PeriodUnit))
+	out.AutoRenew = (*bool)(unsafe.Pointer(in.AutoRenew))
+	out.AutoRenewPeriod = (*int32)(unsafe.Pointer(in.AutoRenewPeriod))
+	return nil
+}
+
+// Convert_alicloud_SubscriptionConfig_To_v1alpha1_SubscriptionConfig is an autogenerated conversion function.
+func Convert_alicloud_SubscriptionConfig_To_v1alpha1_SubscriptionConfig(in *alicloud.SubscriptionConfig, out *SubscriptionConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_SubscriptionConfig_To_v1alpha1_SubscriptionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TransitRouter_To_alicloud_TransitRouter(in *TransitRouter, out *alicloud.TransitRouter, s conversion.Scope) error {
+	out.ID = in.ID
+	out.RouteTableID = in.RouteTableID
+	return nil
+}
+
+// Convert_v1alpha1_TransitRouter_To_alicloud_TransitRouter is an autogenerated conversion function.
+func Convert_v1alpha1_TransitRouter_To_alicloud_TransitRouter(in *TransitRouter, out *alicloud.TransitRouter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TransitRouter_To_alicloud_TransitRouter(in, out, s)
+}
+
+func autoConvert_alicloud_TransitRouter_To_v1alpha1_TransitRouter(in *alicloud.TransitRouter, out *TransitRouter, s conversion.Scope) error {
+	out.ID = in.ID
+	out.RouteTableID = in.RouteTableID
+	return nil
+}
+
+// Convert_alicloud_TransitRouter_To_v1alpha1_TransitRouter is an autogenerated conversion function.
+func Convert_alicloud_TransitRouter_To_v1alpha1_TransitRouter(in *alicloud.TransitRouter, out *TransitRouter, s conversion.Scope) error {
+	return autoConvert_alicloud_TransitRouter_To_v1alpha1_TransitRouter(in, out, s)
+}
+
 func autoConvert_v1alpha1_VPC_To_alicloud_VPC(in *VPC, out *alicloud.VPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
 	out.GardenerManagedNATGateway = (*bool)(unsafe.Pointer(in.GardenerManagedNATGateway))
+	out.NatGateway = (*alicloud.ManagedNatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -581,6 +1206,7 @@ func autoConvert_alicloud_VPC_To_v1alpha1_VPC(in *alicloud.VPC, out *VPC, s conv
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
 	out.GardenerManagedNATGateway = (*bool)(unsafe.Pointer(in.GardenerManagedNATGateway))
+	out.NatGateway = (*ManagedNatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -589,10 +1215,65 @@ func Convert_alicloud_VPC_To_v1alpha1_VPC(in *alicloud.VPC, out *VPC, s conversi
 	return autoConvert_alicloud_VPC_To_v1alpha1_VPC(in, out, s)
 }
 
+func autoConvert_v1alpha1_VPCEndpoint_To_alicloud_VPCEndpoint(in *VPCEndpoint, out *alicloud.VPCEndpoint, s conversion.Scope) error {
+	out.Type = (*alicloud.VPCEndpointType)(unsafe.Pointer(in.Type))
+	out.ServiceName = in.ServiceName
+	out.PrivateDNSName = (*string)(unsafe.Pointer(in.PrivateDNSName))
+	return nil
+}
+
+// Convert_v1alpha1_VPCEndpoint_To_alicloud_VPCEndpoint is an autogenerated conversion function.
+func Convert_v1alpha1_VPCEndpoint_To_alicloud_VPCEndpoint(in *VPCEndpoint, out *alicloud.VPCEndpoint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCEndpoint_To_alicloud_VPCEndpoint(in, out, s)
+}
+
+func autoConvert_alicloud_VPCEndpoint_To_v1alpha1_VPCEndpoint(in *alicloud.VPCEndpoint, out *VPCEndpoint, s conversion.Scope) error {
+	out.Type = (*VPCEndpointType)(unsafe.Pointer(in.Type))
+	out.ServiceName = in.ServiceName
+	out.PrivateDNSName = (*string)(unsafe.Pointer(in.PrivateDNSName))
+	return nil
+}
+
+// Convert_alicloud_VPCEndpoint_To_v1alpha1_VPCEndpoint is an autogenerated conversion function.
+func Convert_alicloud_VPCEndpoint_To_v1alpha1_VPCEndpoint(in *alicloud.VPCEndpoint, out *VPCEndpoint, s conversion.Scope) error {
+	return autoConvert_alicloud_VPCEndpoint_To_v1alpha1_VPCEndpoint(in, out, s)
+}
+
+func autoConvert_v1alpha1_VPCEndpointStatus_To_alicloud_VPCEndpointStatus(in *VPCEndpointStatus, out *alicloud.VPCEndpointStatus, s conversion.Scope) error {
+	out.Type = alicloud.VPCEndpointType(in.Type)
+	out.ServiceName = in.ServiceName
+	out.ID = in.ID
+	out.Domain = in.Domain
+	out.PrivateZoneID = in.PrivateZoneID
+	return nil
+}
+
+// Convert_v1alpha1_VPCEndpointStatus_To_alicloud_VPCEndpointStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VPCEndpointStatus_To_alicloud_VPCEndpointStatus(in *VPCEndpointStatus, out *alicloud.VPCEndpointStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCEndpointStatus_To_alicloud_VPCEndpointStatus(in, out, s)
+}
+
+func autoConvert_alicloud_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(in *alicloud.VPCEndpointStatus, out *VPCEndpointStatus, s conversion.Scope) error {
+	out.Type = VPCEndpointType(in.Type)
+	out.ServiceName = in.ServiceName
+	out.ID = in.ID
+	out.Domain = in.Domain
+	out.PrivateZoneID = in.PrivateZoneID
+	return nil
+}
+
+// Convert_alicloud_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus is an autogenerated conversion function.
+func Convert_alicloud_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(in *alicloud.VPCEndpointStatus, out *VPCEndpointStatus, s conversion.Scope) error {
+	return autoConvert_alicloud_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_VPCStatus_To_alicloud_VPCStatus(in *VPCStatus, out *alicloud.VPCStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.VSwitches = *(*[]alicloud.VSwitch)(unsafe.Pointer(&in.VSwitches))
 	out.SecurityGroups = *(*[]alicloud.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.IPv6CIDR = in.IPv6CIDR
+	out.IPv6GatewayID = in.IPv6GatewayID
+	out.VPCEndpoints = *(*[]alicloud.VPCEndpointStatus)(unsafe.Pointer(&in.VPCEndpoints))
 	return nil
 }
 
@@ -605,6 +1286,9 @@ func autoConvert_alicloud_VPCStatus_To_v1alpha1_VPCStatus(in *alicloud.VPCStatus
 	out.ID = in.ID
 	out.VSwitches = *(*[]VSwitch)(unsafe.Pointer(&in.VSwitches))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.IPv6CIDR = in.IPv6CIDR
+	out.IPv6GatewayID = in.IPv6GatewayID
+	out.VPCEndpoints = *(*[]VPCEndpointStatus)(unsafe.Pointer(&in.VPCEndpoints))
 	return nil
 }
 
@@ -617,6 +1301,7 @@ func autoConvert_v1alpha1_VSwitch_To_alicloud_VSwitch(in *VSwitch, out *alicloud
 	out.Purpose = alicloud.Purpose(in.Purpose)
 	out.ID = in.ID
 	out.Zone = in.Zone
+	out.IPv6CIDR = in.IPv6CIDR
 	return nil
 }
 
@@ -629,6 +1314,7 @@ func autoConvert_alicloud_VSwitch_To_v1alpha1_VSwitch(in *alicloud.VSwitch, out
 	out.Purpose = Purpose(in.Purpose)
 	out.ID = in.ID
 	out.Zone = in.Zone
+	out.IPv6CIDR = in.IPv6CIDR
 	return nil
 }
 
@@ -637,8 +1323,89 @@ func Convert_alicloud_VSwitch_To_v1alpha1_VSwitch(in *alicloud.VSwitch, out *VSw
 	return autoConvert_alicloud_VSwitch_To_v1alpha1_VSwitch(in, out, s)
 }
 
+func autoConvert_v1alpha1_Volume_To_alicloud_Volume(in *Volume, out *alicloud.Volume, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	out.PerformanceLevel = (*alicloud.PerformanceLevel)(unsafe.Pointer(in.PerformanceLevel))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.BurstingEnabled = (*bool)(unsafe.Pointer(in.BurstingEnabled))
+	out.AutoSnapshotPolicyID = (*string)(unsafe.Pointer(in.AutoSnapshotPolicyID))
+	return nil
+}
+
+// Convert_v1alpha1_Volume_To_alicloud_Volume is an autogenerated conversion function.
+func Convert_v1alpha1_Volume_To_alicloud_Volume(in *Volume, out *alicloud.Volume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Volume_To_alicloud_Volume(in, out, s)
+}
+
+func autoConvert_alicloud_Volume_To_v1alpha1_Volume(in *alicloud.Volume, out *Volume, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	out.PerformanceLevel = (*PerformanceLevel)(unsafe.Pointer(in.PerformanceLevel))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.BurstingEnabled = (*bool)(unsafe.Pointer(in.BurstingEnabled))
+	out.AutoSnapshotPolicyID = (*string)(unsafe.Pointer(in.AutoSnapshotPolicyID))
+	return nil
+}
+
+// Convert_alicloud_Volume_To_v1alpha1_Volume is an autogenerated conversion function.
+func Convert_alicloud_Volume_To_v1alpha1_Volume(in *alicloud.Volume, out *Volume, s conversion.Scope) error {
+	return autoConvert_alicloud_Volume_To_v1alpha1_Volume(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	out.InstanceChargeType = (*alicloud.InstanceChargeType)(unsafe.Pointer(in.InstanceChargeType))
+	out.Subscription = (*alicloud.SubscriptionConfig)(unsafe.Pointer(in.Subscription))
+	out.InternetChargeType = (*alicloud.InternetChargeType)(unsafe.Pointer(in.InternetChargeType))
+	out.InternetMaxBandwidthIn = (*int32)(unsafe.Pointer(in.InternetMaxBandwidthIn))
+	out.InternetMaxBandwidthOut = (*int32)(unsafe.Pointer(in.InternetMaxBandwidthOut))
+	out.Spot = (*alicloud.SpotConfig)(unsafe.Pointer(in.Spot))
+	out.Placement = (*alicloud.PlacementConfig)(unsafe.Pointer(in.Placement))
+	out.AdditionalSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroupIDs))
+	out.SecurityGroupRules = *(*[]alicloud.SecurityGroupRule)(unsafe.Pointer(&in.SecurityGroupRules))
+	out.FallbackInstanceTypes = *(*[]string)(unsafe.Pointer(&in.FallbackInstanceTypes))
+	out.Volume = (*alicloud.Volume)(unsafe.Pointer(in.Volume))
+	out.DataVolumes = *(*[]alicloud.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.InstanceMetadataOptions = (*alicloud.InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.RAMRoleName = (*string)(unsafe.Pointer(in.RAMRoleName))
+	out.ResourceGroupID = (*string)(unsafe.Pointer(in.ResourceGroupID))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in, out, s)
+}
+
+func autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.InstanceChargeType = (*InstanceChargeType)(unsafe.Pointer(in.InstanceChargeType))
+	out.Subscription = (*SubscriptionConfig)(unsafe.Pointer(in.Subscription))
+	out.InternetChargeType = (*InternetChargeType)(unsafe.Pointer(in.InternetChargeType))
+	out.InternetMaxBandwidthIn = (*int32)(unsafe.Pointer(in.InternetMaxBandwidthIn))
+	out.InternetMaxBandwidthOut = (*int32)(unsafe.Pointer(in.InternetMaxBandwidthOut))
+	out.Spot = (*SpotConfig)(unsafe.Pointer(in.Spot))
+	out.Placement = (*PlacementConfig)(unsafe.Pointer(in.Placement))
+	out.AdditionalSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroupIDs))
+	out.SecurityGroupRules = *(*[]SecurityGroupRule)(unsafe.Pointer(&in.SecurityGroupRules))
+	out.FallbackInstanceTypes = *(*[]string)(unsafe.Pointer(&in.FallbackInstanceTypes))
+	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
+	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.InstanceMetadataOptions = (*InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.RAMRoleName = (*string)(unsafe.Pointer(in.RAMRoleName))
+	out.ResourceGroupID = (*string)(unsafe.Pointer(in.ResourceGroupID))
+	return nil
+}
+
+// Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(in *WorkerStatus, out *alicloud.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]alicloud.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.DeploymentSets = *(*[]alicloud.DeploymentSet)(unsafe.Pointer(&in.DeploymentSets))
+	out.SecurityGroups = *(*[]alicloud.PoolSecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.InstanceTypeFallbacks = *(*[]alicloud.InstanceTypeFallback)(unsafe.Pointer(&in.InstanceTypeFallbacks))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.InstanceTypeArchitectures = *(*map[string]string)(unsafe.Pointer(&in.InstanceTypeArchitectures))
 	return nil
 }
 
@@ -649,6 +1416,11 @@ func Convert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(in *WorkerStatus, ou
 
 func autoConvert_alicloud_WorkerStatus_To_v1alpha1_WorkerStatus(in *alicloud.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.DeploymentSets = *(*[]DeploymentSet)(unsafe.Pointer(&in.DeploymentSets))
+	out.SecurityGroups = *(*[]PoolSecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.InstanceTypeFallbacks = *(*[]InstanceTypeFallback)(unsafe.Pointer(&in.InstanceTypeFallbacks))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.InstanceTypeArchitectures = *(*map[string]string)(unsafe.Pointer(&in.InstanceTypeArchitectures))
 	return nil
 }
 
@@ -662,6 +1434,8 @@ func autoConvert_v1alpha1_Zone_To_alicloud_Zone(in *Zone, out *alicloud.Zone, s
 	out.Worker = in.Worker
 	out.Workers = in.Workers
 	out.NatGateway = (*alicloud.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.WorkersIPv6Index = (*int32)(unsafe.Pointer(in.WorkersIPv6Index))
+	out.WorkersVSwitchID = (*string)(unsafe.Pointer(in.WorkersVSwitchID))
 	return nil
 }
 
@@ -675,6 +1449,8 @@ func autoConvert_alicloud_Zone_To_v1alpha1_Zone(in *alicloud.Zone, out *Zone, s
 	out.Worker = in.Worker
 	out.Workers = in.Workers
 	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.WorkersIPv6Index = (*int32)(unsafe.Pointer(in.WorkersIPv6Index))
+	out.WorkersVSwitchID = (*string)(unsafe.Pointer(in.WorkersVSwitchID))
 	return nil
 }
 
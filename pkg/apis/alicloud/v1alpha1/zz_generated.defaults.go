@@ -17,5 +17,39 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&CloudProfileConfig{}, func(obj interface{}) { SetObjectDefaults_CloudProfileConfig(obj.(*CloudProfileConfig)) })
+	scheme.AddTypeDefaultingFunc(&InfrastructureConfig{}, func(obj interface{}) { SetObjectDefaults_InfrastructureConfig(obj.(*InfrastructureConfig)) })
+	scheme.AddTypeDefaultingFunc(&WorkerConfig{}, func(obj interface{}) { SetObjectDefaults_WorkerConfig(obj.(*WorkerConfig)) })
 	return nil
 }
+
+func SetObjectDefaults_CloudProfileConfig(in *CloudProfileConfig) {
+	for i := range in.MachineImages {
+		a := &in.MachineImages[i]
+		for j := range a.Versions {
+			b := &a.Versions[j]
+			for k := range b.Regions {
+				c := &b.Regions[k]
+				SetDefaults_RegionIDMapping(c)
+			}
+		}
+	}
+}
+
+func SetObjectDefaults_InfrastructureConfig(in *InfrastructureConfig) {
+	for i := range in.Networks.SecurityGroupRules {
+		a := &in.Networks.SecurityGroupRules[i]
+		SetDefaults_SecurityGroupRule(a)
+	}
+}
+
+func SetObjectDefaults_WorkerConfig(in *WorkerConfig) {
+	SetDefaults_WorkerConfig(in)
+	if in.Subscription != nil {
+		SetDefaults_SubscriptionConfig(in.Subscription)
+	}
+	for i := range in.SecurityGroupRules {
+		a := &in.SecurityGroupRules[i]
+		SetDefaults_SecurityGroupRule(a)
+	}
+}
@@ -6,8 +6,12 @@ package validation
 
 import (
 	"fmt"
+	"slices"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 )
@@ -25,6 +29,20 @@ func ValidateCloudProfileConfig(cloudProfile *apisalicloud.CloudProfileConfig, f
 		allErrs = append(allErrs, ValidateMachineImage(idxPath, machineImage)...)
 	}
 
+	ramRoleNamesPath := fldPath.Child("allowedRAMRoleNames")
+	ramRoleNames := sets.New[string]()
+	for i, name := range cloudProfile.AllowedRAMRoleNames {
+		idxPath := ramRoleNamesPath.Index(i)
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide a name"))
+			continue
+		}
+		if ramRoleNames.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		ramRoleNames.Insert(name)
+	}
+
 	return allErrs
 }
 
@@ -49,6 +67,7 @@ func ValidateMachineImage(validationPath *field.Path, machineImage apisalicloud.
 		if len(version.Regions) == 0 {
 			allErrs = append(allErrs, field.Required(jdxPath.Child("regions"), fmt.Sprintf("must provide at least one region for machine image %q and version %q", machineImage.Name, version.Version)))
 		}
+		regionArchitectures := sets.New[string]()
 		for k, region := range version.Regions {
 			kdxPath := jdxPath.Child("regions").Index(k)
 			if len(region.Name) == 0 {
@@ -57,6 +76,16 @@ func ValidateMachineImage(validationPath *field.Path, machineImage apisalicloud.
 			if len(region.ID) == 0 {
 				allErrs = append(allErrs, field.Required(kdxPath.Child("id"), "must provide an id"))
 			}
+
+			architecture := ptr.Deref(region.Architecture, v1beta1constants.ArchitectureAMD64)
+			if !slices.Contains(v1beta1constants.ValidArchitectures, architecture) {
+				allErrs = append(allErrs, field.NotSupported(kdxPath.Child("architecture"), architecture, v1beta1constants.ValidArchitectures))
+			}
+			key := region.Name + "/" + architecture
+			if regionArchitectures.Has(key) {
+				allErrs = append(allErrs, field.Duplicate(kdxPath, fmt.Sprintf("%s (%s)", region.Name, architecture)))
+			}
+			regionArchitectures.Insert(key)
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/validation"
@@ -107,6 +108,51 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					"Field": Equal("root.machineImages[0].versions[0].regions[0].id"),
 				}))))
 			})
+
+			It("should allow mappings of the same region for different architectures", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = append(cloudProfileConfig.MachineImages[0].Versions[0].Regions,
+					apisalicloud.RegionIDMapping{Name: "china", ID: "some-arm-image-id", Architecture: ptr.To("arm64")},
+				)
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))).To(BeEmpty())
+			})
+
+			It("should forbid unsupported architectures and duplicate region mappings", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = append(cloudProfileConfig.MachineImages[0].Versions[0].Regions,
+					apisalicloud.RegionIDMapping{Name: "china", ID: "other-image-id", Architecture: ptr.To("amd64")},
+					apisalicloud.RegionIDMapping{Name: "china", ID: "some-image-id", Architecture: ptr.To("sparc")},
+				)
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.machineImages[0].versions[0].regions[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineImages[0].versions[0].regions[2].architecture"),
+				}))))
+			})
+		})
+
+		Context("RAM role validation", func() {
+			It("should allow RAM role names", func() {
+				cloudProfileConfig.AllowedRAMRoleNames = []string{"node-role", "other-role"}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate RAM role names", func() {
+				cloudProfileConfig.AllowedRAMRoleNames = []string{"node-role", "", "node-role"}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.allowedRAMRoleNames[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.allowedRAMRoleNames[2]"),
+				}))))
+			})
 		})
 	})
 })
@@ -19,5 +19,9 @@ func ValidateControlPlaneConfig(controlPlaneConfig *apisalicloud.ControlPlaneCon
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
 	}
 
+	if controlPlaneConfig.DiskEncryption != nil {
+		allErrs = append(allErrs, validateKMSKeyID(controlPlaneConfig.DiskEncryption.KMSKeyID, fldPath.Child("diskEncryption", "kmsKeyID"))...)
+	}
+
 	return allErrs
 }
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/validation"
@@ -46,5 +47,16 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		It("should forbid an empty default KMS key", func() {
+			controlPlane.DiskEncryption = &apisalicloud.DiskEncryption{KMSKeyID: ptr.To("")}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.32.0", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("diskEncryption.kmsKeyID"),
+				})),
+			))
+		})
 	})
 })
@@ -5,12 +5,58 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+)
+
+const (
+	// maxIPv6CIDRIndex is the maximum index of a /64 IPv6 CIDR block within the /56 IPv6 CIDR block of a VPC.
+	maxIPv6CIDRIndex = 255
+	// maxSnatIPs is the maximum number of EIPs which can be bound to a single SNAT entry.
+	maxSnatIPs = 20
+	// maxUserTags is the maximum number of user-defined tags. Alicloud allows 20 tags per resource, the remaining ones
+	// are reserved for the tags of the extension and the labels of the worker pools.
+	maxUserTags = 10
+	// maxTagLength is the maximum length of the keys and values of tags.
+	maxTagLength = 128
+)
+
+// reservedTagPrefixes are the prefixes of tag keys which are reserved by Alicloud or the extension.
+var reservedTagPrefixes = []string{"aliyun", "acs:", "kubernetes.io/"}
+
+var (
+	// cenIDRegex matches the IDs of CEN instances, e.g. `cen-7qthudw0ll6jmc****`.
+	cenIDRegex = regexp.MustCompile(`^cen-[a-z0-9]+$`)
+	// transitRouterIDRegex matches the IDs of transit routers, e.g. `tr-p0w3x8c9em72a40nw****`.
+	transitRouterIDRegex = regexp.MustCompile(`^tr-[a-z0-9]+$`)
+	// transitRouterRouteTableIDRegex matches the IDs of transit router route tables, e.g. `vtb-bp1dudbh2d5na6b50****`.
+	transitRouterRouteTableIDRegex = regexp.MustCompile(`^vtb-[a-z0-9]+$`)
+	// endpointServiceNameRegex matches the names of PrivateLink endpoint services, e.g. `com.aliyuncs.privatelink.cn-hangzhou.oss`.
+	endpointServiceNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]*[a-z0-9])?$`)
+	// gatewayEndpointServiceNameRegex matches the names of the services offering VPC gateway endpoints, which is only
+	// OSS, e.g. `com.aliyun.cn-hangzhou.oss`.
+	gatewayEndpointServiceNameRegex = regexp.MustCompile(`^com\.aliyun\.[a-z0-9-]+\.oss$`)
+)
+
+// resourceGroupIDRegex matches the IDs of Alicloud resource groups, e.g. `rg-acfmxazb4ph6aiy`.
+var resourceGroupIDRegex = regexp.MustCompile(`^rg-[a-z0-9]+$`)
+
+var (
+	natGatewayInternetChargeTypes = sets.New("PayByLcu", "PayBySpec")
+	natGatewaySpecs               = sets.New("Small", "Middle", "Large", "XLarge.1")
+	vpcEndpointTypes              = sets.New(apisalicloud.VPCEndpointTypeInterface, apisalicloud.VPCEndpointTypeGateway)
 )
 
 // ValidateInfrastructureConfig validates a InfrastructureConfig object.
@@ -69,6 +115,18 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 		allErrs = append(allErrs, nodes.ValidateSubset(workerCIDRs...)...)
 	}
 
+	for i, cidr := range infra.Networks.NodePortSourceCIDRs {
+		cidrPath := networksPath.Child("nodePortSourceCIDRs").Index(i)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidrvalidation.NewCIDR(cidr, cidrPath))...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidrPath, cidr)...)
+	}
+
+	allErrs = append(allErrs, validateSecurityGroupRules(infra.Networks.SecurityGroupRules, networksPath.Child("securityGroupRules"))...)
+	allErrs = append(allErrs, validateIPv6(infra.Networks, networksPath)...)
+	allErrs = append(allErrs, validateWorkersVSwitchIDs(infra.Networks, networksPath)...)
+	allErrs = append(allErrs, validateCEN(infra.Networks.CEN, nodesCIDR, networksPath.Child("cen"))...)
+	allErrs = append(allErrs, validateVPCEndpoints(infra.Networks.VPCEndpoints, networksPath.Child("vpcEndpoints"))...)
+
 	if (infra.Networks.VPC.ID == nil && infra.Networks.VPC.CIDR == nil) || (infra.Networks.VPC.ID != nil && infra.Networks.VPC.CIDR != nil) {
 		allErrs = append(allErrs, field.Invalid(networksPath.Child("vpc"), infra.Networks.VPC, "must specify either a vpc id or a cidr"))
 	} else if infra.Networks.VPC.CIDR != nil && infra.Networks.VPC.ID == nil {
@@ -81,6 +139,14 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 		allErrs = append(allErrs, vpcCIDR.ValidateNotOverlap(pods, services)...)
 	}
 
+	if natGateway := infra.Networks.VPC.NatGateway; natGateway != nil {
+		natGatewayPath := networksPath.Child("vpc", "natGateway")
+		if infra.Networks.VPC.ID != nil && !ptr.Deref(infra.Networks.VPC.GardenerManagedNATGateway, false) {
+			allErrs = append(allErrs, field.Forbidden(natGatewayPath, "can only be set if the NAT gateway is managed by Gardener"))
+		}
+		allErrs = append(allErrs, validateManagedNatGatewayConfig(natGateway, natGatewayPath)...)
+	}
+
 	// make sure that VPC cidrs don't overlap with each other
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(cidrs, false)...)
 	if pods != nil {
@@ -90,6 +156,162 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 		allErrs = append(allErrs, services.ValidateNotOverlap(cidrs...)...)
 	}
 
+	allErrs = append(allErrs, validateResourceGroupID(infra.ResourceGroupID, field.NewPath("resourceGroupID"))...)
+
+	tagsPath := field.NewPath("tags")
+	for key, value := range infra.Tags {
+		allErrs = append(allErrs, validateTag(key, value, tagsPath.Key(key))...)
+	}
+	if len(infra.Tags) > maxUserTags {
+		allErrs = append(allErrs, field.TooMany(tagsPath, len(infra.Tags), maxUserTags))
+	}
+
+	return allErrs
+}
+
+func validateWorkersVSwitchIDs(networks apisalicloud.Networks, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	vswitchIDs := sets.New[string]()
+	for i, zone := range networks.Zones {
+		if zone.WorkersVSwitchID == nil {
+			continue
+		}
+		idPath := fldPath.Child("zones").Index(i).Child("workersVSwitchID")
+		if networks.VPC.ID == nil {
+			allErrs = append(allErrs, field.Forbidden(idPath, "can only be set if networks.vpc.id is set"))
+		}
+		if zone.WorkersIPv6Index != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("zones").Index(i).Child("workersIPv6Index"), "cannot be set together with workersVSwitchID"))
+		}
+		if *zone.WorkersVSwitchID == "" {
+			allErrs = append(allErrs, field.Invalid(idPath, *zone.WorkersVSwitchID, "cannot be empty string"))
+			continue
+		}
+		if vswitchIDs.Has(*zone.WorkersVSwitchID) {
+			allErrs = append(allErrs, field.Duplicate(idPath, *zone.WorkersVSwitchID))
+		}
+		vswitchIDs.Insert(*zone.WorkersVSwitchID)
+	}
+
+	return allErrs
+}
+
+func validateIPv6(networks apisalicloud.Networks, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	indexes := sets.New[int32]()
+	for i, zone := range networks.Zones {
+		indexPath := fldPath.Child("zones").Index(i).Child("workersIPv6Index")
+		if networks.IPv6 == nil {
+			if zone.WorkersIPv6Index != nil {
+				allErrs = append(allErrs, field.Forbidden(indexPath, "can only be set if IPv6 is enabled"))
+			}
+			continue
+		}
+
+		index := helper.GetWorkersIPv6Index(zone, i)
+		if index < 0 || index > maxIPv6CIDRIndex {
+			allErrs = append(allErrs, field.Invalid(indexPath, index, fmt.Sprintf("must be between 0 and %d", maxIPv6CIDRIndex)))
+		} else if indexes.Has(index) {
+			allErrs = append(allErrs, field.Duplicate(indexPath, index))
+		}
+		indexes.Insert(index)
+	}
+
+	return allErrs
+}
+
+func validateCEN(cen *apisalicloud.CEN, nodesCIDR *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cen == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateID(cen.ID, cenIDRegex, fldPath.Child("id"))...)
+	if tr := cen.TransitRouter; tr != nil {
+		trPath := fldPath.Child("transitRouter")
+		allErrs = append(allErrs, validateID(tr.ID, transitRouterIDRegex, trPath.Child("id"))...)
+		allErrs = append(allErrs, validateID(tr.RouteTableID, transitRouterRouteTableIDRegex, trPath.Child("routeTableID"))...)
+		if nodesCIDR == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("networking", "nodes"), "must be set to route the nodes CIDR to the transit router"))
+		}
+	}
+	return allErrs
+}
+
+func validateVPCEndpoints(endpoints []apisalicloud.VPCEndpoint, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	serviceNames := sets.New[string]()
+	privateDNSNames := sets.New[string]()
+	for i, endpoint := range endpoints {
+		endpointPath := fldPath.Index(i)
+		endpointType := helper.VPCEndpointType(endpoint)
+		if !vpcEndpointTypes.Has(endpointType) {
+			allErrs = append(allErrs, field.NotSupported(endpointPath.Child("type"), endpointType, sets.List(vpcEndpointTypes)))
+		}
+
+		if endpoint.PrivateDNSName != nil {
+			privateDNSNamePath := endpointPath.Child("privateDNSName")
+			if endpointType != apisalicloud.VPCEndpointTypeInterface {
+				allErrs = append(allErrs, field.Forbidden(privateDNSNamePath, "can only be set for interface endpoints"))
+			} else {
+				for _, msg := range utilvalidation.IsDNS1123Subdomain(*endpoint.PrivateDNSName) {
+					allErrs = append(allErrs, field.Invalid(privateDNSNamePath, *endpoint.PrivateDNSName, msg))
+				}
+				if privateDNSNames.Has(*endpoint.PrivateDNSName) {
+					allErrs = append(allErrs, field.Duplicate(privateDNSNamePath, *endpoint.PrivateDNSName))
+				}
+				privateDNSNames.Insert(*endpoint.PrivateDNSName)
+			}
+		}
+
+		serviceNamePath := endpointPath.Child("serviceName")
+		if endpoint.ServiceName == "" {
+			allErrs = append(allErrs, field.Required(serviceNamePath, "must be set"))
+			continue
+		}
+		serviceNameRegex := endpointServiceNameRegex
+		if endpointType == apisalicloud.VPCEndpointTypeGateway {
+			serviceNameRegex = gatewayEndpointServiceNameRegex
+		}
+		if !serviceNameRegex.MatchString(endpoint.ServiceName) {
+			allErrs = append(allErrs, field.Invalid(serviceNamePath, endpoint.ServiceName, fmt.Sprintf("must match %s", serviceNameRegex)))
+		}
+		if serviceNames.Has(endpoint.ServiceName) {
+			allErrs = append(allErrs, field.Duplicate(serviceNamePath, endpoint.ServiceName))
+		}
+		serviceNames.Insert(endpoint.ServiceName)
+	}
+	return allErrs
+}
+
+func validateID(id string, regex *regexp.Regexp, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if id == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must be set"))
+	} else if !regex.MatchString(id) {
+		allErrs = append(allErrs, field.Invalid(fldPath, id, fmt.Sprintf("must match %s", regex)))
+	}
+	return allErrs
+}
+
+func validateManagedNatGatewayConfig(natGateway *apisalicloud.ManagedNatGatewayConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if natGateway.InternetChargeType != nil && !natGatewayInternetChargeTypes.Has(*natGateway.InternetChargeType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("internetChargeType"), *natGateway.InternetChargeType, sets.List(natGatewayInternetChargeTypes)))
+	}
+	if natGateway.Spec != nil {
+		specPath := fldPath.Child("spec")
+		if !natGatewaySpecs.Has(*natGateway.Spec) {
+			allErrs = append(allErrs, field.NotSupported(specPath, *natGateway.Spec, sets.List(natGatewaySpecs)))
+		}
+		if ptr.Deref(natGateway.InternetChargeType, "") != "PayBySpec" {
+			allErrs = append(allErrs, field.Forbidden(specPath, "can only be set if the internet charge type is PayBySpec"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -97,9 +319,38 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisalicloud.InfrastructureConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.VPC, oldConfig.Networks.VPC, field.NewPath("networks").Child("vpc"))...)
+	// the configuration of the managed NAT gateway is validated separately as parts of it can be changed
+	oldVPC, newVPC := oldConfig.Networks.VPC, newConfig.Networks.VPC
+	oldVPC.NatGateway, newVPC.NatGateway = nil, nil
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC, oldVPC, field.NewPath("networks").Child("vpc"))...)
+	// the billing method of the NAT gateway is only applied on creation, hence it can neither be changed nor be set later on
+	var oldInternetChargeType, newInternetChargeType *string
+	if oldConfig.Networks.VPC.NatGateway != nil {
+		oldInternetChargeType = oldConfig.Networks.VPC.NatGateway.InternetChargeType
+	}
+	if newConfig.Networks.VPC.NatGateway != nil {
+		newInternetChargeType = newConfig.Networks.VPC.NatGateway.InternetChargeType
+	}
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newInternetChargeType, oldInternetChargeType, field.NewPath("networks", "vpc", "natGateway", "internetChargeType"))...)
 	allErrs = append(allErrs, ValidateNetworkZonesConfig(newConfig.Networks.Zones, oldConfig.Networks.Zones, field.NewPath("networks").Child("zones"))...)
 
+	if oldConfig.Networks.IPv6 != nil {
+		if newConfig.Networks.IPv6 == nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("networks").Child("ipv6"), "IPv6 cannot be disabled once it has been enabled"))
+		}
+		for i := range oldConfig.Networks.Zones {
+			if i >= len(newConfig.Networks.Zones) {
+				break
+			}
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(helper.GetWorkersIPv6Index(newConfig.Networks.Zones[i], i), helper.GetWorkersIPv6Index(oldConfig.Networks.Zones[i], i), field.NewPath("networks").Child("zones").Index(i).Child("workersIPv6Index"))...)
+		}
+	}
+
+	// the VPC can be attached to or detached from a CEN instance, but it cannot be moved to another one directly
+	if oldConfig.Networks.CEN != nil && newConfig.Networks.CEN != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.CEN.ID, oldConfig.Networks.CEN.ID, field.NewPath("networks", "cen", "id"))...)
+	}
+
 	return allErrs
 }
 
@@ -116,6 +367,10 @@ func ValidateNetworkZonesConfig(newZones, oldZones []apisalicloud.Zone, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZones[i].Name, newZones[i].Name, fldPath.Index(i))...)
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZones[i].Workers, newZones[i].Workers, fldPath.Index(i))...)
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZones[i].Worker, newZones[i].Worker, fldPath.Index(i))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZones[i].WorkersVSwitchID, oldZones[i].WorkersVSwitchID, fldPath.Index(i).Child("workersVSwitchID"))...)
+		if oldZones[i].NatGateway != nil && oldZones[i].NatGateway.EIPISP != nil && newZones[i].NatGateway != nil && len(helper.GetEIPAllocationIDs(newZones[i].NatGateway)) == 0 {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZones[i].NatGateway.EIPISP, oldZones[i].NatGateway.EIPISP, fldPath.Index(i).Child("natGateway", "eipISP"))...)
+		}
 	}
 
 	for i, zone := range newZones {
@@ -129,12 +384,144 @@ func ValidateNetworkZonesConfig(newZones, oldZones []apisalicloud.Zone, fldPath
 func ValidateNatGatewayConfig(natGateway *apisalicloud.NatGatewayConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if natGateway != nil {
-		if natGateway.EIPAllocationID == nil {
-			allErrs = append(allErrs, field.Invalid(fldPath, natGateway, "eip id is not specified"))
-		} else if *natGateway.EIPAllocationID == "" {
+	if natGateway == nil {
+		return allErrs
+	}
+
+	if natGateway.EIPAllocationID != nil {
+		if *natGateway.EIPAllocationID == "" {
 			allErrs = append(allErrs, field.Invalid(fldPath, natGateway, "eip id cannot be empty string"))
 		}
+		if natGateway.EIPAllocationIDs != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("eipAllocationIDs"), "cannot be set together with eipAllocationID"))
+		}
+		return append(allErrs, validateExistingEIPConfig(natGateway, "eipAllocationID", fldPath)...)
+	}
+
+	if natGateway.EIPAllocationIDs != nil {
+		idsPath := fldPath.Child("eipAllocationIDs")
+		if len(natGateway.EIPAllocationIDs) == 0 {
+			allErrs = append(allErrs, field.Required(idsPath, "must contain at least one eip id"))
+		}
+		if len(natGateway.EIPAllocationIDs) > maxSnatIPs {
+			allErrs = append(allErrs, field.TooMany(idsPath, len(natGateway.EIPAllocationIDs), maxSnatIPs))
+		}
+		ids := sets.New[string]()
+		for i, id := range natGateway.EIPAllocationIDs {
+			if id == "" {
+				allErrs = append(allErrs, field.Invalid(idsPath.Index(i), id, "eip id cannot be empty string"))
+				continue
+			}
+			if ids.Has(id) {
+				allErrs = append(allErrs, field.Duplicate(idsPath.Index(i), id))
+			}
+			ids.Insert(id)
+		}
+		return append(allErrs, validateExistingEIPConfig(natGateway, "eipAllocationIDs", fldPath)...)
+	}
+
+	if natGateway.EIPCount == nil && natGateway.EIPBandwidth == nil && natGateway.EIPISP == nil && natGateway.EIPBandwidthPackageID == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, natGateway, "eip id is not specified"))
+	}
+	if natGateway.EIPCount != nil && (*natGateway.EIPCount < 1 || *natGateway.EIPCount > maxSnatIPs) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eipCount"), *natGateway.EIPCount, fmt.Sprintf("must be between 1 and %d", maxSnatIPs)))
+	}
+	if natGateway.EIPBandwidth != nil {
+		if *natGateway.EIPBandwidth < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("eipBandwidth"), *natGateway.EIPBandwidth, "must be at least 1"))
+		}
+		if natGateway.EIPBandwidthPackageID != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("eipBandwidth"), "cannot be set together with eipBandwidthPackageID, the bandwidth is determined by the bandwidth package"))
+		}
+	}
+	if natGateway.EIPISP != nil && *natGateway.EIPISP == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eipISP"), *natGateway.EIPISP, "cannot be empty string"))
+	}
+	if natGateway.EIPBandwidthPackageID != nil && *natGateway.EIPBandwidthPackageID == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eipBandwidthPackageID"), *natGateway.EIPBandwidthPackageID, "cannot be empty string"))
+	}
+
+	return allErrs
+}
+
+// validateExistingEIPConfig validates that no settings for Gardener managed EIPs are made if existing EIPs are used.
+func validateExistingEIPConfig(natGateway *apisalicloud.NatGatewayConfig, existingField string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	detail := fmt.Sprintf("cannot be set together with %s", existingField)
+
+	if natGateway.EIPCount != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("eipCount"), detail))
+	}
+	if natGateway.EIPBandwidth != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("eipBandwidth"), detail))
+	}
+	if natGateway.EIPISP != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("eipISP"), detail))
+	}
+	if natGateway.EIPBandwidthPackageID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("eipBandwidthPackageID"), detail))
+	}
+
+	return allErrs
+}
+
+func validateResourceGroupID(resourceGroupID *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resourceGroupID != nil && !resourceGroupIDRegex.MatchString(*resourceGroupID) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *resourceGroupID, fmt.Sprintf("must match %s", resourceGroupIDRegex)))
+	}
+
+	return allErrs
+}
+
+// ValidateTagAnnotations validates the user-defined tags inherited from the given shoot annotations. The limit of tags
+// applies to the tags of the annotations and the infrastructure config together.
+func ValidateTagAnnotations(annotations map[string]string, infra *apisalicloud.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for key, value := range annotations {
+		tagKey, ok := strings.CutPrefix(key, apisalicloud.AnnotationKeyPrefixTag)
+		if !ok {
+			continue
+		}
+		if tagKey == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key, "must contain a tag key after the prefix"))
+			continue
+		}
+		allErrs = append(allErrs, validateTag(tagKey, value, fldPath.Key(key))...)
+	}
+	if tags := helper.UserTags(infra, annotations); len(tags) > maxUserTags {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(tags), maxUserTags))
+	}
+
+	return allErrs
+}
+
+func validateTag(key, value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(key) == 0 || len(key) > maxTagLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, key, fmt.Sprintf("tag key must be between 1 and %d characters long", maxTagLength)))
+	}
+	if key == "Name" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "tag key is reserved for the names of the resources"))
+	}
+	for _, prefix := range reservedTagPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), prefix) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("tag key must not start with %q", prefix)))
+		}
+	}
+	if len(value) > maxTagLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("tag value must not be longer than %d characters", maxTagLength)))
+	}
+	if strings.HasPrefix(strings.ToLower(value), "aliyun") || strings.HasPrefix(strings.ToLower(value), "acs:") {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "tag value must not start with \"aliyun\" or \"acs:\""))
+	}
+	for _, s := range []string{key, value} {
+		if strings.Contains(s, "http://") || strings.Contains(s, "https://") {
+			allErrs = append(allErrs, field.Invalid(fldPath, s, "tags must not contain \"http://\" or \"https://\""))
+		}
 	}
 
 	return allErrs
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"fmt"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/onsi/ginkgo/v2"
@@ -190,13 +192,556 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			})
 
 		})
+
+		Context("security group", func() {
+			It("should allow restricting the NodePort source CIDRs and additional rules", func() {
+				infrastructureConfig.Networks.NodePortSourceCIDRs = []string{"192.168.0.0/16", "203.0.113.0/24"}
+				infrastructureConfig.Networks.SecurityGroupRules = []apisalicloud.SecurityGroupRule{
+					{
+						Direction: apisalicloud.SecurityGroupRuleDirectionIngress,
+						Protocol:  "TCP",
+						PortRange: "443/443",
+						CIDR:      "203.0.113.0/24",
+					},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid invalid NodePort source CIDRs", func() {
+				infrastructureConfig.Networks.NodePortSourceCIDRs = []string{"192.168.0.0/16", invalidCIDR, "203.0.113.7/24"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.nodePortSourceCIDRs[1]"),
+				}))))
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.nodePortSourceCIDRs[2]"),
+					"Detail": Equal("must be valid canonical CIDR"),
+				}))))
+			})
+
+			It("should forbid invalid rules", func() {
+				infrastructureConfig.Networks.SecurityGroupRules = []apisalicloud.SecurityGroupRule{
+					{
+						Direction: apisalicloud.SecurityGroupRuleDirectionEgress,
+						Protocol:  "TCP",
+						PortRange: "-1/-1",
+						CIDR:      "0.0.0.0/0",
+					},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.securityGroupRules[0].portRange"),
+				}))
+			})
+		})
+
+		Context("nat gateway", func() {
+			It("should allow configuring the managed NAT gateway and EIPs", func() {
+				infrastructureConfig.Networks.VPC.NatGateway = &apisalicloud.ManagedNatGatewayConfig{
+					InternetChargeType: ptr.To("PayBySpec"),
+					Spec:               ptr.To("Middle"),
+				}
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPBandwidth: ptr.To[int32](200),
+					EIPISP:       ptr.To("BGP_PRO"),
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPBandwidthPackageID: ptr.To("cbwp-1234567890"),
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid invalid NAT gateway configurations", func() {
+				infrastructureConfig.Networks.VPC.NatGateway = &apisalicloud.ManagedNatGatewayConfig{
+					InternetChargeType: ptr.To("PayByTraffic"),
+					Spec:               ptr.To("Huge"),
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.vpc.natGateway.internetChargeType"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.vpc.natGateway.spec"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.natGateway.spec"),
+				}))
+			})
+
+			It("should forbid configuring a NAT gateway which is not managed by Gardener", func() {
+				infrastructureConfig.Networks.VPC = apisalicloud.VPC{
+					ID: ptr.To("vpc-1234567890"),
+					NatGateway: &apisalicloud.ManagedNatGatewayConfig{
+						InternetChargeType: ptr.To("PayByLcu"),
+					},
+				}
+				networking.Nodes = nil
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.natGateway"),
+				}))
+			})
+
+			It("should forbid combining the EIP configuration with an existing EIP", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPAllocationID:       ptr.To("eip-ufxsdckfgitzcz"),
+					EIPBandwidth:          ptr.To[int32](200),
+					EIPISP:                ptr.To("BGP"),
+					EIPBandwidthPackageID: ptr.To("cbwp-1234567890"),
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.eipBandwidth"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.eipISP"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.eipBandwidthPackageID"),
+				}))
+			})
+
+			It("should forbid invalid EIP configurations", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPBandwidth:          ptr.To[int32](0),
+					EIPISP:                ptr.To(""),
+					EIPBandwidthPackageID: ptr.To("cbwp-1234567890"),
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].natGateway.eipBandwidth"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.eipBandwidth"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].natGateway.eipISP"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].natGateway"),
+					"Detail": Equal("eip id is not specified"),
+				}))
+			})
+
+			It("should allow multiple existing EIPs or a count of managed EIPs", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPAllocationIDs: []string{"eip-1", "eip-2"},
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPCount:     ptr.To[int32](4),
+					EIPBandwidth: ptr.To[int32](200),
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid invalid multi EIP configurations", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPAllocationIDs: []string{"eip-1", "", "eip-1"},
+					EIPCount:         ptr.To[int32](2),
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPAllocationID:  ptr.To("eip-1"),
+					EIPAllocationIDs: []string{"eip-2"},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].natGateway.eipAllocationIDs[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[0].natGateway.eipAllocationIDs[2]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.eipCount"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[1].natGateway.eipAllocationIDs"),
+				}))
+			})
+
+			It("should forbid too many EIPs", func() {
+				ids := make([]string, 21)
+				for i := range ids {
+					ids[i] = fmt.Sprintf("eip-%d", i)
+				}
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPAllocationIDs: ids,
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPCount: ptr.To[int32](0),
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("networks.zones[0].natGateway.eipAllocationIDs"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[1].natGateway.eipCount"),
+				}))
+			})
+		})
+
+		Context("existing vSwitches", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.VPC = apisalicloud.VPC{ID: ptr.To("vpc-1234567890")}
+				networking.Nodes = nil
+			})
+
+			It("should allow existing vSwitches for the workers in an existing VPC", func() {
+				infrastructureConfig.Networks.Zones[0].WorkersVSwitchID = ptr.To("vsw-1")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid existing vSwitches without an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = apisalicloud.VPC{CIDR: &vpc}
+				infrastructureConfig.Networks.Zones[0].WorkersVSwitchID = ptr.To("vsw-1")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].workersVSwitchID"),
+				}))
+			})
+
+			It("should forbid invalid and duplicate vSwitch IDs", func() {
+				infrastructureConfig.Networks.IPv6 = &apisalicloud.IPv6{}
+				infrastructureConfig.Networks.Zones[0].WorkersVSwitchID = ptr.To("vsw-1")
+				infrastructureConfig.Networks.Zones[0].WorkersIPv6Index = ptr.To[int32](5)
+				infrastructureConfig.Networks.Zones[1].WorkersVSwitchID = ptr.To("vsw-1")
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, apisalicloud.Zone{
+					Name:             "zone3",
+					Workers:          "10.250.5.0/24",
+					WorkersVSwitchID: ptr.To(""),
+				})
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].workersIPv6Index"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[1].workersVSwitchID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[2].workersVSwitchID"),
+				}))
+			})
+		})
+
+		Context("IPv6", func() {
+			It("should allow enabling IPv6", func() {
+				infrastructureConfig.Networks.IPv6 = &apisalicloud.IPv6{AllowNodePortIPv6: ptr.To(true)}
+				infrastructureConfig.Networks.Zones[1].WorkersIPv6Index = ptr.To[int32](255)
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid IPv6 indexes if IPv6 is disabled", func() {
+				infrastructureConfig.Networks.Zones[0].WorkersIPv6Index = ptr.To[int32](1)
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].workersIPv6Index"),
+				}))
+			})
+
+			It("should forbid invalid and duplicate IPv6 indexes", func() {
+				infrastructureConfig.Networks.IPv6 = &apisalicloud.IPv6{}
+				infrastructureConfig.Networks.Zones[0].WorkersIPv6Index = ptr.To[int32](256)
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, apisalicloud.Zone{
+					Name:             "zone3",
+					Workers:          "10.250.5.0/24",
+					WorkersIPv6Index: ptr.To[int32](1),
+				})
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].workersIPv6Index"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[2].workersIPv6Index"),
+				}))
+			})
+		})
+
+		Context("resource group", func() {
+			It("should allow a resource group", func() {
+				infrastructureConfig.ResourceGroupID = ptr.To("rg-acfmxazb4ph6aiy")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid invalid resource group IDs", func() {
+				infrastructureConfig.ResourceGroupID = ptr.To("")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("resourceGroupID"),
+				}))
+			})
+		})
+
+		Context("tags", func() {
+			It("should allow user-defined tags", func() {
+				infrastructureConfig.Tags = map[string]string{"CostCenter": "4711", "Team": ""}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid reserved and invalid tags", func() {
+				infrastructureConfig.Tags = map[string]string{
+					"Name":                      "foo",
+					"acs:owner":                 "foo",
+					"kubernetes.io/cluster/foo": "1",
+					"Link":                      "https://example.com",
+					"":                          "foo",
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("tags[Name]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("tags[acs:owner]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("tags[kubernetes.io/cluster/foo]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[Link]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[]"),
+				}))
+			})
+
+			It("should forbid too many tags", func() {
+				infrastructureConfig.Tags = map[string]string{}
+				for i := range 11 {
+					infrastructureConfig.Tags[fmt.Sprintf("tag%d", i)] = "foo"
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("tags"),
+				}))
+			})
+		})
+
+		Context("CEN", func() {
+			It("should allow attaching the VPC to a CEN instance or transit router", func() {
+				infrastructureConfig.Networks.CEN = &apisalicloud.CEN{ID: "cen-7qthudw0ll6jmc"}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+
+				infrastructureConfig.Networks.CEN.TransitRouter = &apisalicloud.TransitRouter{
+					ID:           "tr-p0w3x8c9em72a40nw",
+					RouteTableID: "vtb-bp1dudbh2d5na6b50",
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid missing or invalid IDs", func() {
+				infrastructureConfig.Networks.CEN = &apisalicloud.CEN{
+					ID: "vpc-1234",
+					TransitRouter: &apisalicloud.TransitRouter{
+						ID: "tr-p0w3x8c9em72a40nw",
+					},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cen.id"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.cen.transitRouter.routeTableID"),
+				}))
+			})
+
+			It("should require the nodes CIDR for a transit router", func() {
+				networking.Nodes = nil
+				infrastructureConfig.Networks.CEN = &apisalicloud.CEN{
+					ID: "cen-7qthudw0ll6jmc",
+					TransitRouter: &apisalicloud.TransitRouter{
+						ID:           "tr-p0w3x8c9em72a40nw",
+						RouteTableID: "vtb-bp1dudbh2d5na6b50",
+					},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networking.nodes"),
+				}))
+			})
+		})
+
+		Context("VPC endpoints", func() {
+			It("should allow VPC endpoints", func() {
+				infrastructureConfig.Networks.VPCEndpoints = []apisalicloud.VPCEndpoint{
+					{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.oss"},
+					{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.kms", PrivateDNSName: ptr.To("kms.cn-hangzhou.aliyuncs.com")},
+					{Type: ptr.To(apisalicloud.VPCEndpointTypeGateway), ServiceName: "com.aliyun.cn-hangzhou.oss"},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(BeEmpty())
+			})
+
+			It("should forbid invalid types and gateway endpoints of other services than OSS", func() {
+				infrastructureConfig.Networks.VPCEndpoints = []apisalicloud.VPCEndpoint{
+					{Type: ptr.To(apisalicloud.VPCEndpointType("GatewayLoadBalancer")), ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.kms"},
+					{Type: ptr.To(apisalicloud.VPCEndpointTypeGateway), ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.cr"},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.vpcEndpoints[0].type"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpcEndpoints[1].serviceName"),
+				}))
+			})
+
+			It("should forbid invalid and duplicate private DNS names and private DNS names of gateway endpoints", func() {
+				infrastructureConfig.Networks.VPCEndpoints = []apisalicloud.VPCEndpoint{
+					{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.kms", PrivateDNSName: ptr.To("kms.cn-hangzhou.aliyuncs.com")},
+					{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.cr", PrivateDNSName: ptr.To("kms.cn-hangzhou.aliyuncs.com")},
+					{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.sls", PrivateDNSName: ptr.To("SLS_")},
+					{Type: ptr.To(apisalicloud.VPCEndpointTypeGateway), ServiceName: "com.aliyun.cn-hangzhou.oss", PrivateDNSName: ptr.To("oss-cn-hangzhou.aliyuncs.com")},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpcEndpoints[1].privateDNSName"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpcEndpoints[2].privateDNSName"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpcEndpoints[3].privateDNSName"),
+				}))
+			})
+
+			It("should forbid missing, invalid and duplicate service names", func() {
+				infrastructureConfig.Networks.VPCEndpoints = []apisalicloud.VPCEndpoint{
+					{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.oss"},
+					{},
+					{ServiceName: "com.aliyuncs.privatelink.cn-hangzhou.oss"},
+					{ServiceName: "KMS/"},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.vpcEndpoints[1].serviceName"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpcEndpoints[2].serviceName"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpcEndpoints[3].serviceName"),
+				}))
+			})
+		})
+	})
+
+	Describe("#ValidateTagAnnotations", func() {
+		fldPath := field.NewPath("metadata", "annotations")
+
+		It("should allow tags inherited from the shoot annotations", func() {
+			annotations := map[string]string{
+				"tags.alicloud.provider.extensions.gardener.cloud/CostCenter": "4711",
+				"gardener.cloud/operation":                                    "reconcile",
+			}
+
+			Expect(ValidateTagAnnotations(annotations, infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid tags and too many tags together with the infrastructure config", func() {
+			infrastructureConfig.Tags = map[string]string{}
+			for i := range 10 {
+				infrastructureConfig.Tags[fmt.Sprintf("tag%d", i)] = "foo"
+			}
+			annotations := map[string]string{
+				"tags.alicloud.provider.extensions.gardener.cloud/CostCenter": "4711",
+				"tags.alicloud.provider.extensions.gardener.cloud/Name":       "foo",
+				"tags.alicloud.provider.extensions.gardener.cloud/":           "foo",
+			}
+
+			Expect(ValidateTagAnnotations(annotations, infrastructureConfig, fldPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("metadata.annotations[tags.alicloud.provider.extensions.gardener.cloud/Name]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.annotations[tags.alicloud.provider.extensions.gardener.cloud/]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeTooMany),
+				"Field": Equal("metadata.annotations"),
+			}))
+		})
 	})
 
 	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should forbid disabling IPv6", func() {
+			infrastructureConfig.Networks.IPv6 = &apisalicloud.IPv6{}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.IPv6 = nil
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.ipv6"),
+			}))
+		})
+
+		It("should forbid changing the IPv6 index of a zone", func() {
+			infrastructureConfig.Networks.IPv6 = &apisalicloud.IPv6{}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[1].WorkersIPv6Index = ptr.To[int32](5)
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[1].workersIPv6Index"),
+			}))
+		})
+
+		It("should allow enabling IPv6", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.IPv6 = &apisalicloud.IPv6{}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
 		It("should return no errors for an unchanged config", func() {
 			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig)).To(BeEmpty())
 		})
 
+		It("should allow attaching and detaching a CEN instance", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.CEN = &apisalicloud.CEN{ID: "cen-7qthudw0ll6jmc"}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the CEN instance", func() {
+			infrastructureConfig.Networks.CEN = &apisalicloud.CEN{ID: "cen-7qthudw0ll6jmc"}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.CEN.ID = "cen-1234"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.cen.id"),
+			}))
+		})
+
 		It("should forbid changing the VPC section", func() {
 			newInfrastructureConfig := infrastructureConfig.DeepCopy()
 			newCIDR := "1.2.3.4/5"
@@ -223,6 +768,22 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))
 		})
 
+		It("should forbid changing the existing vSwitch of a zone", func() {
+			infrastructureConfig.Networks.VPC = apisalicloud.VPC{ID: ptr.To("vpc-1234567890")}
+			infrastructureConfig.Networks.Zones[0].WorkersVSwitchID = ptr.To("vsw-1")
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].WorkersVSwitchID = ptr.To("vsw-2")
+			newInfrastructureConfig.Networks.Zones[1].WorkersVSwitchID = ptr.To("vsw-3")
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].workersVSwitchID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[1].workersVSwitchID"),
+			}))
+		})
+
 		It("should forbid removing zone in zones section", func() {
 			newInfrastructureConfig := infrastructureConfig.DeepCopy()
 			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[1:]
@@ -246,6 +807,54 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		It("should allow changing the NAT gateway spec and the EIP bandwidth", func() {
+			infrastructureConfig.Networks.VPC.NatGateway = &apisalicloud.ManagedNatGatewayConfig{
+				InternetChargeType: ptr.To("PayBySpec"),
+				Spec:               ptr.To("Small"),
+			}
+			infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+				EIPBandwidth: ptr.To[int32](100),
+				EIPISP:       ptr.To("BGP"),
+			}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.NatGateway.Spec = ptr.To("Large")
+			newInfrastructureConfig.Networks.Zones[0].NatGateway.EIPBandwidth = ptr.To[int32](500)
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the NAT gateway internet charge type and the EIP line type", func() {
+			infrastructureConfig.Networks.VPC.NatGateway = &apisalicloud.ManagedNatGatewayConfig{
+				InternetChargeType: ptr.To("PayByLcu"),
+			}
+			infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+				EIPISP: ptr.To("BGP"),
+			}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.NatGateway.InternetChargeType = ptr.To("PayBySpec")
+			newInfrastructureConfig.Networks.Zones[0].NatGateway.EIPISP = ptr.To("BGP_PRO")
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc.natGateway.internetChargeType"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].natGateway.eipISP"),
+			}))
+		})
+
+		It("should forbid setting the NAT gateway internet charge type of an existing infrastructure", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.NatGateway = &apisalicloud.ManagedNatGatewayConfig{
+				InternetChargeType: ptr.To("PayBySpec"),
+			}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc.natGateway.internetChargeType"),
+			}))
+		})
+
 		It("should allow changing nat gateway by specifying eip id", func() {
 			newInfrastructureConfig := infrastructureConfig.DeepCopy()
 			ipAllocID := "eip-ufxsdckfgitzcz"
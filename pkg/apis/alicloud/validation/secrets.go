@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -24,6 +25,8 @@ var (
 	// accessKeyID accepts only alphanumeric characters [0-9a-zA-Z] and several special characters [._=],
 	// see https://partners-intl.aliyun.com/help/doc-detail/185803.htm
 	accessKeyIDRegex = regexp.MustCompile(`^[0-9a-zA-Z._=]+$`)
+	// roleARNRegex matches the ARNs of RAM roles, e.g. acs:ram::1234567890123456:role/gardener
+	roleARNRegex = regexp.MustCompile(`^acs:ram::[0-9]+:role/[0-9a-zA-Z.-]+$`)
 )
 
 // ValidateCloudProviderSecret checks whether the given secret contains a valid Alicloud access keys.
@@ -58,5 +61,32 @@ func ValidateCloudProviderSecret(secret *corev1.Secret) error {
 		return fmt.Errorf("field %q in secret %s must not contain leading or traling new lines", alicloud.AccessKeySecret, secretRef)
 	}
 
+	return validateRoleFields(secret, secretRef)
+}
+
+func validateRoleFields(secret *corev1.Secret, secretRef string) error {
+	roleARN, ok := secret.Data[alicloud.RoleARN]
+	if !ok {
+		for _, key := range []string{alicloud.ExternalID, alicloud.SessionDuration} {
+			if _, ok := secret.Data[key]; ok {
+				return fmt.Errorf("field %q in secret %s requires field %q", key, secretRef, alicloud.RoleARN)
+			}
+		}
+		return nil
+	}
+	if !roleARNRegex.Match(roleARN) {
+		return fmt.Errorf("field %q in secret %s must be the ARN of a RAM role, e.g. acs:ram::<account-id>:role/<role-name>", alicloud.RoleARN, secretRef)
+	}
+
+	if sessionDuration, ok := secret.Data[alicloud.SessionDuration]; ok {
+		duration, err := time.ParseDuration(string(sessionDuration))
+		if err != nil {
+			return fmt.Errorf("field %q in secret %s must be a duration: %w", alicloud.SessionDuration, secretRef, err)
+		}
+		if duration < alicloud.MinSessionDuration || duration > alicloud.MaxSessionDuration {
+			return fmt.Errorf("field %q in secret %s must be between %s and %s", alicloud.SessionDuration, secretRef, alicloud.MinSessionDuration, alicloud.MaxSessionDuration)
+		}
+	}
+
 	return nil
 }
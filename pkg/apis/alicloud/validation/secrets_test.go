@@ -113,5 +113,44 @@ var _ = Describe("Secret validation", func() {
 			},
 			BeNil(),
 		),
+
+		Entry("should succeed when a RAM role is given",
+			map[string][]byte{
+				alicloud.AccessKeyID:     []byte(strings.Repeat("a", 16)),
+				alicloud.AccessKeySecret: []byte(strings.Repeat("b", 30)),
+				alicloud.RoleARN:         []byte("acs:ram::1234567890123456:role/gardener-shoot"),
+				alicloud.ExternalID:      []byte("abcd1234"),
+				alicloud.SessionDuration: []byte("30m"),
+			},
+			BeNil(),
+		),
+
+		Entry("should return error when the role ARN is invalid",
+			map[string][]byte{
+				alicloud.AccessKeyID:     []byte(strings.Repeat("a", 16)),
+				alicloud.AccessKeySecret: []byte(strings.Repeat("b", 30)),
+				alicloud.RoleARN:         []byte("gardener-shoot"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when the session duration is too long",
+			map[string][]byte{
+				alicloud.AccessKeyID:     []byte(strings.Repeat("a", 16)),
+				alicloud.AccessKeySecret: []byte(strings.Repeat("b", 30)),
+				alicloud.RoleARN:         []byte("acs:ram::1234567890123456:role/gardener-shoot"),
+				alicloud.SessionDuration: []byte("2h"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when an external id is given without role ARN",
+			map[string][]byte{
+				alicloud.AccessKeyID:     []byte(strings.Repeat("a", 16)),
+				alicloud.AccessKeySecret: []byte(strings.Repeat("b", 30)),
+				alicloud.ExternalID:      []byte("abcd1234"),
+			},
+			HaveOccurred(),
+		),
 	)
 })
@@ -9,6 +9,7 @@ import (
 	"math"
 	"net"
 	"regexp"
+	"slices"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	corehelper "github.com/gardener/gardener/pkg/apis/core/helper"
@@ -68,6 +69,26 @@ func ValidateNetworking(networking *core.Networking, fldPath *field.Path) field.
 	return allErrs
 }
 
+// ValidateIPFamilies validates the IP families of a Shoot. Dual-stack is supported with IPv4 as primary IP family and
+// requires IPv6 to be enabled in the infrastructure config.
+func ValidateIPFamilies(ipFamilies []core.IPFamily, infraConfig *apisalicloud.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(ipFamilies) == 0 {
+		return allErrs
+	}
+
+	if ipFamilies[0] != core.IPFamilyIPv4 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Index(0), ipFamilies[0], fmt.Sprintf("the primary IP family must be %s", core.IPFamilyIPv4)))
+	}
+
+	if slices.Contains(ipFamilies, core.IPFamilyIPv6) && (infraConfig == nil || infraConfig.Networks.IPv6 == nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "dual-stack networking requires IPv6 to be enabled in the infrastructure config"))
+	}
+
+	return allErrs
+}
+
 // ValidateWorkers validates the workers of a Shoot.
 func ValidateWorkers(workers []core.Worker, zones []apisalicloud.Zone, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
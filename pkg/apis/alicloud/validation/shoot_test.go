@@ -119,6 +119,49 @@ var _ = Describe("Shoot validation", func() {
 		})
 	})
 
+	Describe("#ValidateIPFamilies", func() {
+		var (
+			ipFamiliesPath = field.NewPath("spec", "networking", "ipFamilies")
+			infraConfig    *apisalicloud.InfrastructureConfig
+		)
+
+		BeforeEach(func() {
+			infraConfig = &apisalicloud.InfrastructureConfig{
+				Networks: apisalicloud.Networks{
+					IPv6: &apisalicloud.IPv6{},
+				},
+			}
+		})
+
+		It("should allow IPv4 single-stack", func() {
+			Expect(ValidateIPFamilies([]core.IPFamily{core.IPFamilyIPv4}, nil, ipFamiliesPath)).To(BeEmpty())
+		})
+
+		It("should allow dual-stack if IPv6 is enabled in the infrastructure config", func() {
+			Expect(ValidateIPFamilies([]core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, infraConfig, ipFamiliesPath)).To(BeEmpty())
+		})
+
+		It("should forbid dual-stack if IPv6 is not enabled in the infrastructure config", func() {
+			infraConfig.Networks.IPv6 = nil
+
+			Expect(ValidateIPFamilies([]core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, infraConfig, ipFamiliesPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.networking.ipFamilies"),
+				})),
+			))
+		})
+
+		It("should forbid IPv6 as primary IP family", func() {
+			Expect(ValidateIPFamilies([]core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4}, infraConfig, ipFamiliesPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.ipFamilies[0]"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateWorkerConfig", func() {
 		var (
 			workers       []core.Worker
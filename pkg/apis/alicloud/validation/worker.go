@@ -0,0 +1,550 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	corehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+)
+
+const (
+	maxInternetBandwidthIn  = 200
+	maxInternetBandwidthOut = 100
+	maxSpotDuration         = 6
+
+	// maxSecurityGroupsPerInstance is the number of security groups an instance can be attached to, including the
+	// nodes security group of the shoot.
+	maxSecurityGroupsPerInstance = 5
+	maxSecurityGroupRulePriority = 100
+	maxFallbackInstanceTypes     = 5
+	maxProvisionedIOPS           = 50000
+	maxHTTPPutResponseHopLimit   = 64
+
+	diskCategoryESSD     = "cloud_essd"
+	diskCategoryESSDAuto = "cloud_auto"
+)
+
+var validInternetChargeTypes = sets.New(
+	apisalicloud.InternetChargeTypePayByTraffic,
+	apisalicloud.InternetChargeTypePayByBandwidth,
+)
+
+var validInstanceChargeTypes = sets.New(
+	apisalicloud.InstanceChargeTypePostPaid,
+	apisalicloud.InstanceChargeTypePrePaid,
+)
+
+var (
+	validPeriods = map[apisalicloud.PeriodUnit]sets.Set[int32]{
+		apisalicloud.PeriodUnitWeek:  sets.New[int32](1, 2, 3, 4),
+		apisalicloud.PeriodUnitMonth: sets.New[int32](1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36, 48, 60),
+	}
+	validAutoRenewPeriods = map[apisalicloud.PeriodUnit]sets.Set[int32]{
+		apisalicloud.PeriodUnitWeek:  sets.New[int32](1, 2, 3),
+		apisalicloud.PeriodUnitMonth: sets.New[int32](1, 2, 3, 6, 12, 24, 36, 48, 60),
+	}
+)
+
+var validSpotStrategies = sets.New(
+	apisalicloud.SpotStrategySpotAsPriceGo,
+	apisalicloud.SpotStrategySpotWithPriceLimit,
+)
+
+var validDeploymentSetStrategies = sets.New(
+	apisalicloud.DeploymentSetStrategyAvailability,
+	apisalicloud.DeploymentSetStrategyAvailabilityGroup,
+	apisalicloud.DeploymentSetStrategyLowLatency,
+)
+
+var validHTTPTokensValues = sets.New(
+	apisalicloud.HTTPTokensOptional,
+	apisalicloud.HTTPTokensRequired,
+)
+
+var validPerformanceLevels = sets.New(
+	apisalicloud.PerformanceLevelPL0,
+	apisalicloud.PerformanceLevelPL1,
+	apisalicloud.PerformanceLevelPL2,
+	apisalicloud.PerformanceLevelPL3,
+)
+
+// minDiskSizePerPerformanceLevel contains the minimum size of ESSD disks for the performance levels which require one.
+var minDiskSizePerPerformanceLevel = map[apisalicloud.PerformanceLevel]resource.Quantity{
+	apisalicloud.PerformanceLevelPL2: resource.MustParse("461Gi"),
+	apisalicloud.PerformanceLevelPL3: resource.MustParse("1261Gi"),
+}
+
+var (
+	validSecurityGroupRuleDirections = sets.New(
+		apisalicloud.SecurityGroupRuleDirectionIngress,
+		apisalicloud.SecurityGroupRuleDirectionEgress,
+	)
+	validSecurityGroupRuleProtocols = sets.New("TCP", "UDP", "ICMP", "GRE", "ALL")
+	validSecurityGroupRulePolicies  = sets.New(
+		apisalicloud.SecurityGroupRulePolicyAccept,
+		apisalicloud.SecurityGroupRulePolicyDrop,
+	)
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object.
+func ValidateWorkerConfig(workerConfig *apisalicloud.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil {
+		return allErrs
+	}
+
+	instanceChargeType := ptr.Deref(workerConfig.InstanceChargeType, apisalicloud.InstanceChargeTypePostPaid)
+	if !validInstanceChargeTypes.Has(instanceChargeType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("instanceChargeType"), instanceChargeType, sets.List(validInstanceChargeTypes)))
+	}
+
+	subscriptionPath := fldPath.Child("subscription")
+	if instanceChargeType == apisalicloud.InstanceChargeTypePrePaid {
+		if workerConfig.Subscription == nil {
+			allErrs = append(allErrs, field.Required(subscriptionPath, fmt.Sprintf("must be set for instance charge type %q", instanceChargeType)))
+		} else {
+			allErrs = append(allErrs, validateSubscriptionConfig(workerConfig.Subscription, subscriptionPath)...)
+		}
+		if workerConfig.Spot != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("spot"), fmt.Sprintf("spot instances cannot be used with instance charge type %q", instanceChargeType)))
+		}
+	} else if workerConfig.Subscription != nil {
+		allErrs = append(allErrs, field.Forbidden(subscriptionPath, fmt.Sprintf("can only be set for instance charge type %q", apisalicloud.InstanceChargeTypePrePaid)))
+	}
+
+	if workerConfig.InternetChargeType != nil && !validInternetChargeTypes.Has(*workerConfig.InternetChargeType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("internetChargeType"), *workerConfig.InternetChargeType, sets.List(validInternetChargeTypes)))
+	}
+
+	if in := workerConfig.InternetMaxBandwidthIn; in != nil && (*in < 1 || *in > maxInternetBandwidthIn) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("internetMaxBandwidthIn"), *in, fmt.Sprintf("must be between 1 and %d", maxInternetBandwidthIn)))
+	}
+
+	if out := workerConfig.InternetMaxBandwidthOut; out != nil && (*out < 0 || *out > maxInternetBandwidthOut) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("internetMaxBandwidthOut"), *out, fmt.Sprintf("must be between 0 and %d", maxInternetBandwidthOut)))
+	}
+
+	if workerConfig.Spot != nil {
+		allErrs = append(allErrs, validateSpotConfig(workerConfig.Spot, fldPath.Child("spot"))...)
+	}
+
+	if placement := workerConfig.Placement; placement != nil {
+		allErrs = append(allErrs, validatePlacementConfig(placement, fldPath.Child("placement"))...)
+		if workerConfig.Spot != nil && usesDedicatedHost(placement) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("spot"), "spot instances cannot be created on dedicated hosts"))
+		}
+	}
+
+	allErrs = append(allErrs, validateAdditionalSecurityGroupIDs(workerConfig, fldPath.Child("additionalSecurityGroupIDs"))...)
+	allErrs = append(allErrs, validateSecurityGroupRules(workerConfig.SecurityGroupRules, fldPath.Child("securityGroupRules"))...)
+	allErrs = append(allErrs, validateFallbackInstanceTypes(workerConfig.FallbackInstanceTypes, fldPath.Child("fallbackInstanceTypes"))...)
+
+	if volume := workerConfig.Volume; volume != nil {
+		volumePath := fldPath.Child("volume")
+		allErrs = append(allErrs, validateKMSKeyID(volume.KMSKeyID, volumePath.Child("kmsKeyID"))...)
+		allErrs = append(allErrs, validateDiskPerformance(volume.PerformanceLevel, volume.ProvisionedIOPS, volume.AutoSnapshotPolicyID, volumePath)...)
+	}
+	allErrs = append(allErrs, validateDataVolumeConfigs(workerConfig.DataVolumes, fldPath.Child("dataVolumes"))...)
+
+	if workerConfig.InstanceMetadataOptions != nil {
+		allErrs = append(allErrs, validateInstanceMetadataOptions(workerConfig.InstanceMetadataOptions, fldPath.Child("instanceMetadataOptions"))...)
+	}
+	if workerConfig.RAMRoleName != nil && len(*workerConfig.RAMRoleName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ramRoleName"), "must not be empty"))
+	}
+	allErrs = append(allErrs, validateResourceGroupID(workerConfig.ResourceGroupID, fldPath.Child("resourceGroupID"))...)
+
+	return allErrs
+}
+
+// ValidateWorkerConfigRAMRoleName validates that the RAM role of a WorkerConfig is allowed by the cloud profile.
+func ValidateWorkerConfigRAMRoleName(workerConfig *apisalicloud.WorkerConfig, cloudProfileConfig *apisalicloud.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.RAMRoleName == nil || len(*workerConfig.RAMRoleName) == 0 {
+		return allErrs
+	}
+
+	var allowedRAMRoleNames []string
+	if cloudProfileConfig != nil {
+		allowedRAMRoleNames = cloudProfileConfig.AllowedRAMRoleNames
+	}
+
+	rolePath := fldPath.Child("ramRoleName")
+	if len(allowedRAMRoleNames) == 0 {
+		allErrs = append(allErrs, field.Forbidden(rolePath, "the cloud profile does not allow attaching RAM roles to instances"))
+	} else if !slices.Contains(allowedRAMRoleNames, *workerConfig.RAMRoleName) {
+		allErrs = append(allErrs, field.NotSupported(rolePath, *workerConfig.RAMRoleName, allowedRAMRoleNames))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigUpdate validates updates on a worker pool and its WorkerConfig. Subscription instances cannot be
+// released before their subscription expires, hence changes rolling the machines of a PrePaid worker pool are forbidden.
+// Machine image updates are allowed nevertheless, as the maintenance forcefully updates expired machine image versions.
+func ValidateWorkerConfigUpdate(oldWorker, newWorker core.Worker, oldWorkerConfig, newWorkerConfig *apisalicloud.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldWorkerConfig == nil || ptr.Deref(oldWorkerConfig.InstanceChargeType, apisalicloud.InstanceChargeTypePostPaid) != apisalicloud.InstanceChargeTypePrePaid {
+		return allErrs
+	}
+	// in-place updates do not replace the machines
+	if corehelper.IsUpdateStrategyInPlace(newWorker.UpdateStrategy) {
+		return allErrs
+	}
+
+	msg := fmt.Sprintf("is immutable for worker pools with instance charge type %q as the change would replace the subscription instances", apisalicloud.InstanceChargeTypePrePaid)
+	if !apiequality.Semantic.DeepEqual(withoutNonRollingFields(newWorkerConfig), withoutNonRollingFields(oldWorkerConfig)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("providerConfig"), msg))
+	}
+	// the rules of the security group of the worker pool are reconciled in place, but adding or removing it rolls the machines
+	if hasPoolSecurityGroup(newWorkerConfig) != hasPoolSecurityGroup(oldWorkerConfig) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("providerConfig", "securityGroupRules"), msg))
+	}
+	if newWorker.Machine.Type != oldWorker.Machine.Type {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("machine", "type"), msg))
+	}
+	if !apiequality.Semantic.DeepEqual(newWorker.Volume, oldWorker.Volume) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("volume"), msg))
+	}
+	if !apiequality.Semantic.DeepEqual(newWorker.DataVolumes, oldWorker.DataVolumes) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataVolumes"), msg))
+	}
+
+	return allErrs
+}
+
+// withoutNonRollingFields returns a copy of the given WorkerConfig without the fields which can be changed without
+// replacing the machines of the worker pool.
+func withoutNonRollingFields(workerConfig *apisalicloud.WorkerConfig) *apisalicloud.WorkerConfig {
+	if workerConfig == nil {
+		return nil
+	}
+	workerConfig = workerConfig.DeepCopy()
+	workerConfig.TypeMeta = metav1.TypeMeta{}
+	workerConfig.SecurityGroupRules = nil
+	workerConfig.FallbackInstanceTypes = nil
+	return workerConfig
+}
+
+func hasPoolSecurityGroup(workerConfig *apisalicloud.WorkerConfig) bool {
+	return workerConfig != nil && len(workerConfig.SecurityGroupRules) > 0
+}
+
+func validateInstanceMetadataOptions(options *apisalicloud.InstanceMetadataOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if options.HTTPTokens != nil && !validHTTPTokensValues.Has(*options.HTTPTokens) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("httpTokens"), *options.HTTPTokens, sets.List(validHTTPTokensValues)))
+	}
+
+	if limit := options.HTTPPutResponseHopLimit; limit != nil && (*limit < 1 || *limit > maxHTTPPutResponseHopLimit) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("httpPutResponseHopLimit"), *limit, fmt.Sprintf("must be between 1 and %d", maxHTTPPutResponseHopLimit)))
+	}
+
+	return allErrs
+}
+
+func validateSubscriptionConfig(subscription *apisalicloud.SubscriptionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	periodUnit := ptr.Deref(subscription.PeriodUnit, apisalicloud.PeriodUnitMonth)
+	periods, ok := validPeriods[periodUnit]
+	if !ok {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("periodUnit"), periodUnit, []apisalicloud.PeriodUnit{apisalicloud.PeriodUnitWeek, apisalicloud.PeriodUnitMonth}))
+		return allErrs
+	}
+
+	if !periods.Has(subscription.Period) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("period"), subscription.Period, fmt.Sprintf("supported values for period unit %q: %v", periodUnit, sets.List(periods))))
+	}
+
+	if subscription.AutoRenewPeriod != nil {
+		autoRenewPeriodPath := fldPath.Child("autoRenewPeriod")
+		if !ptr.Deref(subscription.AutoRenew, false) {
+			allErrs = append(allErrs, field.Forbidden(autoRenewPeriodPath, "can only be set if autoRenew is enabled"))
+		} else if autoRenewPeriods := validAutoRenewPeriods[periodUnit]; !autoRenewPeriods.Has(*subscription.AutoRenewPeriod) {
+			allErrs = append(allErrs, field.Invalid(autoRenewPeriodPath, *subscription.AutoRenewPeriod, fmt.Sprintf("supported values for period unit %q: %v", periodUnit, sets.List(autoRenewPeriods))))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSpotConfig(spot *apisalicloud.SpotConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !validSpotStrategies.Has(spot.Strategy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("strategy"), spot.Strategy, sets.List(validSpotStrategies)))
+	}
+
+	priceLimitPath := fldPath.Child("priceLimit")
+	if spot.Strategy == apisalicloud.SpotStrategySpotWithPriceLimit {
+		if spot.PriceLimit == nil {
+			allErrs = append(allErrs, field.Required(priceLimitPath, fmt.Sprintf("must be set for strategy %q", spot.Strategy)))
+		} else if price, err := strconv.ParseFloat(*spot.PriceLimit, 64); err != nil || price <= 0 {
+			allErrs = append(allErrs, field.Invalid(priceLimitPath, *spot.PriceLimit, "must be a positive decimal number"))
+		}
+	} else if spot.PriceLimit != nil {
+		allErrs = append(allErrs, field.Forbidden(priceLimitPath, fmt.Sprintf("can only be set for strategy %q", apisalicloud.SpotStrategySpotWithPriceLimit)))
+	}
+
+	if spot.Duration != nil && (*spot.Duration < 0 || *spot.Duration > maxSpotDuration) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), *spot.Duration, fmt.Sprintf("must be between 0 and %d", maxSpotDuration)))
+	}
+
+	return allErrs
+}
+
+func validatePlacementConfig(placement *apisalicloud.PlacementConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if placement.DeploymentSetID != nil && len(*placement.DeploymentSetID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deploymentSetID"), *placement.DeploymentSetID, "must not be empty"))
+	}
+
+	if strategy := placement.DeploymentSetStrategy; strategy != nil {
+		strategyPath := fldPath.Child("deploymentSetStrategy")
+		if placement.DeploymentSetID != nil {
+			allErrs = append(allErrs, field.Forbidden(strategyPath, "cannot be combined with deploymentSetID"))
+		} else if !validDeploymentSetStrategies.Has(*strategy) {
+			allErrs = append(allErrs, field.NotSupported(strategyPath, *strategy, sets.List(validDeploymentSetStrategies)))
+		}
+	}
+
+	if placement.DedicatedHostID != nil && len(*placement.DedicatedHostID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("dedicatedHostID"), *placement.DedicatedHostID, "must not be empty"))
+	}
+
+	if placement.DedicatedHostClusterID != nil {
+		dedicatedHostClusterIDPath := fldPath.Child("dedicatedHostClusterID")
+		if placement.DedicatedHostID != nil {
+			allErrs = append(allErrs, field.Forbidden(dedicatedHostClusterIDPath, "cannot be combined with dedicatedHostID"))
+		} else if len(*placement.DedicatedHostClusterID) == 0 {
+			allErrs = append(allErrs, field.Invalid(dedicatedHostClusterIDPath, *placement.DedicatedHostClusterID, "must not be empty"))
+		}
+	}
+
+	if usesDedicatedHost(placement) && (placement.DeploymentSetID != nil || placement.DeploymentSetStrategy != nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "deployment sets cannot be used for instances on dedicated hosts"))
+	}
+
+	return allErrs
+}
+
+func usesDedicatedHost(placement *apisalicloud.PlacementConfig) bool {
+	return placement.DedicatedHostID != nil || placement.DedicatedHostClusterID != nil
+}
+
+func validateAdditionalSecurityGroupIDs(workerConfig *apisalicloud.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// The nodes security group and, if rules are configured, the security group of the worker pool count towards the
+	// maximum number of security groups of an instance.
+	maxAdditionalSecurityGroups := maxSecurityGroupsPerInstance - 1
+	if len(workerConfig.SecurityGroupRules) > 0 {
+		maxAdditionalSecurityGroups--
+	}
+	if len(workerConfig.AdditionalSecurityGroupIDs) > maxAdditionalSecurityGroups {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(workerConfig.AdditionalSecurityGroupIDs), maxAdditionalSecurityGroups))
+	}
+
+	ids := sets.New[string]()
+	for i, id := range workerConfig.AdditionalSecurityGroupIDs {
+		if len(id) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), id, "must not be empty"))
+		} else if ids.Has(id) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), id))
+		}
+		ids.Insert(id)
+	}
+
+	return allErrs
+}
+
+func validateFallbackInstanceTypes(instanceTypes []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(instanceTypes) > maxFallbackInstanceTypes {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(instanceTypes), maxFallbackInstanceTypes))
+	}
+
+	seen := sets.New[string]()
+	for i, instanceType := range instanceTypes {
+		if len(instanceType) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), instanceType, "must not be empty"))
+		} else if seen.Has(instanceType) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), instanceType))
+		}
+		seen.Insert(instanceType)
+	}
+
+	return allErrs
+}
+
+func validateDataVolumeConfigs(dataVolumes []apisalicloud.DataVolume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, dataVolume := range dataVolumes {
+		idxPath := fldPath.Index(i)
+		if len(dataVolume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify the name of a data volume of the worker pool"))
+		} else if names.Has(dataVolume.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), dataVolume.Name))
+		}
+		names.Insert(dataVolume.Name)
+
+		allErrs = append(allErrs, validateKMSKeyID(dataVolume.KMSKeyID, idxPath.Child("kmsKeyID"))...)
+		allErrs = append(allErrs, validateDiskPerformance(dataVolume.PerformanceLevel, dataVolume.ProvisionedIOPS, dataVolume.AutoSnapshotPolicyID, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateDiskPerformance(performanceLevel *apisalicloud.PerformanceLevel, provisionedIOPS *int64, autoSnapshotPolicyID *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if performanceLevel != nil && !validPerformanceLevels.Has(*performanceLevel) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("performanceLevel"), *performanceLevel, sets.List(validPerformanceLevels)))
+	}
+	if provisionedIOPS != nil && (*provisionedIOPS < 0 || *provisionedIOPS > maxProvisionedIOPS) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("provisionedIOPS"), *provisionedIOPS, fmt.Sprintf("must be between 0 and %d", maxProvisionedIOPS)))
+	}
+	if autoSnapshotPolicyID != nil && len(*autoSnapshotPolicyID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("autoSnapshotPolicyID"), *autoSnapshotPolicyID, "must not be empty"))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigVolumes validates the volume settings of a WorkerConfig object against the volumes of the worker
+// pool it belongs to.
+func ValidateWorkerConfigVolumes(workerConfig *apisalicloud.WorkerConfig, volume *core.Volume, dataVolumes []core.DataVolume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil {
+		return allErrs
+	}
+
+	if config := workerConfig.Volume; config != nil && volume != nil {
+		allErrs = append(allErrs, validateDiskCategory(volume.Type, volume.VolumeSize, config.PerformanceLevel, config.ProvisionedIOPS, config.BurstingEnabled, fldPath.Child("volume"))...)
+	}
+
+	for i, config := range workerConfig.DataVolumes {
+		idxPath := fldPath.Child("dataVolumes").Index(i)
+		idx := slices.IndexFunc(dataVolumes, func(dataVolume core.DataVolume) bool { return dataVolume.Name == config.Name })
+		if idx < 0 {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), config.Name))
+			continue
+		}
+		allErrs = append(allErrs, validateDiskCategory(dataVolumes[idx].Type, dataVolumes[idx].VolumeSize, config.PerformanceLevel, config.ProvisionedIOPS, config.BurstingEnabled, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateDiskCategory(category *string, size string, performanceLevel *apisalicloud.PerformanceLevel, provisionedIOPS *int64, burstingEnabled *bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if performanceLevel != nil {
+		if ptr.Deref(category, "") != diskCategoryESSD {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("performanceLevel"), fmt.Sprintf("can only be set for disks of category %q", diskCategoryESSD)))
+		} else if minSize, ok := minDiskSizePerPerformanceLevel[*performanceLevel]; ok {
+			if quantity, err := resource.ParseQuantity(size); err == nil && quantity.Cmp(minSize) < 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("performanceLevel"), *performanceLevel, fmt.Sprintf("requires a disk size of at least %s", minSize.String())))
+			}
+		}
+	}
+
+	if ptr.Deref(category, "") != diskCategoryESSDAuto {
+		if provisionedIOPS != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisionedIOPS"), fmt.Sprintf("can only be set for disks of category %q", diskCategoryESSDAuto)))
+		}
+		if burstingEnabled != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("burstingEnabled"), fmt.Sprintf("can only be set for disks of category %q", diskCategoryESSDAuto)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateKMSKeyID(kmsKeyID *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kmsKeyID != nil && len(*kmsKeyID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *kmsKeyID, "must not be empty"))
+	}
+
+	return allErrs
+}
+
+func validateSecurityGroupRules(rules []apisalicloud.SecurityGroupRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, rule := range rules {
+		rulePath := fldPath.Index(i)
+
+		if !validSecurityGroupRuleDirections.Has(rule.Direction) {
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("direction"), rule.Direction, sets.List(validSecurityGroupRuleDirections)))
+		}
+
+		if !validSecurityGroupRuleProtocols.Has(rule.Protocol) {
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("protocol"), rule.Protocol, sets.List(validSecurityGroupRuleProtocols)))
+		} else {
+			allErrs = append(allErrs, validatePortRange(rule.Protocol, rule.PortRange, rulePath.Child("portRange"))...)
+		}
+
+		if _, _, err := net.ParseCIDR(rule.CIDR); err != nil {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("cidr"), rule.CIDR, "must be a valid CIDR"))
+		}
+
+		if rule.Policy != nil && !validSecurityGroupRulePolicies.Has(*rule.Policy) {
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("policy"), *rule.Policy, sets.List(validSecurityGroupRulePolicies)))
+		}
+
+		if rule.Priority != nil && (*rule.Priority < 1 || *rule.Priority > maxSecurityGroupRulePriority) {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("priority"), *rule.Priority, fmt.Sprintf("must be between 1 and %d", maxSecurityGroupRulePriority)))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePortRange(protocol, portRange string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if protocol != "TCP" && protocol != "UDP" {
+		if portRange != "-1/-1" {
+			allErrs = append(allErrs, field.Invalid(fldPath, portRange, fmt.Sprintf("must be -1/-1 for protocol %q", protocol)))
+		}
+		return allErrs
+	}
+
+	from, to, found := strings.Cut(portRange, "/")
+	fromPort, fromErr := strconv.Atoi(from)
+	toPort, toErr := strconv.Atoi(to)
+	if !found || fromErr != nil || toErr != nil || fromPort < 1 || toPort > 65535 || fromPort > toPort {
+		allErrs = append(allErrs, field.Invalid(fldPath, portRange, "must be a port range in the format <from>/<to> with ports between 1 and 65535"))
+	}
+
+	return allErrs
+}
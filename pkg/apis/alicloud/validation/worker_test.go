@@ -0,0 +1,839 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/validation"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisalicloud.WorkerConfig
+		fldPath      *field.Path
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisalicloud.WorkerConfig{
+			InternetChargeType:      ptr.To(apisalicloud.InternetChargeTypePayByTraffic),
+			InternetMaxBandwidthIn:  ptr.To[int32](5),
+			InternetMaxBandwidthOut: ptr.To[int32](0),
+		}
+		fldPath = field.NewPath("providerConfig")
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should return no errors for a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should return no errors for a nil configuration", func() {
+			Expect(ValidateWorkerConfig(nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported internet charge types", func() {
+			workerConfig.InternetChargeType = ptr.To[apisalicloud.InternetChargeType]("PayByNothing")
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("providerConfig.internetChargeType"),
+				})),
+			))
+		})
+
+		It("should forbid bandwidths out of range", func() {
+			workerConfig.InternetMaxBandwidthIn = ptr.To[int32](0)
+			workerConfig.InternetMaxBandwidthOut = ptr.To[int32](101)
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.internetMaxBandwidthIn"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.internetMaxBandwidthOut"),
+				})),
+			))
+		})
+
+		Context("subscription", func() {
+			BeforeEach(func() {
+				workerConfig.InstanceChargeType = ptr.To(apisalicloud.InstanceChargeTypePrePaid)
+				workerConfig.Subscription = &apisalicloud.SubscriptionConfig{
+					Period:          1,
+					PeriodUnit:      ptr.To(apisalicloud.PeriodUnitMonth),
+					AutoRenew:       ptr.To(true),
+					AutoRenewPeriod: ptr.To[int32](12),
+				}
+			})
+
+			It("should allow subscription instances", func() {
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported instance charge types", func() {
+				workerConfig.InstanceChargeType = ptr.To[apisalicloud.InstanceChargeType]("Free")
+				workerConfig.Subscription = nil
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.instanceChargeType"),
+					})),
+				))
+			})
+
+			It("should require the subscription settings for PrePaid instances", func() {
+				workerConfig.Subscription = nil
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("providerConfig.subscription"),
+					})),
+				))
+			})
+
+			It("should forbid the subscription settings for PostPaid instances", func() {
+				workerConfig.InstanceChargeType = ptr.To(apisalicloud.InstanceChargeTypePostPaid)
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.subscription"),
+					})),
+				))
+			})
+
+			It("should forbid spot instances for PrePaid instances", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{Strategy: apisalicloud.SpotStrategySpotAsPriceGo}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.spot"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported periods", func() {
+				workerConfig.Subscription.PeriodUnit = ptr.To(apisalicloud.PeriodUnitWeek)
+				workerConfig.Subscription.Period = 5
+				workerConfig.Subscription.AutoRenewPeriod = ptr.To[int32](4)
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.subscription.period"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.subscription.autoRenewPeriod"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported period units", func() {
+				workerConfig.Subscription.PeriodUnit = ptr.To[apisalicloud.PeriodUnit]("Year")
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.subscription.periodUnit"),
+					})),
+				))
+			})
+
+			It("should forbid an auto renew period if auto renew is disabled", func() {
+				workerConfig.Subscription.AutoRenew = nil
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.subscription.autoRenewPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("spot", func() {
+			It("should allow spot instances with the market price", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{
+					Strategy: apisalicloud.SpotStrategySpotAsPriceGo,
+					Duration: ptr.To[int32](1),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should allow spot instances with a price limit", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{
+					Strategy:   apisalicloud.SpotStrategySpotWithPriceLimit,
+					PriceLimit: ptr.To("0.25"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported strategies", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{Strategy: "NoSpot"}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.spot.strategy"),
+					})),
+				))
+			})
+
+			It("should require a price limit for strategy SpotWithPriceLimit", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{Strategy: apisalicloud.SpotStrategySpotWithPriceLimit}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("providerConfig.spot.priceLimit"),
+					})),
+				))
+			})
+
+			It("should forbid invalid price limits", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{
+					Strategy:   apisalicloud.SpotStrategySpotWithPriceLimit,
+					PriceLimit: ptr.To("-1"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.spot.priceLimit"),
+					})),
+				))
+			})
+
+			It("should forbid a price limit for strategy SpotAsPriceGo", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{
+					Strategy:   apisalicloud.SpotStrategySpotAsPriceGo,
+					PriceLimit: ptr.To("0.25"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.spot.priceLimit"),
+					})),
+				))
+			})
+
+			It("should forbid durations out of range", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{
+					Strategy: apisalicloud.SpotStrategySpotAsPriceGo,
+					Duration: ptr.To[int32](7),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.spot.duration"),
+					})),
+				))
+			})
+		})
+
+		Context("placement", func() {
+			It("should allow a deployment set strategy", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DeploymentSetStrategy: ptr.To(apisalicloud.DeploymentSetStrategyAvailability),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should allow an existing deployment set", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DeploymentSetID: ptr.To("ds-1234"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should allow a dedicated host", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DedicatedHostID: ptr.To("dh-1234"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported deployment set strategies", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DeploymentSetStrategy: ptr.To[apisalicloud.DeploymentSetStrategy]("Anywhere"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.placement.deploymentSetStrategy"),
+					})),
+				))
+			})
+
+			It("should forbid empty IDs", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DeploymentSetID: ptr.To(""),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.placement.deploymentSetID"),
+					})),
+				))
+			})
+
+			It("should forbid combining a deployment set ID and strategy", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DeploymentSetID:       ptr.To("ds-1234"),
+					DeploymentSetStrategy: ptr.To(apisalicloud.DeploymentSetStrategyAvailability),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.placement.deploymentSetStrategy"),
+					})),
+				))
+			})
+
+			It("should forbid combining a dedicated host and a dedicated host cluster", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DedicatedHostID:        ptr.To("dh-1234"),
+					DedicatedHostClusterID: ptr.To("dc-1234"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.placement.dedicatedHostClusterID"),
+					})),
+				))
+			})
+
+			It("should forbid deployment sets on dedicated hosts", func() {
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DeploymentSetStrategy:  ptr.To(apisalicloud.DeploymentSetStrategyAvailability),
+					DedicatedHostClusterID: ptr.To("dc-1234"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.placement"),
+					})),
+				))
+			})
+
+			It("should forbid spot instances on dedicated hosts", func() {
+				workerConfig.Spot = &apisalicloud.SpotConfig{
+					Strategy: apisalicloud.SpotStrategySpotAsPriceGo,
+				}
+				workerConfig.Placement = &apisalicloud.PlacementConfig{
+					DedicatedHostID: ptr.To("dh-1234"),
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.spot"),
+					})),
+				))
+			})
+		})
+
+		Context("security groups", func() {
+			var rule apisalicloud.SecurityGroupRule
+
+			BeforeEach(func() {
+				rule = apisalicloud.SecurityGroupRule{
+					Direction: apisalicloud.SecurityGroupRuleDirectionIngress,
+					Protocol:  "TCP",
+					PortRange: "22/22",
+					CIDR:      "10.0.0.0/8",
+					Policy:    ptr.To(apisalicloud.SecurityGroupRulePolicyAccept),
+					Priority:  ptr.To[int32](1),
+				}
+			})
+
+			It("should allow additional security groups and rules", func() {
+				workerConfig.AdditionalSecurityGroupIDs = []string{"sg-1", "sg-2", "sg-3"}
+				workerConfig.SecurityGroupRules = []apisalicloud.SecurityGroupRule{
+					rule,
+					{
+						Direction: apisalicloud.SecurityGroupRuleDirectionEgress,
+						Protocol:  "ALL",
+						PortRange: "-1/-1",
+						CIDR:      "0.0.0.0/0",
+					},
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate security group IDs", func() {
+				workerConfig.AdditionalSecurityGroupIDs = []string{"sg-1", "", "sg-1"}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.additionalSecurityGroupIDs[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("providerConfig.additionalSecurityGroupIDs[2]"),
+					})),
+				))
+			})
+
+			It("should forbid too many security groups", func() {
+				workerConfig.AdditionalSecurityGroupIDs = []string{"sg-1", "sg-2", "sg-3", "sg-4"}
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+
+				workerConfig.SecurityGroupRules = []apisalicloud.SecurityGroupRule{rule}
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("providerConfig.additionalSecurityGroupIDs"),
+					})),
+				))
+			})
+
+			It("should forbid invalid rules", func() {
+				workerConfig.SecurityGroupRules = []apisalicloud.SecurityGroupRule{
+					{
+						Direction: "inbound",
+						Protocol:  "SCTP",
+						PortRange: "1/2",
+						CIDR:      "10.0.0.0",
+						Policy:    ptr.To[apisalicloud.SecurityGroupRulePolicy]("Reject"),
+						Priority:  ptr.To[int32](101),
+					},
+				}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.securityGroupRules[0].direction"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.securityGroupRules[0].protocol"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.securityGroupRules[0].cidr"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.securityGroupRules[0].policy"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.securityGroupRules[0].priority"),
+					})),
+				))
+			})
+
+			DescribeTable("should validate port ranges",
+				func(protocol, portRange string, valid bool) {
+					rule.Protocol = protocol
+					rule.PortRange = portRange
+					workerConfig.SecurityGroupRules = []apisalicloud.SecurityGroupRule{rule}
+
+					if valid {
+						Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+					} else {
+						Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("providerConfig.securityGroupRules[0].portRange"),
+							})),
+						))
+					}
+				},
+				Entry("single TCP port", "TCP", "443/443", true),
+				Entry("UDP port range", "UDP", "30000/32767", true),
+				Entry("ICMP without ports", "ICMP", "-1/-1", true),
+				Entry("missing separator", "TCP", "443", false),
+				Entry("reversed range", "TCP", "443/80", false),
+				Entry("port zero", "TCP", "0/80", false),
+				Entry("port out of range", "UDP", "1/65536", false),
+				Entry("ICMP with ports", "ICMP", "1/2", false),
+			)
+		})
+
+		Context("fallback instance types", func() {
+			It("should allow fallback instance types", func() {
+				workerConfig.FallbackInstanceTypes = []string{"ecs.g7.large", "ecs.g6.large"}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate fallback instance types", func() {
+				workerConfig.FallbackInstanceTypes = []string{"ecs.g7.large", "", "ecs.g7.large"}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.fallbackInstanceTypes[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("providerConfig.fallbackInstanceTypes[2]"),
+					})),
+				))
+			})
+
+			It("should forbid too many fallback instance types", func() {
+				workerConfig.FallbackInstanceTypes = []string{"a", "b", "c", "d", "e", "f"}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("providerConfig.fallbackInstanceTypes"),
+					})),
+				))
+			})
+		})
+
+		Context("volumes", func() {
+			It("should allow KMS keys for the volumes", func() {
+				workerConfig.Volume = &apisalicloud.Volume{KMSKeyID: ptr.To("key-1")}
+				workerConfig.DataVolumes = []apisalicloud.DataVolume{{Name: "data1", KMSKeyID: ptr.To("key-2")}, {Name: "data2"}}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid empty KMS keys and invalid data volume names", func() {
+				workerConfig.Volume = &apisalicloud.Volume{KMSKeyID: ptr.To("")}
+				workerConfig.DataVolumes = []apisalicloud.DataVolume{{Name: "data1"}, {Name: "data1", KMSKeyID: ptr.To("")}, {KMSKeyID: ptr.To("key-1")}}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.volume.kmsKeyID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("providerConfig.dataVolumes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.dataVolumes[1].kmsKeyID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("providerConfig.dataVolumes[2].name"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported performance settings", func() {
+				workerConfig.Volume = &apisalicloud.Volume{PerformanceLevel: ptr.To[apisalicloud.PerformanceLevel]("PL4"), AutoSnapshotPolicyID: ptr.To("")}
+				workerConfig.DataVolumes = []apisalicloud.DataVolume{{Name: "data1", ProvisionedIOPS: ptr.To[int64](50001)}}
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.volume.performanceLevel"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.volume.autoSnapshotPolicyID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.dataVolumes[0].provisionedIOPS"),
+					})),
+				))
+			})
+		})
+
+		Context("instance metadata and RAM role", func() {
+			It("should allow hardened instance metadata options and a RAM role", func() {
+				workerConfig.InstanceMetadataOptions = &apisalicloud.InstanceMetadataOptions{
+					HTTPTokens:              ptr.To(apisalicloud.HTTPTokensRequired),
+					HTTPPutResponseHopLimit: ptr.To[int32](2),
+				}
+				workerConfig.RAMRoleName = ptr.To("node-role")
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid instance metadata options and an empty RAM role", func() {
+				workerConfig.InstanceMetadataOptions = &apisalicloud.InstanceMetadataOptions{
+					HTTPTokens:              ptr.To[apisalicloud.HTTPTokensValue]("disabled"),
+					HTTPPutResponseHopLimit: ptr.To[int32](65),
+				}
+				workerConfig.RAMRoleName = ptr.To("")
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("providerConfig.instanceMetadataOptions.httpTokens"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.instanceMetadataOptions.httpPutResponseHopLimit"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("providerConfig.ramRoleName"),
+					})),
+				))
+			})
+		})
+
+		Context("resource group", func() {
+			It("should allow a resource group", func() {
+				workerConfig.ResourceGroupID = ptr.To("rg-acfmxazb4ph6aiy")
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid resource group IDs", func() {
+				workerConfig.ResourceGroupID = ptr.To("my-group")
+
+				Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.resourceGroupID"),
+					})),
+				))
+			})
+		})
+	})
+
+	Describe("#ValidateWorkerConfigRAMRoleName", func() {
+		var cloudProfileConfig *apisalicloud.CloudProfileConfig
+
+		BeforeEach(func() {
+			cloudProfileConfig = &apisalicloud.CloudProfileConfig{AllowedRAMRoleNames: []string{"node-role"}}
+			workerConfig.RAMRoleName = ptr.To("node-role")
+		})
+
+		It("should allow RAM roles allowed by the cloud profile", func() {
+			Expect(ValidateWorkerConfigRAMRoleName(workerConfig, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid RAM roles not allowed by the cloud profile", func() {
+			workerConfig.RAMRoleName = ptr.To("admin-role")
+
+			Expect(ValidateWorkerConfigRAMRoleName(workerConfig, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("providerConfig.ramRoleName"),
+				})),
+			))
+		})
+
+		It("should forbid RAM roles if the cloud profile does not allow any", func() {
+			cloudProfileConfig.AllowedRAMRoleNames = nil
+
+			Expect(ValidateWorkerConfigRAMRoleName(workerConfig, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.ramRoleName"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerConfigUpdate", func() {
+		var (
+			oldWorker, newWorker core.Worker
+			oldWorkerConfig      *apisalicloud.WorkerConfig
+			workerPath           *field.Path
+		)
+
+		BeforeEach(func() {
+			oldWorker = core.Worker{
+				Name: "worker",
+				Machine: core.Machine{
+					Type:  "ecs.g7.large",
+					Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+				},
+				Volume: &core.Volume{Type: ptr.To("cloud_essd"), VolumeSize: "50Gi"},
+			}
+			newWorker = *oldWorker.DeepCopy()
+
+			workerConfig.InstanceChargeType = ptr.To(apisalicloud.InstanceChargeTypePrePaid)
+			workerConfig.Subscription = &apisalicloud.SubscriptionConfig{Period: 1}
+			oldWorkerConfig = workerConfig.DeepCopy()
+			workerPath = field.NewPath("workers").Index(0)
+		})
+
+		It("should allow unchanged PrePaid worker pools", func() {
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(BeEmpty())
+		})
+
+		It("should allow changes not replacing the machines of PrePaid worker pools", func() {
+			oldWorkerConfig.SecurityGroupRules = []apisalicloud.SecurityGroupRule{{Protocol: "TCP", PortRange: "22/22", CIDR: "10.0.0.0/8"}}
+			newWorker.Minimum, newWorker.Maximum = 2, 4
+			workerConfig.FallbackInstanceTypes = []string{"ecs.g6.large"}
+			workerConfig.SecurityGroupRules = []apisalicloud.SecurityGroupRule{{Protocol: "TCP", PortRange: "443/443", CIDR: "10.0.0.0/8"}}
+
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(BeEmpty())
+		})
+
+		It("should forbid changes replacing the machines of PrePaid worker pools", func() {
+			newWorker.Machine.Type = "ecs.g7.xlarge"
+			newWorker.Volume.VolumeSize = "100Gi"
+			newWorker.DataVolumes = []core.DataVolume{{Name: "data1", Type: ptr.To("cloud_essd"), VolumeSize: "100Gi"}}
+			workerConfig.Subscription.AutoRenew = ptr.To(true)
+
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[0].providerConfig"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[0].machine.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[0].volume"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[0].dataVolumes"),
+				})),
+			))
+		})
+
+		It("should allow machine image updates of PrePaid worker pools", func() {
+			newWorker.Machine.Image.Version = "1.1.0"
+
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(BeEmpty())
+		})
+
+		It("should forbid adding a security group to PrePaid worker pools", func() {
+			workerConfig.SecurityGroupRules = []apisalicloud.SecurityGroupRule{{Protocol: "TCP", PortRange: "443/443", CIDR: "10.0.0.0/8"}}
+
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[0].providerConfig.securityGroupRules"),
+				})),
+			))
+		})
+
+		It("should forbid switching PrePaid worker pools to PostPaid", func() {
+			workerConfig.InstanceChargeType = ptr.To(apisalicloud.InstanceChargeTypePostPaid)
+			workerConfig.Subscription = nil
+
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[0].providerConfig"),
+				})),
+			))
+		})
+
+		It("should allow switching PostPaid worker pools to PrePaid", func() {
+			oldWorkerConfig.InstanceChargeType = nil
+			oldWorkerConfig.Subscription = nil
+			newWorker.Machine.Type = "ecs.g7.xlarge"
+
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(BeEmpty())
+		})
+
+		It("should allow changes to PrePaid worker pools updated in-place", func() {
+			newWorker.UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+			newWorker.Machine.Image.Version = "1.1.0"
+
+			Expect(ValidateWorkerConfigUpdate(oldWorker, newWorker, oldWorkerConfig, workerConfig, workerPath)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateWorkerConfigVolumes", func() {
+		var (
+			volume      *core.Volume
+			dataVolumes []core.DataVolume
+		)
+
+		BeforeEach(func() {
+			volume = &core.Volume{Type: ptr.To("cloud_essd"), VolumeSize: "500Gi"}
+			dataVolumes = []core.DataVolume{
+				{Name: "data1", Type: ptr.To("cloud_auto"), VolumeSize: "100Gi"},
+				{Name: "data2", Type: ptr.To("cloud_efficiency"), VolumeSize: "100Gi"},
+			}
+		})
+
+		It("should allow performance settings matching the disk categories", func() {
+			workerConfig.Volume = &apisalicloud.Volume{PerformanceLevel: ptr.To(apisalicloud.PerformanceLevelPL2)}
+			workerConfig.DataVolumes = []apisalicloud.DataVolume{
+				{Name: "data1", ProvisionedIOPS: ptr.To[int64](10000), BurstingEnabled: ptr.To(true), AutoSnapshotPolicyID: ptr.To("sp-1")},
+				{Name: "data2", AutoSnapshotPolicyID: ptr.To("sp-1")},
+			}
+
+			Expect(ValidateWorkerConfigVolumes(workerConfig, volume, dataVolumes, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid performance settings not matching the disk categories", func() {
+			workerConfig.Volume = &apisalicloud.Volume{ProvisionedIOPS: ptr.To[int64](10000)}
+			workerConfig.DataVolumes = []apisalicloud.DataVolume{
+				{Name: "data1", PerformanceLevel: ptr.To(apisalicloud.PerformanceLevelPL1)},
+				{Name: "data2", BurstingEnabled: ptr.To(true)},
+			}
+
+			Expect(ValidateWorkerConfigVolumes(workerConfig, volume, dataVolumes, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.volume.provisionedIOPS"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.dataVolumes[0].performanceLevel"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.dataVolumes[1].burstingEnabled"),
+				})),
+			))
+		})
+
+		It("should forbid performance levels exceeding the disk size", func() {
+			workerConfig.Volume = &apisalicloud.Volume{PerformanceLevel: ptr.To(apisalicloud.PerformanceLevelPL3)}
+
+			Expect(ValidateWorkerConfigVolumes(workerConfig, volume, dataVolumes, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.volume.performanceLevel"),
+				})),
+			))
+		})
+
+		It("should forbid settings for unknown data volumes", func() {
+			workerConfig.DataVolumes = []apisalicloud.DataVolume{{Name: "data3", KMSKeyID: ptr.To("key-1")}}
+
+			Expect(ValidateWorkerConfigVolumes(workerConfig, volume, dataVolumes, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("providerConfig.dataVolumes[0].name"),
+				})),
+			))
+		})
+	})
+})
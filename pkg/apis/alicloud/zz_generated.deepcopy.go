@@ -43,6 +43,27 @@ func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CEN) DeepCopyInto(out *CEN) {
+	*out = *in
+	if in.TransitRouter != nil {
+		in, out := &in.TransitRouter, &out.TransitRouter
+		*out = new(TransitRouter)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CEN.
+func (in *CEN) DeepCopy() *CEN {
+	if in == nil {
+		return nil
+	}
+	out := new(CEN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSI) DeepCopyInto(out *CSI) {
 	*out = *in
@@ -98,6 +119,11 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AllowedRAMRoleNames != nil {
+		in, out := &in.AllowedRAMRoleNames, &out.AllowedRAMRoleNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -133,6 +159,11 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(CSI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiskEncryption != nil {
+		in, out := &in.DiskEncryption, &out.DiskEncryption
+		*out = new(DiskEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -154,6 +185,105 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataVolume) DeepCopyInto(out *DataVolume) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceLevel != nil {
+		in, out := &in.PerformanceLevel, &out.PerformanceLevel
+		*out = new(PerformanceLevel)
+		**out = **in
+	}
+	if in.ProvisionedIOPS != nil {
+		in, out := &in.ProvisionedIOPS, &out.ProvisionedIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BurstingEnabled != nil {
+		in, out := &in.BurstingEnabled, &out.BurstingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoSnapshotPolicyID != nil {
+		in, out := &in.AutoSnapshotPolicyID, &out.AutoSnapshotPolicyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
+func (in *DataVolume) DeepCopy() *DataVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DataVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentSet) DeepCopyInto(out *DeploymentSet) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSet.
+func (in *DeploymentSet) DeepCopy() *DeploymentSet {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6) DeepCopyInto(out *IPv6) {
+	*out = *in
+	if in.AllowNodePortIPv6 != nil {
+		in, out := &in.AllowNodePortIPv6, &out.AllowNodePortIPv6
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6.
+func (in *IPv6) DeepCopy() *IPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImmutableConfig) DeepCopyInto(out *ImmutableConfig) {
 	*out = *in
@@ -175,6 +305,18 @@ func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.Networks.DeepCopyInto(&out.Networks)
+	if in.ResourceGroupID != nil {
+		in, out := &in.ResourceGroupID, &out.ResourceGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -196,6 +338,27 @@ func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructurePlan) DeepCopyInto(out *InfrastructurePlan) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PlannedChange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructurePlan.
+func (in *InfrastructurePlan) DeepCopy() *InfrastructurePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructurePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 	*out = *in
@@ -208,6 +371,18 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(InfrastructurePlan)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -229,6 +404,49 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
+	*out = *in
+	if in.HTTPTokens != nil {
+		in, out := &in.HTTPTokens, &out.HTTPTokens
+		*out = new(HTTPTokensValue)
+		**out = **in
+	}
+	if in.HTTPPutResponseHopLimit != nil {
+		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptions.
+func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMetadataOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTypeFallback) DeepCopyInto(out *InstanceTypeFallback) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTypeFallback.
+func (in *InstanceTypeFallback) DeepCopy() *InstanceTypeFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTypeFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -237,6 +455,16 @@ func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -256,7 +484,9 @@ func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 	if in.Regions != nil {
 		in, out := &in.Regions, &out.Regions
 		*out = make([]RegionIDMapping, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -294,6 +524,32 @@ func (in *MachineImages) DeepCopy() *MachineImages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedNatGatewayConfig) DeepCopyInto(out *ManagedNatGatewayConfig) {
+	*out = *in
+	if in.InternetChargeType != nil {
+		in, out := &in.InternetChargeType, &out.InternetChargeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNatGatewayConfig.
+func (in *ManagedNatGatewayConfig) DeepCopy() *ManagedNatGatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedNatGatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
 	*out = *in
@@ -302,6 +558,31 @@ func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EIPAllocationIDs != nil {
+		in, out := &in.EIPAllocationIDs, &out.EIPAllocationIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EIPCount != nil {
+		in, out := &in.EIPCount, &out.EIPCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EIPBandwidth != nil {
+		in, out := &in.EIPBandwidth, &out.EIPBandwidth
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EIPISP != nil {
+		in, out := &in.EIPISP, &out.EIPISP
+		*out = new(string)
+		**out = **in
+	}
+	if in.EIPBandwidthPackageID != nil {
+		in, out := &in.EIPBandwidthPackageID, &out.EIPBandwidthPackageID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -326,6 +607,35 @@ func (in *Networks) DeepCopyInto(out *Networks) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodePortSourceCIDRs != nil {
+		in, out := &in.NodePortSourceCIDRs, &out.NodePortSourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRules != nil {
+		in, out := &in.SecurityGroupRules, &out.SecurityGroupRules
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPv6)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CEN != nil {
+		in, out := &in.CEN, &out.CEN
+		*out = new(CEN)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make([]VPCEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -339,9 +649,82 @@ func (in *Networks) DeepCopy() *Networks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementConfig) DeepCopyInto(out *PlacementConfig) {
+	*out = *in
+	if in.DeploymentSetID != nil {
+		in, out := &in.DeploymentSetID, &out.DeploymentSetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeploymentSetStrategy != nil {
+		in, out := &in.DeploymentSetStrategy, &out.DeploymentSetStrategy
+		*out = new(DeploymentSetStrategy)
+		**out = **in
+	}
+	if in.DedicatedHostID != nil {
+		in, out := &in.DedicatedHostID, &out.DedicatedHostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DedicatedHostClusterID != nil {
+		in, out := &in.DedicatedHostClusterID, &out.DedicatedHostClusterID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementConfig.
+func (in *PlacementConfig) DeepCopy() *PlacementConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolSecurityGroup) DeepCopyInto(out *PoolSecurityGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolSecurityGroup.
+func (in *PoolSecurityGroup) DeepCopy() *PoolSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegionIDMapping) DeepCopyInto(out *RegionIDMapping) {
 	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -371,6 +754,105 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(SecurityGroupRulePolicy)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotConfig) DeepCopyInto(out *SpotConfig) {
+	*out = *in
+	if in.PriceLimit != nil {
+		in, out := &in.PriceLimit, &out.PriceLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotConfig.
+func (in *SpotConfig) DeepCopy() *SpotConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionConfig) DeepCopyInto(out *SubscriptionConfig) {
+	*out = *in
+	if in.PeriodUnit != nil {
+		in, out := &in.PeriodUnit, &out.PeriodUnit
+		*out = new(PeriodUnit)
+		**out = **in
+	}
+	if in.AutoRenew != nil {
+		in, out := &in.AutoRenew, &out.AutoRenew
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoRenewPeriod != nil {
+		in, out := &in.AutoRenewPeriod, &out.AutoRenewPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionConfig.
+func (in *SubscriptionConfig) DeepCopy() *SubscriptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitRouter) DeepCopyInto(out *TransitRouter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitRouter.
+func (in *TransitRouter) DeepCopy() *TransitRouter {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitRouter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPC) DeepCopyInto(out *VPC) {
 	*out = *in
@@ -389,6 +871,11 @@ func (in *VPC) DeepCopyInto(out *VPC) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NatGateway != nil {
+		in, out := &in.NatGateway, &out.NatGateway
+		*out = new(ManagedNatGatewayConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -402,6 +889,48 @@ func (in *VPC) DeepCopy() *VPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(VPCEndpointType)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointStatus) DeepCopyInto(out *VPCEndpointStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointStatus.
+func (in *VPCEndpointStatus) DeepCopy() *VPCEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 	*out = *in
@@ -415,6 +944,11 @@ func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 		*out = make([]SecurityGroup, len(*in))
 		copy(*out, *in)
 	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make([]VPCEndpointStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -444,6 +978,151 @@ func (in *VSwitch) DeepCopy() *VSwitch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Volume) DeepCopyInto(out *Volume) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceLevel != nil {
+		in, out := &in.PerformanceLevel, &out.PerformanceLevel
+		*out = new(PerformanceLevel)
+		**out = **in
+	}
+	if in.ProvisionedIOPS != nil {
+		in, out := &in.ProvisionedIOPS, &out.ProvisionedIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BurstingEnabled != nil {
+		in, out := &in.BurstingEnabled, &out.BurstingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoSnapshotPolicyID != nil {
+		in, out := &in.AutoSnapshotPolicyID, &out.AutoSnapshotPolicyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
+func (in *Volume) DeepCopy() *Volume {
+	if in == nil {
+		return nil
+	}
+	out := new(Volume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.InstanceChargeType != nil {
+		in, out := &in.InstanceChargeType, &out.InstanceChargeType
+		*out = new(InstanceChargeType)
+		**out = **in
+	}
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(SubscriptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InternetChargeType != nil {
+		in, out := &in.InternetChargeType, &out.InternetChargeType
+		*out = new(InternetChargeType)
+		**out = **in
+	}
+	if in.InternetMaxBandwidthIn != nil {
+		in, out := &in.InternetMaxBandwidthIn, &out.InternetMaxBandwidthIn
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InternetMaxBandwidthOut != nil {
+		in, out := &in.InternetMaxBandwidthOut, &out.InternetMaxBandwidthOut
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Spot != nil {
+		in, out := &in.Spot, &out.Spot
+		*out = new(SpotConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalSecurityGroupIDs != nil {
+		in, out := &in.AdditionalSecurityGroupIDs, &out.AdditionalSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRules != nil {
+		in, out := &in.SecurityGroupRules, &out.SecurityGroupRules
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FallbackInstanceTypes != nil {
+		in, out := &in.FallbackInstanceTypes, &out.FallbackInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(Volume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]DataVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceMetadataOptions != nil {
+		in, out := &in.InstanceMetadataOptions, &out.InstanceMetadataOptions
+		*out = new(InstanceMetadataOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RAMRoleName != nil {
+		in, out := &in.RAMRoleName, &out.RAMRoleName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupID != nil {
+		in, out := &in.ResourceGroupID, &out.ResourceGroupID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerConfig.
+func (in *WorkerConfig) DeepCopy() *WorkerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	*out = *in
@@ -455,6 +1134,37 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DeploymentSets != nil {
+		in, out := &in.DeploymentSets, &out.DeploymentSets
+		*out = make([]DeploymentSet, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]PoolSecurityGroup, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceTypeFallbacks != nil {
+		in, out := &in.InstanceTypeFallbacks, &out.InstanceTypeFallbacks
+		*out = make([]InstanceTypeFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InstanceTypeArchitectures != nil {
+		in, out := &in.InstanceTypeArchitectures, &out.InstanceTypeArchitectures
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -484,6 +1194,16 @@ func (in *Zone) DeepCopyInto(out *Zone) {
 		*out = new(NatGatewayConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkersIPv6Index != nil {
+		in, out := &in.WorkersIPv6Index, &out.WorkersIPv6Index
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WorkersVSwitchID != nil {
+		in, out := &in.WorkersVSwitchID, &out.WorkersVSwitchID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
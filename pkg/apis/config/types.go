@@ -34,6 +34,12 @@ type ControllerConfiguration struct {
 	HealthCheckConfig *apisconfigv1alpha1.HealthCheckConfig
 	// CSI is the config for CSI plugin components
 	CSI *CSI
+	// DefaultResourceGroupID is the ID of the resource group the Alicloud resources of shoots are placed in if their
+	// infrastructure config does not specify one. It only applies to infrastructures created after it has been set.
+	DefaultResourceGroupID *string
+	// InfrastructureDriftCheck is the configuration of the drift check for flow-managed infrastructures. The drift check
+	// is disabled if it is not set.
+	InfrastructureDriftCheck *InfrastructureDriftCheck
 }
 
 // Service is a load balancer service configuration.
@@ -64,6 +70,12 @@ type ETCDBackup struct {
 	Schedule *string
 }
 
+// InfrastructureDriftCheck is the configuration of the drift check for flow-managed infrastructures.
+type InfrastructureDriftCheck struct {
+	// SyncPeriod is the period in which the infrastructures are checked for drift.
+	SyncPeriod metav1.Duration
+}
+
 // CSI is csi components configuration.
 type CSI struct {
 	// EnableADController enables disks to be attached/detached from csi-attacher
@@ -38,6 +38,14 @@ type ControllerConfiguration struct {
 	// CSI is the config for CSI plugin components
 	// +optional
 	CSI *CSI `json:"csi,omitempty"`
+	// DefaultResourceGroupID is the ID of the resource group the Alicloud resources of shoots are placed in if their
+	// infrastructure config does not specify one. It only applies to infrastructures created after it has been set.
+	// +optional
+	DefaultResourceGroupID *string `json:"defaultResourceGroupID,omitempty"`
+	// InfrastructureDriftCheck is the configuration of the drift check for flow-managed infrastructures. The drift check
+	// is disabled if it is not set.
+	// +optional
+	InfrastructureDriftCheck *InfrastructureDriftCheck `json:"infrastructureDriftCheck,omitempty"`
 }
 
 // Service is a load balancer service configuration.
@@ -71,6 +79,12 @@ type ETCDBackup struct {
 	Schedule *string `json:"schedule,omitempty"`
 }
 
+// InfrastructureDriftCheck is the configuration of the drift check for flow-managed infrastructures.
+type InfrastructureDriftCheck struct {
+	// SyncPeriod is the period in which the infrastructures are checked for drift.
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+}
+
 // CSI is csi components configuration.
 type CSI struct {
 	// EnableADController enables disks to be attached/detached from csi-provisioner
@@ -78,6 +78,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InfrastructureDriftCheck)(nil), (*config.InfrastructureDriftCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureDriftCheck_To_config_InfrastructureDriftCheck(a.(*InfrastructureDriftCheck), b.(*config.InfrastructureDriftCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.InfrastructureDriftCheck)(nil), (*InfrastructureDriftCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_InfrastructureDriftCheck_To_v1alpha1_InfrastructureDriftCheck(a.(*config.InfrastructureDriftCheck), b.(*InfrastructureDriftCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Service)(nil), (*config.Service)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Service_To_config_Service(a.(*Service), b.(*config.Service), scope)
 	}); err != nil {
@@ -123,6 +133,8 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.CSI = (*config.CSI)(unsafe.Pointer(in.CSI))
+	out.DefaultResourceGroupID = (*string)(unsafe.Pointer(in.DefaultResourceGroupID))
+	out.InfrastructureDriftCheck = (*config.InfrastructureDriftCheck)(unsafe.Pointer(in.InfrastructureDriftCheck))
 	return nil
 }
 
@@ -143,6 +155,8 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.CSI = (*CSI)(unsafe.Pointer(in.CSI))
+	out.DefaultResourceGroupID = (*string)(unsafe.Pointer(in.DefaultResourceGroupID))
+	out.InfrastructureDriftCheck = (*InfrastructureDriftCheck)(unsafe.Pointer(in.InfrastructureDriftCheck))
 	return nil
 }
 
@@ -223,6 +237,26 @@ func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage,
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
 
+func autoConvert_v1alpha1_InfrastructureDriftCheck_To_config_InfrastructureDriftCheck(in *InfrastructureDriftCheck, out *config.InfrastructureDriftCheck, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureDriftCheck_To_config_InfrastructureDriftCheck is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureDriftCheck_To_config_InfrastructureDriftCheck(in *InfrastructureDriftCheck, out *config.InfrastructureDriftCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureDriftCheck_To_config_InfrastructureDriftCheck(in, out, s)
+}
+
+func autoConvert_config_InfrastructureDriftCheck_To_v1alpha1_InfrastructureDriftCheck(in *config.InfrastructureDriftCheck, out *InfrastructureDriftCheck, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_config_InfrastructureDriftCheck_To_v1alpha1_InfrastructureDriftCheck is an autogenerated conversion function.
+func Convert_config_InfrastructureDriftCheck_To_v1alpha1_InfrastructureDriftCheck(in *config.InfrastructureDriftCheck, out *InfrastructureDriftCheck, s conversion.Scope) error {
+	return autoConvert_config_InfrastructureDriftCheck_To_v1alpha1_InfrastructureDriftCheck(in, out, s)
+}
+
 func autoConvert_v1alpha1_Service_To_config_Service(in *Service, out *config.Service, s conversion.Scope) error {
 	out.BackendLoadBalancerSpec = in.BackendLoadBalancerSpec
 	return nil
@@ -68,6 +68,16 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(CSI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultResourceGroupID != nil {
+		in, out := &in.DefaultResourceGroupID, &out.DefaultResourceGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InfrastructureDriftCheck != nil {
+		in, out := &in.InfrastructureDriftCheck, &out.InfrastructureDriftCheck
+		*out = new(InfrastructureDriftCheck)
+		**out = **in
+	}
 	return
 }
 
@@ -154,6 +164,23 @@ func (in *ETCDStorage) DeepCopy() *ETCDStorage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureDriftCheck) DeepCopyInto(out *InfrastructureDriftCheck) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureDriftCheck.
+func (in *InfrastructureDriftCheck) DeepCopy() *InfrastructureDriftCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureDriftCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
@@ -10,6 +10,7 @@ import (
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/config/loader"
@@ -79,6 +80,13 @@ func (c *Config) ApplyToBeSharedImageIDs(toBeSharedImageIDs *[]string) {
 	}
 }
 
+// ApplyDefaultResourceGroupID sets the given default resource group ID to that of this Config.
+func (c *Config) ApplyDefaultResourceGroupID(resourceGroupID **string) {
+	if c.Config.DefaultResourceGroupID != nil {
+		*resourceGroupID = c.Config.DefaultResourceGroupID
+	}
+}
+
 // ApplyETCDStorage sets the given etcd storage configuration to that of this Config.
 func (c *Config) ApplyETCDStorage(etcdStorage *config.ETCDStorage) {
 	*etcdStorage = c.Config.ETCD.Storage
@@ -108,6 +116,14 @@ func (c *Config) ApplyHealthCheckConfig(config *apisconfigv1alpha1.HealthCheckCo
 	}
 }
 
+// ApplyInfrastructureDriftCheckSyncPeriod sets the given sync period to that of the infrastructure drift check of this
+// Config. It is left untouched if the drift check is not configured.
+func (c *Config) ApplyInfrastructureDriftCheckSyncPeriod(syncPeriod **metav1.Duration) {
+	if c.Config.InfrastructureDriftCheck != nil {
+		*syncPeriod = &c.Config.InfrastructureDriftCheck.SyncPeriod
+	}
+}
+
 // ApplyCSI applies the ApplyCSI to the config
 func (c *Config) ApplyCSI(csi *config.CSI) {
 	if c.Config.CSI != nil {
@@ -22,7 +22,7 @@ func (a *actuator) Delete(ctx context.Context, _ logr.Logger, bb *extensionsv1al
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	ossClient, err := a.aliClientFactory.NewOSSClient(alicloudclient.ComputeStorageEndpoint(bb.Spec.Region), authConfig.AccessKeyID, authConfig.AccessKeySecret)
+	ossClient, err := a.aliClientFactory.NewOSSClient(alicloudclient.ComputeStorageEndpoint(bb.Spec.Region), authConfig)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -40,7 +40,7 @@ func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extens
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	ossClient, err := a.aliClientFactory.NewOSSClient(alicloudclient.ComputeStorageEndpoint(bb.Spec.Region), authConfig.AccessKeyID, authConfig.AccessKeySecret)
+	ossClient, err := a.aliClientFactory.NewOSSClient(alicloudclient.ComputeStorageEndpoint(bb.Spec.Region), authConfig)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -31,7 +31,7 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 		return err
 	}
 
-	aliCloudECSClient, err := a.newClientFactory.NewECSClient(opt.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	aliCloudECSClient, err := a.newClientFactory.NewECSClient(opt.Region, credentials)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
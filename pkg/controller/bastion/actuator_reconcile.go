@@ -57,7 +57,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return err
 	}
 
-	aliCloudECSClient, err := a.newClientFactory.NewECSClient(opt.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	aliCloudECSClient, err := a.newClientFactory.NewECSClient(opt.Region, credentials)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
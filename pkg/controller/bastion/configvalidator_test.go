@@ -141,8 +141,8 @@ var _ = Describe("ConfigValidator", func() {
 			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: cluster.ObjectMeta.Name, Name: v1beta1constants.SecretNameCloudProvider}, gomock.AssignableToTypeOf(&corev1beta1.CloudProfile{})).DoAndReturn(clientGet(cloudProfile))
 			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&corev1beta1.SecretBinding{})).DoAndReturn(clientGet(secretBinding))
 			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(clientGet(secret))
-			alicloudClientFactory.EXPECT().NewECSClient(region, accessKeyID, accessKeySecret).Return(ecsClient, nil)
-			alicloudClientFactory.EXPECT().NewVPCClient(region, accessKeyID, accessKeySecret).Return(vpcClient, nil)
+			alicloudClientFactory.EXPECT().NewECSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(ecsClient, nil)
+			alicloudClientFactory.EXPECT().NewVPCClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(vpcClient, nil)
 		})
 
 		It("should succeed if there are infrastructureStatus passed", func() {
@@ -53,16 +53,19 @@ type imageEncryptor struct {
 	sourceImageID string
 	imageName     string
 	imageVersion  string
+	kmsKeyID      string
 	rosClient     alicloudclient.ROS
 }
 
-// NewImageEncryptor creates an ImageEncrypter instance
-func NewImageEncryptor(client alicloudclient.ROS, regionID, imageName, imageVersion, sourceImageID string) ImageEncrypter {
+// NewImageEncryptor creates an ImageEncrypter instance.
+// The image is encrypted with the given KMS key, or with the default key if it is empty.
+func NewImageEncryptor(client alicloudclient.ROS, regionID, imageName, imageVersion, sourceImageID, kmsKeyID string) ImageEncrypter {
 	return &imageEncryptor{
 		regionID:      regionID,
 		sourceImageID: sourceImageID,
 		imageName:     imageName,
 		imageVersion:  imageVersion,
+		kmsKeyID:      kmsKeyID,
 		rosClient:     client,
 	}
 }
@@ -135,12 +138,19 @@ func (ie *imageEncryptor) createStack() (string, error) {
 		},
 	}
 
+	destinationImageName := fmt.Sprintf("%s-%s-%s-encrypted", ie.imageName, ie.imageVersion, ie.regionID)
+	if ie.kmsKeyID != "" {
+		destinationImageName = fmt.Sprintf("%s-%s", destinationImageName, ie.kmsKeyID)
+	}
 	parameters := []ros.CreateStackParameters{
 		{ParameterKey: "ImageId", ParameterValue: ie.sourceImageID},
 		{ParameterKey: "DestinationDescription", ParameterValue: fmt.Sprintf("copied from image %s", ie.sourceImageID)},
-		{ParameterKey: "DestinationImageName", ParameterValue: fmt.Sprintf("%s-%s-%s-encrypted", ie.imageName, ie.imageVersion, ie.regionID)},
+		{ParameterKey: "DestinationImageName", ParameterValue: destinationImageName},
 		{ParameterKey: "DestinationRegionId", ParameterValue: ie.regionID},
 	}
+	if ie.kmsKeyID != "" {
+		parameters = append(parameters, ros.CreateStackParameters{ParameterKey: "KMSKeyId", ParameterValue: ie.kmsKeyID})
+	}
 	stackRequest.Parameters = &parameters
 	stackRequest.SetScheme("HTTPS")
 	response, err := ie.rosClient.CreateStack(stackRequest)
@@ -233,11 +243,16 @@ func (ie *imageEncryptor) getEncrytpedImageIDFromStack(stackId string) (string,
 }
 
 func (ie *imageEncryptor) getStackName() string {
-	return GetEncryptImageStackName(ie.imageName, ie.imageVersion, ie.regionID)
+	return GetEncryptImageStackName(ie.imageName, ie.imageVersion, ie.regionID, ie.kmsKeyID)
 }
 
 // GetEncryptImageStackName returns the encrypt image stack name for the given image name and version.
-func GetEncryptImageStackName(imageName, imageVersion, regionID string) string {
+// Images encrypted with a customer-managed KMS key get a distinct stack per key.
+func GetEncryptImageStackName(imageName, imageVersion, regionID, kmsKeyID string) string {
 	var rosNameFormat = "encrypt_image_%s_%s_%s"
-	return strings.ReplaceAll(fmt.Sprintf(rosNameFormat, imageName, imageVersion, regionID), ".", "-")
+	stackName := fmt.Sprintf(rosNameFormat, imageName, imageVersion, regionID)
+	if kmsKeyID != "" {
+		stackName = fmt.Sprintf("%s_%s", stackName, kmsKeyID)
+	}
+	return strings.ReplaceAll(stackName, ".", "-")
 }
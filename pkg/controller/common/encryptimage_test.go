@@ -53,6 +53,7 @@ var _ = Describe("Encrypt Image tools", func() {
 				imageName,
 				imageVersion,
 				sourceImageID,
+				"",
 			).(*imageEncryptor)
 			Expect(ok).To(BeTrue())
 		})
@@ -62,7 +63,11 @@ var _ = Describe("Encrypt Image tools", func() {
 
 		Describe("#GetEncryptImageStackName", func() {
 			It("should compose correct name", func() {
-				Expect(GetEncryptImageStackName(imageName, imageVersion, regionID)).To(Equal("encrypt_image_GardenLinux_1-184-0_cn-shanghai"))
+				Expect(GetEncryptImageStackName(imageName, imageVersion, regionID, "")).To(Equal("encrypt_image_GardenLinux_1-184-0_cn-shanghai"))
+			})
+
+			It("should compose a distinct name for a customer-managed KMS key", func() {
+				Expect(GetEncryptImageStackName(imageName, imageVersion, regionID, "key-123")).To(Equal("encrypt_image_GardenLinux_1-184-0_cn-shanghai_key-123"))
 			})
 		})
 
@@ -150,6 +155,33 @@ var _ = Describe("Encrypt Image tools", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(result).To(Equal(encryptedImgID))
 			})
+			It("should create the stack with the customer-managed KMS key", func() {
+				listResponse := ros.ListStacksResponse{}
+				createResponse := ros.CreateStackResponse{
+					StackId: stackID,
+				}
+				getResponse := ros.GetStackResponse{
+					Status:  "CREATE_COMPLETE",
+					Outputs: []map[string]string{{"OutputKey": "ImageId", "OutputValue": encryptedImgID}},
+				}
+				shootROSClient.EXPECT().ListStacks(gomock.Any()).DoAndReturn(func(request *ros.ListStacksRequest) (*ros.ListStacksResponse, error) {
+					Expect(*request.StackName).To(Equal([]string{"encrypt_image_GardenLinux_1-184-0_cn-shanghai_key-123"}))
+					return &listResponse, nil
+				})
+				shootROSClient.EXPECT().CreateStack(gomock.Any()).DoAndReturn(func(request *ros.CreateStackRequest) (*ros.CreateStackResponse, error) {
+					Expect(*request.Parameters).To(ContainElements(
+						ros.CreateStackParameters{ParameterKey: "DestinationImageName", ParameterValue: "GardenLinux-1.184.0-cn-shanghai-encrypted-key-123"},
+						ros.CreateStackParameters{ParameterKey: "KMSKeyId", ParameterValue: "key-123"},
+					))
+					return &createResponse, nil
+				})
+				shootROSClient.EXPECT().GetStack(gomock.Any()).Return(&getResponse, nil)
+				defaultEncryptor.rosClient = shootROSClient
+				defaultEncryptor.kmsKeyID = "key-123"
+				result, err := defaultEncryptor.TryToGetEncryptedImageID(ctx, timeout, interval)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(encryptedImgID))
+			})
 			It("should fail when no stack exists and a stack is created with failure", func() {
 				listResponse := ros.ListStacksResponse{}
 				createResponse := ros.CreateStackResponse{
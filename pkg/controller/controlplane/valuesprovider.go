@@ -9,7 +9,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -272,6 +274,39 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	return vp.getControlPlaneShootChartValues(cpConfig, credentials)
 }
 
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	_ context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	_ *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	cpConfig, err := vp.decodeControlPlaneConfig(cp)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	if kmsKeyID := helper.DefaultKMSKeyID(cpConfig); kmsKeyID != "" {
+		values["kmsKeyID"] = kmsKeyID
+	}
+
+	if cp.Spec.InfrastructureProviderStatus != nil {
+		infraStatus := &apisalicloud.InfrastructureStatus{}
+		if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+			return nil, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
+		}
+		// the disks of persistent volumes are tagged by the CSI plugin with the tags given in the format `key:value,...`
+		var diskTags []string
+		for _, key := range slices.Sorted(maps.Keys(infraStatus.Tags)) {
+			diskTags = append(diskTags, key+":"+infraStatus.Tags[key])
+		}
+		if len(diskTags) > 0 {
+			values["diskTags"] = strings.Join(diskTags, ",")
+		}
+	}
+	return values, nil
+}
+
 // cloudConfig wraps the settings for the Alicloud provider.
 // See https://github.com/kubernetes/cloud-provider-alibaba-cloud/blob/master/cloud-controller-manager/alicloud.go
 type cloudConfig struct {
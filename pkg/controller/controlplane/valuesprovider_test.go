@@ -303,6 +303,42 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(controlPlaneShootChartValues))
 		})
 	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return empty storage classes chart values", func() {
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(BeEmpty())
+		})
+
+		It("should return the default KMS key of the shoot", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					DiskEncryption: &apisalicloud.DiskEncryption{
+						KMSKeyID: ptr.To("key-123"),
+					},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"kmsKeyID": "key-123"}))
+		})
+
+		It("should return the user-defined tags for the disks", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.InfrastructureStatus{
+					Tags: map[string]string{"Team": "infra", "CostCenter": "4711"},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"diskTags": "CostCenter:4711,Team:infra"}))
+		})
+	})
 })
 
 func encode(obj runtime.Object) []byte {
@@ -141,7 +141,7 @@ var _ = Describe("Actuator", func() {
 	Describe("#Reconcile", func() {
 		It("should reconcile the DNSRecord if a zone is not specified", func() {
 			expectGetDNSRecordSecret()
-			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, accessKeyID, accessKeySecret).Return(dnsClient, nil)
+			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(dnsClient, nil)
 			dnsClient.EXPECT().GetDomainNames(ctx).Return(domainNames, nil)
 			dnsClient.EXPECT().CreateOrUpdateDomainRecords(ctx, compositeDomainName, dnsName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
 			dnsClient.EXPECT().DeleteDomainRecords(ctx, compositeDomainName, "comment-"+dnsName, "TXT").Return(nil)
@@ -155,7 +155,7 @@ var _ = Describe("Actuator", func() {
 			dns.Spec.Zone = ptr.To(domainName)
 
 			expectGetDNSRecordSecret()
-			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, accessKeyID, accessKeySecret).Return(dnsClient, nil)
+			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(dnsClient, nil)
 			dnsClient.EXPECT().CreateOrUpdateDomainRecords(ctx, domainName, dnsName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
 			dnsClient.EXPECT().DeleteDomainRecords(ctx, domainName, "comment-"+dnsName, "TXT").Return(nil)
 			expectUpdateDNSRecordStatus(domainName)
@@ -168,7 +168,7 @@ var _ = Describe("Actuator", func() {
 			dns.Spec.Zone = ptr.To(domainId)
 
 			expectGetDNSRecordSecret()
-			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, accessKeyID, accessKeySecret).Return(dnsClient, nil)
+			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(dnsClient, nil)
 			dnsClient.EXPECT().GetDomainName(ctx, domainId).Return(compositeDomainName, nil)
 			dnsClient.EXPECT().CreateOrUpdateDomainRecords(ctx, compositeDomainName, dnsName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
 			dnsClient.EXPECT().DeleteDomainRecords(ctx, compositeDomainName, "comment-"+dnsName, "TXT").Return(nil)
@@ -183,7 +183,7 @@ var _ = Describe("Actuator", func() {
 			dns.Status.Zone = ptr.To("example.com:2")
 
 			expectGetDNSRecordSecret()
-			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, accessKeyID, accessKeySecret).Return(dnsClient, nil)
+			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(dnsClient, nil)
 			dnsClient.EXPECT().GetDomainName(ctx, domainId).Return(compositeDomainName, nil)
 			dnsClient.EXPECT().CreateOrUpdateDomainRecords(ctx, compositeDomainName, dnsName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
 			dnsClient.EXPECT().DeleteDomainRecords(ctx, compositeDomainName, "comment-"+dnsName, "TXT").Return(nil)
@@ -199,7 +199,7 @@ var _ = Describe("Actuator", func() {
 			dns.Status.Zone = ptr.To(compositeDomainName)
 
 			expectGetDNSRecordSecret()
-			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, accessKeyID, accessKeySecret).Return(dnsClient, nil)
+			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(dnsClient, nil)
 			dnsClient.EXPECT().DeleteDomainRecords(ctx, compositeDomainName, dnsName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil)
 
 			err := a.Delete(ctx, logger, dns, nil)
@@ -210,7 +210,7 @@ var _ = Describe("Actuator", func() {
 			dns.Status.Zone = ptr.To(domainName)
 
 			expectGetDNSRecordSecret()
-			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, accessKeyID, accessKeySecret).Return(dnsClient, nil)
+			alicloudClientFactory.EXPECT().NewDNSClient(alicloud.DefaultDNSRegion, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(dnsClient, nil)
 			dnsClient.EXPECT().DeleteDomainRecords(ctx, domainName, dnsName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil)
 
 			err := a.Delete(ctx, logger, dns, nil)
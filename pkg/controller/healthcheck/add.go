@@ -23,6 +23,7 @@ import (
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure"
 )
 
 var (
@@ -37,8 +38,20 @@ var (
 			},
 		},
 	}
+	// DefaultInfrastructureDriftCheckOptions are the default options for the drift check of flow-managed
+	// infrastructures. The drift check is disabled as long as no sync period is set.
+	DefaultInfrastructureDriftCheckOptions = InfrastructureDriftCheckOptions{}
 )
 
+// InfrastructureDriftCheckOptions are the options for the drift check of flow-managed infrastructures.
+type InfrastructureDriftCheckOptions struct {
+	// SyncPeriod is the period in which the infrastructures are checked for drift. As each check reads all resources of
+	// an infrastructure from the Alicloud API, it is usually much longer than the sync period of the other health checks.
+	SyncPeriod *metav1.Duration
+	// DefaultResourceGroupID is the ID of the resource group of infrastructures which do not specify one.
+	DefaultResourceGroupID *string
+}
+
 // RegisterHealthChecks registers health checks for each extension resource
 // HealthChecks are grouped by extension (e.g worker), extension.type (e.g alicloud) and  Health Check Type (e.g SystemComponentsHealthy)
 func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthcheck.DefaultAddArgs) error {
@@ -88,7 +101,34 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 	)
 }
 
+// RegisterInfrastructureDriftCheck registers the drift check for flow-managed infrastructures if a sync period is
+// configured. It reports the drift as condition of type InfrastructureInSync.
+func RegisterInfrastructureDriftCheck(_ context.Context, mgr manager.Manager, opts healthcheck.DefaultAddArgs, driftCheckOpts InfrastructureDriftCheckOptions) error {
+	if driftCheckOpts.SyncPeriod == nil {
+		return nil
+	}
+	opts.HealthCheckConfig.SyncPeriod = *driftCheckOpts.SyncPeriod
+
+	return healthcheck.DefaultRegistration(
+		alicloud.Type,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.InfrastructureResource),
+		func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} },
+		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Infrastructure{} },
+		mgr,
+		opts,
+		nil,
+		[]healthcheck.ConditionTypeToHealthCheck{{
+			ConditionType: infrastructure.ConditionTypeInfrastructureInSync,
+			HealthCheck:   infrastructure.NewDriftHealthCheck(driftCheckOpts.DefaultResourceGroupID),
+		}},
+		sets.Set[gardencorev1beta1.ConditionType]{},
+	)
+}
+
 // AddToManager adds a controller with the default Options.
 func AddToManager(ctx context.Context, mgr manager.Manager) error {
-	return RegisterHealthChecks(ctx, mgr, DefaultAddOptions)
+	if err := RegisterHealthChecks(ctx, mgr, DefaultAddOptions); err != nil {
+		return err
+	}
+	return RegisterInfrastructureDriftCheck(ctx, mgr, DefaultAddOptions, DefaultInfrastructureDriftCheckOptions)
 }
@@ -7,6 +7,7 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	extensioncontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -39,7 +40,7 @@ var StatusTypeMeta = func() metav1.TypeMeta {
 }()
 
 // NewActuator instantiates an actuator with the default dependencies.
-func NewActuator(mgr manager.Manager, machineImageOwnerSecretRef *corev1.SecretReference, toBeSharedImageIDs []string, disableProjectedTokenMount bool) (infrastructure.Actuator, error) {
+func NewActuator(mgr manager.Manager, machineImageOwnerSecretRef *corev1.SecretReference, toBeSharedImageIDs []string, defaultResourceGroupID *string, disableProjectedTokenMount bool) (infrastructure.Actuator, error) {
 	return NewActuatorWithDeps(
 		mgr,
 		alicloudclient.NewClientFactory(),
@@ -47,6 +48,7 @@ func NewActuator(mgr manager.Manager, machineImageOwnerSecretRef *corev1.SecretR
 		DefaultTerraformOps(),
 		machineImageOwnerSecretRef,
 		toBeSharedImageIDs,
+		defaultResourceGroupID,
 		disableProjectedTokenMount,
 	)
 }
@@ -59,6 +61,7 @@ func NewActuatorWithDeps(
 	terraformChartOps TerraformChartOps,
 	machineImageOwnerSecretRef *corev1.SecretReference,
 	toBeSharedImageIDs []string,
+	defaultResourceGroupID *string,
 	disableProjectedTokenMount bool,
 ) (infrastructure.Actuator, error) {
 	a := &actuator{
@@ -72,6 +75,7 @@ func NewActuatorWithDeps(
 		terraformChartOps:          terraformChartOps,
 		machineImageOwnerSecretRef: machineImageOwnerSecretRef,
 		toBeSharedImageIDs:         toBeSharedImageIDs,
+		defaultResourceGroupID:     defaultResourceGroupID,
 		disableProjectedTokenMount: disableProjectedTokenMount,
 	}
 
@@ -88,7 +92,7 @@ func NewActuatorWithDeps(
 		if err != nil {
 			return nil, err
 		}
-		a.alicloudECSClient, err = a.newClientFactory.NewECSClient("", seedCloudProviderCredentials.AccessKeyID, seedCloudProviderCredentials.AccessKeySecret)
+		a.alicloudECSClient, err = a.newClientFactory.NewECSClient("", seedCloudProviderCredentials)
 		return nil, err
 	}
 
@@ -108,6 +112,7 @@ type actuator struct {
 
 	machineImageOwnerSecretRef *corev1.SecretReference
 	toBeSharedImageIDs         []string
+	defaultResourceGroupID     *string
 	disableProjectedTokenMount bool
 }
 
@@ -160,7 +165,7 @@ func (a *actuator) ensureOldSSHKeyDetached(ctx context.Context, log logr.Logger,
 		return err
 	}
 
-	shootAlicloudECSClient, err := a.newClientFactory.NewECSClient(infra.Spec.Region, shootCloudProviderCredentials.AccessKeyID, shootCloudProviderCredentials.AccessKeySecret)
+	shootAlicloudECSClient, err := a.newClientFactory.NewECSClient(infra.Spec.Region, shootCloudProviderCredentials)
 	if err != nil {
 		return err
 	}
@@ -181,41 +186,97 @@ func (a *actuator) cleanupServiceLoadBalancers(ctx context.Context, infra *exten
 		return err
 	}
 
-	shootAlicloudSLBClient, err := a.newClientFactory.NewSLBClient(infra.Spec.Region, shootCloudProviderCredentials.AccessKeyID, shootCloudProviderCredentials.AccessKeySecret)
+	shootAlicloudSLBClient, err := a.newClientFactory.NewSLBClient(infra.Spec.Region, shootCloudProviderCredentials)
 	if err != nil {
 		return err
 	}
 
-	loadBalancerIDs, err := shootAlicloudSLBClient.GetLoadBalancerIDs(ctx, infra.Spec.Region)
+	loadBalancerIDs, err := findServiceLoadBalancers(ctx, shootAlicloudSLBClient, infra)
 	if err != nil {
 		return err
 	}
+	for _, loadBalancerID := range loadBalancerIDs {
+		err = shootAlicloudSLBClient.SetLoadBalancerDeleteProtection(ctx, infra.Spec.Region, loadBalancerID, false)
+		if err != nil {
+			return err
+		}
+		err = shootAlicloudSLBClient.DeleteLoadBalancer(ctx, infra.Spec.Region, loadBalancerID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureServiceLoadBalancerTags adds the user-defined tags to the load balancers created by the Alicloud CCM of the
+// shoot and removes the previously added tags which are not desired anymore.
+func (a *actuator) ensureServiceLoadBalancerTags(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, desired, previous map[string]string) error {
+	var obsolete []string
+	for key := range previous {
+		if _, ok := desired[key]; !ok {
+			obsolete = append(obsolete, key)
+		}
+	}
+	if len(desired) == 0 && len(obsolete) == 0 {
+		return nil
+	}
+	slices.Sort(obsolete)
+
+	_, shootCloudProviderCredentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	if err != nil {
+		return err
+	}
+
+	shootAlicloudSLBClient, err := a.newClientFactory.NewSLBClient(infra.Spec.Region, shootCloudProviderCredentials)
+	if err != nil {
+		return err
+	}
+
+	loadBalancerIDs, err := findServiceLoadBalancers(ctx, shootAlicloudSLBClient, infra)
+	if err != nil {
+		return err
+	}
+	for _, loadBalancerID := range loadBalancerIDs {
+		if len(obsolete) > 0 {
+			if err := shootAlicloudSLBClient.UntagLoadBalancer(ctx, infra.Spec.Region, loadBalancerID, obsolete); err != nil {
+				return fmt.Errorf("failed to remove tags from load balancer %s: %w", loadBalancerID, err)
+			}
+		}
+		if len(desired) > 0 {
+			if err := shootAlicloudSLBClient.TagLoadBalancer(ctx, infra.Spec.Region, loadBalancerID, desired); err != nil {
+				return fmt.Errorf("failed to tag load balancer %s: %w", loadBalancerID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// findServiceLoadBalancers returns the IDs of the load balancers created by the Alicloud CCM of the shoot.
+func findServiceLoadBalancers(ctx context.Context, slbClient alicloudclient.SLB, infra *extensionsv1alpha1.Infrastructure) ([]string, error) {
+	loadBalancerIDs, err := slbClient.GetLoadBalancerIDs(ctx, infra.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
 	// SLBs created by Alicloud CCM do not have association with VPCs, so can only be iterated to check
 	// if one SLB is related to this specific Shoot.
 	for _, loadBalancerID := range loadBalancerIDs {
-		vServerGroupName, err := shootAlicloudSLBClient.GetFirstVServerGroupName(ctx, infra.Spec.Region, loadBalancerID)
+		vServerGroupName, err := slbClient.GetFirstVServerGroupName(ctx, infra.Spec.Region, loadBalancerID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if vServerGroupName == "" {
 			continue
 		}
 
 		// Get the last slice of VServerGroupName string divided by '/' which is the clusterid.
-		slices := strings.Split(vServerGroupName, "/")
-		clusterID := slices[len(slices)-1]
-		if clusterID == infra.Namespace {
-			err = shootAlicloudSLBClient.SetLoadBalancerDeleteProtection(ctx, infra.Spec.Region, loadBalancerID, false)
-			if err != nil {
-				return err
-			}
-			err = shootAlicloudSLBClient.DeleteLoadBalancer(ctx, infra.Spec.Region, loadBalancerID)
-			if err != nil {
-				return err
-			}
+		parts := strings.Split(vServerGroupName, "/")
+		if clusterID := parts[len(parts)-1]; clusterID == infra.Namespace {
+			result = append(result, loadBalancerID)
 		}
 	}
-	return nil
+	return result, nil
 }
 
 func (a *actuator) cleanupTerraformerResources(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) error {
@@ -234,7 +295,7 @@ func (a *actuator) cleanupTerraformerResources(ctx context.Context, log logr.Log
 
 // ensureServiceLinkedRole is to check if service linked role exists, if not create one.
 func (a *actuator) ensureServiceLinkedRole(_ context.Context, infra *extensionsv1alpha1.Infrastructure, credentials *alicloud.Credentials) error {
-	client, err := a.newClientFactory.NewRAMClient(infra.Spec.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	client, err := a.newClientFactory.NewRAMClient(infra.Spec.Region, credentials)
 	if err != nil {
 		return err
 	}
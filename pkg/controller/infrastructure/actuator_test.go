@@ -144,6 +144,7 @@ var _ = Describe("Actuator", func() {
 					terraformChartOps,
 					nil,
 					nil,
+					nil,
 					false,
 				)
 				Expect(err).NotTo(HaveOccurred())
@@ -269,7 +270,7 @@ var _ = Describe("Actuator", func() {
 							},
 						}),
 
-					alicloudClientFactory.EXPECT().NewRAMClient(region, accessKeyID, accessKeySecret).Return(shootRAMClient, nil),
+					alicloudClientFactory.EXPECT().NewRAMClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootRAMClient, nil),
 					shootRAMClient.EXPECT().GetServiceLinkedRole(serviceLinkedRoleForNatGw).Return(nil, nil),
 					shootRAMClient.EXPECT().CreateServiceLinkedRole(region, serviceForNatGw).Return(nil),
 
@@ -285,7 +286,7 @@ var _ = Describe("Actuator", func() {
 
 					terraformer.EXPECT().SetEnvVars(gomock.Any()).Return(terraformer),
 
-					alicloudClientFactory.EXPECT().NewVPCClient(region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+					alicloudClientFactory.EXPECT().NewVPCClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(vpcClient, nil),
 
 					terraformer.EXPECT().GetStateOutputVariables(ctx, TerraformerOutputKeyVPCID).
 						Return(map[string]string{
@@ -311,9 +312,9 @@ var _ = Describe("Actuator", func() {
 								alicloud.CredentialsFile: []byte(credentialsFile),
 							},
 						}),
-					alicloudClientFactory.EXPECT().NewECSClient(region, accessKeyID, accessKeySecret).Return(shootECSClient, nil),
-					alicloudClientFactory.EXPECT().NewROSClient(region, accessKeyID, accessKeySecret).Return(shootROSClient, nil),
-					alicloudClientFactory.EXPECT().NewSTSClient(region, accessKeyID, accessKeySecret).Return(shootSTSClient, nil),
+					alicloudClientFactory.EXPECT().NewECSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootECSClient, nil),
+					alicloudClientFactory.EXPECT().NewROSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootROSClient, nil),
+					alicloudClientFactory.EXPECT().NewSTSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootSTSClient, nil),
 					shootSTSClient.EXPECT().GetAccountIDFromCallerIdentity(ctx).Return("", nil),
 
 					terraformer.EXPECT().GetStateOutputVariables(ctx, TerraformerOutputKeyVPCID, TerraformerOutputKeyVPCCIDR, TerraformerOutputKeySecurityGroupID).
@@ -365,7 +366,7 @@ var _ = Describe("Actuator", func() {
 							},
 						}),
 
-					alicloudClientFactory.EXPECT().NewRAMClient(region, accessKeyID, accessKeySecret).Return(shootRAMClient, nil),
+					alicloudClientFactory.EXPECT().NewRAMClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootRAMClient, nil),
 					shootRAMClient.EXPECT().GetServiceLinkedRole(serviceLinkedRoleForNatGw).Return(nil, nil),
 					shootRAMClient.EXPECT().CreateServiceLinkedRole(region, serviceForNatGw).Return(nil),
 
@@ -381,7 +382,7 @@ var _ = Describe("Actuator", func() {
 
 					terraformer.EXPECT().SetEnvVars(gomock.Any()).Return(terraformer),
 
-					alicloudClientFactory.EXPECT().NewVPCClient(region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+					alicloudClientFactory.EXPECT().NewVPCClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(vpcClient, nil),
 
 					terraformer.EXPECT().GetStateOutputVariables(ctx, TerraformerOutputKeyVPCID).
 						Return(map[string]string{
@@ -406,9 +407,9 @@ var _ = Describe("Actuator", func() {
 								alicloud.CredentialsFile: []byte(credentialsFile),
 							},
 						}),
-					alicloudClientFactory.EXPECT().NewECSClient(region, accessKeyID, accessKeySecret).Return(shootECSClient, nil),
-					alicloudClientFactory.EXPECT().NewROSClient(region, accessKeyID, accessKeySecret).Return(shootROSClient, nil),
-					alicloudClientFactory.EXPECT().NewSTSClient(region, accessKeyID, accessKeySecret).Return(shootSTSClient, nil),
+					alicloudClientFactory.EXPECT().NewECSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootECSClient, nil),
+					alicloudClientFactory.EXPECT().NewROSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootROSClient, nil),
+					alicloudClientFactory.EXPECT().NewSTSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret, CredentialsFile: credentialsFile}).Return(shootSTSClient, nil),
 					shootSTSClient.EXPECT().GetAccountIDFromCallerIdentity(ctx).Return("", nil),
 
 					terraformer.EXPECT().GetStateOutputVariables(ctx, TerraformerOutputKeyVPCID, TerraformerOutputKeyVPCCIDR, TerraformerOutputKeySecurityGroupID).
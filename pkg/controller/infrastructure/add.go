@@ -34,6 +34,9 @@ type AddOptions struct {
 	MachineImageOwnerSecretRef *corev1.SecretReference
 	// ToBeSharedImageIDs specifies custom image IDs which need to be shared by shoots
 	ToBeSharedImageIDs []string
+	// DefaultResourceGroupID is the ID of the resource group the resources of shoots are placed in if their
+	// infrastructure config does not specify one. It only applies to infrastructures created after it has been set.
+	DefaultResourceGroupID *string
 	// DisableProjectedTokenMount specifies whether the projected token mount shall be disabled for the terraformer.
 	// Used for testing only.
 	DisableProjectedTokenMount bool
@@ -44,7 +47,7 @@ type AddOptions struct {
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, options AddOptions) error {
-	actuator, err := NewActuator(mgr, options.MachineImageOwnerSecretRef, options.ToBeSharedImageIDs, options.DisableProjectedTokenMount)
+	actuator, err := NewActuator(mgr, options.MachineImageOwnerSecretRef, options.ToBeSharedImageIDs, options.DefaultResourceGroupID, options.DisableProjectedTokenMount)
 	if err != nil {
 		return err
 	}
@@ -13,10 +13,12 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 )
@@ -54,7 +56,7 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not get Alicloud credentials: %+v", err)))
 		return allErrs
 	}
-	actor, err := c.factory.NewActor(credentials.AccessKeyID, credentials.AccessKeySecret, infra.Spec.Region)
+	actor, err := c.factory.NewActor(credentials, infra.Spec.Region)
 	if err != nil {
 		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("create aliclient actor failed: %+v", err)))
 		return allErrs
@@ -74,11 +76,21 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 		allErrs = append(allErrs, c.validateEnhancedNatGatewayZone(ctx, actor, config.Networks.Zones[0].Name, infra.Spec.Region, field.NewPath("networks", "zones[0]", "name"))...)
 	}
 
-	for _, zone := range config.Networks.Zones {
+	for i, zone := range config.Networks.Zones {
+		if zone.WorkersVSwitchID != nil && config.Networks.VPC.ID != nil {
+			logger.Info("Validating infrastructure networks.zones[].workersVSwitchID")
+			allErrs = append(allErrs, c.validateWorkersVSwitch(ctx, actor, zone, *config.Networks.VPC.ID, config.Networks.IPv6 != nil, field.NewPath("networks", "zones").Index(i).Child("workersVSwitchID"))...)
+		}
 		if zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil {
 			logger.Info("Validating infrastructure networks.zones[].natGatewayid.eipAllocationID")
 			allErrs = append(allErrs, c.validateEIP(ctx, actor, *zone.NatGateway.EIPAllocationID, field.NewPath("networks", "zones[]", "natGateway", "eipAllocationID"))...)
 		}
+		if zone.NatGateway != nil && len(zone.NatGateway.EIPAllocationIDs) > 0 {
+			logger.Info("Validating infrastructure networks.zones[].natGatewayid.eipAllocationIDs")
+			for _, eipID := range zone.NatGateway.EIPAllocationIDs {
+				allErrs = append(allErrs, c.validateEIP(ctx, actor, eipID, field.NewPath("networks", "zones[]", "natGateway", "eipAllocationIDs"))...)
+			}
+		}
 	}
 
 	return allErrs
@@ -102,6 +114,29 @@ func (c *configValidator) validateVPC(ctx context.Context, actor aliclient.Actor
 	return allErrs
 }
 
+func (c *configValidator) validateWorkersVSwitch(ctx context.Context, actor aliclient.Actor, zone aliapi.Zone, vpcID string, ipv6 bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	vswitchID := *zone.WorkersVSwitchID
+	vsw, err := actor.GetVSwitch(ctx, vswitchID)
+	if err != nil || vsw == nil {
+		allErrs = append(allErrs, field.NotFound(fldPath, vswitchID))
+		return allErrs
+	}
+	if ptr.Deref(vsw.VpcId, "") != vpcID {
+		allErrs = append(allErrs, field.Invalid(fldPath, vswitchID, fmt.Sprintf("vswitch does not belong to vpc %s", vpcID)))
+	}
+	if vsw.ZoneId != zone.Name {
+		allErrs = append(allErrs, field.Invalid(fldPath, vswitchID, fmt.Sprintf("vswitch does not belong to zone %s", zone.Name)))
+	}
+	if vsw.CidrBlock != zone.Workers {
+		allErrs = append(allErrs, field.Invalid(fldPath, vswitchID, fmt.Sprintf("vswitch cidr %s does not match workers cidr %s", vsw.CidrBlock, zone.Workers)))
+	}
+	if ipv6 && vsw.IPv6CidrBlock == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, vswitchID, "vswitch has no IPv6 cidr"))
+	}
+	return allErrs
+}
+
 func (c *configValidator) validateEIP(ctx context.Context, actor aliclient.Actor, eipId string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	eip, err := actor.GetEIP(ctx, eipId)
@@ -117,7 +117,7 @@ var _ = Describe("ConfigValidator", func() {
 				return nil
 			},
 		)
-		actorFactor.EXPECT().NewActor(accessKeyID, secretAccessKey, region).Return(actor, nil)
+		actorFactor.EXPECT().NewActor(&alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: secretAccessKey, CredentialsFile: credentialsFile}, region).Return(actor, nil)
 
 	})
 
@@ -229,6 +229,75 @@ var _ = Describe("ConfigValidator", func() {
 		Expect(errorList).To(BeEmpty())
 	})
 
+	It("should forbid existing vswitches which do not match the zone", func() {
+		infra.Spec.ProviderConfig.Raw = encode(&apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{
+					ID: ptr.To(vpcID),
+				},
+				Zones: []apisalicloud.Zone{
+					{
+						Name:             "zone_1",
+						Workers:          "10.250.1.0/24",
+						WorkersVSwitchID: ptr.To("vsw-1"),
+					},
+					{
+						Name:             "zone_2",
+						Workers:          "10.250.2.0/24",
+						WorkersVSwitchID: ptr.To("vsw-2"),
+					},
+				},
+			},
+		})
+		actor.EXPECT().GetVpc(ctx, vpcID).Return(&aliclient.VPC{}, nil)
+		actor.EXPECT().FindNatGatewayByVPC(ctx, vpcID).Return(&aliclient.NatGateway{}, nil)
+		actor.EXPECT().GetVSwitch(ctx, "vsw-1").Return(&aliclient.VSwitch{VSwitchId: "vsw-1", VpcId: ptr.To(vpcID), ZoneId: "zone_1", CidrBlock: "10.250.1.0/24"}, nil)
+		actor.EXPECT().GetVSwitch(ctx, "vsw-2").Return(&aliclient.VSwitch{VSwitchId: "vsw-2", VpcId: ptr.To("vpc-other"), ZoneId: "zone_1", CidrBlock: "10.250.3.0/24"}, nil)
+
+		errorList := cv.Validate(ctx, infra)
+		Expect(errorList).To(ConsistOfFields(Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("networks.zones[1].workersVSwitchID"),
+			"Detail": Equal("vswitch does not belong to vpc " + vpcID),
+		}, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("networks.zones[1].workersVSwitchID"),
+			"Detail": Equal("vswitch does not belong to zone zone_2"),
+		}, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("networks.zones[1].workersVSwitchID"),
+			"Detail": Equal("vswitch cidr 10.250.3.0/24 does not match workers cidr 10.250.2.0/24"),
+		}))
+	})
+
+	It("should forbid when one of multiple provided EIPs doesn't exist", func() {
+		infra.Spec.ProviderConfig.Raw = encode(&apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{},
+				Zones: []apisalicloud.Zone{
+					{
+						Name: "zone_1",
+						NatGateway: &apisalicloud.NatGatewayConfig{
+							EIPAllocationIDs: []string{eipID, "eip-2"},
+						},
+					},
+				},
+			},
+		})
+		actor.EXPECT().ListEnhanhcedNatGatewayAvailableZones(ctx, region).Return([]string{
+			"zone_1",
+			"zone_2",
+		}, nil)
+		actor.EXPECT().GetEIP(ctx, eipID).Return(&aliclient.EIP{}, nil)
+		actor.EXPECT().GetEIP(ctx, "eip-2").Return(nil, nil)
+
+		errorList := cv.Validate(ctx, infra)
+		Expect(errorList).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeNotFound),
+			"Field": Equal("networks.zones[].natGateway.eipAllocationIDs"),
+		}))
+	})
+
 })
 
 func encode(obj runtime.Object) []byte {
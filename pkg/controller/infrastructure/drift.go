@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const (
+	// ConditionTypeInfrastructureInSync is the type of the condition of an Infrastructure which reports whether the
+	// resources managed by the flow reconciler match the desired state.
+	ConditionTypeInfrastructureInSync = "InfrastructureInSync"
+
+	// maxDriftDetails is the maximum number of changes listed in the condition message.
+	maxDriftDetails = 10
+)
+
+var driftChanges = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "alicloud_infrastructure_drift_changes",
+	Help: "Number of changes the reconciliation would apply to the resources of a flow-managed infrastructure.",
+}, []string{"namespace", "resource_type", "action"})
+
+func init() {
+	metrics.Registry.MustRegister(driftChanges)
+}
+
+// DriftHealthCheck checks whether the resources of a flow-managed infrastructure drifted from their desired state, e.g.
+// because they have been modified in the Alicloud console. It plans the reconciliation of the infrastructure without
+// applying it and reports the planned changes instead.
+type DriftHealthCheck struct {
+	logger                 logr.Logger
+	seedClient             client.Client
+	clientFactory          aliclient.Factory
+	defaultResourceGroupID *string
+}
+
+var _ healthcheck.HealthCheck = &DriftHealthCheck{}
+
+// NewDriftHealthCheck creates a drift health check reading the resources with the default actor.
+func NewDriftHealthCheck(defaultResourceGroupID *string) healthcheck.HealthCheck {
+	return NewDriftHealthCheckWithDeps(aliclient.FactoryFunc(aliclient.NewActor), defaultResourceGroupID)
+}
+
+// NewDriftHealthCheckWithDeps creates a drift health check reading the resources with actors of the given factory.
+func NewDriftHealthCheckWithDeps(clientFactory aliclient.Factory, defaultResourceGroupID *string) healthcheck.HealthCheck {
+	return &DriftHealthCheck{
+		clientFactory:          clientFactory,
+		defaultResourceGroupID: defaultResourceGroupID,
+	}
+}
+
+// InjectSeedClient injects the seed client
+func (d *DriftHealthCheck) InjectSeedClient(seedClient client.Client) {
+	d.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger
+func (d *DriftHealthCheck) SetLoggerSuffix(provider, extension string) {
+	d.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-drift", provider, extension))
+}
+
+// DeepCopy clones the healthCheck struct by making a copy and returning the pointer to that new copy
+func (d *DriftHealthCheck) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *d
+	return &shallowCopy
+}
+
+// Check executes the health check
+func (d *DriftHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := d.seedClient.Get(ctx, request, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			driftChanges.DeletePartialMatch(prometheus.Labels{"namespace": request.Namespace})
+		}
+		return nil, err
+	}
+
+	fsOk, err := hasFlowState(infra.Status.State)
+	if err != nil {
+		return nil, err
+	}
+	if !fsOk {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+	if !isReconciled(infra) {
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionProgressing,
+			Detail: "infrastructure has not been reconciled with its current specification yet",
+		}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, d.seedClient, infra.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &actuator{
+		client:                 d.seedClient,
+		scheme:                 d.seedClient.Scheme(),
+		decoder:                serializer.NewCodecFactory(d.seedClient.Scheme(), serializer.EnableStrict).UniversalDecoder(),
+		defaultResourceGroupID: d.defaultResourceGroupID,
+	}
+	f := &FlowReconciler{
+		client:   d.seedClient,
+		log:      d.logger.WithValues("infrastructure", client.ObjectKeyFromObject(infra)),
+		actuator: a,
+	}
+	flowState, err := f.getFlowStateFromInfraStatus(infra)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := f.planChanges(ctx, infra, cluster, flowState, d.clientFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	recordDriftMetrics(infra.Namespace, changes)
+	if len(changes) == 0 {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionFalse,
+		Detail: driftDetail(changes),
+	}, nil
+}
+
+// isReconciled returns true if the last reconciliation of the infrastructure succeeded and covered its current spec.
+// Otherwise, the planned changes are expected and are not a drift.
+func isReconciled(infra *extensionsv1alpha1.Infrastructure) bool {
+	lastOperation := infra.Status.LastOperation
+	return lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded &&
+		infra.Status.ObservedGeneration == infra.Generation && !GetPlanAnnotationValue(infra)
+}
+
+func recordDriftMetrics(namespace string, changes []aliclient.Change) {
+	driftChanges.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+	for _, change := range changes {
+		driftChanges.WithLabelValues(namespace, change.ResourceType, string(change.Action)).Inc()
+	}
+}
+
+func driftDetail(changes []aliclient.Change) string {
+	var details []string
+	for i, change := range changes {
+		if i == maxDriftDetails {
+			details = append(details, fmt.Sprintf("and %d more", len(changes)-maxDriftDetails))
+			break
+		}
+		details = append(details, fmt.Sprintf("%s %s %s (%s)", change.Action, change.ResourceType, change.ID, change.Description))
+	}
+	return fmt.Sprintf("infrastructure drifted from its desired state, the next reconciliation would apply %d change(s): %s",
+		len(changes), strings.Join(details, ", "))
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/install"
+	alicloudv1alpha1 "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/fake"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("DriftHealthCheck", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		region    = "eu-central-1"
+	)
+
+	var (
+		ctx         context.Context
+		backend     *fake.Backend
+		scheme      *runtime.Scheme
+		secret      *corev1.Secret
+		cluster     *extensionscontroller.Cluster
+		infra       *extensionsv1alpha1.Infrastructure
+		healthCheck healthcheck.HealthCheck
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		backend = fake.NewBackend(region)
+
+		scheme = runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		install.Install(scheme)
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+			Data: map[string][]byte{
+				alicloud.AccessKeyID:     []byte("ak"),
+				alicloud.AccessKeySecret: []byte("sk"),
+			},
+		}
+		cluster = &extensionscontroller.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Shoot: &gardencorev1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+				Spec: gardencorev1beta1.ShootSpec{
+					Networking: &gardencorev1beta1.Networking{Pods: ptr.To("100.64.0.0/12")},
+				},
+			},
+		}
+
+		config := &alicloudv1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: alicloudv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: alicloudv1alpha1.Networks{
+				VPC:   alicloudv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+				Zones: []alicloudv1alpha1.Zone{{Name: region + "a", Workers: "10.250.0.0/19"}},
+			},
+		}
+		rawConfig, err := json.Marshal(config)
+		Expect(err).NotTo(HaveOccurred())
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace, Generation: 1},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           alicloud.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: rawConfig},
+				},
+				Region:    region,
+				SecretRef: corev1.SecretReference{Name: secret.Name, Namespace: namespace},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ObservedGeneration: 1,
+					LastOperation:      &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			},
+		}
+
+		healthCheck = NewDriftHealthCheckWithDeps(fake.NewFactory(backend), nil)
+		healthCheck.SetLoggerSuffix(alicloud.Type, "infrastructure")
+	})
+
+	reconcile := func() {
+		config := &aliapi.InfrastructureConfig{
+			Networks: aliapi.Networks{
+				VPC:   aliapi.VPC{CIDR: ptr.To("10.250.0.0/16")},
+				Zones: []aliapi.Zone{{Name: region + "a", Workers: "10.250.0.0/19"}},
+			},
+		}
+		var state shared.FlatMap
+		persistor := func(_ context.Context, flatMap shared.FlatMap) error {
+			state = flatMap
+			return nil
+		}
+		credentials := &alicloud.Credentials{AccessKeyID: "ak", AccessKeySecret: "sk"}
+		flowContext, err := infraflow.NewFlowContext(logr.Discard(), fake.NewFactory(backend), credentials, infra, config, nil, persistor, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flowContext.Reconcile(ctx)).To(Succeed())
+
+		rawState, err := infraflow.NewPersistentStateFromFlatMap(state).ToJSON()
+		Expect(err).NotTo(HaveOccurred())
+		infra.Status.State = &runtime.RawExtension{Raw: rawState}
+	}
+
+	check := func() *healthcheck.SingleCheckResult {
+		rawShoot, err := json.Marshal(cluster.Shoot)
+		Expect(err).NotTo(HaveOccurred())
+		clusterObj := &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: rawShoot}},
+		}
+		seedClient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(secret, clusterObj, infra).Build()
+		healthcheck.SeedClientInto(seedClient, healthCheck)
+
+		result, err := healthCheck.Check(ctx, types.NamespacedName{Namespace: namespace, Name: infra.Name})
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("should not report a drift for infrastructures without flow state", func() {
+		Expect(check().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(backend.Calls("DescribeVpcs")).To(BeZero())
+	})
+
+	It("should not report a drift for an up-to-date infrastructure", func() {
+		reconcile()
+
+		Expect(check().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(testutil.GatherAndCount(metrics.Registry, "alicloud_infrastructure_drift_changes")).To(BeZero())
+	})
+
+	It("should report a deleted SNAT entry", func() {
+		reconcile()
+		snatEntry := backend.SNATEntries()[0]
+		Expect(fake.NewActor(backend).DeleteSNatEntry(ctx, snatEntry.SnatEntryId, snatEntry.SnatTableId)).To(Succeed())
+
+		result := check()
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(ContainSubstring("apply 1 change(s): Create SNATEntry " + aliclient.PlannedIDPrefix))
+		Expect(backend.SNATEntries()).To(BeEmpty())
+		Expect(testutil.GatherAndCompare(metrics.Registry, strings.NewReader(`
+# HELP alicloud_infrastructure_drift_changes Number of changes the reconciliation would apply to the resources of a flow-managed infrastructure.
+# TYPE alicloud_infrastructure_drift_changes gauge
+alicloud_infrastructure_drift_changes{action="Create",namespace="shoot--foo--bar",resource_type="SNATEntry"} 1
+`), "alicloud_infrastructure_drift_changes")).To(Succeed())
+
+		Expect(fake.NewActor(backend).CreateSNatEntry(ctx, snatEntry)).Error().NotTo(HaveOccurred())
+		Expect(check().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(testutil.GatherAndCount(metrics.Registry, "alicloud_infrastructure_drift_changes")).To(BeZero())
+	})
+
+	It("should report a changed EIP bandwidth and an additional security group rule", func() {
+		reconcile()
+		actor := fake.NewActor(backend)
+		eip := backend.EIPs()[0]
+		Expect(actor.ModifyEIP(ctx, eip.EipId, &aliclient.EIP{Bandwidth: "200"})).To(Succeed())
+		Expect(actor.AuthorizeSecurityGroupRule(ctx, backend.SecurityGroups()[0].SecurityGroupId, aliclient.SecurityGroupRule{
+			Direction:    "ingress",
+			Policy:       "Accept",
+			Priority:     "1",
+			IpProtocol:   "TCP",
+			PortRange:    "22/22",
+			SourceCidrIp: "0.0.0.0/0",
+		})).To(Succeed())
+
+		result := check()
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(And(
+			ContainSubstring("apply 2 change(s)"),
+			ContainSubstring("Update EIP "+eip.EipId),
+			ContainSubstring("Update SecurityGroup "+backend.SecurityGroups()[0].SecurityGroupId),
+		))
+		Expect(backend.EIPs()[0].Bandwidth).To(Equal("200"))
+	})
+
+	It("should not move an existing infrastructure into a default resource group configured later on", func() {
+		reconcile()
+		healthCheck = NewDriftHealthCheckWithDeps(fake.NewFactory(backend), ptr.To("rg-seed"))
+		healthCheck.SetLoggerSuffix(alicloud.Type, "infrastructure")
+
+		Expect(check().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should not check an infrastructure which has not been reconciled with its current spec", func() {
+		reconcile()
+		infra.Generation = 2
+		snatEntry := backend.SNATEntries()[0]
+		Expect(fake.NewActor(backend).DeleteSNatEntry(ctx, snatEntry.SnatEntryId, snatEntry.SnatTableId)).To(Succeed())
+
+		Expect(check().Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+	})
+})
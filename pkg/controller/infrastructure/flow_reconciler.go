@@ -7,23 +7,31 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	extensioncontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 	aliv1alpha1 "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
 )
 
+// planRequeueInterval is the interval in which the plan is computed again while the plan annotation is set.
+const planRequeueInterval = 5 * time.Minute
+
 // FlowReconciler can manage infrastructure resources using Flow.
 type FlowReconciler struct {
 	client                     client.Client
@@ -78,6 +86,11 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 		if err != nil {
 			return util.DetermineError(err, helper.KnownCodes)
 		}
+	} else if GetPlanAnnotationValue(infra) {
+		flowState, err = f.convertTerraformerState(infra)
+		if err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
+		}
 	} else {
 		flowState, err = f.migrateFlowStateFromTerraformerState(ctx, infra)
 		if err != nil {
@@ -85,6 +98,9 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 		}
 	}
 
+	if GetPlanAnnotationValue(infra) {
+		return f.planWithFlow(ctx, infra, cluster, flowState)
+	}
 	return util.DetermineError(f.reconcileWithFlow(ctx, infra, cluster, flowState), helper.KnownCodes)
 }
 
@@ -121,20 +137,113 @@ func (f *FlowReconciler) reconcileWithFlow(ctx context.Context, infrastructure *
 		}
 	}
 
-	flowContext, err := f.createFlowContext(ctx, infrastructure, cluster, oldState)
+	var oldFlatState shared.FlatMap
+	if oldState != nil {
+		oldFlatState = oldState.ToFlatMap()
+	}
+	infrastructureConfig, err := f.decodeInfrastructureConfig(infrastructure, oldFlatState)
+	if err != nil {
+		return err
+	}
+	var shootAnnotations map[string]string
+	if cluster.Shoot != nil {
+		shootAnnotations = cluster.Shoot.Annotations
+	}
+	userTags := helper.UserTags(infrastructureConfig, shootAnnotations)
+
+	flowContext, err := f.createFlowContext(ctx, infrastructure, cluster, oldState, aliclient.FactoryFunc(aliclient.NewActor), f.statePersistor(infrastructure))
 	if err != nil {
 		return err
 	}
 	if err = flowContext.Reconcile(ctx); err != nil {
-		_ = f.updateStatusProvider(ctx, infrastructure, machineImages, flowContext.ExportState())
+		_ = f.updateStatusProvider(ctx, infrastructure, machineImages, userTags, flowContext.ExportState())
 		return err
 	}
-	return f.updateStatusProvider(ctx, infrastructure, machineImages, flowContext.ExportState())
+
+	var previousUserTags map[string]string
+	if infrastructure.Status.ProviderStatus != nil {
+		infrastructureStatus, err := helper.InfrastructureStatusFromRaw(infrastructure.Status.ProviderStatus)
+		if err != nil {
+			return err
+		}
+		previousUserTags = infrastructureStatus.Tags
+	}
+	if err := f.actuator.ensureServiceLoadBalancerTags(ctx, infrastructure, userTags, previousUserTags); err != nil {
+		return err
+	}
+	return f.updateStatusProvider(ctx, infrastructure, machineImages, userTags, flowContext.ExportState())
 }
 
-func (f *FlowReconciler) migrateFlowStateFromTerraformerState(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
-	f.log.Info("starting terraform state migration")
-	infrastructureConfig, err := f.decodeInfrastructureConfig(infrastructure)
+// planWithFlow runs the reconciliation flow with an actor which only records the changes it would apply to the
+// infrastructure. The changes are stored in the provider status, the state is not persisted. As the infrastructure has
+// not been reconciled, the reconciliation is reported as still in progress afterwards, so that the Infrastructure does
+// not become ready, and the plan is computed again after planRequeueInterval.
+func (f *FlowReconciler) planWithFlow(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster, oldState *infraflow.PersistentState) error {
+	f.log.Info("planWithFlow")
+
+	changes, err := f.planChanges(ctx, infrastructure, cluster, oldState, aliclient.FactoryFunc(aliclient.NewActor))
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	f.log.Info("planned infrastructure changes", "count", len(changes))
+	if err := f.updateStatusPlan(ctx, infrastructure, changes); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	return &reconcilerutils.RequeueAfterError{
+		RequeueAfter: planRequeueInterval,
+		Cause: fmt.Errorf("infrastructure has only been planned because of annotation %s, %d change(s) have not been applied: remove the annotation to reconcile the infrastructure",
+			aliapi.AnnotationKeyPlan, len(changes)),
+	}
+}
+
+// planChanges returns the changes the reconciliation flow would apply to the infrastructure. The given client factory
+// is only used to read the existing resources.
+func (f *FlowReconciler) planChanges(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster,
+	oldState *infraflow.PersistentState, clientFactory aliclient.Factory) ([]aliclient.Change, error) {
+	var planner *aliclient.Planner
+	plannerFactory := aliclient.NewPlannerFactory(clientFactory, func(created *aliclient.Planner) {
+		planner = created
+	})
+	persistor := func(_ context.Context, _ shared.FlatMap) error {
+		return nil
+	}
+	flowContext, err := f.createFlowContext(ctx, infrastructure, cluster, oldState, plannerFactory, persistor)
+	if err != nil {
+		return nil, err
+	}
+	if err := flowContext.Reconcile(ctx); err != nil {
+		return nil, fmt.Errorf("planning the infrastructure changes failed: %w", err)
+	}
+	return planner.Changes(), nil
+}
+
+// updateStatusPlan stores the planned changes in the provider status and keeps all other fields of the status.
+func (f *FlowReconciler) updateStatusPlan(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, changes []aliclient.Change) error {
+	infrastructureStatus := &aliv1alpha1.InfrastructureStatus{}
+	if infra.Status.ProviderStatus != nil {
+		if _, _, err := f.actuator.decoder.Decode(infra.Status.ProviderStatus.Raw, nil, infrastructureStatus); err != nil {
+			return err
+		}
+	}
+	infrastructureStatus.TypeMeta = StatusTypeMeta
+	infrastructureStatus.Plan = &aliv1alpha1.InfrastructurePlan{}
+	for _, change := range changes {
+		infrastructureStatus.Plan.Changes = append(infrastructureStatus.Plan.Changes, aliv1alpha1.PlannedChange{
+			Action:       string(change.Action),
+			ResourceType: change.ResourceType,
+			ID:           change.ID,
+			Description:  change.Description,
+		})
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.ProviderStatus = &runtime.RawExtension{Object: infrastructureStatus}
+	return f.client.Status().Patch(ctx, infra, patch)
+}
+
+// convertTerraformerState converts the Terraformer state of the infrastructure to a flow state without persisting it.
+func (f *FlowReconciler) convertTerraformerState(infrastructure *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
+	infrastructureConfig, err := f.decodeInfrastructureConfig(infrastructure, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +252,15 @@ func (f *FlowReconciler) migrateFlowStateFromTerraformerState(ctx context.Contex
 	if err != nil {
 		return nil, fmt.Errorf("migration from terraform state failed: %w", err)
 	}
+	return state, nil
+}
+
+func (f *FlowReconciler) migrateFlowStateFromTerraformerState(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
+	f.log.Info("starting terraform state migration")
+	state, err := f.convertTerraformerState(infrastructure)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := f.updateStatusState(ctx, infrastructure, state); err != nil {
 		return nil, fmt.Errorf("updating status state failed: %w", err)
@@ -163,14 +281,14 @@ func (f *FlowReconciler) updateStatusState(ctx context.Context, infra *extension
 	return f.client.Status().Patch(ctx, infra, patch)
 }
 
-func (f *FlowReconciler) updateStatusProvider(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, machineImages []aliapi.MachineImage, flatState shared.FlatMap) error {
-	infrastructureConfig, err := f.decodeInfrastructureConfig(infra)
+func (f *FlowReconciler) updateStatusProvider(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, machineImages []aliapi.MachineImage, userTags map[string]string, flatState shared.FlatMap) error {
+	infrastructureConfig, err := f.decodeInfrastructureConfig(infra, flatState)
 	if err != nil {
 		return err
 	}
 
 	state := infraflow.NewPersistentStateFromFlatMap(flatState)
-	infrastructureStatus, err := computeProviderStatusFromFlowState(infrastructureConfig, state)
+	infrastructureStatus, err := computeProviderStatusFromFlowState(infrastructureConfig, userTags, state)
 
 	if err != nil {
 		return err
@@ -208,7 +326,9 @@ func getEgressIpCidrs(state *infraflow.PersistentState) []string {
 			if len(parts) != 3 {
 				continue
 			}
-			if parts[2] == infraflow.ZoneNATGWElasticIPAddress {
+			// a zone may use several elastic IPs as SNAT IP pool. The IPv6 CIDR blocks of the vswitches are not reported,
+			// as the public IPv6 bandwidth of the instances is not managed, i.e. the nodes have no public IPv6 egress.
+			if strings.HasPrefix(parts[2], infraflow.ZoneNATGWElasticIPAddress) {
 				cidrs = append(cidrs, v+"/32")
 			}
 		}
@@ -216,17 +336,20 @@ func getEgressIpCidrs(state *infraflow.PersistentState) []string {
 	if len(cidrs) == 0 {
 		return nil
 	}
+	slices.Sort(cidrs)
 
 	return cidrs
 }
 
-func computeProviderStatusFromFlowState(config *aliapi.InfrastructureConfig, state *infraflow.PersistentState) (*aliv1alpha1.InfrastructureStatus, error) {
+func computeProviderStatusFromFlowState(config *aliapi.InfrastructureConfig, userTags map[string]string, state *infraflow.PersistentState) (*aliv1alpha1.InfrastructureStatus, error) {
 	if len(state.Data) == 0 {
 		return nil, nil
 	}
 
 	status := &aliv1alpha1.InfrastructureStatus{
-		TypeMeta: StatusTypeMeta,
+		TypeMeta:        StatusTypeMeta,
+		ResourceGroupID: ptr.Deref(config.ResourceGroupID, ""),
+		Tags:            userTags,
 	}
 
 	vpcID := ""
@@ -240,6 +363,7 @@ func computeProviderStatusFromFlowState(config *aliapi.InfrastructureConfig, sta
 	}
 	if vpcID != "" {
 		var vswitches []aliv1alpha1.VSwitch
+		vswitchIPv6CIDRs := map[string]string{}
 		prefix := infraflow.ChildIdZones + shared.Separator
 		for k, v := range state.Data {
 			if !shared.IsValidValue(v) {
@@ -250,19 +374,32 @@ func computeProviderStatusFromFlowState(config *aliapi.InfrastructureConfig, sta
 				if len(parts) != 3 {
 					continue
 				}
-				if parts[2] == infraflow.IdentifierZoneVSwitch {
+				switch parts[2] {
+				case infraflow.IdentifierZoneVSwitch:
 					vswitches = append(vswitches, aliv1alpha1.VSwitch{
 						ID:      v,
 						Purpose: aliv1alpha1.PurposeNodes,
 						Zone:    parts[1],
 					})
+				case infraflow.ZoneVSwitchIPv6CIDR:
+					vswitchIPv6CIDRs[parts[1]] = v
 				}
 			}
 		}
+		for i := range vswitches {
+			vswitches[i].IPv6CIDR = vswitchIPv6CIDRs[vswitches[i].Zone]
+		}
 		status.VPC = aliv1alpha1.VPCStatus{
 			ID:        vpcID,
 			VSwitches: vswitches,
 		}
+		if cidr := state.Data[infraflow.VPCIPv6CIDR]; shared.IsValidValue(cidr) {
+			status.VPC.IPv6CIDR = cidr
+		}
+		if gwID := state.Data[infraflow.IdentifierIPv6Gateway]; shared.IsValidValue(gwID) {
+			status.VPC.IPv6GatewayID = gwID
+		}
+		status.VPC.VPCEndpoints = computeVPCEndpointsStatus(config, state)
 		if groupID := state.Data[infraflow.IdentifierNodesSecurityGroup]; shared.IsValidValue(groupID) {
 			status.VPC.SecurityGroups = []aliv1alpha1.SecurityGroup{
 				{
@@ -276,29 +413,67 @@ func computeProviderStatusFromFlowState(config *aliapi.InfrastructureConfig, sta
 	return status, nil
 }
 
-func (f *FlowReconciler) decodeInfrastructureConfig(infrastructure *extensionsv1alpha1.Infrastructure) (*aliapi.InfrastructureConfig, error) {
+func computeVPCEndpointsStatus(config *aliapi.InfrastructureConfig, state *infraflow.PersistentState) []aliv1alpha1.VPCEndpointStatus {
+	var endpoints []aliv1alpha1.VPCEndpointStatus
+	for _, endpoint := range config.Networks.VPCEndpoints {
+		prefix := infraflow.ChildIdVPCEndpoints + shared.Separator + endpoint.ServiceName + shared.Separator
+		id := state.Data[prefix+infraflow.IdentifierVPCEndpoint]
+		if !shared.IsValidValue(id) {
+			continue
+		}
+		domain := state.Data[prefix+infraflow.VPCEndpointDomain]
+		if !shared.IsValidValue(domain) {
+			domain = ""
+		}
+		privateZoneID := state.Data[prefix+infraflow.IdentifierPrivateZone]
+		if !shared.IsValidValue(privateZoneID) {
+			privateZoneID = ""
+		}
+		endpoints = append(endpoints, aliv1alpha1.VPCEndpointStatus{
+			Type:          aliv1alpha1.VPCEndpointType(helper.VPCEndpointType(endpoint)),
+			ServiceName:   endpoint.ServiceName,
+			ID:            id,
+			Domain:        domain,
+			PrivateZoneID: privateZoneID,
+		})
+	}
+	return endpoints
+}
+
+// decodeInfrastructureConfig decodes the InfrastructureConfig of the infrastructure. If it does not specify a resource
+// group, the default resource group for the given flow state is used, see defaultResourceGroupID.
+func (f *FlowReconciler) decodeInfrastructureConfig(infrastructure *extensionsv1alpha1.Infrastructure, state shared.FlatMap) (*aliapi.InfrastructureConfig, error) {
 	infrastructureConfig := &aliapi.InfrastructureConfig{}
 	if _, _, err := f.actuator.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
 		return nil, fmt.Errorf("could not decode provider config: %w", err)
 	}
+	if infrastructureConfig.ResourceGroupID == nil {
+		infrastructureConfig.ResourceGroupID = f.defaultResourceGroupID(state)
+	}
 	return infrastructureConfig, nil
 }
 
-func (f *FlowReconciler) createFlowContext(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster, oldState *infraflow.PersistentState) (*infraflow.FlowContext, error) {
-	infrastructureConfig, err := f.decodeInfrastructureConfig(infrastructure)
-	if err != nil {
-		return nil, err
+// defaultResourceGroupID returns the resource group used for the infrastructure with the given flow state if its
+// InfrastructureConfig does not specify one. The default resource group of the seed only applies to infrastructures
+// created after it has been configured, it is recorded in the state when the infrastructure is created. Otherwise,
+// configuring or changing the default would move the resources of all existing infrastructures and roll their nodes.
+func (f *FlowReconciler) defaultResourceGroupID(state shared.FlatMap) *string {
+	if id := state[infraflow.DefaultResourceGroupID]; shared.IsValidValue(id) {
+		return &id
 	}
-	_, shootCloudProviderCredentials, err := f.actuator.getConfigAndCredentialsForInfra(ctx, infrastructure)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get shoot credentials: %w", err)
+	if shared.IsValidValue(state[infraflow.IdentifierVPC]) {
+		return nil
 	}
+	return f.actuator.defaultResourceGroupID
+}
 
+// statePersistor returns a persistor storing the flow state in the status of the infrastructure.
+func (f *FlowReconciler) statePersistor(infrastructure *extensionsv1alpha1.Infrastructure) shared.FlowStatePersistor {
 	infraObjectKey := client.ObjectKey{
 		Namespace: infrastructure.Namespace,
 		Name:      infrastructure.Name,
 	}
-	persistor := func(ctx context.Context, flatState shared.FlatMap) error {
+	return func(ctx context.Context, flatState shared.FlatMap) error {
 		state := infraflow.NewPersistentStateFromFlatMap(flatState)
 		infra := &extensionsv1alpha1.Infrastructure{}
 		if err := f.client.Get(ctx, infraObjectKey, infra); err != nil {
@@ -306,16 +481,34 @@ func (f *FlowReconciler) createFlowContext(ctx context.Context, infrastructure *
 		}
 		return f.updateStatusState(ctx, infra, state)
 	}
+}
 
-	var oldFlatState shared.FlatMap
+func (f *FlowReconciler) createFlowContext(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster,
+	oldState *infraflow.PersistentState, clientFactory aliclient.Factory, persistor shared.FlowStatePersistor) (*infraflow.FlowContext, error) {
+	oldFlatState := shared.FlatMap{}
 	if oldState != nil {
 		if valid, err := oldState.HasValidVersion(); !valid {
 			return nil, err
 		}
-		oldFlatState = oldState.ToFlatMap()
+		for k, v := range oldState.ToFlatMap() {
+			oldFlatState[k] = v
+		}
+	}
+	// the default resource group is recorded, so that it is kept even if the default of the seed is changed later on
+	if id := f.defaultResourceGroupID(oldFlatState); id != nil {
+		oldFlatState[infraflow.DefaultResourceGroupID] = *id
+	}
+
+	infrastructureConfig, err := f.decodeInfrastructureConfig(infrastructure, oldFlatState)
+	if err != nil {
+		return nil, err
+	}
+	_, shootCloudProviderCredentials, err := f.actuator.getConfigAndCredentialsForInfra(ctx, infrastructure)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shoot credentials: %w", err)
 	}
 
-	return infraflow.NewFlowContext(f.log, shootCloudProviderCredentials, infrastructure, infrastructureConfig, oldFlatState, persistor, cluster)
+	return infraflow.NewFlowContext(f.log, clientFactory, shootCloudProviderCredentials, infrastructure, infrastructureConfig, oldFlatState, persistor, cluster)
 }
 
 func (f *FlowReconciler) getFlowStateFromInfraStatus(infrastructure *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
@@ -328,7 +521,7 @@ func (f *FlowReconciler) getFlowStateFromInfraStatus(infrastructure *extensionsv
 func (f *FlowReconciler) deleteWithFlow(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, oldState *infraflow.PersistentState) error {
 	f.log.Info("deleteWithFlow")
 
-	flowContext, err := f.createFlowContext(ctx, infrastructure, nil, oldState)
+	flowContext, err := f.createFlowContext(ctx, infrastructure, nil, oldState, aliclient.FactoryFunc(aliclient.NewActor), f.statePersistor(infrastructure))
 	if err != nil {
 		return err
 	}
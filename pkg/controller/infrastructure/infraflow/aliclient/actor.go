@@ -7,18 +7,25 @@ package aliclient
 import (
 	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	alierrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/privatelink"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/pvtz"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
 )
 
@@ -31,6 +38,7 @@ type Actor interface {
 	ListVpcs(ctx context.Context, ids []string) ([]*VPC, error)
 	FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error)
 	DeleteVpc(ctx context.Context, id string) error
+	EnableVpcIPv6(ctx context.Context, id string) error
 
 	CreateVSwitch(ctx context.Context, vsw *VSwitch) (*VSwitch, error)
 	GetVSwitch(ctx context.Context, id string) (*VSwitch, error)
@@ -38,6 +46,13 @@ type Actor interface {
 	FindVSwitchesByTags(ctx context.Context, tags Tags) ([]*VSwitch, error)
 	FindVSwitchesByVPC(ctx context.Context, vpcId string) ([]*VSwitch, error)
 	DeleteVSwitch(ctx context.Context, id string) error
+	EnableVSwitchIPv6(ctx context.Context, id string, ipv6CidrBlockIndex int32) error
+
+	CreateIPv6Gateway(ctx context.Context, gw *IPv6Gateway) (*IPv6Gateway, error)
+	GetIPv6Gateway(ctx context.Context, id string) (*IPv6Gateway, error)
+	FindIPv6GatewaysByTags(ctx context.Context, tags Tags) ([]*IPv6Gateway, error)
+	FindIPv6GatewayByVPC(ctx context.Context, vpcId string) (*IPv6Gateway, error)
+	DeleteIPv6Gateway(ctx context.Context, id string) error
 
 	CreateNatGateway(ctx context.Context, ngw *NatGateway) (*NatGateway, error)
 	GetNatGateway(ctx context.Context, id string) (*NatGateway, error)
@@ -45,6 +60,7 @@ type Actor interface {
 	FindNatGatewayByTags(ctx context.Context, tags Tags) ([]*NatGateway, error)
 	FindNatGatewayByVPC(ctx context.Context, vpcId string) (*NatGateway, error)
 	DeleteNatGateway(ctx context.Context, id string) error
+	ModifyNatGatewaySpec(ctx context.Context, id, spec string) error
 
 	CreateEIP(ctx context.Context, eip *EIP) (*EIP, error)
 	GetEIP(ctx context.Context, id string) (*EIP, error)
@@ -53,6 +69,8 @@ type Actor interface {
 	FindEIPsByTags(ctx context.Context, tags Tags) ([]*EIP, error)
 	DeleteEIP(ctx context.Context, id string) error
 	ModifyEIP(ctx context.Context, id string, eip *EIP) error
+	AddEIPToBandwidthPackage(ctx context.Context, bandwidthPackageId, id string) error
+	RemoveEIPFromBandwidthPackage(ctx context.Context, bandwidthPackageId, id string) error
 	AssociateEIP(ctx context.Context, id, to, insType string) error
 	UnAssociateEIP(ctx context.Context, eip *EIP) error
 
@@ -63,6 +81,8 @@ type Actor interface {
 
 	CreateTags(ctx context.Context, resources []string, tags Tags, resourceType string) error
 	DeleteTags(ctx context.Context, resources []string, tags Tags, resourceType string) error
+	MoveResourceGroup(ctx context.Context, id, resourceGroupId, resourceType string) error
+	ListTagKeys(ctx context.Context, resourceType, keyword string) ([]string, error)
 
 	CreateSecurityGroup(ctx context.Context, sg *SecurityGroup) (*SecurityGroup, error)
 	GetSecurityGroup(ctx context.Context, id string) (*SecurityGroup, error)
@@ -72,34 +92,109 @@ type Actor interface {
 
 	AuthorizeSecurityGroupRule(ctx context.Context, sgId string, rule SecurityGroupRule) error
 	RevokeSecurityGroupRule(ctx context.Context, sgId, ruleId, direction string) error
+
+	AttachCENChildInstance(ctx context.Context, cenId, vpcId, region string) error
+	GetCENChildInstance(ctx context.Context, cenId, vpcId, region string) (*CENChildInstance, error)
+	DetachCENChildInstance(ctx context.Context, cenId, vpcId, region string) error
+
+	CreateTransitRouterVpcAttachment(ctx context.Context, attachment *TransitRouterVpcAttachment) (*TransitRouterVpcAttachment, error)
+	GetTransitRouterVpcAttachment(ctx context.Context, id string) (*TransitRouterVpcAttachment, error)
+	FindTransitRouterVpcAttachmentByVPC(ctx context.Context, transitRouterId, vpcId string) (*TransitRouterVpcAttachment, error)
+	AddZonesToTransitRouterVpcAttachment(ctx context.Context, id string, zoneMappings map[string]string) error
+	DeleteTransitRouterVpcAttachment(ctx context.Context, id string) error
+
+	CreateTransitRouterRouteEntry(ctx context.Context, entry *TransitRouterRouteEntry) (*TransitRouterRouteEntry, error)
+	FindTransitRouterRouteEntry(ctx context.Context, routeTableId, destinationCidrBlock string) (*TransitRouterRouteEntry, error)
+	DeleteTransitRouterRouteEntry(ctx context.Context, routeTableId, destinationCidrBlock string) error
+
+	CreateVpcEndpoint(ctx context.Context, endpoint *VPCEndpoint) (*VPCEndpoint, error)
+	GetVpcEndpoint(ctx context.Context, id string) (*VPCEndpoint, error)
+	FindVpcEndpointsByTags(ctx context.Context, tags Tags) ([]*VPCEndpoint, error)
+	AddZonesToVpcEndpoint(ctx context.Context, id string, zoneMappings map[string]string) error
+	DeleteVpcEndpoint(ctx context.Context, id string) error
+
+	ListRouteTables(ctx context.Context, vpcId string) ([]*RouteTable, error)
+	CreateVpcGatewayEndpoint(ctx context.Context, endpoint *VPCGatewayEndpoint) (*VPCGatewayEndpoint, error)
+	GetVpcGatewayEndpoint(ctx context.Context, id string) (*VPCGatewayEndpoint, error)
+	FindVpcGatewayEndpointsByTags(ctx context.Context, tags Tags) ([]*VPCGatewayEndpoint, error)
+	AssociateRouteTablesWithVpcGatewayEndpoint(ctx context.Context, id string, routeTableIds []string) error
+	DeleteVpcGatewayEndpoint(ctx context.Context, id string) error
+
+	CreatePrivateZone(ctx context.Context, zone *PrivateZone) (*PrivateZone, error)
+	GetPrivateZone(ctx context.Context, id string) (*PrivateZone, error)
+	FindPrivateZonesByTags(ctx context.Context, tags Tags) ([]*PrivateZone, error)
+	DeletePrivateZone(ctx context.Context, id string) error
+	ListPrivateZoneRecords(ctx context.Context, zoneId string) ([]*PrivateZoneRecord, error)
+	CreatePrivateZoneRecord(ctx context.Context, record *PrivateZoneRecord) (*PrivateZoneRecord, error)
+	UpdatePrivateZoneRecord(ctx context.Context, record *PrivateZoneRecord) error
 }
 
+const cenChildInstanceTypeVPC = "VPC"
+
 type actor struct {
-	vpcClient    alicloudclient.VPC
-	ecsClient    alicloudclient.ECS
-	Logger       logr.Logger
-	PollInterval time.Duration
+	vpcClient         alicloudclient.VPC
+	ecsClient         alicloudclient.ECS
+	cbnClient         alicloudclient.CBN
+	privateLinkClient alicloudclient.PrivateLink
+	pvtzClient        alicloudclient.PVTZ
+	region            string
+	Logger            logr.Logger
+	PollInterval      time.Duration
 }
 
 var _ Actor = &actor{}
 
 // NewActor is to create a Actor object
-func NewActor(accessKeyID, secretAccessKey, region string) (Actor, error) {
-	clientFactory := alicloudclient.NewClientFactory()
-	vpcClient, err := clientFactory.NewVPCClient(region, accessKeyID, secretAccessKey)
+func NewActor(credentials *alicloud.Credentials, region string) (Actor, error) {
+	return NewActorFromClientFactory(alicloudclient.NewClientFactory(), credentials, region)
+}
+
+// ActorOption is an option for creating an Actor.
+type ActorOption func(*actor)
+
+// WithPollInterval sets the interval in which the actor polls the status of resources it waits for.
+func WithPollInterval(interval time.Duration) ActorOption {
+	return func(c *actor) {
+		c.PollInterval = interval
+	}
+}
+
+// NewActorFromClientFactory creates an Actor using the clients created by the given client factory.
+func NewActorFromClientFactory(clientFactory alicloudclient.ClientFactory, credentials *alicloud.Credentials, region string, opts ...ActorOption) (Actor, error) {
+	vpcClient, err := clientFactory.NewVPCClient(region, credentials)
+	if err != nil {
+		return nil, err
+	}
+	ecsClient, err := clientFactory.NewECSClient(region, credentials)
+	if err != nil {
+		return nil, err
+	}
+	cbnClient, err := clientFactory.NewCBNClient(region, credentials)
 	if err != nil {
 		return nil, err
 	}
-	ecsClient, err := clientFactory.NewECSClient(region, accessKeyID, secretAccessKey)
+	privateLinkClient, err := clientFactory.NewPrivateLinkClient(region, credentials)
 	if err != nil {
 		return nil, err
 	}
-	return &actor{
-		vpcClient:    vpcClient,
-		ecsClient:    ecsClient,
-		Logger:       log.Log.WithName("alicloud-client"),
-		PollInterval: 5 * time.Second,
-	}, nil
+	pvtzClient, err := clientFactory.NewPVTZClient(region, credentials)
+	if err != nil {
+		return nil, err
+	}
+	c := &actor{
+		vpcClient:         vpcClient,
+		ecsClient:         ecsClient,
+		cbnClient:         cbnClient,
+		privateLinkClient: privateLinkClient,
+		pvtzClient:        pvtzClient,
+		region:            region,
+		Logger:            log.Log.WithName("alicloud-client"),
+		PollInterval:      5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
 }
 
 func (c *actor) CreateTags(_ context.Context, resources []string, tags Tags, resourceType string) error {
@@ -124,6 +219,51 @@ func (c *actor) DeleteTags(_ context.Context, resources []string, tags Tags, res
 	return fmt.Errorf("unknown resource type %s", resourceType)
 }
 
+// MoveResourceGroup moves the resource with the given id and type (as used for tags) into the given resource group.
+func (c *actor) MoveResourceGroup(_ context.Context, id, resourceGroupId, resourceType string) error {
+	switch c.getResourceClass(resourceType) {
+	case "vpc":
+		req := vpc.CreateMoveResourceGroupRequest()
+		req.ResourceId = id
+		req.ResourceType = strings.ToLower(resourceType)
+		req.NewResourceGroupId = resourceGroupId
+		_, err := callApi(c.vpcClient.MoveResourceGroup, req)
+		return err
+	case "ecs":
+		req := ecs.CreateJoinResourceGroupRequest()
+		req.ResourceId = id
+		req.ResourceType = resourceType
+		req.ResourceGroupId = resourceGroupId
+		_, err := callApi(c.ecsClient.JoinResourceGroup, req)
+		return err
+	}
+	return fmt.Errorf("unknown resource type %s", resourceType)
+}
+
+// ListTagKeys returns the tag keys containing the given keyword which are used by resources of the given type. Only
+// resource types of the VPC API are supported.
+func (c *actor) ListTagKeys(_ context.Context, resourceType, keyword string) ([]string, error) {
+	if c.getResourceClass(resourceType) != "vpc" {
+		return nil, fmt.Errorf("listing tag keys of resource type %s is not supported", resourceType)
+	}
+	req := vpc.CreateDescribeTagKeysRequest()
+	req.ResourceType = resourceType
+	req.Keyword = keyword
+	respList, err := page_call(c.vpcClient.DescribeTagKeys, req)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, resp := range respList {
+		for _, tagKey := range resp.TagKeys.TagKey {
+			if !contains(keys, tagKey.TagKey) {
+				keys = append(keys, tagKey.TagKey)
+			}
+		}
+	}
+	return keys, nil
+}
+
 func (c *actor) getResourceClass(resourceType string) string {
 	vpc_resourceType_list := []string{
 		"VPC",
@@ -133,6 +273,7 @@ func (c *actor) getResourceClass(resourceType string) string {
 		"VpnGateWay",
 		"NATGATEWAY",
 		"COMMONBANDWIDTHPACKAGE",
+		"IPV6GATEWAY",
 	}
 	ecs_resourceType_list := []string{
 		"instance",
@@ -185,6 +326,7 @@ func (c *actor) CreateSecurityGroup(ctx context.Context, sg *SecurityGroup) (*Se
 	req.SecurityGroupName = sg.Name
 	req.VpcId = sg.VpcId
 	req.Description = sg.Description
+	req.ResourceGroupId = sg.ResourceGroupId
 
 	resp, err := callApi(c.ecsClient.CreateSecurityGroup, req)
 	if err != nil {
@@ -295,11 +437,12 @@ func (c *actor) addIngressSecurityGroupRule(sgId string, rule SecurityGroupRule)
 	req.SecurityGroupId = sgId
 	req.Permissions = &[]ecs.AuthorizeSecurityGroupPermissions{
 		{
-			Policy:       rule.Policy,
-			Priority:     rule.Priority,
-			IpProtocol:   rule.IpProtocol,
-			SourceCidrIp: rule.SourceCidrIp,
-			PortRange:    rule.PortRange,
+			Policy:           rule.Policy,
+			Priority:         rule.Priority,
+			IpProtocol:       rule.IpProtocol,
+			SourceCidrIp:     rule.SourceCidrIp,
+			Ipv6SourceCidrIp: rule.Ipv6SourceCidrIp,
+			PortRange:        rule.PortRange,
 		},
 	}
 
@@ -315,11 +458,12 @@ func (c *actor) addEgressSecurityGroupRule(sgId string, rule SecurityGroupRule)
 	req.SecurityGroupId = sgId
 	req.Permissions = &[]ecs.AuthorizeSecurityGroupEgressPermissions{
 		{
-			Policy:     rule.Policy,
-			Priority:   rule.Priority,
-			IpProtocol: rule.IpProtocol,
-			PortRange:  rule.PortRange,
-			DestCidrIp: rule.DestCidrIp,
+			Policy:         rule.Policy,
+			Priority:       rule.Priority,
+			IpProtocol:     rule.IpProtocol,
+			PortRange:      rule.PortRange,
+			DestCidrIp:     rule.DestCidrIp,
+			Ipv6DestCidrIp: rule.Ipv6DestCidrIp,
 		},
 	}
 
@@ -425,7 +569,7 @@ func (c *actor) CreateSNatEntry(ctx context.Context, entry *SNATEntry) (*SNATEnt
 	}
 
 	var created *SNATEntry
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		created, err = c.GetSNatEntry(ctx, resp.SnatEntryId, entry.SnatTableId)
 		if err != nil {
 			return false, err
@@ -473,7 +617,7 @@ func (c *actor) DeleteSNatEntry(ctx context.Context, id, snatTableId string) err
 	if err != nil {
 		return err
 	}
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		current, err := c.GetSNatEntry(ctx, id, snatTableId)
 		if err != nil {
 			return false, err
@@ -501,6 +645,23 @@ func (c *actor) ModifyEIP(_ context.Context, id string, eip *EIP) error {
 	return nil
 }
 
+func (c *actor) AddEIPToBandwidthPackage(_ context.Context, bandwidthPackageId, id string) error {
+	req := vpc.CreateAddCommonBandwidthPackageIpRequest()
+	req.BandwidthPackageId = bandwidthPackageId
+	req.IpInstanceId = id
+	req.IpType = "EIP"
+	_, err := callApi(c.vpcClient.AddCommonBandwidthPackageIp, req)
+	return err
+}
+
+func (c *actor) RemoveEIPFromBandwidthPackage(_ context.Context, bandwidthPackageId, id string) error {
+	req := vpc.CreateRemoveCommonBandwidthPackageIpRequest()
+	req.BandwidthPackageId = bandwidthPackageId
+	req.IpInstanceId = id
+	_, err := callApi(c.vpcClient.RemoveCommonBandwidthPackageIp, req)
+	return err
+}
+
 func (c *actor) AssociateEIP(ctx context.Context, id, to, insType string) error {
 	req := vpc.CreateAssociateEipAddressRequest()
 	req.AllocationId = id
@@ -512,7 +673,7 @@ func (c *actor) AssociateEIP(ctx context.Context, id, to, insType string) error
 	}
 
 	var theEip *EIP
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		theEip, err = c.GetEIP(ctx, id)
 
 		if err != nil {
@@ -547,7 +708,7 @@ func (c *actor) UnAssociateEIP(ctx context.Context, eip *EIP) error {
 	}
 
 	var theEip *EIP
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		theEip, err = c.GetEIP(ctx, eip.EipId)
 
 		if err != nil {
@@ -573,6 +734,8 @@ func (c *actor) CreateEIP(ctx context.Context, eip *EIP) (*EIP, error) {
 	req.Bandwidth = eip.Bandwidth
 	req.InstanceChargeType = "PostPaid"
 	req.InternetChargeType = eip.InternetChargeType
+	req.ISP = eip.ISP
+	req.ResourceGroupId = eip.ResourceGroupId
 
 	resp, err := callApi(c.vpcClient.AllocateEipAddress, req)
 	if err != nil {
@@ -580,7 +743,7 @@ func (c *actor) CreateEIP(ctx context.Context, eip *EIP) (*EIP, error) {
 	}
 
 	var created *EIP
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		created, err = c.GetEIP(ctx, resp.AllocationId)
 		if err != nil {
 			return false, err
@@ -650,13 +813,18 @@ func (c *actor) DeleteEIP(ctx context.Context, id string) error {
 	if current == nil {
 		return nil
 	}
+	if current.BandwidthPackageId != "" {
+		if err := c.RemoveEIPFromBandwidthPackage(ctx, current.BandwidthPackageId, id); err != nil {
+			return err
+		}
+	}
 	req := vpc.CreateReleaseEipAddressRequest()
 	req.AllocationId = id
 	_, err = callApi(c.vpcClient.ReleaseEipAddress, req)
 	if err != nil {
 		return err
 	}
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		current, err := c.GetEIP(ctx, id)
 		if err != nil {
 			return false, err
@@ -683,13 +851,15 @@ func (c *actor) CreateNatGateway(ctx context.Context, ngw *NatGateway) (*NatGate
 	req.VpcId = *ngw.VpcId
 	req.VSwitchId = ngw.AvailableVSwitches[0]
 	req.NatType = "Enhanced"
+	req.InternetChargeType = ngw.InternetChargeType
+	req.Spec = ngw.Spec
 	resp, err := callApi(c.vpcClient.CreateNatGateway, req)
 	if err != nil {
 		return nil, err
 	}
 
 	var created *NatGateway
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		created, err = c.GetNatGateway(ctx, resp.NatGatewayId)
 		if err != nil {
 			return false, err
@@ -740,6 +910,15 @@ func (c *actor) FindNatGatewayByVPC(_ context.Context, vpcId string) (*NatGatewa
 	return resp[0], nil
 }
 
+func (c *actor) ModifyNatGatewaySpec(_ context.Context, id, spec string) error {
+	req := vpc.CreateModifyNatGatewaySpecRequest()
+	req.NatGatewayId = id
+	req.Spec = spec
+	req.AutoPay = requests.NewBoolean(true)
+	_, err := callApi(c.vpcClient.ModifyNatGatewaySpec, req)
+	return err
+}
+
 func (c *actor) DeleteNatGateway(ctx context.Context, id string) error {
 	current, err := c.GetNatGateway(ctx, id)
 	if err != nil {
@@ -755,7 +934,7 @@ func (c *actor) DeleteNatGateway(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		current, err := c.GetNatGateway(ctx, id)
 		if err != nil {
 			return false, err
@@ -804,7 +983,7 @@ func (c *actor) DeleteVSwitch(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		current, err := c.GetVSwitch(ctx, id)
 		if err != nil {
 			return false, err
@@ -828,6 +1007,9 @@ func (c *actor) CreateVSwitch(ctx context.Context, vsw *VSwitch) (*VSwitch, erro
 	req.VpcId = *vsw.VpcId
 	req.CidrBlock = vsw.CidrBlock
 	req.ZoneId = vsw.ZoneId
+	if vsw.IPv6CidrBlockIndex != nil {
+		req.Ipv6CidrBlock = requests.NewInteger(int(*vsw.IPv6CidrBlockIndex))
+	}
 
 	resp, err := callApi(c.vpcClient.CreateVSwitch, req)
 
@@ -836,7 +1018,7 @@ func (c *actor) CreateVSwitch(ctx context.Context, vsw *VSwitch) (*VSwitch, erro
 	}
 
 	var created *VSwitch
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		created, err = c.GetVSwitch(ctx, resp.VSwitchId)
 		if err != nil {
 			return false, err
@@ -920,7 +1102,7 @@ func (c *actor) DeleteVpc(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		current, err := c.GetVpc(ctx, id)
 		if err != nil {
 			return false, err
@@ -942,6 +1124,10 @@ func (c *actor) CreateVpc(ctx context.Context, desired *VPC) (*VPC, error) {
 	req := vpc.CreateCreateVpcRequest()
 	req.VpcName = desired.Name
 	req.CidrBlock = desired.CidrBlock
+	if desired.EnableIPv6 {
+		req.EnableIpv6 = requests.NewBoolean(true)
+	}
+	req.ResourceGroupId = desired.ResourceGroupId
 
 	resp, err := callApi(c.vpcClient.CreateVpc, req)
 	if err != nil {
@@ -949,7 +1135,7 @@ func (c *actor) CreateVpc(ctx context.Context, desired *VPC) (*VPC, error) {
 	}
 
 	var created *VPC
-	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
 		created, err = c.GetVpc(ctx, resp.VpcId)
 		if err != nil {
 			return false, err
@@ -1000,181 +1186,1060 @@ func (c *actor) FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error) {
 	return c.ListVpcs(ctx, idList)
 }
 
-func (c *actor) createVpcTags(resources []string, tags Tags, resourceType string) error {
-	req := vpc.CreateTagResourcesRequest()
-	req.ResourceType = resourceType
-	req.ResourceId = &resources
+func (c *actor) EnableVpcIPv6(ctx context.Context, id string) error {
+	req := vpc.CreateModifyVpcAttributeRequest()
+	req.VpcId = id
+	req.EnableIPv6 = requests.NewBoolean(true)
 
-	var reqTag []vpc.TagResourcesTag
-	for k, v := range tags {
-		reqTag = append(reqTag, vpc.TagResourcesTag{Key: k, Value: v})
+	_, err := callApi(c.vpcClient.ModifyVpcAttribute, req)
+	if err != nil {
+		return err
 	}
-	req.Tag = &reqTag
-
-	_, err := callApi(c.vpcClient.TagResources, req)
-	return err
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetVpc(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if current == nil {
+			return false, fmt.Errorf("vpc %s not found", id)
+		}
+		return current.IPv6CidrBlock != "", nil
+	})
 }
 
-func (c *actor) deleteVpcTags(resources []string, tags Tags, resourceType string) error {
-	req := vpc.CreateUnTagResourcesRequest()
-	req.ResourceType = resourceType
-	req.ResourceId = &resources
+func (c *actor) EnableVSwitchIPv6(ctx context.Context, id string, ipv6CidrBlockIndex int32) error {
+	req := vpc.CreateModifyVSwitchAttributeRequest()
+	req.VSwitchId = id
+	req.EnableIPv6 = requests.NewBoolean(true)
+	req.Ipv6CidrBlock = requests.NewInteger(int(ipv6CidrBlockIndex))
 
-	var reqTag []string
-	for k := range tags {
-		reqTag = append(reqTag, k)
+	_, err := callApi(c.vpcClient.ModifyVSwitchAttribute, req)
+	if err != nil {
+		return err
 	}
-	req.TagKey = &reqTag
-	_, err := callApi(c.vpcClient.UnTagResources, req)
-	return err
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetVSwitch(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if current == nil {
+			return false, fmt.Errorf("vswitch %s not found", id)
+		}
+		return current.IPv6CidrBlock != "", nil
+	})
 }
 
-func (c *actor) listEcsTagResources(_ context.Context, req *ecs.ListTagResourcesRequest) ([]string, error) {
-	var idList []string
+func (c *actor) CreateIPv6Gateway(ctx context.Context, gw *IPv6Gateway) (*IPv6Gateway, error) {
+	req := vpc.CreateCreateIpv6GatewayRequest()
+	req.Name = gw.Name
+	req.VpcId = gw.VpcId
+	req.ResourceGroupId = gw.ResourceGroupId
+	var reqTag []vpc.CreateIpv6GatewayTag
+	for k, v := range gw.Tags {
+		reqTag = append(reqTag, vpc.CreateIpv6GatewayTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTag
 
-	respList, err := page_call(c.ecsClient.ListTagResources, req)
+	resp, err := callApi(c.vpcClient.CreateIpv6Gateway, req)
 	if err != nil {
 		return nil, err
 	}
 
-	var theList []ecs.TagResource
-	for _, resp := range respList {
-		theList = append(theList, resp.TagResources.TagResource...)
-	}
-	for _, item := range theList {
-		if !contains(idList, item.ResourceId) {
-			idList = append(idList, item.ResourceId)
+	var created *IPv6Gateway
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.GetIPv6Gateway(ctx, resp.Ipv6GatewayId)
+		if err != nil {
+			return false, err
+		}
+		if created == nil {
+			return false, nil
+		}
+		if *created.Status != "Available" {
+			return false, nil
 		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
-	return idList, nil
+	return created, nil
 }
 
-func (c *actor) listVpcTagResources(_ context.Context, req *vpc.ListTagResourcesRequest) ([]string, error) {
-	var idList []string
+func (c *actor) GetIPv6Gateway(_ context.Context, id string) (*IPv6Gateway, error) {
+	req := vpc.CreateDescribeIpv6GatewaysRequest()
+	req.Ipv6GatewayId = id
 
-	respList, err := page_call(c.vpcClient.ListTagResources, req)
-	if err != nil {
-		return nil, err
-	}
+	resp, err := c.describeIPv6Gateways(req)
+	return single(resp, err)
+}
 
-	var theList []vpc.TagResource
-	for _, resp := range respList {
-		theList = append(theList, resp.TagResources.TagResource...)
-	}
-	for _, item := range theList {
-		if !contains(idList, item.ResourceId) {
-			idList = append(idList, item.ResourceId)
-		}
+func (c *actor) FindIPv6GatewaysByTags(_ context.Context, tags Tags) ([]*IPv6Gateway, error) {
+	req := vpc.CreateDescribeIpv6GatewaysRequest()
+	var reqTag []vpc.DescribeIpv6GatewaysTags
+	for k, v := range tags {
+		reqTag = append(reqTag, vpc.DescribeIpv6GatewaysTags{Key: k, Value: v})
 	}
+	req.Tags = &reqTag
 
-	return idList, nil
+	return c.describeIPv6Gateways(req)
 }
 
-func (c *actor) describeSecurityGroup(req *ecs.DescribeSecurityGroupsRequest) ([]*SecurityGroup, error) {
-	var objList []*SecurityGroup
+func (c *actor) FindIPv6GatewayByVPC(_ context.Context, vpcId string) (*IPv6Gateway, error) {
+	req := vpc.CreateDescribeIpv6GatewaysRequest()
+	req.VpcId = vpcId
 
-	respList, err := page_call(c.ecsClient.DescribeSecurityGroups, req)
+	resp, err := c.describeIPv6Gateways(req)
+	return single(resp, err)
+}
+
+func (c *actor) DeleteIPv6Gateway(ctx context.Context, id string) error {
+	current, err := c.GetIPv6Gateway(ctx, id)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var theList []ecs.SecurityGroup
-	for _, resp := range respList {
-		theList = append(theList, resp.SecurityGroups.SecurityGroup...)
+	if current == nil {
+		return nil
 	}
+	req := vpc.CreateDeleteIpv6GatewayRequest()
+	req.Ipv6GatewayId = id
 
-	for _, item := range theList {
-		entry, err := c.fromSecurityGroup(item)
-		if err == nil && entry != nil {
-			objList = append(objList, entry)
+	_, err = callApi(c.vpcClient.DeleteIpv6Gateway, req)
+	if err != nil {
+		return err
+	}
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetIPv6Gateway(ctx, id)
+		if err != nil {
+			return false, err
 		}
+		if current == nil {
+			return true, nil
+		}
+		return false, nil
+	})
+
+	if err != nil {
+		return err
 	}
 
-	return objList, nil
+	return nil
 }
 
-func (c *actor) describeSNATEntry(req *vpc.DescribeSnatTableEntriesRequest) ([]*SNATEntry, error) {
-	var entryList []*SNATEntry
+func (c *actor) AttachCENChildInstance(ctx context.Context, cenId, vpcId, region string) error {
+	req := cbn.CreateAttachCenChildInstanceRequest()
+	req.CenId = cenId
+	req.ChildInstanceId = vpcId
+	req.ChildInstanceRegionId = region
+	req.ChildInstanceType = cenChildInstanceTypeVPC
 
-	respList, err := page_call(c.vpcClient.DescribeSnatTableEntries, req)
+	_, err := callApi(c.cbnClient.AttachCenChildInstance, req)
 	if err != nil {
-		return nil, err
-	}
-	var theList []vpc.SnatTableEntry
-	for _, resp := range respList {
-		theList = append(theList, resp.SnatTableEntries.SnatTableEntry...)
+		return err
 	}
-
-	for _, item := range theList {
-		entry, err := c.fromSNATEntry(item)
-		if err == nil && entry != nil {
-			entryList = append(entryList, entry)
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetCENChildInstance(ctx, cenId, vpcId, region)
+		if err != nil {
+			return false, err
 		}
-	}
-
-	return entryList, nil
+		return current != nil && current.Status == "Attached", nil
+	})
 }
 
-func (c *actor) describeEIP(req *vpc.DescribeEipAddressesRequest) ([]*EIP, error) {
-	var eipList []*EIP
+func (c *actor) GetCENChildInstance(_ context.Context, cenId, vpcId, region string) (*CENChildInstance, error) {
+	req := cbn.CreateDescribeCenAttachedChildInstancesRequest()
+	req.CenId = cenId
+	req.ChildInstanceRegionId = region
+	req.ChildInstanceType = cenChildInstanceTypeVPC
 
-	respList, err := page_call(c.vpcClient.DescribeEipAddresses, req)
+	resList, err := page_call(c.cbnClient.DescribeCenAttachedChildInstances, req)
 	if err != nil {
 		return nil, err
 	}
-	var theList []vpc.EipAddress
-	for _, resp := range respList {
-		theList = append(theList, resp.EipAddresses.EipAddress...)
-	}
-
-	for _, item := range theList {
-		eip, err := c.fromEip(item)
-		if err == nil && eip != nil {
-			eipList = append(eipList, eip)
+	for _, res := range resList {
+		for _, item := range res.ChildInstances.ChildInstance {
+			if item.ChildInstanceId == vpcId {
+				return &CENChildInstance{
+					CenId:           item.CenId,
+					ChildInstanceId: item.ChildInstanceId,
+					RegionId:        item.ChildInstanceRegionId,
+					Status:          item.Status,
+				}, nil
+			}
 		}
 	}
-
-	return eipList, nil
+	return nil, nil
 }
 
-func (c *actor) describeNatGateway(req *vpc.DescribeNatGatewaysRequest) ([]*NatGateway, error) {
-	var ngwList []*NatGateway
-
-	respList, err := page_call(c.vpcClient.DescribeNatGateways, req)
+func (c *actor) DetachCENChildInstance(ctx context.Context, cenId, vpcId, region string) error {
+	current, err := c.GetCENChildInstance(ctx, cenId, vpcId, region)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var theList []vpc.NatGateway
-	for _, resp := range respList {
-		theList = append(theList, resp.NatGateways.NatGateway...)
+	if current == nil {
+		return nil
 	}
+	req := cbn.CreateDetachCenChildInstanceRequest()
+	req.CenId = cenId
+	req.ChildInstanceId = vpcId
+	req.ChildInstanceRegionId = region
+	req.ChildInstanceType = cenChildInstanceTypeVPC
 
-	for _, item := range theList {
-		ngw, err := c.fromNatGateway(item)
-		if err == nil && ngw != nil {
-			ngwList = append(ngwList, ngw)
-		}
+	_, err = callApi(c.cbnClient.DetachCenChildInstance, req)
+	if err != nil {
+		return err
 	}
-
-	return ngwList, nil
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetCENChildInstance(ctx, cenId, vpcId, region)
+		if err != nil {
+			return false, err
+		}
+		return current == nil, nil
+	})
 }
 
-func (c *actor) describeVpcs(req *vpc.DescribeVpcsRequest) ([]*VPC, error) {
-	var vpcList []*VPC
+func (c *actor) CreateTransitRouterVpcAttachment(ctx context.Context, attachment *TransitRouterVpcAttachment) (*TransitRouterVpcAttachment, error) {
+	req := cbn.CreateCreateTransitRouterVpcAttachmentRequest()
+	req.CenId = attachment.CenId
+	req.TransitRouterId = attachment.TransitRouterId
+	req.VpcId = attachment.VpcId
+	req.TransitRouterAttachmentName = attachment.Name
+	req.AutoPublishRouteEnabled = requests.NewBoolean(true)
+	var zoneMappings []cbn.CreateTransitRouterVpcAttachmentZoneMappings
+	for _, zoneId := range slices.Sorted(maps.Keys(attachment.ZoneMappings)) {
+		zoneMappings = append(zoneMappings, cbn.CreateTransitRouterVpcAttachmentZoneMappings{ZoneId: zoneId, VSwitchId: attachment.ZoneMappings[zoneId]})
+	}
+	req.ZoneMappings = &zoneMappings
+	var reqTag []cbn.CreateTransitRouterVpcAttachmentTag
+	for k, v := range attachment.Tags {
+		reqTag = append(reqTag, cbn.CreateTransitRouterVpcAttachmentTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTag
 
-	respList, err := page_call(c.vpcClient.DescribeVpcs, req)
+	resp, err := callApi(c.cbnClient.CreateTransitRouterVpcAttachment, req)
 	if err != nil {
 		return nil, err
 	}
-	var theList []vpc.Vpc
-	for _, resp := range respList {
-		theList = append(theList, resp.Vpcs.Vpc...)
-	}
 
-	for _, item := range theList {
-		vpc, err := c.fromVpc(item)
-		if err == nil && vpc != nil {
-			vpcList = append(vpcList, vpc)
+	var created *TransitRouterVpcAttachment
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.GetTransitRouterVpcAttachment(ctx, resp.TransitRouterAttachmentId)
+		if err != nil {
+			return false, err
+		}
+		return created != nil && created.Status == "Attached", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *actor) GetTransitRouterVpcAttachment(_ context.Context, id string) (*TransitRouterVpcAttachment, error) {
+	req := cbn.CreateListTransitRouterVpcAttachmentsRequest()
+	req.TransitRouterAttachmentId = id
+
+	resp, err := c.listTransitRouterVpcAttachments(req)
+	return single(resp, err)
+}
+
+func (c *actor) FindTransitRouterVpcAttachmentByVPC(_ context.Context, transitRouterId, vpcId string) (*TransitRouterVpcAttachment, error) {
+	req := cbn.CreateListTransitRouterVpcAttachmentsRequest()
+	req.TransitRouterId = transitRouterId
+	req.VpcId = vpcId
+
+	resp, err := c.listTransitRouterVpcAttachments(req)
+	return single(resp, err)
+}
+
+func (c *actor) AddZonesToTransitRouterVpcAttachment(ctx context.Context, id string, zoneMappings map[string]string) error {
+	req := cbn.CreateUpdateTransitRouterVpcAttachmentZonesRequest()
+	req.TransitRouterAttachmentId = id
+	var addZoneMappings []cbn.UpdateTransitRouterVpcAttachmentZonesAddZoneMappings
+	for _, zoneId := range slices.Sorted(maps.Keys(zoneMappings)) {
+		addZoneMappings = append(addZoneMappings, cbn.UpdateTransitRouterVpcAttachmentZonesAddZoneMappings{ZoneId: zoneId, VSwitchId: zoneMappings[zoneId]})
+	}
+	req.AddZoneMappings = &addZoneMappings
+
+	if _, err := callApi(c.cbnClient.UpdateTransitRouterVpcAttachmentZones, req); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetTransitRouterVpcAttachment(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if current == nil {
+			return false, fmt.Errorf("transit router VPC attachment %s not found", id)
+		}
+		return current.Status == "Attached" && hasZoneMappings(current.ZoneMappings, zoneMappings), nil
+	})
+}
+
+func (c *actor) DeleteTransitRouterVpcAttachment(ctx context.Context, id string) error {
+	current, err := c.GetTransitRouterVpcAttachment(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	req := cbn.CreateDeleteTransitRouterVpcAttachmentRequest()
+	req.TransitRouterAttachmentId = id
+
+	_, err = callApi(c.cbnClient.DeleteTransitRouterVpcAttachment, req)
+	if err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetTransitRouterVpcAttachment(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return current == nil, nil
+	})
+}
+
+func (c *actor) CreateTransitRouterRouteEntry(ctx context.Context, entry *TransitRouterRouteEntry) (*TransitRouterRouteEntry, error) {
+	req := cbn.CreateCreateTransitRouterRouteEntryRequest()
+	req.TransitRouterRouteTableId = entry.TransitRouterRouteTableId
+	req.TransitRouterRouteEntryDestinationCidrBlock = entry.DestinationCidrBlock
+	req.TransitRouterRouteEntryNextHopType = "Attachment"
+	req.TransitRouterRouteEntryNextHopId = entry.NextHopId
+	req.TransitRouterRouteEntryName = entry.Name
+
+	_, err := callApi(c.cbnClient.CreateTransitRouterRouteEntry, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *TransitRouterRouteEntry
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.FindTransitRouterRouteEntry(ctx, entry.TransitRouterRouteTableId, entry.DestinationCidrBlock)
+		if err != nil {
+			return false, err
+		}
+		return created != nil && created.Status == "Active", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *actor) FindTransitRouterRouteEntry(_ context.Context, routeTableId, destinationCidrBlock string) (*TransitRouterRouteEntry, error) {
+	req := cbn.CreateListTransitRouterRouteEntriesRequest()
+	req.TransitRouterRouteTableId = routeTableId
+	req.TransitRouterRouteEntryDestinationCidrBlock = destinationCidrBlock
+	req.TransitRouterRouteEntryType = "Static"
+
+	resp, err := callApi(c.cbnClient.ListTransitRouterRouteEntries, req)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*TransitRouterRouteEntry
+	for _, item := range resp.TransitRouterRouteEntries {
+		entries = append(entries, &TransitRouterRouteEntry{
+			Name:                      item.TransitRouterRouteEntryName,
+			TransitRouterRouteTableId: routeTableId,
+			TransitRouterRouteEntryId: item.TransitRouterRouteEntryId,
+			DestinationCidrBlock:      item.TransitRouterRouteEntryDestinationCidrBlock,
+			NextHopId:                 item.TransitRouterRouteEntryNextHopId,
+			Status:                    item.TransitRouterRouteEntryStatus,
+		})
+	}
+	return single(entries, nil)
+}
+
+func (c *actor) DeleteTransitRouterRouteEntry(ctx context.Context, routeTableId, destinationCidrBlock string) error {
+	current, err := c.FindTransitRouterRouteEntry(ctx, routeTableId, destinationCidrBlock)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	req := cbn.CreateDeleteTransitRouterRouteEntryRequest()
+	req.TransitRouterRouteEntryId = current.TransitRouterRouteEntryId
+
+	_, err = callApi(c.cbnClient.DeleteTransitRouterRouteEntry, req)
+	if err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.FindTransitRouterRouteEntry(ctx, routeTableId, destinationCidrBlock)
+		if err != nil {
+			return false, err
+		}
+		return current == nil, nil
+	})
+}
+
+func (c *actor) listTransitRouterVpcAttachments(req *cbn.ListTransitRouterVpcAttachmentsRequest) ([]*TransitRouterVpcAttachment, error) {
+	var attachments []*TransitRouterVpcAttachment
+	for {
+		resp, err := callApi(c.cbnClient.ListTransitRouterVpcAttachments, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.TransitRouterAttachments {
+			attachments = append(attachments, c.fromTransitRouterVpcAttachment(item))
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		req.NextToken = resp.NextToken
+	}
+	return attachments, nil
+}
+
+func (c *actor) CreateVpcEndpoint(ctx context.Context, endpoint *VPCEndpoint) (*VPCEndpoint, error) {
+	req := privatelink.CreateCreateVpcEndpointRequest()
+	req.EndpointName = endpoint.Name
+	req.EndpointType = "Interface"
+	req.ServiceName = endpoint.ServiceName
+	req.VpcId = endpoint.VpcId
+	req.ResourceGroupId = endpoint.ResourceGroupId
+	securityGroupIds := slices.Clone(endpoint.SecurityGroupIds)
+	req.SecurityGroupId = &securityGroupIds
+	var zones []privatelink.CreateVpcEndpointZone
+	for _, zoneId := range slices.Sorted(maps.Keys(endpoint.ZoneMappings)) {
+		zones = append(zones, privatelink.CreateVpcEndpointZone{ZoneId: zoneId, VSwitchId: endpoint.ZoneMappings[zoneId]})
+	}
+	req.Zone = &zones
+	var reqTag []privatelink.CreateVpcEndpointTag
+	for k, v := range endpoint.Tags {
+		reqTag = append(reqTag, privatelink.CreateVpcEndpointTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTag
+
+	resp, err := callApi(c.privateLinkClient.CreateVpcEndpoint, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *VPCEndpoint
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.GetVpcEndpoint(ctx, resp.EndpointId)
+		if err != nil {
+			return false, err
+		}
+		return created != nil && created.Status == "Active", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *actor) GetVpcEndpoint(_ context.Context, id string) (*VPCEndpoint, error) {
+	req := privatelink.CreateListVpcEndpointsRequest()
+	req.EndpointId = id
+
+	resp, err := c.listVpcEndpoints(req)
+	return single(resp, err)
+}
+
+func (c *actor) FindVpcEndpointsByTags(_ context.Context, tags Tags) ([]*VPCEndpoint, error) {
+	req := privatelink.CreateListVpcEndpointsRequest()
+	var reqTag []privatelink.ListVpcEndpointsTag
+	for k, v := range tags {
+		reqTag = append(reqTag, privatelink.ListVpcEndpointsTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTag
+
+	return c.listVpcEndpoints(req)
+}
+
+// AddZonesToVpcEndpoint adds the zones one after the other, as an endpoint can only be modified once the previous
+// modification has been completed.
+func (c *actor) AddZonesToVpcEndpoint(ctx context.Context, id string, zoneMappings map[string]string) error {
+	for _, zoneId := range slices.Sorted(maps.Keys(zoneMappings)) {
+		req := privatelink.CreateAddZoneToVpcEndpointRequest()
+		req.EndpointId = id
+		req.ZoneId = zoneId
+		req.VSwitchId = zoneMappings[zoneId]
+
+		if _, err := callApi(c.privateLinkClient.AddZoneToVpcEndpoint, req); err != nil {
+			return err
+		}
+		err := wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+			current, err := c.GetVpcEndpoint(ctx, id)
+			if err != nil {
+				return false, err
+			}
+			if current == nil {
+				return false, fmt.Errorf("VPC endpoint %s not found", id)
+			}
+			return current.Status == "Active" && current.ZoneMappings[zoneId] == zoneMappings[zoneId], nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *actor) DeleteVpcEndpoint(ctx context.Context, id string) error {
+	current, err := c.GetVpcEndpoint(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	req := privatelink.CreateDeleteVpcEndpointRequest()
+	req.EndpointId = id
+
+	_, err = callApi(c.privateLinkClient.DeleteVpcEndpoint, req)
+	if err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetVpcEndpoint(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return current == nil, nil
+	})
+}
+
+func (c *actor) listVpcEndpoints(req *privatelink.ListVpcEndpointsRequest) ([]*VPCEndpoint, error) {
+	var endpoints []*VPCEndpoint
+	for {
+		resp, err := callApi(c.privateLinkClient.ListVpcEndpoints, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Endpoints {
+			endpoint := c.fromVpcEndpoint(item)
+			// the zones are not part of the list response
+			endpoint.ZoneMappings, err = c.listVpcEndpointZones(item.EndpointId)
+			if err != nil {
+				return nil, err
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		req.NextToken = resp.NextToken
+	}
+	return endpoints, nil
+}
+
+// listVpcEndpointZones returns the vswitches of the endpoint with the given id keyed by their zones.
+func (c *actor) listVpcEndpointZones(id string) (map[string]string, error) {
+	req := privatelink.CreateListVpcEndpointZonesRequest()
+	req.EndpointId = id
+	zoneMappings := map[string]string{}
+	for {
+		resp, err := callApi(c.privateLinkClient.ListVpcEndpointZones, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range resp.Zones {
+			zoneMappings[zone.ZoneId] = zone.VSwitchId
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		req.NextToken = resp.NextToken
+	}
+	return zoneMappings, nil
+}
+
+func (c *actor) ListRouteTables(_ context.Context, vpcId string) ([]*RouteTable, error) {
+	req := vpc.CreateDescribeRouteTableListRequest()
+	req.VpcId = vpcId
+
+	resps, err := page_call(c.vpcClient.DescribeRouteTableList, req)
+	if err != nil {
+		return nil, err
+	}
+	var routeTables []*RouteTable
+	for _, resp := range resps {
+		for _, item := range resp.RouterTableList.RouterTableListType {
+			routeTables = append(routeTables, &RouteTable{
+				RouteTableId:   item.RouteTableId,
+				RouteTableType: item.RouteTableType,
+				VSwitchIds:     slices.Clone(item.VSwitchIds.VSwitchId),
+			})
+		}
+	}
+	return routeTables, nil
+}
+
+func (c *actor) CreateVpcGatewayEndpoint(ctx context.Context, endpoint *VPCGatewayEndpoint) (*VPCGatewayEndpoint, error) {
+	req := vpc.CreateCreateVpcGatewayEndpointRequest()
+	req.EndpointName = endpoint.Name
+	req.ServiceName = endpoint.ServiceName
+	req.VpcId = endpoint.VpcId
+	req.ResourceGroupId = endpoint.ResourceGroupId
+	var reqTag []vpc.CreateVpcGatewayEndpointTag
+	for k, v := range endpoint.Tags {
+		reqTag = append(reqTag, vpc.CreateVpcGatewayEndpointTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTag
+
+	resp, err := callApi(c.vpcClient.CreateVpcGatewayEndpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.waitForVpcGatewayEndpoint(ctx, resp.EndpointId); err != nil {
+		return nil, err
+	}
+	if len(endpoint.RouteTableIds) > 0 {
+		if err := c.AssociateRouteTablesWithVpcGatewayEndpoint(ctx, resp.EndpointId, endpoint.RouteTableIds); err != nil {
+			return nil, err
+		}
+	}
+	return c.GetVpcGatewayEndpoint(ctx, resp.EndpointId)
+}
+
+func (c *actor) GetVpcGatewayEndpoint(_ context.Context, id string) (*VPCGatewayEndpoint, error) {
+	req := vpc.CreateListVpcGatewayEndpointsRequest()
+	req.EndpointId = id
+
+	resp, err := c.listVpcGatewayEndpoints(req)
+	return single(resp, err)
+}
+
+func (c *actor) FindVpcGatewayEndpointsByTags(_ context.Context, tags Tags) ([]*VPCGatewayEndpoint, error) {
+	req := vpc.CreateListVpcGatewayEndpointsRequest()
+	var reqTag []vpc.ListVpcGatewayEndpointsTags
+	for k, v := range tags {
+		reqTag = append(reqTag, vpc.ListVpcGatewayEndpointsTags{Key: k, Value: v})
+	}
+	req.Tags = &reqTag
+
+	return c.listVpcGatewayEndpoints(req)
+}
+
+func (c *actor) AssociateRouteTablesWithVpcGatewayEndpoint(ctx context.Context, id string, routeTableIds []string) error {
+	req := vpc.CreateAssociateRouteTablesWithVpcGatewayEndpointRequest()
+	req.EndpointId = id
+	ids := slices.Clone(routeTableIds)
+	req.RouteTableIds = &ids
+
+	if _, err := callApi(c.vpcClient.AssociateRouteTablesWithVpcGatewayEndpoint, req); err != nil {
+		return err
+	}
+	return c.waitForVpcGatewayEndpoint(ctx, id)
+}
+
+func (c *actor) DeleteVpcGatewayEndpoint(ctx context.Context, id string) error {
+	current, err := c.GetVpcGatewayEndpoint(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	// an endpoint can only be deleted once it is no longer associated with any route table
+	if len(current.RouteTableIds) > 0 {
+		req := vpc.CreateDissociateRouteTablesFromVpcGatewayEndpointRequest()
+		req.EndpointId = id
+		ids := slices.Clone(current.RouteTableIds)
+		req.RouteTableIds = &ids
+		if _, err := callApi(c.vpcClient.DissociateRouteTablesFromVpcGatewayEndpoint, req); err != nil {
+			return err
+		}
+		if err := c.waitForVpcGatewayEndpoint(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	req := vpc.CreateDeleteVpcGatewayEndpointRequest()
+	req.EndpointId = id
+	if _, err := callApi(c.vpcClient.DeleteVpcGatewayEndpoint, req); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetVpcGatewayEndpoint(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return current == nil, nil
+	})
+}
+
+// waitForVpcGatewayEndpoint waits until the VPC gateway endpoint with the given id has finished its current operation.
+func (c *actor) waitForVpcGatewayEndpoint(ctx context.Context, id string) error {
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		current, err := c.GetVpcGatewayEndpoint(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return current != nil && current.Status == "Created", nil
+	})
+}
+
+func (c *actor) listVpcGatewayEndpoints(req *vpc.ListVpcGatewayEndpointsRequest) ([]*VPCGatewayEndpoint, error) {
+	resps, err := page_call(c.vpcClient.ListVpcGatewayEndpoints, req)
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []*VPCGatewayEndpoint
+	for _, resp := range resps {
+		for _, item := range resp.Endpoints {
+			endpoints = append(endpoints, c.fromVpcGatewayEndpoint(item))
+		}
+	}
+	return endpoints, nil
+}
+
+func (c *actor) CreatePrivateZone(ctx context.Context, zone *PrivateZone) (*PrivateZone, error) {
+	req := pvtz.CreateAddZoneRequest()
+	req.ZoneName = zone.Name
+	req.ResourceGroupId = zone.ResourceGroupId
+
+	resp, err := callApi(c.pvtzClient.AddZone, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zone.Tags) > 0 {
+		tagReq := pvtz.CreateTagResourcesRequest()
+		tagReq.ResourceType = "ZONE"
+		tagReq.ResourceId = &[]string{resp.ZoneId}
+		var reqTag []pvtz.TagResourcesTag
+		for k, v := range zone.Tags {
+			reqTag = append(reqTag, pvtz.TagResourcesTag{Key: k, Value: v})
+		}
+		tagReq.Tag = &reqTag
+		if _, err := callApi(c.pvtzClient.TagResources, tagReq); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(zone.VpcIds) > 0 {
+		bindReq := pvtz.CreateBindZoneVpcRequest()
+		bindReq.ZoneId = resp.ZoneId
+		var vpcs []pvtz.BindZoneVpcVpcs
+		for _, vpcId := range zone.VpcIds {
+			vpcs = append(vpcs, pvtz.BindZoneVpcVpcs{RegionId: c.region, VpcId: vpcId})
+		}
+		bindReq.Vpcs = &vpcs
+		if _, err := callApi(c.pvtzClient.BindZoneVpc, bindReq); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.GetPrivateZone(ctx, resp.ZoneId)
+}
+
+// GetPrivateZone returns the private zone with the given id. The tags of the zone are not returned.
+func (c *actor) GetPrivateZone(_ context.Context, id string) (*PrivateZone, error) {
+	req := pvtz.CreateDescribeZoneInfoRequest()
+	req.ZoneId = id
+
+	resp, err := callApi(c.pvtzClient.DescribeZoneInfo, req)
+	if err != nil {
+		if serverErr, ok := err.(*alierrors.ServerError); ok && (serverErr.ErrorCode() == "Zone.Invalid.Id" || serverErr.ErrorCode() == "Zone.NotExists") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	zone := &PrivateZone{
+		Name:            resp.ZoneName,
+		ZoneId:          resp.ZoneId,
+		ResourceGroupId: resp.ResourceGroupId,
+	}
+	for _, item := range resp.BindVpcs.Vpc {
+		zone.VpcIds = append(zone.VpcIds, item.VpcId)
+	}
+	return zone, nil
+}
+
+func (c *actor) FindPrivateZonesByTags(_ context.Context, tags Tags) ([]*PrivateZone, error) {
+	req := pvtz.CreateDescribeZonesRequest()
+	var reqTag []pvtz.DescribeZonesResourceTag
+	for k, v := range tags {
+		reqTag = append(reqTag, pvtz.DescribeZonesResourceTag{Key: k, Value: v})
+	}
+	req.ResourceTag = &reqTag
+	req.PageSize = requests.NewInteger(100)
+
+	var zones []*PrivateZone
+	for page := 1; ; page++ {
+		req.PageNumber = requests.NewInteger(page)
+		resp, err := callApi(c.pvtzClient.DescribeZones, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Zones.Zone {
+			zones = append(zones, c.fromPrivateZone(item))
+		}
+		if page >= resp.TotalPages {
+			break
+		}
+	}
+	return zones, nil
+}
+
+func (c *actor) DeletePrivateZone(ctx context.Context, id string) error {
+	current, err := c.GetPrivateZone(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	// a zone can only be deleted once it is no longer bound to any VPC
+	if len(current.VpcIds) > 0 {
+		bindReq := pvtz.CreateBindZoneVpcRequest()
+		bindReq.ZoneId = id
+		bindReq.Vpcs = &[]pvtz.BindZoneVpcVpcs{}
+		if _, err := callApi(c.pvtzClient.BindZoneVpc, bindReq); err != nil {
+			return err
+		}
+	}
+
+	req := pvtz.CreateDeleteZoneRequest()
+	req.ZoneId = id
+	_, err = callApi(c.pvtzClient.DeleteZone, req)
+	return err
+}
+
+func (c *actor) ListPrivateZoneRecords(_ context.Context, zoneId string) ([]*PrivateZoneRecord, error) {
+	req := pvtz.CreateDescribeZoneRecordsRequest()
+	req.ZoneId = zoneId
+	req.PageSize = requests.NewInteger(100)
+
+	var records []*PrivateZoneRecord
+	for page := 1; ; page++ {
+		req.PageNumber = requests.NewInteger(page)
+		resp, err := callApi(c.pvtzClient.DescribeZoneRecords, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Records.Record {
+			records = append(records, &PrivateZoneRecord{
+				RecordId: item.RecordId,
+				ZoneId:   zoneId,
+				Rr:       item.Rr,
+				Type:     item.Type,
+				Value:    item.Value,
+				Ttl:      item.Ttl,
+			})
+		}
+		if page >= resp.TotalPages {
+			break
+		}
+	}
+	return records, nil
+}
+
+func (c *actor) CreatePrivateZoneRecord(_ context.Context, record *PrivateZoneRecord) (*PrivateZoneRecord, error) {
+	req := pvtz.CreateAddZoneRecordRequest()
+	req.ZoneId = record.ZoneId
+	req.Rr = record.Rr
+	req.Type = record.Type
+	req.Value = record.Value
+	if record.Ttl > 0 {
+		req.Ttl = requests.NewInteger(record.Ttl)
+	}
+
+	resp, err := callApi(c.pvtzClient.AddZoneRecord, req)
+	if err != nil {
+		return nil, err
+	}
+	created := *record
+	created.RecordId = resp.RecordId
+	return &created, nil
+}
+
+func (c *actor) UpdatePrivateZoneRecord(_ context.Context, record *PrivateZoneRecord) error {
+	req := pvtz.CreateUpdateZoneRecordRequest()
+	req.RecordId = requests.NewInteger64(record.RecordId)
+	req.Rr = record.Rr
+	req.Type = record.Type
+	req.Value = record.Value
+	if record.Ttl > 0 {
+		req.Ttl = requests.NewInteger(record.Ttl)
+	}
+
+	_, err := callApi(c.pvtzClient.UpdateZoneRecord, req)
+	return err
+}
+
+func (c *actor) createVpcTags(resources []string, tags Tags, resourceType string) error {
+	req := vpc.CreateTagResourcesRequest()
+	req.ResourceType = resourceType
+	req.ResourceId = &resources
+
+	var reqTag []vpc.TagResourcesTag
+	for k, v := range tags {
+		reqTag = append(reqTag, vpc.TagResourcesTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTag
+
+	_, err := callApi(c.vpcClient.TagResources, req)
+	return err
+}
+
+func (c *actor) deleteVpcTags(resources []string, tags Tags, resourceType string) error {
+	req := vpc.CreateUnTagResourcesRequest()
+	req.ResourceType = resourceType
+	req.ResourceId = &resources
+
+	var reqTag []string
+	for k := range tags {
+		reqTag = append(reqTag, k)
+	}
+	req.TagKey = &reqTag
+	_, err := callApi(c.vpcClient.UnTagResources, req)
+	return err
+}
+
+func (c *actor) listEcsTagResources(_ context.Context, req *ecs.ListTagResourcesRequest) ([]string, error) {
+	var idList []string
+
+	respList, err := page_call(c.ecsClient.ListTagResources, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var theList []ecs.TagResource
+	for _, resp := range respList {
+		theList = append(theList, resp.TagResources.TagResource...)
+	}
+	for _, item := range theList {
+		if !contains(idList, item.ResourceId) {
+			idList = append(idList, item.ResourceId)
+		}
+	}
+
+	return idList, nil
+}
+
+func (c *actor) listVpcTagResources(_ context.Context, req *vpc.ListTagResourcesRequest) ([]string, error) {
+	var idList []string
+
+	respList, err := page_call(c.vpcClient.ListTagResources, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var theList []vpc.TagResource
+	for _, resp := range respList {
+		theList = append(theList, resp.TagResources.TagResource...)
+	}
+	for _, item := range theList {
+		if !contains(idList, item.ResourceId) {
+			idList = append(idList, item.ResourceId)
+		}
+	}
+
+	return idList, nil
+}
+
+func (c *actor) describeSecurityGroup(req *ecs.DescribeSecurityGroupsRequest) ([]*SecurityGroup, error) {
+	var objList []*SecurityGroup
+
+	respList, err := page_call(c.ecsClient.DescribeSecurityGroups, req)
+	if err != nil {
+		return nil, err
+	}
+	var theList []ecs.SecurityGroup
+	for _, resp := range respList {
+		theList = append(theList, resp.SecurityGroups.SecurityGroup...)
+	}
+
+	for _, item := range theList {
+		entry, err := c.fromSecurityGroup(item)
+		if err == nil && entry != nil {
+			objList = append(objList, entry)
+		}
+	}
+
+	return objList, nil
+}
+
+func (c *actor) describeSNATEntry(req *vpc.DescribeSnatTableEntriesRequest) ([]*SNATEntry, error) {
+	var entryList []*SNATEntry
+
+	respList, err := page_call(c.vpcClient.DescribeSnatTableEntries, req)
+	if err != nil {
+		return nil, err
+	}
+	var theList []vpc.SnatTableEntry
+	for _, resp := range respList {
+		theList = append(theList, resp.SnatTableEntries.SnatTableEntry...)
+	}
+
+	for _, item := range theList {
+		entry, err := c.fromSNATEntry(item)
+		if err == nil && entry != nil {
+			entryList = append(entryList, entry)
+		}
+	}
+
+	return entryList, nil
+}
+
+func (c *actor) describeEIP(req *vpc.DescribeEipAddressesRequest) ([]*EIP, error) {
+	var eipList []*EIP
+
+	respList, err := page_call(c.vpcClient.DescribeEipAddresses, req)
+	if err != nil {
+		return nil, err
+	}
+	var theList []vpc.EipAddress
+	for _, resp := range respList {
+		theList = append(theList, resp.EipAddresses.EipAddress...)
+	}
+
+	for _, item := range theList {
+		eip, err := c.fromEip(item)
+		if err == nil && eip != nil {
+			eipList = append(eipList, eip)
+		}
+	}
+
+	return eipList, nil
+}
+
+func (c *actor) describeNatGateway(req *vpc.DescribeNatGatewaysRequest) ([]*NatGateway, error) {
+	var ngwList []*NatGateway
+
+	respList, err := page_call(c.vpcClient.DescribeNatGateways, req)
+	if err != nil {
+		return nil, err
+	}
+	var theList []vpc.NatGateway
+	for _, resp := range respList {
+		theList = append(theList, resp.NatGateways.NatGateway...)
+	}
+
+	for _, item := range theList {
+		ngw, err := c.fromNatGateway(item)
+		if err == nil && ngw != nil {
+			ngwList = append(ngwList, ngw)
+		}
+	}
+
+	return ngwList, nil
+}
+
+func (c *actor) describeVpcs(req *vpc.DescribeVpcsRequest) ([]*VPC, error) {
+	var vpcList []*VPC
+
+	respList, err := page_call(c.vpcClient.DescribeVpcs, req)
+	if err != nil {
+		return nil, err
+	}
+	var theList []vpc.Vpc
+	for _, resp := range respList {
+		theList = append(theList, resp.Vpcs.Vpc...)
+	}
+
+	for _, item := range theList {
+		vpc, err := c.fromVpc(item)
+		if err == nil && vpc != nil {
+			vpcList = append(vpcList, vpc)
 		}
 	}
 
@@ -1203,6 +2268,28 @@ func (c *actor) describeVSwitches(req *vpc.DescribeVSwitchesRequest) ([]*VSwitch
 	return vswitchList, nil
 }
 
+func (c *actor) describeIPv6Gateways(req *vpc.DescribeIpv6GatewaysRequest) ([]*IPv6Gateway, error) {
+	var gwList []*IPv6Gateway
+
+	respList, err := page_call(c.vpcClient.DescribeIpv6Gateways, req)
+	if err != nil {
+		return nil, err
+	}
+	var theList []vpc.Ipv6Gateway
+	for _, resp := range respList {
+		theList = append(theList, resp.Ipv6Gateways.Ipv6Gateway...)
+	}
+
+	for _, item := range theList {
+		gw, err := c.fromIPv6Gateway(item)
+		if err == nil && gw != nil {
+			gwList = append(gwList, gw)
+		}
+	}
+
+	return gwList, nil
+}
+
 func (c *actor) fromSecurityGroupRule(item ecs.Permission) (*SecurityGroupRule, error) {
 	rule := &SecurityGroupRule{
 		SecurityGroupRuleId: item.SecurityGroupRuleId,
@@ -1212,6 +2299,8 @@ func (c *actor) fromSecurityGroupRule(item ecs.Permission) (*SecurityGroupRule,
 		PortRange:           item.PortRange,
 		DestCidrIp:          item.DestCidrIp,
 		SourceCidrIp:        item.SourceCidrIp,
+		Ipv6DestCidrIp:      item.Ipv6DestCidrIp,
+		Ipv6SourceCidrIp:    item.Ipv6SourceCidrIp,
 		Direction:           item.Direction,
 	}
 	return rule, nil
@@ -1222,6 +2311,7 @@ func (c *actor) fromSecurityGroup(item ecs.SecurityGroup) (*SecurityGroup, error
 		Name:            item.SecurityGroupName,
 		VpcId:           item.VpcId,
 		SecurityGroupId: item.SecurityGroupId,
+		ResourceGroupId: item.ResourceGroupId,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1233,12 +2323,15 @@ func (c *actor) fromSecurityGroup(item ecs.SecurityGroup) (*SecurityGroup, error
 
 func (c *actor) fromVSwitch(item vpc.VSwitch) (*VSwitch, error) {
 	vswitch := &VSwitch{
-		Name:      item.VSwitchName,
-		VpcId:     &item.VpcId,
-		ZoneId:    item.ZoneId,
-		CidrBlock: item.CidrBlock,
-		Status:    &item.Status,
-		VSwitchId: item.VSwitchId,
+		Name:          item.VSwitchName,
+		VpcId:         &item.VpcId,
+		ZoneId:        item.ZoneId,
+		CidrBlock:     item.CidrBlock,
+		IPv6CidrBlock: item.Ipv6CidrBlock,
+		Status:        &item.Status,
+		VSwitchId:     item.VSwitchId,
+
+		ResourceGroupId: item.ResourceGroupId,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1255,6 +2348,10 @@ func (c *actor) fromNatGateway(item vpc.NatGateway) (*NatGateway, error) {
 		VpcId:        &item.VpcId,
 		Status:       &item.Status,
 		VswitchId:    &item.NatGatewayPrivateInfo.VswitchId,
+
+		InternetChargeType: item.InternetChargeType,
+		Spec:               item.Spec,
+		ResourceGroupId:    item.ResourceGroupId,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1287,11 +2384,14 @@ func (c *actor) fromEip(item vpc.EipAddress) (*EIP, error) {
 		Name:               item.Name,
 		Bandwidth:          item.Bandwidth,
 		InternetChargeType: item.InternetChargeType,
+		ISP:                item.ISP,
+		BandwidthPackageId: item.BandwidthPackageId,
 		EipId:              item.AllocationId,
 		Status:             &item.Status,
 		InstanceType:       &item.InstanceType,
 		InstanceId:         &item.InstanceId,
 		IpAddress:          item.IpAddress,
+		ResourceGroupId:    item.ResourceGroupId,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1306,8 +2406,12 @@ func (c *actor) fromVpc(item vpc.Vpc) (*VPC, error) {
 		Name:  item.VpcName,
 		VpcId: item.VpcId,
 
-		CidrBlock: item.CidrBlock,
-		Status:    &item.Status,
+		CidrBlock:     item.CidrBlock,
+		EnableIPv6:    item.Ipv6CidrBlock != "",
+		IPv6CidrBlock: item.Ipv6CidrBlock,
+		Status:        &item.Status,
+
+		ResourceGroupId: item.ResourceGroupId,
 	}
 
 	tags := Tags{}
@@ -1319,6 +2423,93 @@ func (c *actor) fromVpc(item vpc.Vpc) (*VPC, error) {
 	return vpc, nil
 }
 
+func (c *actor) fromIPv6Gateway(item vpc.Ipv6Gateway) (*IPv6Gateway, error) {
+	gw := &IPv6Gateway{
+		Name:          item.Name,
+		IPv6GatewayId: item.Ipv6GatewayId,
+		VpcId:         item.VpcId,
+		Status:        &item.Status,
+
+		ResourceGroupId: item.ResourceGroupId,
+	}
+	tags := Tags{}
+	for _, t := range item.Tags.Tag {
+		tags[t.Key] = t.Value
+	}
+	gw.Tags = tags
+	return gw, nil
+}
+
+func (c *actor) fromTransitRouterVpcAttachment(item cbn.TransitRouterAttachment) *TransitRouterVpcAttachment {
+	attachment := &TransitRouterVpcAttachment{
+		Tags:                      Tags{},
+		Name:                      item.TransitRouterAttachmentName,
+		CenId:                     item.CenId,
+		TransitRouterId:           item.TransitRouterId,
+		TransitRouterAttachmentId: item.TransitRouterAttachmentId,
+		VpcId:                     item.VpcId,
+		ZoneMappings:              map[string]string{},
+		Status:                    item.Status,
+	}
+	for _, t := range item.Tags {
+		attachment.Tags[t.Key] = t.Value
+	}
+	for _, zm := range item.ZoneMappings {
+		attachment.ZoneMappings[zm.ZoneId] = zm.VSwitchId
+	}
+	return attachment
+}
+
+func (c *actor) fromVpcEndpoint(item privatelink.Endpoint) *VPCEndpoint {
+	endpoint := &VPCEndpoint{
+		Tags:            Tags{},
+		Name:            item.EndpointName,
+		ServiceName:     item.ServiceName,
+		EndpointId:      item.EndpointId,
+		VpcId:           item.VpcId,
+		Domain:          item.EndpointDomain,
+		Status:          item.EndpointStatus,
+		ResourceGroupId: item.ResourceGroupId,
+	}
+	for _, t := range item.Tags {
+		endpoint.Tags[t.Key] = t.Value
+	}
+	return endpoint
+}
+
+func (c *actor) fromVpcGatewayEndpoint(item vpc.Endpoint) *VPCGatewayEndpoint {
+	endpoint := &VPCGatewayEndpoint{
+		Tags:            Tags{},
+		Name:            item.EndpointName,
+		ServiceName:     item.ServiceName,
+		EndpointId:      item.EndpointId,
+		VpcId:           item.VpcId,
+		RouteTableIds:   slices.Clone(item.AssociatedRouteTables),
+		Status:          item.EndpointStatus,
+		ResourceGroupId: item.ResourceGroupId,
+	}
+	for _, t := range item.Tags {
+		endpoint.Tags[t.Key] = t.Value
+	}
+	return endpoint
+}
+
+func (c *actor) fromPrivateZone(item pvtz.Zone) *PrivateZone {
+	zone := &PrivateZone{
+		Tags:            Tags{},
+		Name:            item.ZoneName,
+		ZoneId:          item.ZoneId,
+		ResourceGroupId: item.ResourceGroupId,
+	}
+	for _, t := range item.ResourceTags.ResourceTag {
+		zone.Tags[t.Key] = t.Value
+	}
+	for _, v := range item.Vpcs.Vpc {
+		zone.VpcIds = append(zone.VpcIds, v.VpcId)
+	}
+	return zone
+}
+
 func listByIds[RESP any](geter func(id string) (*RESP, error), ids []string) ([]*RESP, error) {
 	var theList []*RESP
 	for _, id := range ids {
@@ -1367,10 +2558,15 @@ func page_call[REQ any, RESP any](call func(req *REQ) (*RESP, error), req *REQ)
 		"DescribeNatGatewaysRequest",
 		"DescribeEipAddressesRequest",
 		"DescribeSnatTableEntriesRequest",
+		"DescribeIpv6GatewaysRequest",
+		"DescribeCenAttachedChildInstancesRequest",
+		"DescribeRouteTableListRequest",
 	}
 	type2_req_type_name_list := []string{
 		"ListTagResourcesRequest",
+		"DescribeTagKeysRequest",
 		"DescribeSecurityGroupsRequest",
+		"ListVpcGatewayEndpointsRequest",
 	}
 
 	reqTypeName := reflect.ValueOf(req).Elem().Type().Name()
@@ -1425,10 +2621,11 @@ func page_call_type_2[REQ any, RESP any](call func(req *REQ) (*RESP, error), req
 			break
 		}
 		reflect.ValueOf(req).Elem().FieldByName("NextToken").SetString(nextToken)
-		resp, err := callApi(call, req)
-		if err == nil {
-			theList = append(theList, *resp)
+		resp, err = callApi(call, req)
+		if err != nil {
+			return nil, err
 		}
+		theList = append(theList, *resp)
 	}
 
 	return theList, nil
@@ -1468,3 +2665,13 @@ func contains(elems []string, elem string) bool {
 	}
 	return false
 }
+
+// hasZoneMappings returns true if all the given zone mappings are contained in the current ones.
+func hasZoneMappings(current, zoneMappings map[string]string) bool {
+	for zoneId, vswitchId := range zoneMappings {
+		if current[zoneId] != vswitchId {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aliclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	mockalicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
+)
+
+var _ = Describe("Actor", func() {
+	Describe("#page_call", func() {
+		var (
+			pages map[string]*ecs.DescribeSecurityGroupsResponse
+			errs  map[string]error
+			calls []string
+			call  = func(req *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
+				calls = append(calls, req.NextToken)
+				if len(calls) > len(pages)+len(errs) {
+					// fail instead of returning an error, the pagination must not loop forever if it swallows errors
+					Fail(fmt.Sprintf("unexpected call with next token %q", req.NextToken))
+				}
+				if err := errs[req.NextToken]; err != nil {
+					return nil, err
+				}
+				return pages[req.NextToken], nil
+			}
+			page = func(nextToken string, ids ...string) *ecs.DescribeSecurityGroupsResponse {
+				resp := ecs.CreateDescribeSecurityGroupsResponse()
+				resp.NextToken = nextToken
+				for _, id := range ids {
+					resp.SecurityGroups.SecurityGroup = append(resp.SecurityGroups.SecurityGroup, ecs.SecurityGroup{SecurityGroupId: id})
+				}
+				return resp
+			}
+		)
+
+		BeforeEach(func() {
+			pages = map[string]*ecs.DescribeSecurityGroupsResponse{}
+			errs = map[string]error{}
+			calls = nil
+		})
+
+		It("should follow the next token until the last page", func() {
+			pages[""] = page("token-1", "sg-1")
+			pages["token-1"] = page("token-2", "sg-2")
+			pages["token-2"] = page("", "sg-3")
+
+			result, err := page_call(call, ecs.CreateDescribeSecurityGroupsRequest())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal([]string{"", "token-1", "token-2"}))
+			var ids []string
+			for _, resp := range result {
+				for _, sg := range resp.SecurityGroups.SecurityGroup {
+					ids = append(ids, sg.SecurityGroupId)
+				}
+			}
+			Expect(ids).To(Equal([]string{"sg-1", "sg-2", "sg-3"}))
+		})
+
+		It("should return the error of a subsequent page", func() {
+			pages[""] = page("token-1", "sg-1")
+			errs["token-1"] = fmt.Errorf("fake")
+
+			_, err := page_call(call, ecs.CreateDescribeSecurityGroupsRequest())
+			Expect(err).To(MatchError("fake"))
+			Expect(calls).To(Equal([]string{"", "token-1"}))
+		})
+	})
+
+	Describe("#ModifyNatGatewaySpec", func() {
+		It("should change the spec of the NAT gateway and pay for it automatically", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			vpcClient := mockalicloudclient.NewMockVPC(ctrl)
+			a := &actor{vpcClient: vpcClient}
+
+			vpcClient.EXPECT().ModifyNatGatewaySpec(gomock.Any()).DoAndReturn(func(req *vpc.ModifyNatGatewaySpecRequest) (*vpc.ModifyNatGatewaySpecResponse, error) {
+				Expect(req.NatGatewayId).To(Equal("ngw-1"))
+				Expect(req.Spec).To(Equal("Large"))
+				Expect(req.AutoPay.GetValue()).To(BeTrue())
+				return vpc.CreateModifyNatGatewaySpecResponse(), nil
+			})
+
+			Expect(a.ModifyNatGatewaySpec(context.Background(), "ngw-1", "Large")).To(Succeed())
+			ctrl.Finish()
+		})
+	})
+})
@@ -0,0 +1,1832 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const (
+	// DefaultResourceGroupID is the id of the resource group resources are placed in if no resource group is requested.
+	DefaultResourceGroupID = "rg-default"
+
+	statusPending       = "Pending"
+	statusCreating      = "Creating"
+	statusAvailable     = "Available"
+	statusDeleting      = "Deleting"
+	statusAssociating   = "Associating"
+	statusUnassociating = "Unassociating"
+	statusInUse         = "InUse"
+	statusReleasing     = "Releasing"
+	statusAttaching     = "Attaching"
+	statusAttached      = "Attached"
+	statusDetaching     = "Detaching"
+	statusActive        = "Active"
+	statusCreated       = "Created"
+	statusDissociating  = "Dissociating"
+	statusModifying     = "Modifying"
+
+	routeTableTypeSystem = "System"
+
+	defaultNatGatewayInternetChargeType = "PayByLcu"
+	defaultEIPBandwidth                 = "5"
+	defaultEIPInternetChargeType        = "PayByBandwidth"
+	defaultEIPISP                       = "BGP"
+)
+
+// Backend is a stateful in-memory model of the Alicloud resources managed by the infrastructure flow. It is accessed
+// through the fake clients of NewClientFactory, hence the real actor, e.g. created by NewActor, can be tested against
+// it. All clients and actors created for a backend share its resources.
+//
+// Like the Alicloud API, the backend lets new or modified resources pass through transitional statuses (e.g. Pending
+// before Available) and refuses to delete resources which other resources still depend on. Faults can be injected
+// for single operations with InjectFault.
+type Backend struct {
+	// AccountID is the id of the Alicloud account returned by the fake STS client.
+	AccountID string
+	// PendingReads is the number of reads for which a resource stays in a transitional status before it reaches its
+	// target status. It must be set before the backend is used.
+	PendingReads int
+	// NatGatewayZones are the zones in which enhanced NAT gateways are available. If it is empty, they are available in
+	// all zones in which vswitches exist. It must be set before the backend is used.
+	NatGatewayZones []string
+
+	region      string
+	lock        sync.Mutex
+	counter     int
+	calls       map[string]int
+	faults      map[string]*fault
+	transitions map[string]*transition
+
+	vpcs           map[string]*aliclient.VPC
+	vswitches      map[string]*aliclient.VSwitch
+	ipv6Gateways   map[string]*aliclient.IPv6Gateway
+	natGateways    map[string]*aliclient.NatGateway
+	eips           map[string]*aliclient.EIP
+	snatEntries    map[string]*aliclient.SNATEntry
+	securityGroups map[string]*aliclient.SecurityGroup
+	cenChildren    map[string]*aliclient.CENChildInstance
+	trAttachments  map[string]*aliclient.TransitRouterVpcAttachment
+	trRouteEntries map[string]*aliclient.TransitRouterRouteEntry
+	vpcEndpoints   map[string]*aliclient.VPCEndpoint
+	gwEndpoints    map[string]*aliclient.VPCGatewayEndpoint
+	privateZones   map[string]*aliclient.PrivateZone
+	zoneRecords    map[string]*aliclient.PrivateZoneRecord
+	// routeTables maps the VPC ids to the ids of their system route tables, which exist as long as the VPC exists.
+	routeTables map[string]string
+}
+
+// fault is an error injected for an operation.
+type fault struct {
+	err error
+	// remaining is the number of calls still failing, the fault is permanent if it is not positive.
+	remaining int
+}
+
+// transition is a pending status change of a resource, which is applied after the resource has been read a number of
+// times.
+type transition struct {
+	reads int
+	apply func()
+}
+
+// NewBackend creates a new empty backend for the given region.
+func NewBackend(region string) *Backend {
+	return &Backend{
+		AccountID:      "1234567890123456",
+		region:         region,
+		calls:          map[string]int{},
+		faults:         map[string]*fault{},
+		transitions:    map[string]*transition{},
+		vpcs:           map[string]*aliclient.VPC{},
+		vswitches:      map[string]*aliclient.VSwitch{},
+		ipv6Gateways:   map[string]*aliclient.IPv6Gateway{},
+		natGateways:    map[string]*aliclient.NatGateway{},
+		eips:           map[string]*aliclient.EIP{},
+		snatEntries:    map[string]*aliclient.SNATEntry{},
+		securityGroups: map[string]*aliclient.SecurityGroup{},
+		cenChildren:    map[string]*aliclient.CENChildInstance{},
+		trAttachments:  map[string]*aliclient.TransitRouterVpcAttachment{},
+		trRouteEntries: map[string]*aliclient.TransitRouterRouteEntry{},
+		vpcEndpoints:   map[string]*aliclient.VPCEndpoint{},
+		gwEndpoints:    map[string]*aliclient.VPCGatewayEndpoint{},
+		privateZones:   map[string]*aliclient.PrivateZone{},
+		zoneRecords:    map[string]*aliclient.PrivateZoneRecord{},
+		routeTables:    map[string]string{},
+	}
+}
+
+// Region returns the region of the backend.
+func (b *Backend) Region() string {
+	return b.region
+}
+
+// checkRegion returns an error if the backend does not serve the given region.
+func (b *Backend) checkRegion(region string) error {
+	if region != b.region {
+		return fmt.Errorf("the fake backend serves region %s, not %s", b.region, region)
+	}
+	return nil
+}
+
+// InjectFault lets the next count calls of the given operation fail with the given error. If count is not positive,
+// all calls fail until ClearFaults is called. Operations are named after the called method of the fake client, e.g.
+// "CreateNatGateway" or "DescribeVpcs". A fault injected for an operation replaces any previous one.
+func (b *Backend) InjectFault(operation string, err error, count int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.faults[operation] = &fault{err: err, remaining: count}
+}
+
+// ClearFaults removes all injected faults.
+func (b *Backend) ClearFaults() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.faults = map[string]*fault{}
+}
+
+// Calls returns the number of calls of the given operation, including the failed ones.
+func (b *Backend) Calls(operation string) int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.calls[operation]
+}
+
+// call records a call of the given operation and returns the fault injected for it, if any.
+func (b *Backend) call(operation string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.calls[operation]++
+	f := b.faults[operation]
+	if f == nil {
+		return nil
+	}
+	if f.remaining > 0 {
+		f.remaining--
+		if f.remaining == 0 {
+			delete(b.faults, operation)
+		}
+	}
+	return f.err
+}
+
+// IsEmpty returns true if the backend does not contain any resources.
+func (b *Backend) IsEmpty() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return len(b.vpcs)+len(b.vswitches)+len(b.ipv6Gateways)+len(b.natGateways)+len(b.eips)+len(b.snatEntries)+
+		len(b.securityGroups)+len(b.cenChildren)+len(b.trAttachments)+len(b.trRouteEntries)+len(b.vpcEndpoints)+
+		len(b.gwEndpoints)+len(b.privateZones)+len(b.zoneRecords) == 0
+}
+
+// VPCs returns copies of all VPCs.
+func (b *Backend) VPCs() []*aliclient.VPC {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.vpcs, copyVPC)
+}
+
+// VSwitches returns copies of all vswitches.
+func (b *Backend) VSwitches() []*aliclient.VSwitch {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.vswitches, copyVSwitch)
+}
+
+// IPv6Gateways returns copies of all IPv6 gateways.
+func (b *Backend) IPv6Gateways() []*aliclient.IPv6Gateway {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.ipv6Gateways, copyIPv6Gateway)
+}
+
+// NatGateways returns copies of all NAT gateways.
+func (b *Backend) NatGateways() []*aliclient.NatGateway {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.natGateways, copyNatGateway)
+}
+
+// EIPs returns copies of all elastic IPs.
+func (b *Backend) EIPs() []*aliclient.EIP {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.eips, copyEIP)
+}
+
+// SNATEntries returns copies of all SNAT entries.
+func (b *Backend) SNATEntries() []*aliclient.SNATEntry {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.snatEntries, copySNATEntry)
+}
+
+// SecurityGroups returns copies of all security groups including their rules.
+func (b *Backend) SecurityGroups() []*aliclient.SecurityGroup {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.securityGroups, copySecurityGroup)
+}
+
+// CENChildInstances returns copies of all VPCs attached to CEN instances.
+func (b *Backend) CENChildInstances() []*aliclient.CENChildInstance {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.cenChildren, copyCENChildInstance)
+}
+
+// TransitRouterVpcAttachments returns copies of all transit router VPC attachments.
+func (b *Backend) TransitRouterVpcAttachments() []*aliclient.TransitRouterVpcAttachment {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.trAttachments, copyTransitRouterVpcAttachment)
+}
+
+// TransitRouterRouteEntries returns copies of all transit router route entries.
+func (b *Backend) TransitRouterRouteEntries() []*aliclient.TransitRouterRouteEntry {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.trRouteEntries, copyTransitRouterRouteEntry)
+}
+
+// VPCEndpoints returns copies of all VPC endpoints.
+func (b *Backend) VPCEndpoints() []*aliclient.VPCEndpoint {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.vpcEndpoints, copyVPCEndpoint)
+}
+
+// VPCGatewayEndpoints returns copies of all VPC gateway endpoints.
+func (b *Backend) VPCGatewayEndpoints() []*aliclient.VPCGatewayEndpoint {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.gwEndpoints, copyVPCGatewayEndpoint)
+}
+
+// PrivateZones returns copies of all private zones.
+func (b *Backend) PrivateZones() []*aliclient.PrivateZone {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return all(b.privateZones, copyPrivateZone)
+}
+
+// PrivateZoneRecords returns copies of all records of the private zone with the given id.
+func (b *Backend) PrivateZoneRecords(zoneId string) []*aliclient.PrivateZoneRecord {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	var result []*aliclient.PrivateZoneRecord
+	for _, record := range all(b.zoneRecords, copyPrivateZoneRecord) {
+		if record.ZoneId == zoneId {
+			result = append(result, record)
+		}
+	}
+	return result
+}
+
+func (b *Backend) nextNumber() int {
+	b.counter++
+	return b.counter
+}
+
+func (b *Backend) newID(prefix string) string {
+	return fmt.Sprintf("%s-%08d", prefix, b.nextNumber())
+}
+
+// transit schedules the given status change of the resource with the given id. It is applied immediately if
+// PendingReads is not positive. A status change which is still pending for the resource is applied first.
+func (b *Backend) transit(id string, apply func()) {
+	b.settle(id)
+	if b.PendingReads <= 0 {
+		apply()
+		return
+	}
+	b.transitions[id] = &transition{reads: b.PendingReads, apply: apply}
+}
+
+// observe records a read of the resource with the given id and applies its pending status change once it has been
+// read often enough.
+func (b *Backend) observe(id string) {
+	t := b.transitions[id]
+	if t == nil {
+		return
+	}
+	if t.reads > 0 {
+		t.reads--
+		return
+	}
+	delete(b.transitions, id)
+	t.apply()
+}
+
+// settle applies the pending status change of the resource with the given id immediately.
+func (b *Backend) settle(id string) {
+	if t := b.transitions[id]; t != nil {
+		delete(b.transitions, id)
+		t.apply()
+	}
+}
+
+func resourceGroupOrDefault(id string) string {
+	if id == "" {
+		return DefaultResourceGroupID
+	}
+	return id
+}
+
+func parseCIDR(cidr string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, invalidParameter("InvalidCidrBlock.Malformed", fmt.Sprintf("The CIDR block %q is malformed.", cidr))
+	}
+	return ipNet, nil
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func contains(outer, inner *net.IPNet) bool {
+	outerSize, _ := outer.Mask.Size()
+	innerSize, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && innerSize >= outerSize
+}
+
+// VPCs
+
+func (b *Backend) createVpc(desired *aliclient.VPC) (*aliclient.VPC, error) {
+	if _, err := parseCIDR(desired.CidrBlock); err != nil {
+		return nil, err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vpc := &aliclient.VPC{
+		Tags:            maps.Clone(desired.Tags),
+		Name:            desired.Name,
+		VpcId:           b.newID("vpc"),
+		CidrBlock:       desired.CidrBlock,
+		Status:          ptr.To(statusPending),
+		ResourceGroupId: resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if vpc.Tags == nil {
+		vpc.Tags = aliclient.Tags{}
+	}
+	b.vpcs[vpc.VpcId] = vpc
+	b.routeTables[vpc.VpcId] = b.newID("vtb")
+	b.transit(vpc.VpcId, func() {
+		vpc.Status = ptr.To(statusAvailable)
+		if desired.EnableIPv6 {
+			b.allocateVpcIPv6(vpc)
+		}
+	})
+	return copyVPC(vpc), nil
+}
+
+func (b *Backend) allocateVpcIPv6(vpc *aliclient.VPC) {
+	vpc.EnableIPv6 = true
+	vpc.IPv6CidrBlock = fmt.Sprintf("2408:4000:%x::/56", b.nextNumber())
+}
+
+func (b *Backend) listVpcs(match func(*aliclient.VPC) bool) []*aliclient.VPC {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.vpcs, match, copyVPC)
+}
+
+func (b *Backend) enableVpcIPv6(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vpc := b.vpcs[id]
+	if vpc == nil {
+		return notFound("InvalidVpcId.NotFound", id)
+	}
+	if vpc.IPv6CidrBlock != "" {
+		return nil
+	}
+	b.transit(id, func() {
+		b.allocateVpcIPv6(vpc)
+	})
+	return nil
+}
+
+func (b *Backend) deleteVpc(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vpc := b.vpcs[id]
+	if vpc == nil {
+		return notFound("InvalidVpcId.NotFound", id)
+	}
+	for _, vsw := range sortedValues(b.vswitches) {
+		if ptr.Deref(vsw.VpcId, "") == id {
+			return dependencyViolation("VSwitch", vsw.VSwitchId)
+		}
+	}
+	for _, sg := range sortedValues(b.securityGroups) {
+		if sg.VpcId == id {
+			return dependencyViolation("SecurityGroup", sg.SecurityGroupId)
+		}
+	}
+	for _, ngw := range sortedValues(b.natGateways) {
+		if ptr.Deref(ngw.VpcId, "") == id {
+			return dependencyViolation("NatGateway", ngw.NatGatewayId)
+		}
+	}
+	for _, gw := range sortedValues(b.ipv6Gateways) {
+		if gw.VpcId == id {
+			return dependencyViolation("Ipv6Gateway", gw.IPv6GatewayId)
+		}
+	}
+	for _, endpoint := range sortedValues(b.vpcEndpoints) {
+		if endpoint.VpcId == id {
+			return dependencyViolation("VpcEndpoint", endpoint.EndpointId)
+		}
+	}
+	for _, endpoint := range sortedValues(b.gwEndpoints) {
+		if endpoint.VpcId == id {
+			return dependencyViolation("VpcGatewayEndpoint", endpoint.EndpointId)
+		}
+	}
+	for _, zone := range sortedValues(b.privateZones) {
+		if slices.Contains(zone.VpcIds, id) {
+			return dependencyViolation("PrivateZone", zone.ZoneId)
+		}
+	}
+	for _, attachment := range sortedValues(b.trAttachments) {
+		if attachment.VpcId == id {
+			return dependencyViolation("TransitRouterAttachment", attachment.TransitRouterAttachmentId)
+		}
+	}
+	for _, child := range sortedValues(b.cenChildren) {
+		if child.ChildInstanceId == id {
+			return dependencyViolation("CenInstance", child.CenId)
+		}
+	}
+	b.settle(id)
+	vpc.Status = ptr.To(statusDeleting)
+	b.transit(id, func() {
+		delete(b.vpcs, id)
+		delete(b.routeTables, id)
+	})
+	return nil
+}
+
+// VSwitches
+
+func (b *Backend) createVSwitch(desired *aliclient.VSwitch) (*aliclient.VSwitch, error) {
+	cidr, err := parseCIDR(desired.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+	if desired.ZoneId == "" {
+		return nil, invalidParameter("InvalidZoneId.NotFound", "The zone of the vswitch must be specified.")
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vpcId := ptr.Deref(desired.VpcId, "")
+	vpc := b.vpcs[vpcId]
+	if vpc == nil {
+		return nil, notFound("InvalidVpcId.NotFound", vpcId)
+	}
+	vpcCIDR, _ := parseCIDR(vpc.CidrBlock)
+	if !contains(vpcCIDR, cidr) {
+		return nil, invalidParameter("InvalidCidrBlock.NotInVpc", fmt.Sprintf("The CIDR block %s is not part of the VPC CIDR block %s.", desired.CidrBlock, vpc.CidrBlock))
+	}
+	for _, other := range sortedValues(b.vswitches) {
+		otherCIDR, _ := parseCIDR(other.CidrBlock)
+		if ptr.Deref(other.VpcId, "") == vpcId && overlaps(cidr, otherCIDR) {
+			return nil, invalidParameter("InvalidCidrBlock.Overlapped", fmt.Sprintf("The CIDR block %s overlaps with vswitch %s.", desired.CidrBlock, other.VSwitchId))
+		}
+	}
+	if desired.IPv6CidrBlockIndex != nil && vpc.IPv6CidrBlock == "" {
+		return nil, invalidParameter("OperationFailed.Ipv6NotEnabled", fmt.Sprintf("IPv6 is not enabled for VPC %s.", vpcId))
+	}
+	vsw := &aliclient.VSwitch{
+		Tags:            maps.Clone(desired.Tags),
+		Name:            desired.Name,
+		VSwitchId:       b.newID("vsw"),
+		VpcId:           ptr.To(vpcId),
+		CidrBlock:       desired.CidrBlock,
+		ZoneId:          desired.ZoneId,
+		Status:          ptr.To(statusPending),
+		ResourceGroupId: resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if vsw.Tags == nil {
+		vsw.Tags = aliclient.Tags{}
+	}
+	b.vswitches[vsw.VSwitchId] = vsw
+	b.transit(vsw.VSwitchId, func() {
+		vsw.Status = ptr.To(statusAvailable)
+		if desired.IPv6CidrBlockIndex != nil {
+			vsw.IPv6CidrBlock = vswitchIPv6CIDR(vpc.IPv6CidrBlock, *desired.IPv6CidrBlockIndex)
+		}
+	})
+	return copyVSwitch(vsw), nil
+}
+
+// vswitchIPv6CIDR returns the /64 IPv6 CIDR block with the given index within the /56 IPv6 CIDR block of a VPC.
+func vswitchIPv6CIDR(vpcIPv6CIDR string, index int32) string {
+	return fmt.Sprintf("%s:%x::/64", strings.TrimSuffix(vpcIPv6CIDR, "::/56"), index)
+}
+
+func (b *Backend) listVSwitches(match func(*aliclient.VSwitch) bool) []*aliclient.VSwitch {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.vswitches, match, copyVSwitch)
+}
+
+func (b *Backend) enableVSwitchIPv6(id string, index int32) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vsw := b.vswitches[id]
+	if vsw == nil {
+		return notFound("InvalidVSwitchId.NotFound", id)
+	}
+	vpc := b.vpcs[ptr.Deref(vsw.VpcId, "")]
+	if vpc == nil || vpc.IPv6CidrBlock == "" {
+		return invalidParameter("OperationFailed.Ipv6NotEnabled", fmt.Sprintf("IPv6 is not enabled for the VPC of vswitch %s.", id))
+	}
+	if vsw.IPv6CidrBlock != "" {
+		return nil
+	}
+	b.transit(id, func() {
+		vsw.IPv6CidrBlock = vswitchIPv6CIDR(vpc.IPv6CidrBlock, index)
+	})
+	return nil
+}
+
+func (b *Backend) deleteVSwitch(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vsw := b.vswitches[id]
+	if vsw == nil {
+		return notFound("InvalidVSwitchId.NotFound", id)
+	}
+	for _, ngw := range sortedValues(b.natGateways) {
+		if ptr.Deref(ngw.VswitchId, "") == id {
+			return dependencyViolation("NatGateway", ngw.NatGatewayId)
+		}
+	}
+	for _, entry := range sortedValues(b.snatEntries) {
+		if entry.VSwitchId == id {
+			return dependencyViolation("SnatEntry", entry.SnatEntryId)
+		}
+	}
+	for _, attachment := range sortedValues(b.trAttachments) {
+		if slices.Contains(slices.Collect(maps.Values(attachment.ZoneMappings)), id) {
+			return dependencyViolation("TransitRouterAttachment", attachment.TransitRouterAttachmentId)
+		}
+	}
+	for _, endpoint := range sortedValues(b.vpcEndpoints) {
+		if slices.Contains(slices.Collect(maps.Values(endpoint.ZoneMappings)), id) {
+			return dependencyViolation("VpcEndpoint", endpoint.EndpointId)
+		}
+	}
+	b.settle(id)
+	vsw.Status = ptr.To(statusDeleting)
+	b.transit(id, func() {
+		delete(b.vswitches, id)
+	})
+	return nil
+}
+
+// IPv6 gateways
+
+func (b *Backend) createIPv6Gateway(desired *aliclient.IPv6Gateway) (*aliclient.IPv6Gateway, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.vpcs[desired.VpcId] == nil {
+		return nil, notFound("InvalidVpcId.NotFound", desired.VpcId)
+	}
+	for _, other := range sortedValues(b.ipv6Gateways) {
+		if other.VpcId == desired.VpcId {
+			return nil, invalidParameter("QuotaExceeded.Ipv6Gateway", fmt.Sprintf("The VPC %s already has the IPv6 gateway %s.", desired.VpcId, other.IPv6GatewayId))
+		}
+	}
+	gw := &aliclient.IPv6Gateway{
+		Tags:            maps.Clone(desired.Tags),
+		Name:            desired.Name,
+		IPv6GatewayId:   b.newID("ipv6gw"),
+		VpcId:           desired.VpcId,
+		Status:          ptr.To(statusCreating),
+		ResourceGroupId: resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if gw.Tags == nil {
+		gw.Tags = aliclient.Tags{}
+	}
+	b.ipv6Gateways[gw.IPv6GatewayId] = gw
+	b.transit(gw.IPv6GatewayId, func() {
+		gw.Status = ptr.To(statusAvailable)
+	})
+	return copyIPv6Gateway(gw), nil
+}
+
+func (b *Backend) listIPv6Gateways(match func(*aliclient.IPv6Gateway) bool) []*aliclient.IPv6Gateway {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.ipv6Gateways, match, copyIPv6Gateway)
+}
+
+func (b *Backend) deleteIPv6Gateway(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	gw := b.ipv6Gateways[id]
+	if gw == nil {
+		return notFound("InvalidIpv6GatewayId.NotFound", id)
+	}
+	b.settle(id)
+	gw.Status = ptr.To(statusDeleting)
+	b.transit(id, func() {
+		delete(b.ipv6Gateways, id)
+	})
+	return nil
+}
+
+// NAT gateways
+
+func (b *Backend) createNatGateway(desired *aliclient.NatGateway) (*aliclient.NatGateway, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vpcId := ptr.Deref(desired.VpcId, "")
+	if b.vpcs[vpcId] == nil {
+		return nil, notFound("InvalidVpcId.NotFound", vpcId)
+	}
+	vswitchId := ptr.Deref(desired.VswitchId, "")
+	vsw := b.vswitches[vswitchId]
+	if vsw == nil || ptr.Deref(vsw.VpcId, "") != vpcId {
+		return nil, notFound("InvalidVSwitchId.NotFound", vswitchId)
+	}
+	if len(b.NatGatewayZones) > 0 && !slices.Contains(b.NatGatewayZones, vsw.ZoneId) {
+		return nil, invalidParameter("OperationUnsupported.ZoneNotSupported", fmt.Sprintf("Enhanced NAT gateways are not available in zone %s.", vsw.ZoneId))
+	}
+	ngw := &aliclient.NatGateway{
+		Tags:               maps.Clone(desired.Tags),
+		Name:               desired.Name,
+		NatGatewayId:       b.newID("ngw"),
+		VpcId:              ptr.To(vpcId),
+		VswitchId:          ptr.To(vswitchId),
+		Status:             ptr.To(statusCreating),
+		InternetChargeType: desired.InternetChargeType,
+		Spec:               desired.Spec,
+		SNATTableIDs:       []string{b.newID("stb")},
+		ResourceGroupId:    resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if ngw.Tags == nil {
+		ngw.Tags = aliclient.Tags{}
+	}
+	if ngw.InternetChargeType == "" {
+		ngw.InternetChargeType = defaultNatGatewayInternetChargeType
+	}
+	b.natGateways[ngw.NatGatewayId] = ngw
+	b.transit(ngw.NatGatewayId, func() {
+		ngw.Status = ptr.To(statusAvailable)
+	})
+	return copyNatGateway(ngw), nil
+}
+
+// natGatewayZones returns the sorted zones in which enhanced NAT gateways are available.
+func (b *Backend) natGatewayZones() []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if len(b.NatGatewayZones) > 0 {
+		return slices.Sorted(slices.Values(b.NatGatewayZones))
+	}
+	var zones []string
+	for _, vsw := range sortedValues(b.vswitches) {
+		if !slices.Contains(zones, vsw.ZoneId) {
+			zones = append(zones, vsw.ZoneId)
+		}
+	}
+	slices.Sort(zones)
+	return zones
+}
+
+func (b *Backend) listNatGateways(match func(*aliclient.NatGateway) bool) []*aliclient.NatGateway {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.natGateways, match, copyNatGateway)
+}
+
+func (b *Backend) modifyNatGatewaySpec(id, spec string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	ngw := b.natGateways[id]
+	if ngw == nil {
+		return notFound("InvalidNatGatewayId.NotFound", id)
+	}
+	ngw.Spec = spec
+	return nil
+}
+
+// deleteNatGateway deletes the NAT gateway with the given id. If force is set, its SNAT entries are deleted and its
+// elastic IPs are unassociated, otherwise they let the deletion fail.
+func (b *Backend) deleteNatGateway(id string, force bool) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	ngw := b.natGateways[id]
+	if ngw == nil {
+		return notFound("InvalidNatGatewayId.NotFound", id)
+	}
+	for _, entry := range sortedValues(b.snatEntries) {
+		if !slices.Contains(ngw.SNATTableIDs, entry.SnatTableId) {
+			continue
+		}
+		if !force {
+			return dependencyViolation("SnatEntry", entry.SnatEntryId)
+		}
+		delete(b.transitions, entry.SnatEntryId)
+		delete(b.snatEntries, entry.SnatEntryId)
+	}
+	for _, eip := range sortedValues(b.eips) {
+		if ptr.Deref(eip.InstanceId, "") != id {
+			continue
+		}
+		if !force {
+			return dependencyViolation("EIP", eip.EipId)
+		}
+		delete(b.transitions, eip.EipId)
+		eip.Status = ptr.To(statusAvailable)
+		eip.InstanceId = ptr.To("")
+		eip.InstanceType = ptr.To("")
+	}
+	b.settle(id)
+	ngw.Status = ptr.To(statusDeleting)
+	b.transit(id, func() {
+		delete(b.natGateways, id)
+	})
+	return nil
+}
+
+// Elastic IPs
+
+func (b *Backend) createEIP(desired *aliclient.EIP) (*aliclient.EIP, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	number := b.nextNumber()
+	eip := &aliclient.EIP{
+		Tags:               maps.Clone(desired.Tags),
+		Name:               desired.Name,
+		Bandwidth:          desired.Bandwidth,
+		InternetChargeType: desired.InternetChargeType,
+		ISP:                desired.ISP,
+		EipId:              fmt.Sprintf("eip-%08d", number),
+		Status:             ptr.To(statusAvailable),
+		InstanceType:       ptr.To(""),
+		InstanceId:         ptr.To(""),
+		IpAddress:          fmt.Sprintf("47.%d.%d.%d", number>>16&0xff, number>>8&0xff, number&0xff),
+		ResourceGroupId:    resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if eip.Tags == nil {
+		eip.Tags = aliclient.Tags{}
+	}
+	if eip.Bandwidth == "" {
+		eip.Bandwidth = defaultEIPBandwidth
+	}
+	if eip.InternetChargeType == "" {
+		eip.InternetChargeType = defaultEIPInternetChargeType
+	}
+	if eip.ISP == "" {
+		eip.ISP = defaultEIPISP
+	}
+	b.eips[eip.EipId] = eip
+	return copyEIP(eip), nil
+}
+
+func (b *Backend) listEIPs(match func(*aliclient.EIP) bool) []*aliclient.EIP {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.eips, match, copyEIP)
+}
+
+func (b *Backend) modifyEIPBandwidth(id, bandwidth string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	eip := b.eips[id]
+	if eip == nil {
+		return notFound("InvalidAllocationId.NotFound", id)
+	}
+	if bandwidth != "" {
+		eip.Bandwidth = bandwidth
+	}
+	return nil
+}
+
+func (b *Backend) addEIPToBandwidthPackage(bandwidthPackageId, id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	eip := b.eips[id]
+	if eip == nil {
+		return notFound("InvalidAllocationId.NotFound", id)
+	}
+	if eip.BandwidthPackageId != "" {
+		return invalidParameter("OperationFailed.EipInBandwidthPackage", fmt.Sprintf("The EIP %s is already added to bandwidth package %s.", id, eip.BandwidthPackageId))
+	}
+	eip.BandwidthPackageId = bandwidthPackageId
+	return nil
+}
+
+func (b *Backend) removeEIPFromBandwidthPackage(bandwidthPackageId, id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	eip := b.eips[id]
+	if eip == nil {
+		return notFound("InvalidAllocationId.NotFound", id)
+	}
+	if eip.BandwidthPackageId != bandwidthPackageId {
+		return invalidParameter("OperationFailed.EipNotInBandwidthPackage", fmt.Sprintf("The EIP %s is not added to bandwidth package %s.", id, bandwidthPackageId))
+	}
+	eip.BandwidthPackageId = ""
+	return nil
+}
+
+func (b *Backend) associateEIP(id, instanceId, instanceType string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	eip := b.eips[id]
+	if eip == nil {
+		return notFound("InvalidAllocationId.NotFound", id)
+	}
+	b.settle(id)
+	if ptr.Deref(eip.Status, "") != statusAvailable {
+		return invalidParameter("IncorrectEipStatus", fmt.Sprintf("The EIP %s is in status %s.", id, ptr.Deref(eip.Status, "")))
+	}
+	if instanceType == "Nat" && b.natGateways[instanceId] == nil {
+		return notFound("InvalidInstanceId.NotFound", instanceId)
+	}
+	eip.Status = ptr.To(statusAssociating)
+	eip.InstanceId = ptr.To(instanceId)
+	eip.InstanceType = ptr.To(instanceType)
+	b.transit(id, func() {
+		eip.Status = ptr.To(statusInUse)
+	})
+	return nil
+}
+
+func (b *Backend) unassociateEIP(id, instanceId string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	eip := b.eips[id]
+	if eip == nil {
+		return notFound("InvalidAllocationId.NotFound", id)
+	}
+	b.settle(id)
+	if ptr.Deref(eip.Status, "") != statusInUse || ptr.Deref(eip.InstanceId, "") != instanceId {
+		return invalidParameter("IncorrectEipStatus", fmt.Sprintf("The EIP %s is not associated with instance %s.", id, instanceId))
+	}
+	for _, entry := range sortedValues(b.snatEntries) {
+		if slices.Contains(strings.Split(entry.IpAddress, ","), eip.IpAddress) {
+			return dependencyViolation("SnatEntry", entry.SnatEntryId)
+		}
+	}
+	eip.Status = ptr.To(statusUnassociating)
+	b.transit(id, func() {
+		eip.Status = ptr.To(statusAvailable)
+		eip.InstanceId = ptr.To("")
+		eip.InstanceType = ptr.To("")
+	})
+	return nil
+}
+
+func (b *Backend) releaseEIP(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	eip := b.eips[id]
+	if eip == nil {
+		return notFound("InvalidAllocationId.NotFound", id)
+	}
+	b.settle(id)
+	if ptr.Deref(eip.Status, "") != statusAvailable {
+		return invalidParameter("IncorrectEipStatus", fmt.Sprintf("The EIP %s is in status %s.", id, ptr.Deref(eip.Status, "")))
+	}
+	if eip.BandwidthPackageId != "" {
+		return dependencyViolation("BandwidthPackage", eip.BandwidthPackageId)
+	}
+	eip.Status = ptr.To(statusReleasing)
+	b.transit(id, func() {
+		delete(b.eips, id)
+	})
+	return nil
+}
+
+// SNAT entries
+
+func (b *Backend) createSNatEntry(desired *aliclient.SNATEntry) (*aliclient.SNATEntry, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	ngw := b.natGatewayBySnatTable(desired.SnatTableId)
+	if ngw == nil {
+		return nil, notFound("InvalidSnatTableId.NotFound", desired.SnatTableId)
+	}
+	vsw := b.vswitches[desired.VSwitchId]
+	if vsw == nil || ptr.Deref(vsw.VpcId, "") != ptr.Deref(ngw.VpcId, "") {
+		return nil, notFound("InvalidVSwitchId.NotFound", desired.VSwitchId)
+	}
+	for _, ipAddress := range strings.Split(desired.IpAddress, ",") {
+		eip := b.eipByAddress(ipAddress)
+		if eip == nil || ptr.Deref(eip.InstanceId, "") != ngw.NatGatewayId {
+			return nil, invalidParameter("InvalidIp.NotInNatgw", fmt.Sprintf("The IP %s is not associated with NAT gateway %s.", ipAddress, ngw.NatGatewayId))
+		}
+	}
+	for _, other := range sortedValues(b.snatEntries) {
+		if other.SnatTableId == desired.SnatTableId && other.VSwitchId == desired.VSwitchId {
+			return nil, invalidParameter("Forbidden.SourceVSwitchId.Duplicated", fmt.Sprintf("The vswitch %s is already used by SNAT entry %s.", desired.VSwitchId, other.SnatEntryId))
+		}
+	}
+	entry := &aliclient.SNATEntry{
+		Name:         desired.Name,
+		NatGatewayId: ngw.NatGatewayId,
+		VSwitchId:    desired.VSwitchId,
+		IpAddress:    desired.IpAddress,
+		SnatTableId:  desired.SnatTableId,
+		SnatEntryId:  b.newID("snat"),
+		Status:       ptr.To(statusPending),
+	}
+	b.snatEntries[entry.SnatEntryId] = entry
+	b.transit(entry.SnatEntryId, func() {
+		entry.Status = ptr.To(statusAvailable)
+	})
+	return copySNATEntry(entry), nil
+}
+
+func (b *Backend) natGatewayBySnatTable(snatTableId string) *aliclient.NatGateway {
+	for _, ngw := range sortedValues(b.natGateways) {
+		if slices.Contains(ngw.SNATTableIDs, snatTableId) {
+			return ngw
+		}
+	}
+	return nil
+}
+
+func (b *Backend) eipByAddress(ipAddress string) *aliclient.EIP {
+	for _, eip := range sortedValues(b.eips) {
+		if eip.IpAddress == ipAddress {
+			return eip
+		}
+	}
+	return nil
+}
+
+func (b *Backend) listSNatEntries(match func(*aliclient.SNATEntry) bool) []*aliclient.SNATEntry {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.snatEntries, match, copySNATEntry)
+}
+
+func (b *Backend) hasSnatTable(snatTableId string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.natGatewayBySnatTable(snatTableId) != nil
+}
+
+func (b *Backend) deleteSNatEntry(id, snatTableId string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	entry := b.snatEntries[id]
+	if entry == nil || entry.SnatTableId != snatTableId {
+		return notFound("InvalidSnatEntryId.NotFound", id)
+	}
+	b.settle(id)
+	entry.Status = ptr.To(statusDeleting)
+	b.transit(id, func() {
+		delete(b.snatEntries, id)
+	})
+	return nil
+}
+
+// Security groups
+
+func (b *Backend) createSecurityGroup(desired *aliclient.SecurityGroup) (*aliclient.SecurityGroup, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.vpcs[desired.VpcId] == nil {
+		return nil, notFound("InvalidVpcId.NotFound", desired.VpcId)
+	}
+	sg := &aliclient.SecurityGroup{
+		Tags:            maps.Clone(desired.Tags),
+		Name:            desired.Name,
+		VpcId:           desired.VpcId,
+		Description:     desired.Description,
+		SecurityGroupId: b.newID("sg"),
+		ResourceGroupId: resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if sg.Tags == nil {
+		sg.Tags = aliclient.Tags{}
+	}
+	b.securityGroups[sg.SecurityGroupId] = sg
+	return copySecurityGroup(sg), nil
+}
+
+func (b *Backend) getSecurityGroup(id string) *aliclient.SecurityGroup {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return get(b, b.securityGroups, id, copySecurityGroup)
+}
+
+func (b *Backend) listSecurityGroups(match func(*aliclient.SecurityGroup) bool) []*aliclient.SecurityGroup {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.securityGroups, match, copySecurityGroup)
+}
+
+func (b *Backend) deleteSecurityGroup(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.securityGroups[id] == nil {
+		return notFound("InvalidSecurityGroupId.NotFound", id)
+	}
+	for _, endpoint := range sortedValues(b.vpcEndpoints) {
+		if slices.Contains(endpoint.SecurityGroupIds, id) {
+			return dependencyViolation("VpcEndpoint", endpoint.EndpointId)
+		}
+	}
+	delete(b.securityGroups, id)
+	return nil
+}
+
+func (b *Backend) authorizeSecurityGroupRule(sgId string, rule *aliclient.SecurityGroupRule) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	sg := b.securityGroups[sgId]
+	if sg == nil {
+		return notFound("InvalidSecurityGroupId.NotFound", sgId)
+	}
+	for _, existing := range sg.Rules {
+		if ruleKey(existing) == ruleKey(rule) {
+			return invalidParameter("InvalidPermission.Duplicate", fmt.Sprintf("The rule already exists as %s.", existing.SecurityGroupRuleId))
+		}
+	}
+	created := *rule
+	created.SecurityGroupRuleId = b.newID("sgr")
+	sg.Rules = append(sg.Rules, &created)
+	return nil
+}
+
+func (b *Backend) revokeSecurityGroupRule(sgId, ruleId, direction string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	sg := b.securityGroups[sgId]
+	if sg == nil {
+		return notFound("InvalidSecurityGroupId.NotFound", sgId)
+	}
+	index := slices.IndexFunc(sg.Rules, func(rule *aliclient.SecurityGroupRule) bool {
+		return rule.SecurityGroupRuleId == ruleId && rule.Direction == direction
+	})
+	if index < 0 {
+		return notFound("InvalidSecurityGroupRuleId.NotFound", ruleId)
+	}
+	sg.Rules = slices.Delete(sg.Rules, index, index+1)
+	return nil
+}
+
+func ruleKey(rule *aliclient.SecurityGroupRule) string {
+	return strings.Join([]string{rule.Direction, rule.Policy, rule.Priority, rule.IpProtocol, rule.PortRange,
+		rule.SourceCidrIp, rule.DestCidrIp, rule.Ipv6SourceCidrIp, rule.Ipv6DestCidrIp}, "|")
+}
+
+// Tags and resource groups
+
+// resource returns the tags and the resource group id of the resource with the given type and id. The type is
+// matched case-insensitively, as the VPC and ECS APIs use different spellings.
+func (b *Backend) resource(resourceType, id string) (aliclient.Tags, *string, error) {
+	switch strings.ToLower(resourceType) {
+	case "vpc":
+		if vpc := b.vpcs[id]; vpc != nil {
+			return vpc.Tags, &vpc.ResourceGroupId, nil
+		}
+	case "vswitch":
+		if vsw := b.vswitches[id]; vsw != nil {
+			return vsw.Tags, &vsw.ResourceGroupId, nil
+		}
+	case "natgateway":
+		if ngw := b.natGateways[id]; ngw != nil {
+			return ngw.Tags, &ngw.ResourceGroupId, nil
+		}
+	case "eip":
+		if eip := b.eips[id]; eip != nil {
+			return eip.Tags, &eip.ResourceGroupId, nil
+		}
+	case "ipv6gateway":
+		if gw := b.ipv6Gateways[id]; gw != nil {
+			return gw.Tags, &gw.ResourceGroupId, nil
+		}
+	case "securitygroup":
+		if sg := b.securityGroups[id]; sg != nil {
+			return sg.Tags, &sg.ResourceGroupId, nil
+		}
+	case "zone":
+		if zone := b.privateZones[id]; zone != nil {
+			return zone.Tags, &zone.ResourceGroupId, nil
+		}
+	default:
+		return nil, nil, unsupportedResourceType(resourceType)
+	}
+	return nil, nil, notFound("InvalidResourceId.NotFound", id)
+}
+
+// ids returns the sorted ids of all resources of the given type and whether the type is supported.
+func (b *Backend) ids(resourceType string) ([]string, bool) {
+	switch strings.ToLower(resourceType) {
+	case "vpc":
+		return slices.Sorted(maps.Keys(b.vpcs)), true
+	case "vswitch":
+		return slices.Sorted(maps.Keys(b.vswitches)), true
+	case "natgateway":
+		return slices.Sorted(maps.Keys(b.natGateways)), true
+	case "eip":
+		return slices.Sorted(maps.Keys(b.eips)), true
+	case "ipv6gateway":
+		return slices.Sorted(maps.Keys(b.ipv6Gateways)), true
+	case "securitygroup":
+		return slices.Sorted(maps.Keys(b.securityGroups)), true
+	case "zone":
+		return slices.Sorted(maps.Keys(b.privateZones)), true
+	}
+	return nil, false
+}
+
+func unsupportedResourceType(resourceType string) error {
+	return invalidParameter("InvalidResourceType.NotSupported", fmt.Sprintf("The resource type %s is not supported.", resourceType))
+}
+
+func (b *Backend) tagResources(resourceType string, ids []string, tags aliclient.Tags) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, id := range ids {
+		if _, _, err := b.resource(resourceType, id); err != nil {
+			return err
+		}
+	}
+	for _, id := range ids {
+		current, _, _ := b.resource(resourceType, id)
+		maps.Copy(current, tags)
+	}
+	return nil
+}
+
+func (b *Backend) untagResources(resourceType string, ids []string, keys []string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, id := range ids {
+		if _, _, err := b.resource(resourceType, id); err != nil {
+			return err
+		}
+	}
+	for _, id := range ids {
+		current, _, _ := b.resource(resourceType, id)
+		for _, key := range keys {
+			delete(current, key)
+		}
+	}
+	return nil
+}
+
+// listTagResources returns the ids of all resources of the given type which have all the given tags.
+func (b *Backend) listTagResources(resourceType string, tags aliclient.Tags) ([]string, error) {
+	tagged, err := b.resourceTags(resourceType, nil, tags)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Sorted(maps.Keys(tagged)), nil
+}
+
+// tagKeys returns the sorted tag keys containing the given keyword which are used by resources of the given type.
+func (b *Backend) tagKeys(resourceType, keyword string) ([]string, error) {
+	tagged, err := b.resourceTags(resourceType, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys := sets.New[string]()
+	for _, tags := range tagged {
+		for key := range tags {
+			if strings.Contains(key, keyword) {
+				keys.Insert(key)
+			}
+		}
+	}
+	return sets.List(keys), nil
+}
+
+// resourceTags returns copies of the tags of the resources of the given type which have all the given tags, keyed by
+// the resource ids. If ids are given, only these resources are considered and unknown ids are ignored.
+func (b *Backend) resourceTags(resourceType string, ids []string, tags aliclient.Tags) (map[string]aliclient.Tags, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	candidates, ok := b.ids(resourceType)
+	if !ok {
+		return nil, unsupportedResourceType(resourceType)
+	}
+	result := map[string]aliclient.Tags{}
+	for _, id := range candidates {
+		if len(ids) > 0 && !slices.Contains(ids, id) {
+			continue
+		}
+		current, _, _ := b.resource(resourceType, id)
+		if hasTags(current, tags) {
+			result[id] = current.Clone()
+		}
+	}
+	return result, nil
+}
+
+func (b *Backend) moveResourceGroup(resourceType, id, resourceGroupId string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	_, group, err := b.resource(resourceType, id)
+	if err != nil {
+		return err
+	}
+	*group = resourceGroupId
+	return nil
+}
+
+func hasTags(current, tags aliclient.Tags) bool {
+	for k, v := range tags {
+		if cv, ok := current[k]; !ok || cv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// CEN child instances
+
+func cenChildKey(cenId, vpcId string) string {
+	return cenId + "/" + vpcId
+}
+
+func (b *Backend) attachCENChildInstance(cenId, vpcId, region string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if region == b.region && b.vpcs[vpcId] == nil {
+		return notFound("InvalidVpcId.NotFound", vpcId)
+	}
+	key := cenChildKey(cenId, vpcId)
+	if b.cenChildren[key] != nil {
+		return invalidParameter("Forbidden.ChildInstanceAlreadyAttached", fmt.Sprintf("The VPC %s is already attached to CEN %s.", vpcId, cenId))
+	}
+	child := &aliclient.CENChildInstance{
+		CenId:           cenId,
+		ChildInstanceId: vpcId,
+		RegionId:        region,
+		Status:          statusAttaching,
+	}
+	b.cenChildren[key] = child
+	b.transit(key, func() {
+		child.Status = statusAttached
+	})
+	return nil
+}
+
+func (b *Backend) listCENChildInstances(cenId, region string) []*aliclient.CENChildInstance {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.cenChildren, func(child *aliclient.CENChildInstance) bool {
+		return child.CenId == cenId && (region == "" || child.RegionId == region)
+	}, copyCENChildInstance)
+}
+
+func (b *Backend) detachCENChildInstance(cenId, vpcId string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	key := cenChildKey(cenId, vpcId)
+	child := b.cenChildren[key]
+	if child == nil {
+		return notFound("InvalidChildInstanceId.NotFound", vpcId)
+	}
+	b.settle(key)
+	child.Status = statusDetaching
+	b.transit(key, func() {
+		delete(b.cenChildren, key)
+	})
+	return nil
+}
+
+// Transit router VPC attachments
+
+func (b *Backend) createTransitRouterVpcAttachment(desired *aliclient.TransitRouterVpcAttachment) (*aliclient.TransitRouterVpcAttachment, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.vpcs[desired.VpcId] == nil {
+		return nil, notFound("InvalidVpcId.NotFound", desired.VpcId)
+	}
+	if err := b.checkZoneMappings(desired.VpcId, desired.ZoneMappings); err != nil {
+		return nil, err
+	}
+	for _, other := range sortedValues(b.trAttachments) {
+		if other.TransitRouterId == desired.TransitRouterId && other.VpcId == desired.VpcId {
+			return nil, invalidParameter("Forbidden.VpcAttachmentExists", fmt.Sprintf("The VPC %s is already attached to transit router %s.", desired.VpcId, desired.TransitRouterId))
+		}
+	}
+	attachment := &aliclient.TransitRouterVpcAttachment{
+		Tags:                      maps.Clone(desired.Tags),
+		Name:                      desired.Name,
+		CenId:                     desired.CenId,
+		TransitRouterId:           desired.TransitRouterId,
+		TransitRouterAttachmentId: b.newID("tr-attach"),
+		VpcId:                     desired.VpcId,
+		ZoneMappings:              maps.Clone(desired.ZoneMappings),
+		Status:                    statusAttaching,
+	}
+	if attachment.Tags == nil {
+		attachment.Tags = aliclient.Tags{}
+	}
+	b.trAttachments[attachment.TransitRouterAttachmentId] = attachment
+	b.transit(attachment.TransitRouterAttachmentId, func() {
+		attachment.Status = statusAttached
+	})
+	return copyTransitRouterVpcAttachment(attachment), nil
+}
+
+// checkZoneMappings checks that the vswitches of the given zone mappings exist in the given VPC and zones.
+func (b *Backend) checkZoneMappings(vpcId string, zoneMappings map[string]string) error {
+	for _, zoneId := range slices.Sorted(maps.Keys(zoneMappings)) {
+		vsw := b.vswitches[zoneMappings[zoneId]]
+		if vsw == nil || ptr.Deref(vsw.VpcId, "") != vpcId || vsw.ZoneId != zoneId {
+			return notFound("InvalidVSwitchId.NotFound", zoneMappings[zoneId])
+		}
+	}
+	return nil
+}
+
+func (b *Backend) listTransitRouterVpcAttachments(match func(*aliclient.TransitRouterVpcAttachment) bool) []*aliclient.TransitRouterVpcAttachment {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.trAttachments, match, copyTransitRouterVpcAttachment)
+}
+
+func (b *Backend) addZonesToTransitRouterVpcAttachment(id string, zoneMappings map[string]string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	attachment := b.trAttachments[id]
+	if attachment == nil {
+		return notFound("InvalidTransitRouterAttachmentId.NotFound", id)
+	}
+	if attachment.Status != statusAttached {
+		return invalidParameter("IncorrectStatus.Attachment", fmt.Sprintf("The transit router attachment %s is in status %s.", id, attachment.Status))
+	}
+	if err := b.checkZoneMappings(attachment.VpcId, zoneMappings); err != nil {
+		return err
+	}
+	attachment.Status = statusModifying
+	b.transit(id, func() {
+		maps.Copy(attachment.ZoneMappings, zoneMappings)
+		attachment.Status = statusAttached
+	})
+	return nil
+}
+
+func (b *Backend) deleteTransitRouterVpcAttachment(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	attachment := b.trAttachments[id]
+	if attachment == nil {
+		return notFound("InvalidTransitRouterAttachmentId.NotFound", id)
+	}
+	for _, entry := range sortedValues(b.trRouteEntries) {
+		if entry.NextHopId == id {
+			return dependencyViolation("TransitRouterRouteEntry", entry.TransitRouterRouteEntryId)
+		}
+	}
+	b.settle(id)
+	attachment.Status = statusDetaching
+	b.transit(id, func() {
+		delete(b.trAttachments, id)
+	})
+	return nil
+}
+
+// Transit router route entries
+
+func (b *Backend) createTransitRouterRouteEntry(desired *aliclient.TransitRouterRouteEntry) (*aliclient.TransitRouterRouteEntry, error) {
+	if _, err := parseCIDR(desired.DestinationCidrBlock); err != nil {
+		return nil, err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.trAttachments[desired.NextHopId] == nil {
+		return nil, notFound("InvalidTransitRouterAttachmentId.NotFound", desired.NextHopId)
+	}
+	for _, other := range sortedValues(b.trRouteEntries) {
+		if other.TransitRouterRouteTableId == desired.TransitRouterRouteTableId && other.DestinationCidrBlock == desired.DestinationCidrBlock {
+			return nil, invalidParameter("InvalidDestinationCidrBlock.Duplicated", fmt.Sprintf("The route table %s already has a route entry for %s.", desired.TransitRouterRouteTableId, desired.DestinationCidrBlock))
+		}
+	}
+	entry := &aliclient.TransitRouterRouteEntry{
+		Name:                      desired.Name,
+		TransitRouterRouteTableId: desired.TransitRouterRouteTableId,
+		TransitRouterRouteEntryId: b.newID("rte"),
+		DestinationCidrBlock:      desired.DestinationCidrBlock,
+		NextHopId:                 desired.NextHopId,
+		Status:                    statusCreating,
+	}
+	b.trRouteEntries[entry.TransitRouterRouteEntryId] = entry
+	b.transit(entry.TransitRouterRouteEntryId, func() {
+		entry.Status = statusActive
+	})
+	return copyTransitRouterRouteEntry(entry), nil
+}
+
+func (b *Backend) listTransitRouterRouteEntries(match func(*aliclient.TransitRouterRouteEntry) bool) []*aliclient.TransitRouterRouteEntry {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.trRouteEntries, match, copyTransitRouterRouteEntry)
+}
+
+func (b *Backend) deleteTransitRouterRouteEntry(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	entry := b.trRouteEntries[id]
+	if entry == nil {
+		return notFound("InvalidTransitRouterRouteEntryId.NotFound", id)
+	}
+	b.settle(id)
+	entry.Status = statusDeleting
+	b.transit(id, func() {
+		delete(b.trRouteEntries, id)
+	})
+	return nil
+}
+
+// VPC endpoints
+
+func (b *Backend) createVpcEndpoint(desired *aliclient.VPCEndpoint) (*aliclient.VPCEndpoint, error) {
+	if desired.ServiceName == "" {
+		return nil, invalidParameter("MissingParameter.ServiceName", "The service name of the endpoint must be specified.")
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.vpcs[desired.VpcId] == nil {
+		return nil, notFound("InvalidVpcId.NotFound", desired.VpcId)
+	}
+	for _, sgId := range desired.SecurityGroupIds {
+		if sg := b.securityGroups[sgId]; sg == nil || sg.VpcId != desired.VpcId {
+			return nil, notFound("InvalidSecurityGroupId.NotFound", sgId)
+		}
+	}
+	if err := b.checkZoneMappings(desired.VpcId, desired.ZoneMappings); err != nil {
+		return nil, err
+	}
+	id := b.newID("ep")
+	endpoint := &aliclient.VPCEndpoint{
+		Tags:             maps.Clone(desired.Tags),
+		Name:             desired.Name,
+		ServiceName:      desired.ServiceName,
+		EndpointId:       id,
+		VpcId:            desired.VpcId,
+		SecurityGroupIds: slices.Clone(desired.SecurityGroupIds),
+		ZoneMappings:     maps.Clone(desired.ZoneMappings),
+		Domain:           fmt.Sprintf("%s.%s.%s.privatelink.aliyuncs.com", id, desired.ServiceName, b.region),
+		Status:           statusCreating,
+		ResourceGroupId:  resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if endpoint.Tags == nil {
+		endpoint.Tags = aliclient.Tags{}
+	}
+	b.vpcEndpoints[id] = endpoint
+	b.transit(id, func() {
+		endpoint.Status = statusActive
+	})
+	return copyVPCEndpoint(endpoint), nil
+}
+
+func (b *Backend) listVpcEndpoints(match func(*aliclient.VPCEndpoint) bool) []*aliclient.VPCEndpoint {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.vpcEndpoints, match, copyVPCEndpoint)
+}
+
+func (b *Backend) listVpcEndpointZones(id string) (map[string]string, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	endpoint := b.vpcEndpoints[id]
+	if endpoint == nil {
+		return nil, notFound("EndpointNotFound", id)
+	}
+	return maps.Clone(endpoint.ZoneMappings), nil
+}
+
+func (b *Backend) addZoneToVpcEndpoint(id, zoneId, vswitchId string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	endpoint := b.vpcEndpoints[id]
+	if endpoint == nil {
+		return notFound("EndpointNotFound", id)
+	}
+	if endpoint.Status != statusActive {
+		return invalidParameter("EndpointOperationDenied", fmt.Sprintf("The endpoint %s is in status %s.", id, endpoint.Status))
+	}
+	if _, ok := endpoint.ZoneMappings[zoneId]; ok {
+		return invalidParameter("EndpointZoneAlreadyExists", fmt.Sprintf("The endpoint %s already has a vswitch in zone %s.", id, zoneId))
+	}
+	if err := b.checkZoneMappings(endpoint.VpcId, map[string]string{zoneId: vswitchId}); err != nil {
+		return err
+	}
+	endpoint.Status = statusPending
+	b.transit(id, func() {
+		endpoint.ZoneMappings[zoneId] = vswitchId
+		endpoint.Status = statusActive
+	})
+	return nil
+}
+
+func (b *Backend) deleteVpcEndpoint(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	endpoint := b.vpcEndpoints[id]
+	if endpoint == nil {
+		return notFound("EndpointNotFound", id)
+	}
+	b.settle(id)
+	endpoint.Status = statusDeleting
+	b.transit(id, func() {
+		delete(b.vpcEndpoints, id)
+	})
+	return nil
+}
+
+// Route tables
+
+// listRouteTables returns the route tables of the VPC with the given id. Only the system route table, which all
+// vswitches of the VPC are associated with, is modelled.
+func (b *Backend) listRouteTables(vpcId string) ([]*aliclient.RouteTable, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	routeTableId, ok := b.routeTables[vpcId]
+	if !ok {
+		return nil, notFound("InvalidVpcId.NotFound", vpcId)
+	}
+	routeTable := &aliclient.RouteTable{RouteTableId: routeTableId, RouteTableType: routeTableTypeSystem}
+	for _, vsw := range sortedValues(b.vswitches) {
+		if ptr.Deref(vsw.VpcId, "") == vpcId {
+			routeTable.VSwitchIds = append(routeTable.VSwitchIds, vsw.VSwitchId)
+		}
+	}
+	return []*aliclient.RouteTable{routeTable}, nil
+}
+
+// VPC gateway endpoints
+
+func (b *Backend) createVpcGatewayEndpoint(desired *aliclient.VPCGatewayEndpoint) (*aliclient.VPCGatewayEndpoint, error) {
+	if desired.ServiceName == "" {
+		return nil, invalidParameter("MissingParameter.ServiceName", "The service name of the endpoint must be specified.")
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.vpcs[desired.VpcId] == nil {
+		return nil, notFound("InvalidVpcId.NotFound", desired.VpcId)
+	}
+	for _, endpoint := range sortedValues(b.gwEndpoints) {
+		if endpoint.VpcId == desired.VpcId && endpoint.ServiceName == desired.ServiceName {
+			return nil, invalidParameter("ResourceAlreadyExist.Endpoint",
+				fmt.Sprintf("The VPC %s already has a gateway endpoint for service %s.", desired.VpcId, desired.ServiceName))
+		}
+	}
+	id := b.newID("vpce")
+	endpoint := &aliclient.VPCGatewayEndpoint{
+		Tags:            maps.Clone(desired.Tags),
+		Name:            desired.Name,
+		ServiceName:     desired.ServiceName,
+		EndpointId:      id,
+		VpcId:           desired.VpcId,
+		Status:          statusCreating,
+		ResourceGroupId: resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	if endpoint.Tags == nil {
+		endpoint.Tags = aliclient.Tags{}
+	}
+	b.gwEndpoints[id] = endpoint
+	b.transit(id, func() {
+		endpoint.Status = statusCreated
+	})
+	return copyVPCGatewayEndpoint(endpoint), nil
+}
+
+func (b *Backend) listVpcGatewayEndpoints(match func(*aliclient.VPCGatewayEndpoint) bool) []*aliclient.VPCGatewayEndpoint {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.gwEndpoints, match, copyVPCGatewayEndpoint)
+}
+
+func (b *Backend) associateRouteTablesWithVpcGatewayEndpoint(id string, routeTableIds []string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	endpoint := b.gwEndpoints[id]
+	if endpoint == nil {
+		return notFound("ResourceNotFound.Endpoint", id)
+	}
+	if endpoint.Status != statusCreated {
+		return invalidParameter("IncorrectStatus.Endpoint", fmt.Sprintf("The gateway endpoint %s is in status %s.", id, endpoint.Status))
+	}
+	for _, routeTableId := range routeTableIds {
+		if b.routeTables[endpoint.VpcId] != routeTableId {
+			return notFound("ResourceNotFound.RouteTable", routeTableId)
+		}
+	}
+	endpoint.Status = statusAssociating
+	b.transit(id, func() {
+		for _, routeTableId := range routeTableIds {
+			if !slices.Contains(endpoint.RouteTableIds, routeTableId) {
+				endpoint.RouteTableIds = append(endpoint.RouteTableIds, routeTableId)
+			}
+		}
+		endpoint.Status = statusCreated
+	})
+	return nil
+}
+
+func (b *Backend) dissociateRouteTablesFromVpcGatewayEndpoint(id string, routeTableIds []string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	endpoint := b.gwEndpoints[id]
+	if endpoint == nil {
+		return notFound("ResourceNotFound.Endpoint", id)
+	}
+	if endpoint.Status != statusCreated {
+		return invalidParameter("IncorrectStatus.Endpoint", fmt.Sprintf("The gateway endpoint %s is in status %s.", id, endpoint.Status))
+	}
+	endpoint.Status = statusDissociating
+	b.transit(id, func() {
+		endpoint.RouteTableIds = slices.DeleteFunc(endpoint.RouteTableIds, func(routeTableId string) bool {
+			return slices.Contains(routeTableIds, routeTableId)
+		})
+		endpoint.Status = statusCreated
+	})
+	return nil
+}
+
+func (b *Backend) deleteVpcGatewayEndpoint(id string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	endpoint := b.gwEndpoints[id]
+	if endpoint == nil {
+		return notFound("ResourceNotFound.Endpoint", id)
+	}
+	if len(endpoint.RouteTableIds) > 0 {
+		return dependencyViolation("RouteTable", endpoint.RouteTableIds[0])
+	}
+	b.settle(id)
+	endpoint.Status = statusDeleting
+	b.transit(id, func() {
+		delete(b.gwEndpoints, id)
+	})
+	return nil
+}
+
+// Private zones
+
+func (b *Backend) addZone(desired *aliclient.PrivateZone) (*aliclient.PrivateZone, error) {
+	if desired.Name == "" {
+		return nil, invalidParameter("MissingParameter.ZoneName", "The name of the zone must be specified.")
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	zone := &aliclient.PrivateZone{
+		Tags:            aliclient.Tags{},
+		Name:            desired.Name,
+		ZoneId:          b.newID("pvtz"),
+		ResourceGroupId: resourceGroupOrDefault(desired.ResourceGroupId),
+	}
+	b.privateZones[zone.ZoneId] = zone
+	return copyPrivateZone(zone), nil
+}
+
+func (b *Backend) listPrivateZones(match func(*aliclient.PrivateZone) bool) []*aliclient.PrivateZone {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return list(b, b.privateZones, match, copyPrivateZone)
+}
+
+// bindZoneVpc replaces the VPCs the private zone with the given id is bound to.
+func (b *Backend) bindZoneVpc(zoneId string, vpcIds []string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	zone := b.privateZones[zoneId]
+	if zone == nil {
+		return notFound("Zone.Invalid.Id", zoneId)
+	}
+	for _, vpcId := range vpcIds {
+		if b.vpcs[vpcId] == nil {
+			return notFound("InvalidVpcId.NotFound", vpcId)
+		}
+	}
+	zone.VpcIds = slices.Clone(vpcIds)
+	return nil
+}
+
+func (b *Backend) deleteZone(zoneId string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	zone := b.privateZones[zoneId]
+	if zone == nil {
+		return notFound("Zone.Invalid.Id", zoneId)
+	}
+	if len(zone.VpcIds) > 0 {
+		return dependencyViolation("Vpc", zone.VpcIds[0])
+	}
+	for id, record := range b.zoneRecords {
+		if record.ZoneId == zoneId {
+			delete(b.zoneRecords, id)
+		}
+	}
+	delete(b.privateZones, zoneId)
+	return nil
+}
+
+func (b *Backend) addZoneRecord(desired *aliclient.PrivateZoneRecord) (*aliclient.PrivateZoneRecord, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.privateZones[desired.ZoneId] == nil {
+		return nil, notFound("Zone.Invalid.Id", desired.ZoneId)
+	}
+	for _, record := range sortedValues(b.zoneRecords) {
+		if record.ZoneId == desired.ZoneId && record.Rr == desired.Rr && record.Type == desired.Type && record.Value == desired.Value {
+			return nil, invalidParameter("Record.Invalid.Conflict", fmt.Sprintf("The record %s already exists.", desired.Rr))
+		}
+	}
+	record := *desired
+	record.RecordId = int64(b.nextNumber())
+	b.zoneRecords[strconv.FormatInt(record.RecordId, 10)] = &record
+	return copyPrivateZoneRecord(&record), nil
+}
+
+func (b *Backend) listZoneRecords(zoneId string) ([]*aliclient.PrivateZoneRecord, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.privateZones[zoneId] == nil {
+		return nil, notFound("Zone.Invalid.Id", zoneId)
+	}
+	return list(b, b.zoneRecords, func(record *aliclient.PrivateZoneRecord) bool {
+		return record.ZoneId == zoneId
+	}, copyPrivateZoneRecord), nil
+}
+
+func (b *Backend) updateZoneRecord(desired *aliclient.PrivateZoneRecord) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	record := b.zoneRecords[strconv.FormatInt(desired.RecordId, 10)]
+	if record == nil {
+		return notFound("Record.Invalid.Id", strconv.FormatInt(desired.RecordId, 10))
+	}
+	record.Rr = desired.Rr
+	record.Type = desired.Type
+	record.Value = desired.Value
+	record.Ttl = desired.Ttl
+	return nil
+}
+
+// helpers for the resource maps, which must be called with the lock held
+
+func sortedValues[T any](m map[string]*T) []*T {
+	var result []*T
+	for _, id := range slices.Sorted(maps.Keys(m)) {
+		result = append(result, m[id])
+	}
+	return result
+}
+
+// all returns copies of all resources without reading them.
+func all[T any](m map[string]*T, clone func(*T) *T) []*T {
+	var result []*T
+	for _, obj := range sortedValues(m) {
+		result = append(result, clone(obj))
+	}
+	return result
+}
+
+// get reads the resource with the given id and returns a copy, or nil if it does not exist (anymore).
+func get[T any](b *Backend, m map[string]*T, id string, clone func(*T) *T) *T {
+	if m[id] == nil {
+		return nil
+	}
+	b.observe(id)
+	if obj := m[id]; obj != nil {
+		return clone(obj)
+	}
+	return nil
+}
+
+// list reads all resources matching the given function and returns copies of them.
+func list[T any](b *Backend, m map[string]*T, match func(*T) bool, clone func(*T) *T) []*T {
+	var result []*T
+	for _, id := range slices.Sorted(maps.Keys(m)) {
+		obj := m[id]
+		if obj == nil || !match(obj) {
+			continue
+		}
+		if obj := get(b, m, id, clone); obj != nil {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/fake"
+)
+
+const region = "eu-central-1"
+
+var _ = Describe("Backend", func() {
+	var (
+		ctx     context.Context
+		backend *fake.Backend
+		actor   aliclient.Actor
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		backend = fake.NewBackend(region)
+		actor = fake.NewActor(backend)
+	})
+
+	createVpc := func() *aliclient.VPC {
+		vpc, err := actor.CreateVpc(ctx, &aliclient.VPC{Name: "shoot--foo--bar", CidrBlock: "10.0.0.0/16"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actor.CreateTags(ctx, []string{vpc.VpcId}, aliclient.Tags{"Name": "shoot--foo--bar"}, "VPC")).To(Succeed())
+		vpc, err = actor.GetVpc(ctx, vpc.VpcId)
+		Expect(err).NotTo(HaveOccurred())
+		return vpc
+	}
+
+	Describe("status transitions", func() {
+		It("should let a created resource pass through a pending status", func() {
+			backend.PendingReads = 2
+			client, err := fake.NewClientFactory(backend).NewVPCClient(region, &alicloud.Credentials{})
+			Expect(err).NotTo(HaveOccurred())
+
+			createReq := vpc.CreateCreateVpcRequest()
+			createReq.CidrBlock = "10.0.0.0/16"
+			created, err := client.CreateVpc(createReq)
+			Expect(err).NotTo(HaveOccurred())
+
+			describeReq := vpc.CreateDescribeVpcsRequest()
+			describeReq.VpcId = created.VpcId
+			var statuses []string
+			for range 4 {
+				resp, err := client.DescribeVpcs(describeReq)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Vpcs.Vpc).To(HaveLen(1))
+				statuses = append(statuses, resp.Vpcs.Vpc[0].Status)
+			}
+			Expect(statuses).To(Equal([]string{"Pending", "Pending", "Available", "Available"}))
+		})
+
+		It("should wait in the actor until a created resource is available", func() {
+			backend.PendingReads = 3
+
+			vpc := createVpc()
+			Expect(vpc.Status).To(Equal(ptr.To("Available")))
+		})
+
+		It("should wait in the actor until a deleted resource is gone", func() {
+			backend.PendingReads = 3
+			vpc := createVpc()
+
+			Expect(actor.DeleteVpc(ctx, vpc.VpcId)).To(Succeed())
+			Expect(backend.IsEmpty()).To(BeTrue())
+		})
+	})
+
+	Describe("dependencies", func() {
+		It("should refuse to delete a VPC with vswitches", func() {
+			vpc := createVpc()
+			vsw, err := actor.CreateVSwitch(ctx, &aliclient.VSwitch{VpcId: &vpc.VpcId, CidrBlock: "10.0.0.0/24", ZoneId: region + "a"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = actor.DeleteVpc(ctx, vpc.VpcId)
+			Expect(err).To(MatchError(ContainSubstring("DependencyViolation.VSwitch")))
+
+			Expect(actor.DeleteVSwitch(ctx, vsw.VSwitchId)).To(Succeed())
+			Expect(actor.DeleteVpc(ctx, vpc.VpcId)).To(Succeed())
+			Expect(backend.IsEmpty()).To(BeTrue())
+		})
+
+		It("should refuse to create a vswitch outside of the VPC CIDR", func() {
+			vpc := createVpc()
+
+			_, err := actor.CreateVSwitch(ctx, &aliclient.VSwitch{VpcId: &vpc.VpcId, CidrBlock: "10.1.0.0/24", ZoneId: region + "a"})
+			Expect(err).To(HaveOccurred())
+			Expect(backend.VSwitches()).To(BeEmpty())
+		})
+	})
+
+	Describe("faults", func() {
+		It("should fail the given number of calls", func() {
+			backend.InjectFault("CreateVpc", fake.ErrOutOfStock, 1)
+
+			_, err := actor.CreateVpc(ctx, &aliclient.VPC{CidrBlock: "10.0.0.0/16"})
+			Expect(err).To(MatchError(ContainSubstring("OperationDenied.NoStock")))
+			_, err = actor.CreateVpc(ctx, &aliclient.VPC{CidrBlock: "10.0.0.0/16"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.Calls("CreateVpc")).To(Equal(2))
+		})
+
+		It("should fail all calls until the faults are cleared", func() {
+			// throttling errors are not used, as the actor retries them
+			backend.InjectFault("DescribeVpcs", fake.NewServerError("ServiceUnavailable", "The request has failed due to a temporary failure of the server."), 0)
+
+			for range 3 {
+				_, err := actor.GetVpc(ctx, "vpc-1")
+				Expect(err).To(MatchError(ContainSubstring("ServiceUnavailable")))
+			}
+			backend.ClearFaults()
+			Expect(actor.GetVpc(ctx, "vpc-1")).To(BeNil())
+		})
+	})
+
+	Describe("client factory", func() {
+		It("should let actors created from the client factory share the backend", func() {
+			vpc := createVpc()
+
+			realActor, err := aliclient.NewActorFromClientFactory(fake.NewClientFactory(backend), &alicloud.Credentials{}, region)
+			Expect(err).NotTo(HaveOccurred())
+
+			current, err := realActor.GetVpc(ctx, vpc.VpcId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current).To(Equal(vpc))
+
+			found, err := realActor.FindVpcsByTags(ctx, aliclient.Tags{"Name": "shoot--foo--bar"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(HaveLen(1))
+			Expect(found[0].VpcId).To(Equal(vpc.VpcId))
+		})
+
+		It("should refuse to create clients for another region", func() {
+			_, err := fake.NewClientFactory(backend).NewVPCClient("cn-beijing", &alicloud.Credentials{})
+			Expect(err).To(HaveOccurred())
+			_, err = fake.NewFactory(backend).NewActor(&alicloud.Credentials{}, "cn-beijing")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// cbnClient is a fake CBN client working on a Backend. Only the request parameters used by the infrastructure flow
+// are evaluated.
+type cbnClient struct {
+	backend *Backend
+}
+
+var _ alicloudclient.CBN = &cbnClient{}
+
+func (c *cbnClient) AttachCenChildInstance(request *cbn.AttachCenChildInstanceRequest) (*cbn.AttachCenChildInstanceResponse, error) {
+	if err := c.backend.call("AttachCenChildInstance"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.attachCENChildInstance(request.CenId, request.ChildInstanceId, request.ChildInstanceRegionId); err != nil {
+		return nil, err
+	}
+	return cbn.CreateAttachCenChildInstanceResponse(), nil
+}
+
+func (c *cbnClient) DetachCenChildInstance(request *cbn.DetachCenChildInstanceRequest) (*cbn.DetachCenChildInstanceResponse, error) {
+	if err := c.backend.call("DetachCenChildInstance"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.detachCENChildInstance(request.CenId, request.ChildInstanceId); err != nil {
+		return nil, err
+	}
+	return cbn.CreateDetachCenChildInstanceResponse(), nil
+}
+
+func (c *cbnClient) DescribeCenAttachedChildInstances(request *cbn.DescribeCenAttachedChildInstancesRequest) (*cbn.DescribeCenAttachedChildInstancesResponse, error) {
+	if err := c.backend.call("DescribeCenAttachedChildInstances"); err != nil {
+		return nil, err
+	}
+	var items []cbn.ChildInstance
+	for _, child := range c.backend.listCENChildInstances(request.CenId, request.ChildInstanceRegionId) {
+		items = append(items, cbn.ChildInstance{
+			CenId:                 child.CenId,
+			ChildInstanceId:       child.ChildInstanceId,
+			ChildInstanceRegionId: child.RegionId,
+			ChildInstanceType:     "VPC",
+			Status:                child.Status,
+		})
+	}
+	resp := cbn.CreateDescribeCenAttachedChildInstancesResponse()
+	resp.ChildInstances.ChildInstance, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *cbnClient) CreateTransitRouterVpcAttachment(request *cbn.CreateTransitRouterVpcAttachmentRequest) (*cbn.CreateTransitRouterVpcAttachmentResponse, error) {
+	if err := c.backend.call("CreateTransitRouterVpcAttachment"); err != nil {
+		return nil, err
+	}
+	desired := &aliclient.TransitRouterVpcAttachment{
+		Tags:            aliclient.Tags{},
+		Name:            request.TransitRouterAttachmentName,
+		CenId:           request.CenId,
+		TransitRouterId: request.TransitRouterId,
+		VpcId:           request.VpcId,
+		ZoneMappings:    map[string]string{},
+	}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			desired.Tags[t.Key] = t.Value
+		}
+	}
+	if request.ZoneMappings != nil {
+		for _, zm := range *request.ZoneMappings {
+			desired.ZoneMappings[zm.ZoneId] = zm.VSwitchId
+		}
+	}
+	created, err := c.backend.createTransitRouterVpcAttachment(desired)
+	if err != nil {
+		return nil, err
+	}
+	resp := cbn.CreateCreateTransitRouterVpcAttachmentResponse()
+	resp.TransitRouterAttachmentId = created.TransitRouterAttachmentId
+	return resp, nil
+}
+
+// ListTransitRouterVpcAttachments returns all matching attachments at once.
+func (c *cbnClient) ListTransitRouterVpcAttachments(request *cbn.ListTransitRouterVpcAttachmentsRequest) (*cbn.ListTransitRouterVpcAttachmentsResponse, error) {
+	if err := c.backend.call("ListTransitRouterVpcAttachments"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	attachments := c.backend.listTransitRouterVpcAttachments(func(attachment *aliclient.TransitRouterVpcAttachment) bool {
+		return matches(request.TransitRouterAttachmentId, attachment.TransitRouterAttachmentId) &&
+			matches(request.TransitRouterId, attachment.TransitRouterId) && matches(request.CenId, attachment.CenId) &&
+			matches(request.VpcId, attachment.VpcId) && matches(request.Status, attachment.Status) &&
+			hasTags(attachment.Tags, tags)
+	})
+	resp := cbn.CreateListTransitRouterVpcAttachmentsResponse()
+	for _, attachment := range attachments {
+		item := cbn.TransitRouterAttachment{
+			TransitRouterAttachmentName: attachment.Name,
+			CenId:                       attachment.CenId,
+			TransitRouterId:             attachment.TransitRouterId,
+			TransitRouterAttachmentId:   attachment.TransitRouterAttachmentId,
+			VpcId:                       attachment.VpcId,
+			VpcRegionId:                 c.backend.Region(),
+			ResourceType:                "VPC",
+			AutoPublishRouteEnabled:     true,
+			Status:                      attachment.Status,
+		}
+		for _, key := range sortedKeys(attachment.Tags) {
+			item.Tags = append(item.Tags, cbn.Tag{Key: key, Value: attachment.Tags[key]})
+		}
+		for _, zoneId := range sortedKeys(attachment.ZoneMappings) {
+			item.ZoneMappings = append(item.ZoneMappings, cbn.ZoneMapping{ZoneId: zoneId, VSwitchId: attachment.ZoneMappings[zoneId]})
+		}
+		resp.TransitRouterAttachments = append(resp.TransitRouterAttachments, item)
+	}
+	resp.TotalCount = len(attachments)
+	resp.MaxResults = len(attachments)
+	return resp, nil
+}
+
+func (c *cbnClient) UpdateTransitRouterVpcAttachmentZones(request *cbn.UpdateTransitRouterVpcAttachmentZonesRequest) (*cbn.UpdateTransitRouterVpcAttachmentZonesResponse, error) {
+	if err := c.backend.call("UpdateTransitRouterVpcAttachmentZones"); err != nil {
+		return nil, err
+	}
+	zoneMappings := map[string]string{}
+	if request.AddZoneMappings != nil {
+		for _, zm := range *request.AddZoneMappings {
+			zoneMappings[zm.ZoneId] = zm.VSwitchId
+		}
+	}
+	if err := c.backend.addZonesToTransitRouterVpcAttachment(request.TransitRouterAttachmentId, zoneMappings); err != nil {
+		return nil, err
+	}
+	return cbn.CreateUpdateTransitRouterVpcAttachmentZonesResponse(), nil
+}
+
+func (c *cbnClient) DeleteTransitRouterVpcAttachment(request *cbn.DeleteTransitRouterVpcAttachmentRequest) (*cbn.DeleteTransitRouterVpcAttachmentResponse, error) {
+	if err := c.backend.call("DeleteTransitRouterVpcAttachment"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteTransitRouterVpcAttachment(request.TransitRouterAttachmentId); err != nil {
+		return nil, err
+	}
+	return cbn.CreateDeleteTransitRouterVpcAttachmentResponse(), nil
+}
+
+func (c *cbnClient) CreateTransitRouterRouteEntry(request *cbn.CreateTransitRouterRouteEntryRequest) (*cbn.CreateTransitRouterRouteEntryResponse, error) {
+	if err := c.backend.call("CreateTransitRouterRouteEntry"); err != nil {
+		return nil, err
+	}
+	created, err := c.backend.createTransitRouterRouteEntry(&aliclient.TransitRouterRouteEntry{
+		Name:                      request.TransitRouterRouteEntryName,
+		TransitRouterRouteTableId: request.TransitRouterRouteTableId,
+		DestinationCidrBlock:      request.TransitRouterRouteEntryDestinationCidrBlock,
+		NextHopId:                 request.TransitRouterRouteEntryNextHopId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := cbn.CreateCreateTransitRouterRouteEntryResponse()
+	resp.TransitRouterRouteEntryId = created.TransitRouterRouteEntryId
+	return resp, nil
+}
+
+// ListTransitRouterRouteEntries returns all matching route entries at once. All modelled route entries are static.
+func (c *cbnClient) ListTransitRouterRouteEntries(request *cbn.ListTransitRouterRouteEntriesRequest) (*cbn.ListTransitRouterRouteEntriesResponse, error) {
+	if err := c.backend.call("ListTransitRouterRouteEntries"); err != nil {
+		return nil, err
+	}
+	entries := c.backend.listTransitRouterRouteEntries(func(entry *aliclient.TransitRouterRouteEntry) bool {
+		return matches(request.TransitRouterRouteTableId, entry.TransitRouterRouteTableId) &&
+			matches(request.TransitRouterRouteEntryDestinationCidrBlock, entry.DestinationCidrBlock) &&
+			matches(request.TransitRouterRouteEntryNextHopId, entry.NextHopId) &&
+			matches(request.TransitRouterRouteEntryType, "Static")
+	})
+	resp := cbn.CreateListTransitRouterRouteEntriesResponse()
+	for _, entry := range entries {
+		resp.TransitRouterRouteEntries = append(resp.TransitRouterRouteEntries, cbn.TransitRouterRouteEntry{
+			TransitRouterRouteEntryId:                   entry.TransitRouterRouteEntryId,
+			TransitRouterRouteEntryName:                 entry.Name,
+			TransitRouterRouteEntryDestinationCidrBlock: entry.DestinationCidrBlock,
+			TransitRouterRouteEntryType:                 "Static",
+			TransitRouterRouteEntryNextHopType:          "Attachment",
+			TransitRouterRouteEntryNextHopId:            entry.NextHopId,
+			TransitRouterRouteEntryStatus:               entry.Status,
+		})
+	}
+	resp.TotalCount = len(entries)
+	resp.MaxResults = len(entries)
+	return resp, nil
+}
+
+func (c *cbnClient) DeleteTransitRouterRouteEntry(request *cbn.DeleteTransitRouterRouteEntryRequest) (*cbn.DeleteTransitRouterRouteEntryResponse, error) {
+	if err := c.backend.call("DeleteTransitRouterRouteEntry"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteTransitRouterRouteEntry(request.TransitRouterRouteEntryId); err != nil {
+		return nil, err
+	}
+	return cbn.CreateDeleteTransitRouterRouteEntryResponse(), nil
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// pollInterval is the interval in which the actors working on a Backend poll the status of resources. The status of
+// a resource only changes when it is read, hence there is no need to wait between the reads.
+const pollInterval = time.Millisecond
+
+// clientFactory is a fake alicloudclient.ClientFactory creating clients which work on a Backend. It supports the
+// ECS, VPC, CBN, PrivateLink, PVTZ and STS clients, creating any other client fails.
+type clientFactory struct {
+	backend *Backend
+}
+
+var _ alicloudclient.ClientFactory = &clientFactory{}
+
+// NewClientFactory creates a client factory returning fake clients working on the given backend. The credentials are
+// ignored. It can be passed to aliclient.NewActorFromClientFactory to test the real actor against the backend.
+func NewClientFactory(backend *Backend) alicloudclient.ClientFactory {
+	return &clientFactory{backend: backend}
+}
+
+// NewActor creates the real actor working on the given backend through the fake clients of NewClientFactory.
+func NewActor(backend *Backend) aliclient.Actor {
+	actor, err := NewFactory(backend).NewActor(&alicloud.Credentials{}, backend.Region())
+	if err != nil {
+		// cannot happen, the fake clients can always be created for the region of the backend
+		panic(err)
+	}
+	return actor
+}
+
+// NewFactory creates a factory returning real actors which work on the given backend through the fake clients of
+// NewClientFactory. The credentials are ignored.
+func NewFactory(backend *Backend) aliclient.Factory {
+	return aliclient.FactoryFunc(func(credentials *alicloud.Credentials, region string) (aliclient.Actor, error) {
+		return aliclient.NewActorFromClientFactory(NewClientFactory(backend), credentials, region, aliclient.WithPollInterval(pollInterval))
+	})
+}
+
+func (f *clientFactory) NewECSClient(region string, _ *alicloud.Credentials) (alicloudclient.ECS, error) {
+	if err := f.backend.checkRegion(region); err != nil {
+		return nil, err
+	}
+	return &ecsClient{backend: f.backend}, nil
+}
+
+func (f *clientFactory) NewSTSClient(region string, _ *alicloud.Credentials) (alicloudclient.STS, error) {
+	if err := f.backend.checkRegion(region); err != nil {
+		return nil, err
+	}
+	return &stsClient{backend: f.backend}, nil
+}
+
+func (f *clientFactory) NewVPCClient(region string, _ *alicloud.Credentials) (alicloudclient.VPC, error) {
+	if err := f.backend.checkRegion(region); err != nil {
+		return nil, err
+	}
+	return &vpcClient{backend: f.backend}, nil
+}
+
+func (f *clientFactory) NewCBNClient(region string, _ *alicloud.Credentials) (alicloudclient.CBN, error) {
+	if err := f.backend.checkRegion(region); err != nil {
+		return nil, err
+	}
+	return &cbnClient{backend: f.backend}, nil
+}
+
+func (f *clientFactory) NewPrivateLinkClient(region string, _ *alicloud.Credentials) (alicloudclient.PrivateLink, error) {
+	if err := f.backend.checkRegion(region); err != nil {
+		return nil, err
+	}
+	return &privateLinkClient{backend: f.backend}, nil
+}
+
+func (f *clientFactory) NewPVTZClient(region string, _ *alicloud.Credentials) (alicloudclient.PVTZ, error) {
+	if err := f.backend.checkRegion(region); err != nil {
+		return nil, err
+	}
+	return &pvtzClient{backend: f.backend}, nil
+}
+
+func (f *clientFactory) NewSLBClient(_ string, _ *alicloud.Credentials) (alicloudclient.SLB, error) {
+	return nil, notSupported("SLB")
+}
+
+func (f *clientFactory) NewRAMClient(_ string, _ *alicloud.Credentials) (alicloudclient.RAM, error) {
+	return nil, notSupported("RAM")
+}
+
+func (f *clientFactory) NewROSClient(_ string, _ *alicloud.Credentials) (alicloudclient.ROS, error) {
+	return nil, notSupported("ROS")
+}
+
+func (f *clientFactory) NewOSSClient(_ string, _ *alicloud.Credentials) (alicloudclient.OSS, error) {
+	return nil, notSupported("OSS")
+}
+
+func (f *clientFactory) NewOSSClientFromSecretRef(_ context.Context, _ client.Client, _ *corev1.SecretReference, _ string) (alicloudclient.OSS, error) {
+	return nil, notSupported("OSS")
+}
+
+func (f *clientFactory) NewDNSClient(_ string, _ *alicloud.Credentials) (alicloudclient.DNS, error) {
+	return nil, notSupported("DNS")
+}
+
+func notSupported(service string) error {
+	return fmt.Errorf("%s clients are not supported by the fake backend", service)
+}
+
+// stsClient is a fake STS client returning the account id of a Backend.
+type stsClient struct {
+	backend *Backend
+}
+
+func (c *stsClient) GetAccountIDFromCallerIdentity(_ context.Context) (string, error) {
+	if err := c.backend.call("GetCallerIdentity"); err != nil {
+		return "", err
+	}
+	return c.backend.AccountID, nil
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	cp := *s
+	return &cp
+}
+
+func copyVPC(in *aliclient.VPC) *aliclient.VPC {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.Status = copyString(in.Status)
+	return &out
+}
+
+func copyVSwitch(in *aliclient.VSwitch) *aliclient.VSwitch {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = copyString(in.VpcId)
+	out.Status = copyString(in.Status)
+	if in.IPv6CidrBlockIndex != nil {
+		index := *in.IPv6CidrBlockIndex
+		out.IPv6CidrBlockIndex = &index
+	}
+	return &out
+}
+
+func copyIPv6Gateway(in *aliclient.IPv6Gateway) *aliclient.IPv6Gateway {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.Status = copyString(in.Status)
+	return &out
+}
+
+func copyNatGateway(in *aliclient.NatGateway) *aliclient.NatGateway {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcId = copyString(in.VpcId)
+	out.VswitchId = copyString(in.VswitchId)
+	out.Status = copyString(in.Status)
+	out.AvailableVSwitches = slices.Clone(in.AvailableVSwitches)
+	out.SNATTableIDs = slices.Clone(in.SNATTableIDs)
+	return &out
+}
+
+func copyEIP(in *aliclient.EIP) *aliclient.EIP {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.Status = copyString(in.Status)
+	out.InstanceType = copyString(in.InstanceType)
+	out.InstanceId = copyString(in.InstanceId)
+	return &out
+}
+
+func copySNATEntry(in *aliclient.SNATEntry) *aliclient.SNATEntry {
+	out := *in
+	out.Status = copyString(in.Status)
+	return &out
+}
+
+func copySecurityGroup(in *aliclient.SecurityGroup) *aliclient.SecurityGroup {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.Status = copyString(in.Status)
+	out.Rules = nil
+	for _, rule := range in.Rules {
+		cp := *rule
+		out.Rules = append(out.Rules, &cp)
+	}
+	return &out
+}
+
+func copyCENChildInstance(in *aliclient.CENChildInstance) *aliclient.CENChildInstance {
+	out := *in
+	return &out
+}
+
+func copyTransitRouterVpcAttachment(in *aliclient.TransitRouterVpcAttachment) *aliclient.TransitRouterVpcAttachment {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.ZoneMappings = maps.Clone(in.ZoneMappings)
+	return &out
+}
+
+func copyTransitRouterRouteEntry(in *aliclient.TransitRouterRouteEntry) *aliclient.TransitRouterRouteEntry {
+	out := *in
+	return &out
+}
+
+func copyVPCEndpoint(in *aliclient.VPCEndpoint) *aliclient.VPCEndpoint {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.SecurityGroupIds = slices.Clone(in.SecurityGroupIds)
+	out.ZoneMappings = maps.Clone(in.ZoneMappings)
+	return &out
+}
+
+func copyVPCGatewayEndpoint(in *aliclient.VPCGatewayEndpoint) *aliclient.VPCGatewayEndpoint {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.RouteTableIds = slices.Clone(in.RouteTableIds)
+	return &out
+}
+
+func copyPrivateZone(in *aliclient.PrivateZone) *aliclient.PrivateZone {
+	out := *in
+	out.Tags = in.Tags.Clone()
+	out.VpcIds = slices.Clone(in.VpcIds)
+	return &out
+}
+
+func copyPrivateZoneRecord(in *aliclient.PrivateZoneRecord) *aliclient.PrivateZoneRecord {
+	out := *in
+	return &out
+}
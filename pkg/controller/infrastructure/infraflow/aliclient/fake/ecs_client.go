@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"slices"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"k8s.io/utils/ptr"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const (
+	directionIngress = "ingress"
+	directionEgress  = "egress"
+)
+
+// ecsClient is a fake ECS client working on a Backend. It only models security groups, their tags and resource
+// groups. Calling any other method of the ECS interface (e.g. for images or instances) panics.
+type ecsClient struct {
+	alicloudclient.ECS
+	backend *Backend
+}
+
+var _ alicloudclient.ECS = &ecsClient{}
+
+func (c *ecsClient) GetSecurityGroup(name string) (*ecs.DescribeSecurityGroupsResponse, error) {
+	request := ecs.CreateDescribeSecurityGroupsRequest()
+	request.SecurityGroupName = name
+	return c.DescribeSecurityGroups(request)
+}
+
+func (c *ecsClient) GetSecurityGroupWithID(id string) (*ecs.DescribeSecurityGroupsResponse, error) {
+	request := ecs.CreateDescribeSecurityGroupsRequest()
+	request.SecurityGroupId = id
+	return c.DescribeSecurityGroups(request)
+}
+
+// DescribeSecurityGroups returns all matching security groups at once, as the paging of the actor does not handle a
+// NextToken.
+func (c *ecsClient) DescribeSecurityGroups(request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
+	if err := c.backend.call("DescribeSecurityGroups"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	groups := c.backend.listSecurityGroups(func(sg *aliclient.SecurityGroup) bool {
+		return matches(request.SecurityGroupId, sg.SecurityGroupId) && matches(request.SecurityGroupName, sg.Name) &&
+			matches(request.VpcId, sg.VpcId) && matches(request.ResourceGroupId, sg.ResourceGroupId) &&
+			hasTags(sg.Tags, tags)
+	})
+	resp := ecs.CreateDescribeSecurityGroupsResponse()
+	for _, sg := range groups {
+		item := ecs.SecurityGroup{
+			SecurityGroupId:   sg.SecurityGroupId,
+			SecurityGroupName: sg.Name,
+			Description:       sg.Description,
+			SecurityGroupType: "normal",
+			VpcId:             sg.VpcId,
+			ResourceGroupId:   sg.ResourceGroupId,
+		}
+		for _, key := range sortedKeys(sg.Tags) {
+			item.Tags.Tag = append(item.Tags.Tag, ecs.Tag{Key: key, Value: sg.Tags[key], TagKey: key, TagValue: sg.Tags[key]})
+		}
+		resp.SecurityGroups.SecurityGroup = append(resp.SecurityGroups.SecurityGroup, item)
+	}
+	resp.RegionId = c.backend.Region()
+	resp.TotalCount = len(groups)
+	resp.PageNumber = 1
+	resp.PageSize = len(groups)
+	return resp, nil
+}
+
+func (c *ecsClient) DescribeSecurityGroupAttribute(request *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+	if err := c.backend.call("DescribeSecurityGroupAttribute"); err != nil {
+		return nil, err
+	}
+	sg := c.backend.getSecurityGroup(request.SecurityGroupId)
+	if sg == nil {
+		return nil, notFound("InvalidSecurityGroupId.NotFound", request.SecurityGroupId)
+	}
+	resp := ecs.CreateDescribeSecurityGroupAttributeResponse()
+	resp.SecurityGroupId = sg.SecurityGroupId
+	resp.SecurityGroupName = sg.Name
+	resp.Description = sg.Description
+	resp.VpcId = sg.VpcId
+	resp.RegionId = c.backend.Region()
+	for _, rule := range sg.Rules {
+		if !matches(request.Direction, rule.Direction) && request.Direction != "all" {
+			continue
+		}
+		resp.Permissions.Permission = append(resp.Permissions.Permission, ecs.Permission{
+			SecurityGroupRuleId: rule.SecurityGroupRuleId,
+			Direction:           rule.Direction,
+			Policy:              rule.Policy,
+			Priority:            rule.Priority,
+			IpProtocol:          rule.IpProtocol,
+			PortRange:           rule.PortRange,
+			SourceCidrIp:        rule.SourceCidrIp,
+			DestCidrIp:          rule.DestCidrIp,
+			Ipv6SourceCidrIp:    rule.Ipv6SourceCidrIp,
+			Ipv6DestCidrIp:      rule.Ipv6DestCidrIp,
+		})
+	}
+	return resp, nil
+}
+
+func (c *ecsClient) CreateSecurityGroups(vpcId, name string) (*ecs.CreateSecurityGroupResponse, error) {
+	request := ecs.CreateCreateSecurityGroupRequest()
+	request.VpcId = vpcId
+	request.SecurityGroupName = name
+	return c.CreateSecurityGroup(request)
+}
+
+func (c *ecsClient) DeleteSecurityGroups(id string) error {
+	request := ecs.CreateDeleteSecurityGroupRequest()
+	request.SecurityGroupId = id
+	_, err := c.DeleteSecurityGroup(request)
+	return err
+}
+
+func (c *ecsClient) CreateIngressRule(request *ecs.AuthorizeSecurityGroupRequest) error {
+	_, err := c.AuthorizeSecurityGroup(request)
+	return err
+}
+
+func (c *ecsClient) CreateEgressRule(request *ecs.AuthorizeSecurityGroupEgressRequest) error {
+	_, err := c.AuthorizeSecurityGroupEgress(request)
+	return err
+}
+
+func (c *ecsClient) RevokeIngressRule(request *ecs.RevokeSecurityGroupRequest) error {
+	_, err := c.RevokeSecurityGroup(request)
+	return err
+}
+
+func (c *ecsClient) RevokeEgressRule(request *ecs.RevokeSecurityGroupEgressRequest) error {
+	_, err := c.RevokeSecurityGroupEgress(request)
+	return err
+}
+
+func (c *ecsClient) CreateSecurityGroup(request *ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error) {
+	if err := c.backend.call("CreateSecurityGroup"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	created, err := c.backend.createSecurityGroup(&aliclient.SecurityGroup{
+		Tags:            tags,
+		Name:            request.SecurityGroupName,
+		VpcId:           request.VpcId,
+		Description:     request.Description,
+		ResourceGroupId: request.ResourceGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := ecs.CreateCreateSecurityGroupResponse()
+	resp.SecurityGroupId = created.SecurityGroupId
+	return resp, nil
+}
+
+func (c *ecsClient) DeleteSecurityGroup(request *ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error) {
+	if err := c.backend.call("DeleteSecurityGroup"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteSecurityGroup(request.SecurityGroupId); err != nil {
+		return nil, err
+	}
+	return ecs.CreateDeleteSecurityGroupResponse(), nil
+}
+
+// AuthorizeSecurityGroup adds the given permissions as ingress rules. If no permissions are given, the rule is taken
+// from the flat parameters of the request.
+func (c *ecsClient) AuthorizeSecurityGroup(request *ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error) {
+	if err := c.backend.call("AuthorizeSecurityGroup"); err != nil {
+		return nil, err
+	}
+	permissions := ptr.Deref(request.Permissions, nil)
+	if len(permissions) == 0 {
+		permissions = []ecs.AuthorizeSecurityGroupPermissions{{
+			Policy:           request.Policy,
+			Priority:         request.Priority,
+			IpProtocol:       request.IpProtocol,
+			PortRange:        request.PortRange,
+			SourceCidrIp:     request.SourceCidrIp,
+			Ipv6SourceCidrIp: request.Ipv6SourceCidrIp,
+			DestCidrIp:       request.DestCidrIp,
+			Ipv6DestCidrIp:   request.Ipv6DestCidrIp,
+		}}
+	}
+	for _, p := range permissions {
+		if err := c.backend.authorizeSecurityGroupRule(request.SecurityGroupId, &aliclient.SecurityGroupRule{
+			Direction:        directionIngress,
+			Policy:           p.Policy,
+			Priority:         p.Priority,
+			IpProtocol:       p.IpProtocol,
+			PortRange:        p.PortRange,
+			SourceCidrIp:     p.SourceCidrIp,
+			Ipv6SourceCidrIp: p.Ipv6SourceCidrIp,
+			DestCidrIp:       p.DestCidrIp,
+			Ipv6DestCidrIp:   p.Ipv6DestCidrIp,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return ecs.CreateAuthorizeSecurityGroupResponse(), nil
+}
+
+// AuthorizeSecurityGroupEgress adds the given permissions as egress rules. If no permissions are given, the rule is
+// taken from the flat parameters of the request.
+func (c *ecsClient) AuthorizeSecurityGroupEgress(request *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error) {
+	if err := c.backend.call("AuthorizeSecurityGroupEgress"); err != nil {
+		return nil, err
+	}
+	permissions := ptr.Deref(request.Permissions, nil)
+	if len(permissions) == 0 {
+		permissions = []ecs.AuthorizeSecurityGroupEgressPermissions{{
+			Policy:           request.Policy,
+			Priority:         request.Priority,
+			IpProtocol:       request.IpProtocol,
+			PortRange:        request.PortRange,
+			SourceCidrIp:     request.SourceCidrIp,
+			Ipv6SourceCidrIp: request.Ipv6SourceCidrIp,
+			DestCidrIp:       request.DestCidrIp,
+			Ipv6DestCidrIp:   request.Ipv6DestCidrIp,
+		}}
+	}
+	for _, p := range permissions {
+		if err := c.backend.authorizeSecurityGroupRule(request.SecurityGroupId, &aliclient.SecurityGroupRule{
+			Direction:        directionEgress,
+			Policy:           p.Policy,
+			Priority:         p.Priority,
+			IpProtocol:       p.IpProtocol,
+			PortRange:        p.PortRange,
+			SourceCidrIp:     p.SourceCidrIp,
+			Ipv6SourceCidrIp: p.Ipv6SourceCidrIp,
+			DestCidrIp:       p.DestCidrIp,
+			Ipv6DestCidrIp:   p.Ipv6DestCidrIp,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return ecs.CreateAuthorizeSecurityGroupEgressResponse(), nil
+}
+
+// RevokeSecurityGroup removes the ingress rules with the given ids. If no ids are given, the rule matching the flat
+// parameters of the request is removed.
+func (c *ecsClient) RevokeSecurityGroup(request *ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error) {
+	if err := c.backend.call("RevokeSecurityGroup"); err != nil {
+		return nil, err
+	}
+	ruleIds := ptr.Deref(request.SecurityGroupRuleId, nil)
+	if len(ruleIds) == 0 {
+		ruleIds = c.findRule(request.SecurityGroupId, &aliclient.SecurityGroupRule{
+			Direction:        directionIngress,
+			Policy:           request.Policy,
+			Priority:         request.Priority,
+			IpProtocol:       request.IpProtocol,
+			PortRange:        request.PortRange,
+			SourceCidrIp:     request.SourceCidrIp,
+			Ipv6SourceCidrIp: request.Ipv6SourceCidrIp,
+			DestCidrIp:       request.DestCidrIp,
+			Ipv6DestCidrIp:   request.Ipv6DestCidrIp,
+		})
+	}
+	for _, ruleId := range ruleIds {
+		if err := c.backend.revokeSecurityGroupRule(request.SecurityGroupId, ruleId, directionIngress); err != nil {
+			return nil, err
+		}
+	}
+	return ecs.CreateRevokeSecurityGroupResponse(), nil
+}
+
+// RevokeSecurityGroupEgress removes the egress rules with the given ids. If no ids are given, the rule matching the
+// flat parameters of the request is removed.
+func (c *ecsClient) RevokeSecurityGroupEgress(request *ecs.RevokeSecurityGroupEgressRequest) (*ecs.RevokeSecurityGroupEgressResponse, error) {
+	if err := c.backend.call("RevokeSecurityGroupEgress"); err != nil {
+		return nil, err
+	}
+	ruleIds := ptr.Deref(request.SecurityGroupRuleId, nil)
+	if len(ruleIds) == 0 {
+		ruleIds = c.findRule(request.SecurityGroupId, &aliclient.SecurityGroupRule{
+			Direction:        directionEgress,
+			Policy:           request.Policy,
+			Priority:         request.Priority,
+			IpProtocol:       request.IpProtocol,
+			PortRange:        request.PortRange,
+			SourceCidrIp:     request.SourceCidrIp,
+			Ipv6SourceCidrIp: request.Ipv6SourceCidrIp,
+			DestCidrIp:       request.DestCidrIp,
+			Ipv6DestCidrIp:   request.Ipv6DestCidrIp,
+		})
+	}
+	for _, ruleId := range ruleIds {
+		if err := c.backend.revokeSecurityGroupRule(request.SecurityGroupId, ruleId, directionEgress); err != nil {
+			return nil, err
+		}
+	}
+	return ecs.CreateRevokeSecurityGroupEgressResponse(), nil
+}
+
+// findRule returns the id of the rule of the given security group which equals the given rule.
+func (c *ecsClient) findRule(sgId string, rule *aliclient.SecurityGroupRule) []string {
+	sg := c.backend.getSecurityGroup(sgId)
+	if sg == nil {
+		return nil
+	}
+	index := slices.IndexFunc(sg.Rules, func(existing *aliclient.SecurityGroupRule) bool {
+		return ruleKey(existing) == ruleKey(rule)
+	})
+	if index < 0 {
+		return nil
+	}
+	return []string{sg.Rules[index].SecurityGroupRuleId}
+}
+
+// ListTagResources returns all matching resources at once, as the paging of the actor does not handle a NextToken.
+func (c *ecsClient) ListTagResources(request *ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error) {
+	if err := c.backend.call("ListTagResources"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	tagged, err := c.backend.resourceTags(request.ResourceType, ptr.Deref(request.ResourceId, nil), tags)
+	if err != nil {
+		return nil, err
+	}
+	resp := ecs.CreateListTagResourcesResponse()
+	for _, id := range sortedKeys(tagged) {
+		for _, key := range sortedKeys(tagged[id]) {
+			resp.TagResources.TagResource = append(resp.TagResources.TagResource, ecs.TagResource{
+				ResourceType: request.ResourceType,
+				ResourceId:   id,
+				TagKey:       key,
+				TagValue:     tagged[id][key],
+			})
+		}
+	}
+	return resp, nil
+}
+
+func (c *ecsClient) TagResources(request *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error) {
+	if err := c.backend.call("TagResources"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	if err := c.backend.tagResources(request.ResourceType, ptr.Deref(request.ResourceId, nil), tags); err != nil {
+		return nil, err
+	}
+	return ecs.CreateTagResourcesResponse(), nil
+}
+
+func (c *ecsClient) UntagResources(request *ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error) {
+	if err := c.backend.call("UntagResources"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.untagResources(request.ResourceType, ptr.Deref(request.ResourceId, nil), ptr.Deref(request.TagKey, nil)); err != nil {
+		return nil, err
+	}
+	return ecs.CreateUntagResourcesResponse(), nil
+}
+
+func (c *ecsClient) JoinResourceGroup(request *ecs.JoinResourceGroupRequest) (*ecs.JoinResourceGroupResponse, error) {
+	if err := c.backend.call("JoinResourceGroup"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.moveResourceGroup(request.ResourceType, request.ResourceId, request.ResourceGroupId); err != nil {
+		return nil, err
+	}
+	return ecs.CreateJoinResourceGroupResponse(), nil
+}
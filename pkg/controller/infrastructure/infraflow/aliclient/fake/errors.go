@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	alierrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+)
+
+var (
+	// ErrThrottling is the error returned by Alicloud if the request rate of the user has been exceeded.
+	ErrThrottling = NewServerError("Throttling.User", "Request was denied due to user flow control.")
+	// ErrOutOfStock is the error returned by Alicloud if the requested resource is sold out in the zone.
+	ErrOutOfStock = NewServerError("OperationDenied.NoStock", "The requested resource is sold out in the specified zone.")
+	// ErrDependencyViolation is the error returned by Alicloud if a resource cannot be deleted because other resources
+	// still depend on it.
+	ErrDependencyViolation = NewServerError("DependencyViolation", "The specified resource has dependent resources.")
+)
+
+// NewServerError creates an error with the given code and message as it is returned by the Alicloud SDK for a failed
+// request.
+func NewServerError(code, message string) error {
+	content, _ := json.Marshal(map[string]string{
+		"Code":    code,
+		"Message": message,
+	})
+	return alierrors.NewServerError(http.StatusBadRequest, string(content), "")
+}
+
+func notFound(code, id string) error {
+	return NewServerError(code, fmt.Sprintf("The specified resource %s does not exist.", id))
+}
+
+func dependencyViolation(kind, id string) error {
+	return NewServerError("DependencyViolation."+kind, fmt.Sprintf("The resource is still used by %s %s.", kind, id))
+}
+
+func invalidParameter(code, message string) error {
+	return NewServerError(code, message)
+}
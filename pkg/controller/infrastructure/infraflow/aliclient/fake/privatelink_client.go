@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/privatelink"
+	"k8s.io/utils/ptr"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// privateLinkClient is a fake PrivateLink client working on a Backend. Only the request parameters used by the
+// infrastructure flow are evaluated.
+type privateLinkClient struct {
+	backend *Backend
+}
+
+var _ alicloudclient.PrivateLink = &privateLinkClient{}
+
+func (c *privateLinkClient) CreateVpcEndpoint(request *privatelink.CreateVpcEndpointRequest) (*privatelink.CreateVpcEndpointResponse, error) {
+	if err := c.backend.call("CreateVpcEndpoint"); err != nil {
+		return nil, err
+	}
+	desired := &aliclient.VPCEndpoint{
+		Tags:             aliclient.Tags{},
+		Name:             request.EndpointName,
+		ServiceName:      request.ServiceName,
+		VpcId:            request.VpcId,
+		SecurityGroupIds: ptr.Deref(request.SecurityGroupId, nil),
+		ZoneMappings:     map[string]string{},
+		ResourceGroupId:  request.ResourceGroupId,
+	}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			desired.Tags[t.Key] = t.Value
+		}
+	}
+	if request.Zone != nil {
+		for _, zone := range *request.Zone {
+			desired.ZoneMappings[zone.ZoneId] = zone.VSwitchId
+		}
+	}
+	created, err := c.backend.createVpcEndpoint(desired)
+	if err != nil {
+		return nil, err
+	}
+	resp := privatelink.CreateCreateVpcEndpointResponse()
+	resp.EndpointId = created.EndpointId
+	resp.EndpointName = created.Name
+	resp.ServiceName = created.ServiceName
+	resp.VpcId = created.VpcId
+	resp.EndpointDomain = created.Domain
+	resp.EndpointStatus = created.Status
+	return resp, nil
+}
+
+// ListVpcEndpoints returns all matching endpoints at once.
+func (c *privateLinkClient) ListVpcEndpoints(request *privatelink.ListVpcEndpointsRequest) (*privatelink.ListVpcEndpointsResponse, error) {
+	if err := c.backend.call("ListVpcEndpoints"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	endpoints := c.backend.listVpcEndpoints(func(endpoint *aliclient.VPCEndpoint) bool {
+		return matches(request.EndpointId, endpoint.EndpointId) && matches(request.EndpointName, endpoint.Name) &&
+			matches(request.ServiceName, endpoint.ServiceName) && matches(request.VpcId, endpoint.VpcId) &&
+			matches(request.EndpointStatus, endpoint.Status) && matches(request.ResourceGroupId, endpoint.ResourceGroupId) &&
+			hasTags(endpoint.Tags, tags)
+	})
+	resp := privatelink.CreateListVpcEndpointsResponse()
+	for _, endpoint := range endpoints {
+		item := privatelink.Endpoint{
+			EndpointId:      endpoint.EndpointId,
+			EndpointName:    endpoint.Name,
+			EndpointType:    "Interface",
+			ServiceName:     endpoint.ServiceName,
+			VpcId:           endpoint.VpcId,
+			EndpointDomain:  endpoint.Domain,
+			EndpointStatus:  endpoint.Status,
+			RegionId:        c.backend.Region(),
+			ResourceGroupId: endpoint.ResourceGroupId,
+		}
+		for _, key := range sortedKeys(endpoint.Tags) {
+			item.Tags = append(item.Tags, privatelink.TagModel{Key: key, Value: endpoint.Tags[key]})
+		}
+		resp.Endpoints = append(resp.Endpoints, item)
+	}
+	resp.TotalCount = len(endpoints)
+	resp.MaxResults = len(endpoints)
+	return resp, nil
+}
+
+func (c *privateLinkClient) DeleteVpcEndpoint(request *privatelink.DeleteVpcEndpointRequest) (*privatelink.DeleteVpcEndpointResponse, error) {
+	if err := c.backend.call("DeleteVpcEndpoint"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteVpcEndpoint(request.EndpointId); err != nil {
+		return nil, err
+	}
+	return privatelink.CreateDeleteVpcEndpointResponse(), nil
+}
+
+// ListVpcEndpointZones returns all zones of the endpoint at once.
+func (c *privateLinkClient) ListVpcEndpointZones(request *privatelink.ListVpcEndpointZonesRequest) (*privatelink.ListVpcEndpointZonesResponse, error) {
+	if err := c.backend.call("ListVpcEndpointZones"); err != nil {
+		return nil, err
+	}
+	zoneMappings, err := c.backend.listVpcEndpointZones(request.EndpointId)
+	if err != nil {
+		return nil, err
+	}
+	resp := privatelink.CreateListVpcEndpointZonesResponse()
+	for _, zoneId := range sortedKeys(zoneMappings) {
+		resp.Zones = append(resp.Zones, privatelink.Zone{ZoneId: zoneId, VSwitchId: zoneMappings[zoneId], ZoneStatus: "Connected"})
+	}
+	resp.MaxResults = len(zoneMappings)
+	return resp, nil
+}
+
+func (c *privateLinkClient) AddZoneToVpcEndpoint(request *privatelink.AddZoneToVpcEndpointRequest) (*privatelink.AddZoneToVpcEndpointResponse, error) {
+	if err := c.backend.call("AddZoneToVpcEndpoint"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.addZoneToVpcEndpoint(request.EndpointId, request.ZoneId, request.VSwitchId); err != nil {
+		return nil, err
+	}
+	return privatelink.CreateAddZoneToVpcEndpointResponse(), nil
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/pvtz"
+	"k8s.io/utils/ptr"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// pvtzClient is a fake PrivateZone client working on a Backend. Only the request parameters used by the
+// infrastructure flow are evaluated.
+type pvtzClient struct {
+	backend *Backend
+}
+
+var _ alicloudclient.PVTZ = &pvtzClient{}
+
+func (c *pvtzClient) AddZone(request *pvtz.AddZoneRequest) (*pvtz.AddZoneResponse, error) {
+	if err := c.backend.call("AddZone"); err != nil {
+		return nil, err
+	}
+	created, err := c.backend.addZone(&aliclient.PrivateZone{
+		Name:            request.ZoneName,
+		ResourceGroupId: request.ResourceGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := pvtz.CreateAddZoneResponse()
+	resp.ZoneId = created.ZoneId
+	resp.ZoneName = created.Name
+	resp.Success = true
+	return resp, nil
+}
+
+func (c *pvtzClient) DescribeZones(request *pvtz.DescribeZonesRequest) (*pvtz.DescribeZonesResponse, error) {
+	if err := c.backend.call("DescribeZones"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.ResourceTag != nil {
+		for _, t := range *request.ResourceTag {
+			tags[t.Key] = t.Value
+		}
+	}
+	zones := c.backend.listPrivateZones(func(zone *aliclient.PrivateZone) bool {
+		return matches(request.ResourceGroupId, zone.ResourceGroupId) && hasTags(zone.Tags, tags)
+	})
+	var items []pvtz.Zone
+	for _, zone := range zones {
+		item := pvtz.Zone{
+			ZoneId:          zone.ZoneId,
+			ZoneName:        zone.Name,
+			ResourceGroupId: zone.ResourceGroupId,
+		}
+		for _, key := range sortedKeys(zone.Tags) {
+			item.ResourceTags.ResourceTag = append(item.ResourceTags.ResourceTag, pvtz.ResourceTag{Key: key, Value: zone.Tags[key]})
+		}
+		item.Vpcs.Vpc = c.toVpcs(zone.VpcIds)
+		items = append(items, item)
+	}
+	resp := pvtz.CreateDescribeZonesResponse()
+	resp.Zones.Zone, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalItems = len(items)
+	resp.TotalPages = totalPages(len(items), resp.PageSize)
+	return resp, nil
+}
+
+func (c *pvtzClient) DescribeZoneInfo(request *pvtz.DescribeZoneInfoRequest) (*pvtz.DescribeZoneInfoResponse, error) {
+	if err := c.backend.call("DescribeZoneInfo"); err != nil {
+		return nil, err
+	}
+	zones := c.backend.listPrivateZones(func(zone *aliclient.PrivateZone) bool {
+		return zone.ZoneId == request.ZoneId
+	})
+	if len(zones) == 0 {
+		return nil, notFound("Zone.Invalid.Id", request.ZoneId)
+	}
+	resp := pvtz.CreateDescribeZoneInfoResponse()
+	resp.ZoneId = zones[0].ZoneId
+	resp.ZoneName = zones[0].Name
+	resp.ResourceGroupId = zones[0].ResourceGroupId
+	resp.BindVpcs.Vpc = c.toVpcs(zones[0].VpcIds)
+	return resp, nil
+}
+
+func (c *pvtzClient) BindZoneVpc(request *pvtz.BindZoneVpcRequest) (*pvtz.BindZoneVpcResponse, error) {
+	if err := c.backend.call("BindZoneVpc"); err != nil {
+		return nil, err
+	}
+	var vpcIds []string
+	for _, v := range ptr.Deref(request.Vpcs, nil) {
+		if v.RegionId != c.backend.Region() {
+			return nil, invalidParameter("Vpc.Invalid.Region", "Only VPCs of the region of the fake backend can be bound.")
+		}
+		vpcIds = append(vpcIds, v.VpcId)
+	}
+	if err := c.backend.bindZoneVpc(request.ZoneId, vpcIds); err != nil {
+		return nil, err
+	}
+	return pvtz.CreateBindZoneVpcResponse(), nil
+}
+
+func (c *pvtzClient) AddZoneRecord(request *pvtz.AddZoneRecordRequest) (*pvtz.AddZoneRecordResponse, error) {
+	if err := c.backend.call("AddZoneRecord"); err != nil {
+		return nil, err
+	}
+	ttl, _ := strconv.Atoi(string(request.Ttl))
+	created, err := c.backend.addZoneRecord(&aliclient.PrivateZoneRecord{
+		ZoneId: request.ZoneId,
+		Rr:     request.Rr,
+		Type:   request.Type,
+		Value:  request.Value,
+		Ttl:    ttl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := pvtz.CreateAddZoneRecordResponse()
+	resp.RecordId = created.RecordId
+	resp.Success = true
+	return resp, nil
+}
+
+func (c *pvtzClient) DescribeZoneRecords(request *pvtz.DescribeZoneRecordsRequest) (*pvtz.DescribeZoneRecordsResponse, error) {
+	if err := c.backend.call("DescribeZoneRecords"); err != nil {
+		return nil, err
+	}
+	records, err := c.backend.listZoneRecords(request.ZoneId)
+	if err != nil {
+		return nil, err
+	}
+	var items []pvtz.Record
+	for _, record := range records {
+		items = append(items, pvtz.Record{
+			RecordId: record.RecordId,
+			Rr:       record.Rr,
+			Type:     record.Type,
+			Value:    record.Value,
+			Ttl:      record.Ttl,
+			Status:   "ENABLE",
+		})
+	}
+	resp := pvtz.CreateDescribeZoneRecordsResponse()
+	resp.Records.Record, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalItems = len(items)
+	resp.TotalPages = totalPages(len(items), resp.PageSize)
+	return resp, nil
+}
+
+func (c *pvtzClient) UpdateZoneRecord(request *pvtz.UpdateZoneRecordRequest) (*pvtz.UpdateZoneRecordResponse, error) {
+	if err := c.backend.call("UpdateZoneRecord"); err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseInt(string(request.RecordId), 10, 64)
+	if err != nil {
+		return nil, invalidParameter("Record.Invalid.Id", "The record id must be a number.")
+	}
+	ttl, _ := strconv.Atoi(string(request.Ttl))
+	if err := c.backend.updateZoneRecord(&aliclient.PrivateZoneRecord{
+		RecordId: id,
+		Rr:       request.Rr,
+		Type:     request.Type,
+		Value:    request.Value,
+		Ttl:      ttl,
+	}); err != nil {
+		return nil, err
+	}
+	resp := pvtz.CreateUpdateZoneRecordResponse()
+	resp.RecordId = id
+	return resp, nil
+}
+
+func (c *pvtzClient) TagResources(request *pvtz.TagResourcesRequest) (*pvtz.TagResourcesResponse, error) {
+	if err := c.backend.call("TagResources"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	if err := c.backend.tagResources(request.ResourceType, ptr.Deref(request.ResourceId, nil), tags); err != nil {
+		return nil, err
+	}
+	return pvtz.CreateTagResourcesResponse(), nil
+}
+
+func (c *pvtzClient) DeleteZone(request *pvtz.DeleteZoneRequest) (*pvtz.DeleteZoneResponse, error) {
+	if err := c.backend.call("DeleteZone"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteZone(request.ZoneId); err != nil {
+		return nil, err
+	}
+	resp := pvtz.CreateDeleteZoneResponse()
+	resp.ZoneId = request.ZoneId
+	return resp, nil
+}
+
+func (c *pvtzClient) toVpcs(vpcIds []string) []pvtz.Vpc {
+	var result []pvtz.Vpc
+	for _, vpcId := range vpcIds {
+		result = append(result, pvtz.Vpc{RegionId: c.backend.Region(), VpcId: vpcId})
+	}
+	return result
+}
+
+// totalPages returns the number of pages needed for the given number of items.
+func totalPages(items, pageSize int) int {
+	return (items + pageSize - 1) / pageSize
+}
@@ -0,0 +1,869 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"k8s.io/utils/ptr"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const defaultPageSize = 10
+
+// vpcClient is a fake VPC client working on a Backend. Only the request parameters used by the infrastructure flow
+// are evaluated.
+type vpcClient struct {
+	backend *Backend
+}
+
+var _ alicloudclient.VPC = &vpcClient{}
+
+func (c *vpcClient) ListEnhanhcedNatGatewayAvailableZones(_ *vpc.ListEnhanhcedNatGatewayAvailableZonesRequest) (*vpc.ListEnhanhcedNatGatewayAvailableZonesResponse, error) {
+	if err := c.backend.call("ListEnhanhcedNatGatewayAvailableZones"); err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateListEnhanhcedNatGatewayAvailableZonesResponse()
+	for _, zoneId := range c.backend.natGatewayZones() {
+		resp.Zones = append(resp.Zones, vpc.Zone{ZoneId: zoneId, LocalName: zoneId})
+	}
+	return resp, nil
+}
+
+func (c *vpcClient) GetVPCWithID(_ context.Context, vpcID string) ([]vpc.Vpc, error) {
+	request := vpc.CreateDescribeVpcsRequest()
+	request.VpcId = vpcID
+	response, err := c.DescribeVpcs(request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Vpcs.Vpc, nil
+}
+
+func (c *vpcClient) GetNatGatewaysWithVPCID(_ context.Context, vpcID string) ([]vpc.NatGateway, error) {
+	request := vpc.CreateDescribeNatGatewaysRequest()
+	request.VpcId = vpcID
+	response, err := c.DescribeNatGateways(request)
+	if err != nil {
+		return nil, err
+	}
+	return response.NatGateways.NatGateway, nil
+}
+
+func (c *vpcClient) GetEIPWithID(_ context.Context, eipID string) ([]vpc.EipAddress, error) {
+	request := vpc.CreateDescribeEipAddressesRequest()
+	request.AllocationId = eipID
+	response, err := c.DescribeEipAddresses(request)
+	if err != nil {
+		return nil, err
+	}
+	return response.EipAddresses.EipAddress, nil
+}
+
+func (c *vpcClient) GetEnhanhcedNatGatewayAvailableZones(_ context.Context, region string) ([]string, error) {
+	request := vpc.CreateListEnhanhcedNatGatewayAvailableZonesRequest()
+	request.RegionId = region
+	response, err := c.ListEnhanhcedNatGatewayAvailableZones(request)
+	if err != nil {
+		return nil, err
+	}
+	zoneIDs := make([]string, 0, len(response.Zones))
+	for _, zone := range response.Zones {
+		zoneIDs = append(zoneIDs, zone.ZoneId)
+	}
+	return zoneIDs, nil
+}
+
+func (c *vpcClient) GetVPCInfo(ctx context.Context, vpcID string) (*alicloudclient.VPCInfo, error) {
+	vpcs, err := c.GetVPCWithID(ctx, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	if len(vpcs) != 1 {
+		return nil, fmt.Errorf("ambiguous VPC response: expected 1 VPC but got %v", vpcs)
+	}
+	natGateways, err := c.GetNatGatewaysWithVPCID(ctx, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	if len(natGateways) != 1 {
+		return nil, fmt.Errorf("ambiguous NAT Gateway response: expected 1 NAT Gateway but got %v", natGateways)
+	}
+	natGateway := natGateways[0]
+	internetChargeType, err := c.FetchEIPInternetChargeType(ctx, &natGateway, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	return &alicloudclient.VPCInfo{
+		CIDR:               vpcs[0].CidrBlock,
+		NATGatewayID:       natGateway.NatGatewayId,
+		SNATTableIDs:       strings.Join(natGateway.SnatTableIds.SnatTableId, ","),
+		InternetChargeType: internetChargeType,
+	}, nil
+}
+
+func (c *vpcClient) GetVPCInfoByName(name string) (*alicloudclient.VPCInfo, error) {
+	request := vpc.CreateDescribeVpcsRequest()
+	request.VpcName = name
+	response, err := c.DescribeVpcs(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Vpcs.Vpc) == 0 {
+		return nil, fmt.Errorf("shoot vpc must be not empty")
+	}
+	if len(response.Vpcs.Vpc[0].VSwitchIds.VSwitchId) == 0 {
+		return nil, fmt.Errorf("vswitch must be not empty")
+	}
+	return &alicloudclient.VPCInfo{
+		VSwitchID: response.Vpcs.Vpc[0].VSwitchIds.VSwitchId[0],
+		VPCID:     response.Vpcs.Vpc[0].VpcId,
+	}, nil
+}
+
+func (c *vpcClient) FetchEIPInternetChargeType(ctx context.Context, natGateway *vpc.NatGateway, vpcID string) (string, error) {
+	if natGateway == nil {
+		natGateways, err := c.GetNatGatewaysWithVPCID(ctx, vpcID)
+		if err != nil {
+			return "", err
+		}
+		if len(natGateways) != 1 {
+			return alicloudclient.DefaultInternetChargeType, nil
+		}
+		natGateway = &natGateways[0]
+	}
+	if len(natGateway.IpLists.IpList) == 0 {
+		return alicloudclient.DefaultInternetChargeType, nil
+	}
+	eips, err := c.GetEIPWithID(ctx, natGateway.IpLists.IpList[0].AllocationId)
+	if err != nil {
+		return "", err
+	}
+	if len(eips) == 0 {
+		return alicloudclient.DefaultInternetChargeType, nil
+	}
+	return eips[0].InternetChargeType, nil
+}
+
+func (c *vpcClient) CreateVpc(request *vpc.CreateVpcRequest) (*vpc.CreateVpcResponse, error) {
+	if err := c.backend.call("CreateVpc"); err != nil {
+		return nil, err
+	}
+	enableIPv6, _ := request.EnableIpv6.GetValue()
+	created, err := c.backend.createVpc(&aliclient.VPC{
+		Name:            request.VpcName,
+		CidrBlock:       request.CidrBlock,
+		EnableIPv6:      enableIPv6,
+		ResourceGroupId: request.ResourceGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateCreateVpcResponse()
+	resp.VpcId = created.VpcId
+	resp.ResourceGroupId = created.ResourceGroupId
+	return resp, nil
+}
+
+func (c *vpcClient) DescribeVpcs(request *vpc.DescribeVpcsRequest) (*vpc.DescribeVpcsResponse, error) {
+	if err := c.backend.call("DescribeVpcs"); err != nil {
+		return nil, err
+	}
+	vpcs := c.backend.listVpcs(func(v *aliclient.VPC) bool {
+		return matches(request.VpcId, v.VpcId) && matches(request.VpcName, v.Name)
+	})
+	vswitches := c.backend.VSwitches()
+	var items []vpc.Vpc
+	for _, v := range vpcs {
+		item := vpc.Vpc{
+			VpcId:           v.VpcId,
+			VpcName:         v.Name,
+			RegionId:        c.backend.Region(),
+			CidrBlock:       v.CidrBlock,
+			Ipv6CidrBlock:   v.IPv6CidrBlock,
+			Status:          ptr.Deref(v.Status, ""),
+			ResourceGroupId: v.ResourceGroupId,
+			Tags:            vpc.TagsInDescribeVpcs{Tag: toVpcTags(v.Tags)},
+		}
+		for _, vsw := range vswitches {
+			if ptr.Deref(vsw.VpcId, "") == v.VpcId {
+				item.VSwitchIds.VSwitchId = append(item.VSwitchIds.VSwitchId, vsw.VSwitchId)
+			}
+		}
+		items = append(items, item)
+	}
+	resp := vpc.CreateDescribeVpcsResponse()
+	resp.Vpcs.Vpc, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *vpcClient) DeleteVpc(request *vpc.DeleteVpcRequest) (*vpc.DeleteVpcResponse, error) {
+	if err := c.backend.call("DeleteVpc"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteVpc(request.VpcId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateDeleteVpcResponse(), nil
+}
+
+func (c *vpcClient) ModifyVpcAttribute(request *vpc.ModifyVpcAttributeRequest) (*vpc.ModifyVpcAttributeResponse, error) {
+	if err := c.backend.call("ModifyVpcAttribute"); err != nil {
+		return nil, err
+	}
+	if enable, _ := request.EnableIPv6.GetValue(); enable {
+		if err := c.backend.enableVpcIPv6(request.VpcId); err != nil {
+			return nil, err
+		}
+	}
+	return vpc.CreateModifyVpcAttributeResponse(), nil
+}
+
+func (c *vpcClient) CreateVSwitch(request *vpc.CreateVSwitchRequest) (*vpc.CreateVSwitchResponse, error) {
+	if err := c.backend.call("CreateVSwitch"); err != nil {
+		return nil, err
+	}
+	desired := &aliclient.VSwitch{
+		Name:      request.VSwitchName,
+		VpcId:     ptr.To(request.VpcId),
+		CidrBlock: request.CidrBlock,
+		ZoneId:    request.ZoneId,
+	}
+	if index, err := request.Ipv6CidrBlock.GetValue(); err == nil {
+		desired.IPv6CidrBlockIndex = ptr.To(int32(index)) // #nosec: G115
+	}
+	created, err := c.backend.createVSwitch(desired)
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateCreateVSwitchResponse()
+	resp.VSwitchId = created.VSwitchId
+	return resp, nil
+}
+
+func (c *vpcClient) DescribeVSwitches(request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
+	if err := c.backend.call("DescribeVSwitches"); err != nil {
+		return nil, err
+	}
+	vswitches := c.backend.listVSwitches(func(vsw *aliclient.VSwitch) bool {
+		return matches(request.VSwitchId, vsw.VSwitchId) && matches(request.VpcId, ptr.Deref(vsw.VpcId, "")) &&
+			matches(request.ZoneId, vsw.ZoneId)
+	})
+	var items []vpc.VSwitch
+	for _, vsw := range vswitches {
+		items = append(items, vpc.VSwitch{
+			VSwitchId:       vsw.VSwitchId,
+			VSwitchName:     vsw.Name,
+			VpcId:           ptr.Deref(vsw.VpcId, ""),
+			ZoneId:          vsw.ZoneId,
+			CidrBlock:       vsw.CidrBlock,
+			Ipv6CidrBlock:   vsw.IPv6CidrBlock,
+			Status:          ptr.Deref(vsw.Status, ""),
+			ResourceGroupId: vsw.ResourceGroupId,
+			Tags:            vpc.TagsInDescribeVSwitches{Tag: toVpcTags(vsw.Tags)},
+		})
+	}
+	resp := vpc.CreateDescribeVSwitchesResponse()
+	resp.VSwitches.VSwitch, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *vpcClient) GetVSwitchesInfoByID(id string) (*alicloudclient.VSwitchInfo, error) {
+	request := vpc.CreateDescribeVSwitchesRequest()
+	request.VSwitchId = id
+	response, err := c.DescribeVSwitches(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.VSwitches.VSwitch) == 0 {
+		return nil, fmt.Errorf("vswitches not found")
+	}
+	return &alicloudclient.VSwitchInfo{
+		ZoneID: response.VSwitches.VSwitch[0].ZoneId,
+	}, nil
+}
+
+func (c *vpcClient) DeleteVSwitch(request *vpc.DeleteVSwitchRequest) (*vpc.DeleteVSwitchResponse, error) {
+	if err := c.backend.call("DeleteVSwitch"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteVSwitch(request.VSwitchId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateDeleteVSwitchResponse(), nil
+}
+
+func (c *vpcClient) ModifyVSwitchAttribute(request *vpc.ModifyVSwitchAttributeRequest) (*vpc.ModifyVSwitchAttributeResponse, error) {
+	if err := c.backend.call("ModifyVSwitchAttribute"); err != nil {
+		return nil, err
+	}
+	if enable, _ := request.EnableIPv6.GetValue(); enable {
+		index, err := request.Ipv6CidrBlock.GetValue()
+		if err != nil {
+			return nil, invalidParameter("MissingParameter.Ipv6CidrBlock", "The IPv6 CIDR block index of the vswitch must be specified.")
+		}
+		if err := c.backend.enableVSwitchIPv6(request.VSwitchId, int32(index)); err != nil { // #nosec: G115
+			return nil, err
+		}
+	}
+	return vpc.CreateModifyVSwitchAttributeResponse(), nil
+}
+
+func (c *vpcClient) CreateIpv6Gateway(request *vpc.CreateIpv6GatewayRequest) (*vpc.CreateIpv6GatewayResponse, error) {
+	if err := c.backend.call("CreateIpv6Gateway"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	created, err := c.backend.createIPv6Gateway(&aliclient.IPv6Gateway{
+		Tags:            tags,
+		Name:            request.Name,
+		VpcId:           request.VpcId,
+		ResourceGroupId: request.ResourceGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateCreateIpv6GatewayResponse()
+	resp.Ipv6GatewayId = created.IPv6GatewayId
+	resp.ResourceGroupId = created.ResourceGroupId
+	return resp, nil
+}
+
+func (c *vpcClient) DescribeIpv6Gateways(request *vpc.DescribeIpv6GatewaysRequest) (*vpc.DescribeIpv6GatewaysResponse, error) {
+	if err := c.backend.call("DescribeIpv6Gateways"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tags != nil {
+		for _, t := range *request.Tags {
+			tags[t.Key] = t.Value
+		}
+	}
+	gateways := c.backend.listIPv6Gateways(func(gw *aliclient.IPv6Gateway) bool {
+		return matches(request.Ipv6GatewayId, gw.IPv6GatewayId) && matches(request.VpcId, gw.VpcId) &&
+			hasTags(gw.Tags, tags)
+	})
+	var items []vpc.Ipv6Gateway
+	for _, gw := range gateways {
+		items = append(items, vpc.Ipv6Gateway{
+			Ipv6GatewayId:   gw.IPv6GatewayId,
+			Name:            gw.Name,
+			VpcId:           gw.VpcId,
+			Status:          ptr.Deref(gw.Status, ""),
+			RegionId:        c.backend.Region(),
+			ResourceGroupId: gw.ResourceGroupId,
+			Tags:            vpc.TagsInDescribeIpv6Gateways{Tag: toVpcTags(gw.Tags)},
+		})
+	}
+	resp := vpc.CreateDescribeIpv6GatewaysResponse()
+	resp.Ipv6Gateways.Ipv6Gateway, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *vpcClient) DeleteIpv6Gateway(request *vpc.DeleteIpv6GatewayRequest) (*vpc.DeleteIpv6GatewayResponse, error) {
+	if err := c.backend.call("DeleteIpv6Gateway"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteIPv6Gateway(request.Ipv6GatewayId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateDeleteIpv6GatewayResponse(), nil
+}
+
+func (c *vpcClient) CreateNatGateway(request *vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error) {
+	if err := c.backend.call("CreateNatGateway"); err != nil {
+		return nil, err
+	}
+	created, err := c.backend.createNatGateway(&aliclient.NatGateway{
+		Name:               request.Name,
+		VpcId:              ptr.To(request.VpcId),
+		VswitchId:          ptr.To(request.VSwitchId),
+		InternetChargeType: request.InternetChargeType,
+		Spec:               request.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateCreateNatGatewayResponse()
+	resp.NatGatewayId = created.NatGatewayId
+	resp.SnatTableIds.SnatTableId = created.SNATTableIDs
+	return resp, nil
+}
+
+func (c *vpcClient) DescribeNatGateways(request *vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error) {
+	if err := c.backend.call("DescribeNatGateways"); err != nil {
+		return nil, err
+	}
+	natGateways := c.backend.listNatGateways(func(ngw *aliclient.NatGateway) bool {
+		return matches(request.NatGatewayId, ngw.NatGatewayId) && matches(request.VpcId, ptr.Deref(ngw.VpcId, ""))
+	})
+	eips := c.backend.EIPs()
+	var items []vpc.NatGateway
+	for _, ngw := range natGateways {
+		item := vpc.NatGateway{
+			NatGatewayId:       ngw.NatGatewayId,
+			Name:               ngw.Name,
+			VpcId:              ptr.Deref(ngw.VpcId, ""),
+			Status:             ptr.Deref(ngw.Status, ""),
+			NatType:            "Enhanced",
+			InternetChargeType: ngw.InternetChargeType,
+			Spec:               ngw.Spec,
+			RegionId:           c.backend.Region(),
+			ResourceGroupId:    ngw.ResourceGroupId,
+			Tags:               vpc.TagsInDescribeNatGateways{Tag: toVpcTags(ngw.Tags)},
+		}
+		item.NatGatewayPrivateInfo.VswitchId = ptr.Deref(ngw.VswitchId, "")
+		item.SnatTableIds.SnatTableId = ngw.SNATTableIDs
+		for _, eip := range eips {
+			if ptr.Deref(eip.InstanceId, "") == ngw.NatGatewayId {
+				item.IpLists.IpList = append(item.IpLists.IpList, vpc.IpList{AllocationId: eip.EipId, IpAddress: eip.IpAddress})
+			}
+		}
+		items = append(items, item)
+	}
+	resp := vpc.CreateDescribeNatGatewaysResponse()
+	resp.NatGateways.NatGateway, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *vpcClient) DeleteNatGateway(request *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error) {
+	if err := c.backend.call("DeleteNatGateway"); err != nil {
+		return nil, err
+	}
+	force, _ := request.Force.GetValue()
+	if err := c.backend.deleteNatGateway(request.NatGatewayId, force); err != nil {
+		return nil, err
+	}
+	return vpc.CreateDeleteNatGatewayResponse(), nil
+}
+
+func (c *vpcClient) ModifyNatGatewaySpec(request *vpc.ModifyNatGatewaySpecRequest) (*vpc.ModifyNatGatewaySpecResponse, error) {
+	if err := c.backend.call("ModifyNatGatewaySpec"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.modifyNatGatewaySpec(request.NatGatewayId, request.Spec); err != nil {
+		return nil, err
+	}
+	return vpc.CreateModifyNatGatewaySpecResponse(), nil
+}
+
+func (c *vpcClient) DescribeSnatTableEntries(request *vpc.DescribeSnatTableEntriesRequest) (*vpc.DescribeSnatTableEntriesResponse, error) {
+	if err := c.backend.call("DescribeSnatTableEntries"); err != nil {
+		return nil, err
+	}
+	if request.SnatTableId != "" && !c.backend.hasSnatTable(request.SnatTableId) {
+		return nil, notFound("InvalidSnatTableId.NotFound", request.SnatTableId)
+	}
+	entries := c.backend.listSNatEntries(func(entry *aliclient.SNATEntry) bool {
+		return matches(request.SnatEntryId, entry.SnatEntryId) && matches(request.SnatTableId, entry.SnatTableId) &&
+			matches(request.NatGatewayId, entry.NatGatewayId)
+	})
+	var items []vpc.SnatTableEntry
+	for _, entry := range entries {
+		items = append(items, vpc.SnatTableEntry{
+			SnatEntryId:     entry.SnatEntryId,
+			SnatEntryName:   entry.Name,
+			SnatTableId:     entry.SnatTableId,
+			SourceVSwitchId: entry.VSwitchId,
+			SnatIp:          entry.IpAddress,
+			Status:          ptr.Deref(entry.Status, ""),
+		})
+	}
+	resp := vpc.CreateDescribeSnatTableEntriesResponse()
+	resp.SnatTableEntries.SnatTableEntry, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *vpcClient) DescribeEipAddresses(request *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
+	if err := c.backend.call("DescribeEipAddresses"); err != nil {
+		return nil, err
+	}
+	eips := c.backend.listEIPs(func(eip *aliclient.EIP) bool {
+		return matches(request.AllocationId, eip.EipId) && matches(request.EipAddress, eip.IpAddress) &&
+			matches(request.AssociatedInstanceId, ptr.Deref(eip.InstanceId, ""))
+	})
+	var items []vpc.EipAddress
+	for _, eip := range eips {
+		items = append(items, vpc.EipAddress{
+			AllocationId:       eip.EipId,
+			Name:               eip.Name,
+			IpAddress:          eip.IpAddress,
+			Bandwidth:          eip.Bandwidth,
+			InternetChargeType: eip.InternetChargeType,
+			ISP:                eip.ISP,
+			BandwidthPackageId: eip.BandwidthPackageId,
+			Status:             ptr.Deref(eip.Status, ""),
+			InstanceType:       ptr.Deref(eip.InstanceType, ""),
+			InstanceId:         ptr.Deref(eip.InstanceId, ""),
+			RegionId:           c.backend.Region(),
+			ResourceGroupId:    eip.ResourceGroupId,
+			Tags:               vpc.TagsInDescribeEipAddresses{Tag: toVpcTags(eip.Tags)},
+		})
+	}
+	resp := vpc.CreateDescribeEipAddressesResponse()
+	resp.EipAddresses.EipAddress, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *vpcClient) AllocateEipAddress(request *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error) {
+	if err := c.backend.call("AllocateEipAddress"); err != nil {
+		return nil, err
+	}
+	created, err := c.backend.createEIP(&aliclient.EIP{
+		Name:               request.Name,
+		Bandwidth:          request.Bandwidth,
+		InternetChargeType: request.InternetChargeType,
+		ISP:                request.ISP,
+		ResourceGroupId:    request.ResourceGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateAllocateEipAddressResponse()
+	resp.AllocationId = created.EipId
+	resp.EipAddress = created.IpAddress
+	resp.ResourceGroupId = created.ResourceGroupId
+	return resp, nil
+}
+
+func (c *vpcClient) ReleaseEipAddress(request *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error) {
+	if err := c.backend.call("ReleaseEipAddress"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.releaseEIP(request.AllocationId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateReleaseEipAddressResponse(), nil
+}
+
+func (c *vpcClient) ModifyEipAddressAttribute(request *vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error) {
+	if err := c.backend.call("ModifyEipAddressAttribute"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.modifyEIPBandwidth(request.AllocationId, request.Bandwidth); err != nil {
+		return nil, err
+	}
+	return vpc.CreateModifyEipAddressAttributeResponse(), nil
+}
+
+func (c *vpcClient) AssociateEipAddress(request *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error) {
+	if err := c.backend.call("AssociateEipAddress"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.associateEIP(request.AllocationId, request.InstanceId, request.InstanceType); err != nil {
+		return nil, err
+	}
+	return vpc.CreateAssociateEipAddressResponse(), nil
+}
+
+func (c *vpcClient) UnassociateEipAddress(request *vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error) {
+	if err := c.backend.call("UnassociateEipAddress"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.unassociateEIP(request.AllocationId, request.InstanceId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateUnassociateEipAddressResponse(), nil
+}
+
+func (c *vpcClient) AddCommonBandwidthPackageIp(request *vpc.AddCommonBandwidthPackageIpRequest) (*vpc.AddCommonBandwidthPackageIpResponse, error) {
+	if err := c.backend.call("AddCommonBandwidthPackageIp"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.addEIPToBandwidthPackage(request.BandwidthPackageId, request.IpInstanceId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateAddCommonBandwidthPackageIpResponse(), nil
+}
+
+func (c *vpcClient) RemoveCommonBandwidthPackageIp(request *vpc.RemoveCommonBandwidthPackageIpRequest) (*vpc.RemoveCommonBandwidthPackageIpResponse, error) {
+	if err := c.backend.call("RemoveCommonBandwidthPackageIp"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.removeEIPFromBandwidthPackage(request.BandwidthPackageId, request.IpInstanceId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateRemoveCommonBandwidthPackageIpResponse(), nil
+}
+
+func (c *vpcClient) CreateSnatEntry(request *vpc.CreateSnatEntryRequest) (*vpc.CreateSnatEntryResponse, error) {
+	if err := c.backend.call("CreateSnatEntry"); err != nil {
+		return nil, err
+	}
+	created, err := c.backend.createSNatEntry(&aliclient.SNATEntry{
+		Name:        request.SnatEntryName,
+		VSwitchId:   request.SourceVSwitchId,
+		IpAddress:   request.SnatIp,
+		SnatTableId: request.SnatTableId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateCreateSnatEntryResponse()
+	resp.SnatEntryId = created.SnatEntryId
+	return resp, nil
+}
+
+func (c *vpcClient) DeleteSnatEntry(request *vpc.DeleteSnatEntryRequest) (*vpc.DeleteSnatEntryResponse, error) {
+	if err := c.backend.call("DeleteSnatEntry"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteSNatEntry(request.SnatEntryId, request.SnatTableId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateDeleteSnatEntryResponse(), nil
+}
+
+func (c *vpcClient) TagResources(request *vpc.TagResourcesRequest) (*vpc.TagResourcesResponse, error) {
+	if err := c.backend.call("TagResources"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	if err := c.backend.tagResources(request.ResourceType, ptr.Deref(request.ResourceId, nil), tags); err != nil {
+		return nil, err
+	}
+	return vpc.CreateTagResourcesResponse(), nil
+}
+
+func (c *vpcClient) UnTagResources(request *vpc.UnTagResourcesRequest) (*vpc.UnTagResourcesResponse, error) {
+	if err := c.backend.call("UnTagResources"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.untagResources(request.ResourceType, ptr.Deref(request.ResourceId, nil), ptr.Deref(request.TagKey, nil)); err != nil {
+		return nil, err
+	}
+	return vpc.CreateUnTagResourcesResponse(), nil
+}
+
+// ListTagResources returns all matching resources at once.
+func (c *vpcClient) ListTagResources(request *vpc.ListTagResourcesRequest) (*vpc.ListTagResourcesResponse, error) {
+	if err := c.backend.call("ListTagResources"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			tags[t.Key] = t.Value
+		}
+	}
+	tagged, err := c.backend.resourceTags(request.ResourceType, ptr.Deref(request.ResourceId, nil), tags)
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateListTagResourcesResponse()
+	for _, id := range sortedKeys(tagged) {
+		for _, key := range sortedKeys(tagged[id]) {
+			resp.TagResources.TagResource = append(resp.TagResources.TagResource, vpc.TagResource{
+				ResourceType: request.ResourceType,
+				ResourceId:   id,
+				TagKey:       key,
+				TagValue:     tagged[id][key],
+			})
+		}
+	}
+	return resp, nil
+}
+
+// DescribeTagKeys returns all matching tag keys at once.
+func (c *vpcClient) DescribeTagKeys(request *vpc.DescribeTagKeysRequest) (*vpc.DescribeTagKeysResponse, error) {
+	if err := c.backend.call("DescribeTagKeys"); err != nil {
+		return nil, err
+	}
+	keys, err := c.backend.tagKeys(request.ResourceType, request.Keyword)
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateDescribeTagKeysResponse()
+	for _, key := range keys {
+		resp.TagKeys.TagKey = append(resp.TagKeys.TagKey, vpc.TagKey{TagKey: key, Type: "Custom"})
+	}
+	return resp, nil
+}
+
+func (c *vpcClient) MoveResourceGroup(request *vpc.MoveResourceGroupRequest) (*vpc.MoveResourceGroupResponse, error) {
+	if err := c.backend.call("MoveResourceGroup"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.moveResourceGroup(request.ResourceType, request.ResourceId, request.NewResourceGroupId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateMoveResourceGroupResponse(), nil
+}
+
+func (c *vpcClient) DescribeRouteTableList(request *vpc.DescribeRouteTableListRequest) (*vpc.DescribeRouteTableListResponse, error) {
+	if err := c.backend.call("DescribeRouteTableList"); err != nil {
+		return nil, err
+	}
+	routeTables, err := c.backend.listRouteTables(request.VpcId)
+	if err != nil {
+		return nil, err
+	}
+	var items []vpc.RouterTableListType
+	for _, routeTable := range routeTables {
+		if !matches(request.RouteTableId, routeTable.RouteTableId) {
+			continue
+		}
+		item := vpc.RouterTableListType{
+			VpcId:          request.VpcId,
+			RouteTableId:   routeTable.RouteTableId,
+			RouteTableType: routeTable.RouteTableType,
+			Status:         statusAvailable,
+		}
+		item.VSwitchIds.VSwitchId = routeTable.VSwitchIds
+		items = append(items, item)
+	}
+	resp := vpc.CreateDescribeRouteTableListResponse()
+	resp.RouterTableList.RouterTableListType, resp.PageNumber, resp.PageSize = paginate(items, request.PageNumber, request.PageSize)
+	resp.TotalCount = len(items)
+	return resp, nil
+}
+
+func (c *vpcClient) CreateVpcGatewayEndpoint(request *vpc.CreateVpcGatewayEndpointRequest) (*vpc.CreateVpcGatewayEndpointResponse, error) {
+	if err := c.backend.call("CreateVpcGatewayEndpoint"); err != nil {
+		return nil, err
+	}
+	desired := &aliclient.VPCGatewayEndpoint{
+		Tags:            aliclient.Tags{},
+		Name:            request.EndpointName,
+		ServiceName:     request.ServiceName,
+		VpcId:           request.VpcId,
+		ResourceGroupId: request.ResourceGroupId,
+	}
+	if request.Tag != nil {
+		for _, t := range *request.Tag {
+			desired.Tags[t.Key] = t.Value
+		}
+	}
+	created, err := c.backend.createVpcGatewayEndpoint(desired)
+	if err != nil {
+		return nil, err
+	}
+	resp := vpc.CreateCreateVpcGatewayEndpointResponse()
+	resp.EndpointId = created.EndpointId
+	resp.EndpointName = created.Name
+	resp.ServiceName = created.ServiceName
+	resp.ResourceGroupId = created.ResourceGroupId
+	return resp, nil
+}
+
+// ListVpcGatewayEndpoints returns all matching endpoints at once.
+func (c *vpcClient) ListVpcGatewayEndpoints(request *vpc.ListVpcGatewayEndpointsRequest) (*vpc.ListVpcGatewayEndpointsResponse, error) {
+	if err := c.backend.call("ListVpcGatewayEndpoints"); err != nil {
+		return nil, err
+	}
+	tags := aliclient.Tags{}
+	if request.Tags != nil {
+		for _, t := range *request.Tags {
+			tags[t.Key] = t.Value
+		}
+	}
+	endpoints := c.backend.listVpcGatewayEndpoints(func(endpoint *aliclient.VPCGatewayEndpoint) bool {
+		return matches(request.EndpointId, endpoint.EndpointId) && matches(request.EndpointName, endpoint.Name) &&
+			matches(request.ServiceName, endpoint.ServiceName) && matches(request.ResourceGroupId, endpoint.ResourceGroupId) &&
+			hasTags(endpoint.Tags, tags)
+	})
+	resp := vpc.CreateListVpcGatewayEndpointsResponse()
+	for _, endpoint := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, vpc.Endpoint{
+			EndpointId:            endpoint.EndpointId,
+			EndpointName:          endpoint.Name,
+			ServiceName:           endpoint.ServiceName,
+			VpcId:                 endpoint.VpcId,
+			EndpointStatus:        endpoint.Status,
+			ResourceGroupId:       endpoint.ResourceGroupId,
+			AssociatedRouteTables: endpoint.RouteTableIds,
+			Tags:                  toVpcTags(endpoint.Tags),
+		})
+	}
+	resp.TotalCount = int64(len(endpoints))
+	resp.MaxResults = int64(len(endpoints))
+	return resp, nil
+}
+
+func (c *vpcClient) AssociateRouteTablesWithVpcGatewayEndpoint(request *vpc.AssociateRouteTablesWithVpcGatewayEndpointRequest) (*vpc.AssociateRouteTablesWithVpcGatewayEndpointResponse, error) {
+	if err := c.backend.call("AssociateRouteTablesWithVpcGatewayEndpoint"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.associateRouteTablesWithVpcGatewayEndpoint(request.EndpointId, ptr.Deref(request.RouteTableIds, nil)); err != nil {
+		return nil, err
+	}
+	return vpc.CreateAssociateRouteTablesWithVpcGatewayEndpointResponse(), nil
+}
+
+func (c *vpcClient) DissociateRouteTablesFromVpcGatewayEndpoint(request *vpc.DissociateRouteTablesFromVpcGatewayEndpointRequest) (*vpc.DissociateRouteTablesFromVpcGatewayEndpointResponse, error) {
+	if err := c.backend.call("DissociateRouteTablesFromVpcGatewayEndpoint"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.dissociateRouteTablesFromVpcGatewayEndpoint(request.EndpointId, ptr.Deref(request.RouteTableIds, nil)); err != nil {
+		return nil, err
+	}
+	return vpc.CreateDissociateRouteTablesFromVpcGatewayEndpointResponse(), nil
+}
+
+func (c *vpcClient) DeleteVpcGatewayEndpoint(request *vpc.DeleteVpcGatewayEndpointRequest) (*vpc.DeleteVpcGatewayEndpointResponse, error) {
+	if err := c.backend.call("DeleteVpcGatewayEndpoint"); err != nil {
+		return nil, err
+	}
+	if err := c.backend.deleteVpcGatewayEndpoint(request.EndpointId); err != nil {
+		return nil, err
+	}
+	return vpc.CreateDeleteVpcGatewayEndpointResponse(), nil
+}
+
+func toVpcTags(tags aliclient.Tags) []vpc.Tag {
+	var result []vpc.Tag
+	for _, key := range sortedKeys(tags) {
+		result = append(result, vpc.Tag{Key: key, Value: tags[key], TagKey: key, TagValue: tags[key]})
+	}
+	return result
+}
+
+// matches returns true if the given filter of a request is not set or equal to the given value.
+func matches(filter, value string) bool {
+	return filter == "" || filter == value
+}
+
+// paginate returns the items on the requested page together with the page number and size.
+func paginate[T any](items []T, pageNumber, pageSize requests.Integer) ([]T, int, int) {
+	number, size := 1, defaultPageSize
+	if n, err := strconv.Atoi(string(pageNumber)); err == nil && n > 0 {
+		number = n
+	}
+	if s, err := strconv.Atoi(string(pageSize)); err == nil && s > 0 {
+		size = s
+	}
+	start := min((number-1)*size, len(items))
+	end := min(start+size, len(items))
+	return items[start:end], number, size
+}
+
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	return slices.Sorted(maps.Keys(m))
+}
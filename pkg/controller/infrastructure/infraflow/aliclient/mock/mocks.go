@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	alicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	aliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,48 @@ func (m *MockActor) EXPECT() *MockActorMockRecorder {
 	return m.recorder
 }
 
+// AddEIPToBandwidthPackage mocks base method.
+func (m *MockActor) AddEIPToBandwidthPackage(ctx context.Context, bandwidthPackageId, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEIPToBandwidthPackage", ctx, bandwidthPackageId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEIPToBandwidthPackage indicates an expected call of AddEIPToBandwidthPackage.
+func (mr *MockActorMockRecorder) AddEIPToBandwidthPackage(ctx, bandwidthPackageId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEIPToBandwidthPackage", reflect.TypeOf((*MockActor)(nil).AddEIPToBandwidthPackage), ctx, bandwidthPackageId, id)
+}
+
+// AddZonesToTransitRouterVpcAttachment mocks base method.
+func (m *MockActor) AddZonesToTransitRouterVpcAttachment(ctx context.Context, id string, zoneMappings map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddZonesToTransitRouterVpcAttachment", ctx, id, zoneMappings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddZonesToTransitRouterVpcAttachment indicates an expected call of AddZonesToTransitRouterVpcAttachment.
+func (mr *MockActorMockRecorder) AddZonesToTransitRouterVpcAttachment(ctx, id, zoneMappings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddZonesToTransitRouterVpcAttachment", reflect.TypeOf((*MockActor)(nil).AddZonesToTransitRouterVpcAttachment), ctx, id, zoneMappings)
+}
+
+// AddZonesToVpcEndpoint mocks base method.
+func (m *MockActor) AddZonesToVpcEndpoint(ctx context.Context, id string, zoneMappings map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddZonesToVpcEndpoint", ctx, id, zoneMappings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddZonesToVpcEndpoint indicates an expected call of AddZonesToVpcEndpoint.
+func (mr *MockActorMockRecorder) AddZonesToVpcEndpoint(ctx, id, zoneMappings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddZonesToVpcEndpoint", reflect.TypeOf((*MockActor)(nil).AddZonesToVpcEndpoint), ctx, id, zoneMappings)
+}
+
 // AssociateEIP mocks base method.
 func (m *MockActor) AssociateEIP(ctx context.Context, id, to, insType string) error {
 	m.ctrl.T.Helper()
@@ -55,6 +98,34 @@ func (mr *MockActorMockRecorder) AssociateEIP(ctx, id, to, insType any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateEIP", reflect.TypeOf((*MockActor)(nil).AssociateEIP), ctx, id, to, insType)
 }
 
+// AssociateRouteTablesWithVpcGatewayEndpoint mocks base method.
+func (m *MockActor) AssociateRouteTablesWithVpcGatewayEndpoint(ctx context.Context, id string, routeTableIds []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateRouteTablesWithVpcGatewayEndpoint", ctx, id, routeTableIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateRouteTablesWithVpcGatewayEndpoint indicates an expected call of AssociateRouteTablesWithVpcGatewayEndpoint.
+func (mr *MockActorMockRecorder) AssociateRouteTablesWithVpcGatewayEndpoint(ctx, id, routeTableIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateRouteTablesWithVpcGatewayEndpoint", reflect.TypeOf((*MockActor)(nil).AssociateRouteTablesWithVpcGatewayEndpoint), ctx, id, routeTableIds)
+}
+
+// AttachCENChildInstance mocks base method.
+func (m *MockActor) AttachCENChildInstance(ctx context.Context, cenId, vpcId, region string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachCENChildInstance", ctx, cenId, vpcId, region)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachCENChildInstance indicates an expected call of AttachCENChildInstance.
+func (mr *MockActorMockRecorder) AttachCENChildInstance(ctx, cenId, vpcId, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachCENChildInstance", reflect.TypeOf((*MockActor)(nil).AttachCENChildInstance), ctx, cenId, vpcId, region)
+}
+
 // AuthorizeSecurityGroupRule mocks base method.
 func (m *MockActor) AuthorizeSecurityGroupRule(ctx context.Context, sgId string, rule aliclient.SecurityGroupRule) error {
 	m.ctrl.T.Helper()
@@ -84,6 +155,21 @@ func (mr *MockActorMockRecorder) CreateEIP(ctx, eip any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEIP", reflect.TypeOf((*MockActor)(nil).CreateEIP), ctx, eip)
 }
 
+// CreateIPv6Gateway mocks base method.
+func (m *MockActor) CreateIPv6Gateway(ctx context.Context, gw *aliclient.IPv6Gateway) (*aliclient.IPv6Gateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIPv6Gateway", ctx, gw)
+	ret0, _ := ret[0].(*aliclient.IPv6Gateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIPv6Gateway indicates an expected call of CreateIPv6Gateway.
+func (mr *MockActorMockRecorder) CreateIPv6Gateway(ctx, gw any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIPv6Gateway", reflect.TypeOf((*MockActor)(nil).CreateIPv6Gateway), ctx, gw)
+}
+
 // CreateNatGateway mocks base method.
 func (m *MockActor) CreateNatGateway(ctx context.Context, ngw *aliclient.NatGateway) (*aliclient.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +185,36 @@ func (mr *MockActorMockRecorder) CreateNatGateway(ctx, ngw any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatGateway", reflect.TypeOf((*MockActor)(nil).CreateNatGateway), ctx, ngw)
 }
 
+// CreatePrivateZone mocks base method.
+func (m *MockActor) CreatePrivateZone(ctx context.Context, zone *aliclient.PrivateZone) (*aliclient.PrivateZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateZone", ctx, zone)
+	ret0, _ := ret[0].(*aliclient.PrivateZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivateZone indicates an expected call of CreatePrivateZone.
+func (mr *MockActorMockRecorder) CreatePrivateZone(ctx, zone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateZone", reflect.TypeOf((*MockActor)(nil).CreatePrivateZone), ctx, zone)
+}
+
+// CreatePrivateZoneRecord mocks base method.
+func (m *MockActor) CreatePrivateZoneRecord(ctx context.Context, record *aliclient.PrivateZoneRecord) (*aliclient.PrivateZoneRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateZoneRecord", ctx, record)
+	ret0, _ := ret[0].(*aliclient.PrivateZoneRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivateZoneRecord indicates an expected call of CreatePrivateZoneRecord.
+func (mr *MockActorMockRecorder) CreatePrivateZoneRecord(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateZoneRecord", reflect.TypeOf((*MockActor)(nil).CreatePrivateZoneRecord), ctx, record)
+}
+
 // CreateSNatEntry mocks base method.
 func (m *MockActor) CreateSNatEntry(ctx context.Context, entry *aliclient.SNATEntry) (*aliclient.SNATEntry, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +259,36 @@ func (mr *MockActorMockRecorder) CreateTags(ctx, resources, tags, resourceType a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockActor)(nil).CreateTags), ctx, resources, tags, resourceType)
 }
 
+// CreateTransitRouterRouteEntry mocks base method.
+func (m *MockActor) CreateTransitRouterRouteEntry(ctx context.Context, entry *aliclient.TransitRouterRouteEntry) (*aliclient.TransitRouterRouteEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitRouterRouteEntry", ctx, entry)
+	ret0, _ := ret[0].(*aliclient.TransitRouterRouteEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransitRouterRouteEntry indicates an expected call of CreateTransitRouterRouteEntry.
+func (mr *MockActorMockRecorder) CreateTransitRouterRouteEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitRouterRouteEntry", reflect.TypeOf((*MockActor)(nil).CreateTransitRouterRouteEntry), ctx, entry)
+}
+
+// CreateTransitRouterVpcAttachment mocks base method.
+func (m *MockActor) CreateTransitRouterVpcAttachment(ctx context.Context, attachment *aliclient.TransitRouterVpcAttachment) (*aliclient.TransitRouterVpcAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitRouterVpcAttachment", ctx, attachment)
+	ret0, _ := ret[0].(*aliclient.TransitRouterVpcAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransitRouterVpcAttachment indicates an expected call of CreateTransitRouterVpcAttachment.
+func (mr *MockActorMockRecorder) CreateTransitRouterVpcAttachment(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitRouterVpcAttachment", reflect.TypeOf((*MockActor)(nil).CreateTransitRouterVpcAttachment), ctx, attachment)
+}
+
 // CreateVSwitch mocks base method.
 func (m *MockActor) CreateVSwitch(ctx context.Context, vsw *aliclient.VSwitch) (*aliclient.VSwitch, error) {
 	m.ctrl.T.Helper()
@@ -173,6 +319,36 @@ func (mr *MockActorMockRecorder) CreateVpc(ctx, vpc any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpc", reflect.TypeOf((*MockActor)(nil).CreateVpc), ctx, vpc)
 }
 
+// CreateVpcEndpoint mocks base method.
+func (m *MockActor) CreateVpcEndpoint(ctx context.Context, endpoint *aliclient.VPCEndpoint) (*aliclient.VPCEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpcEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(*aliclient.VPCEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpcEndpoint indicates an expected call of CreateVpcEndpoint.
+func (mr *MockActorMockRecorder) CreateVpcEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcEndpoint", reflect.TypeOf((*MockActor)(nil).CreateVpcEndpoint), ctx, endpoint)
+}
+
+// CreateVpcGatewayEndpoint mocks base method.
+func (m *MockActor) CreateVpcGatewayEndpoint(ctx context.Context, endpoint *aliclient.VPCGatewayEndpoint) (*aliclient.VPCGatewayEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpcGatewayEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(*aliclient.VPCGatewayEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpcGatewayEndpoint indicates an expected call of CreateVpcGatewayEndpoint.
+func (mr *MockActorMockRecorder) CreateVpcGatewayEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcGatewayEndpoint", reflect.TypeOf((*MockActor)(nil).CreateVpcGatewayEndpoint), ctx, endpoint)
+}
+
 // DeleteEIP mocks base method.
 func (m *MockActor) DeleteEIP(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -187,6 +363,20 @@ func (mr *MockActorMockRecorder) DeleteEIP(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEIP", reflect.TypeOf((*MockActor)(nil).DeleteEIP), ctx, id)
 }
 
+// DeleteIPv6Gateway mocks base method.
+func (m *MockActor) DeleteIPv6Gateway(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIPv6Gateway", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIPv6Gateway indicates an expected call of DeleteIPv6Gateway.
+func (mr *MockActorMockRecorder) DeleteIPv6Gateway(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPv6Gateway", reflect.TypeOf((*MockActor)(nil).DeleteIPv6Gateway), ctx, id)
+}
+
 // DeleteNatGateway mocks base method.
 func (m *MockActor) DeleteNatGateway(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -201,6 +391,20 @@ func (mr *MockActorMockRecorder) DeleteNatGateway(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockActor)(nil).DeleteNatGateway), ctx, id)
 }
 
+// DeletePrivateZone mocks base method.
+func (m *MockActor) DeletePrivateZone(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateZone", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateZone indicates an expected call of DeletePrivateZone.
+func (mr *MockActorMockRecorder) DeletePrivateZone(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateZone", reflect.TypeOf((*MockActor)(nil).DeletePrivateZone), ctx, id)
+}
+
 // DeleteSNatEntry mocks base method.
 func (m *MockActor) DeleteSNatEntry(ctx context.Context, id, snatTableId string) error {
 	m.ctrl.T.Helper()
@@ -243,6 +447,34 @@ func (mr *MockActorMockRecorder) DeleteTags(ctx, resources, tags, resourceType a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTags", reflect.TypeOf((*MockActor)(nil).DeleteTags), ctx, resources, tags, resourceType)
 }
 
+// DeleteTransitRouterRouteEntry mocks base method.
+func (m *MockActor) DeleteTransitRouterRouteEntry(ctx context.Context, routeTableId, destinationCidrBlock string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitRouterRouteEntry", ctx, routeTableId, destinationCidrBlock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitRouterRouteEntry indicates an expected call of DeleteTransitRouterRouteEntry.
+func (mr *MockActorMockRecorder) DeleteTransitRouterRouteEntry(ctx, routeTableId, destinationCidrBlock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitRouterRouteEntry", reflect.TypeOf((*MockActor)(nil).DeleteTransitRouterRouteEntry), ctx, routeTableId, destinationCidrBlock)
+}
+
+// DeleteTransitRouterVpcAttachment mocks base method.
+func (m *MockActor) DeleteTransitRouterVpcAttachment(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitRouterVpcAttachment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitRouterVpcAttachment indicates an expected call of DeleteTransitRouterVpcAttachment.
+func (mr *MockActorMockRecorder) DeleteTransitRouterVpcAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitRouterVpcAttachment", reflect.TypeOf((*MockActor)(nil).DeleteTransitRouterVpcAttachment), ctx, id)
+}
+
 // DeleteVSwitch mocks base method.
 func (m *MockActor) DeleteVSwitch(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -271,6 +503,76 @@ func (mr *MockActorMockRecorder) DeleteVpc(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpc", reflect.TypeOf((*MockActor)(nil).DeleteVpc), ctx, id)
 }
 
+// DeleteVpcEndpoint mocks base method.
+func (m *MockActor) DeleteVpcEndpoint(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcEndpoint", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVpcEndpoint indicates an expected call of DeleteVpcEndpoint.
+func (mr *MockActorMockRecorder) DeleteVpcEndpoint(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpoint", reflect.TypeOf((*MockActor)(nil).DeleteVpcEndpoint), ctx, id)
+}
+
+// DeleteVpcGatewayEndpoint mocks base method.
+func (m *MockActor) DeleteVpcGatewayEndpoint(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcGatewayEndpoint", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVpcGatewayEndpoint indicates an expected call of DeleteVpcGatewayEndpoint.
+func (mr *MockActorMockRecorder) DeleteVpcGatewayEndpoint(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcGatewayEndpoint", reflect.TypeOf((*MockActor)(nil).DeleteVpcGatewayEndpoint), ctx, id)
+}
+
+// DetachCENChildInstance mocks base method.
+func (m *MockActor) DetachCENChildInstance(ctx context.Context, cenId, vpcId, region string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachCENChildInstance", ctx, cenId, vpcId, region)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachCENChildInstance indicates an expected call of DetachCENChildInstance.
+func (mr *MockActorMockRecorder) DetachCENChildInstance(ctx, cenId, vpcId, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachCENChildInstance", reflect.TypeOf((*MockActor)(nil).DetachCENChildInstance), ctx, cenId, vpcId, region)
+}
+
+// EnableVSwitchIPv6 mocks base method.
+func (m *MockActor) EnableVSwitchIPv6(ctx context.Context, id string, ipv6CidrBlockIndex int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableVSwitchIPv6", ctx, id, ipv6CidrBlockIndex)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableVSwitchIPv6 indicates an expected call of EnableVSwitchIPv6.
+func (mr *MockActorMockRecorder) EnableVSwitchIPv6(ctx, id, ipv6CidrBlockIndex any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableVSwitchIPv6", reflect.TypeOf((*MockActor)(nil).EnableVSwitchIPv6), ctx, id, ipv6CidrBlockIndex)
+}
+
+// EnableVpcIPv6 mocks base method.
+func (m *MockActor) EnableVpcIPv6(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableVpcIPv6", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableVpcIPv6 indicates an expected call of EnableVpcIPv6.
+func (mr *MockActorMockRecorder) EnableVpcIPv6(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableVpcIPv6", reflect.TypeOf((*MockActor)(nil).EnableVpcIPv6), ctx, id)
+}
+
 // FindEIPsByTags mocks base method.
 func (m *MockActor) FindEIPsByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.EIP, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +588,36 @@ func (mr *MockActorMockRecorder) FindEIPsByTags(ctx, tags any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEIPsByTags", reflect.TypeOf((*MockActor)(nil).FindEIPsByTags), ctx, tags)
 }
 
+// FindIPv6GatewayByVPC mocks base method.
+func (m *MockActor) FindIPv6GatewayByVPC(ctx context.Context, vpcId string) (*aliclient.IPv6Gateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIPv6GatewayByVPC", ctx, vpcId)
+	ret0, _ := ret[0].(*aliclient.IPv6Gateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIPv6GatewayByVPC indicates an expected call of FindIPv6GatewayByVPC.
+func (mr *MockActorMockRecorder) FindIPv6GatewayByVPC(ctx, vpcId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIPv6GatewayByVPC", reflect.TypeOf((*MockActor)(nil).FindIPv6GatewayByVPC), ctx, vpcId)
+}
+
+// FindIPv6GatewaysByTags mocks base method.
+func (m *MockActor) FindIPv6GatewaysByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.IPv6Gateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIPv6GatewaysByTags", ctx, tags)
+	ret0, _ := ret[0].([]*aliclient.IPv6Gateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIPv6GatewaysByTags indicates an expected call of FindIPv6GatewaysByTags.
+func (mr *MockActorMockRecorder) FindIPv6GatewaysByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIPv6GatewaysByTags", reflect.TypeOf((*MockActor)(nil).FindIPv6GatewaysByTags), ctx, tags)
+}
+
 // FindNatGatewayByTags mocks base method.
 func (m *MockActor) FindNatGatewayByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +648,21 @@ func (mr *MockActorMockRecorder) FindNatGatewayByVPC(ctx, vpcId any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNatGatewayByVPC", reflect.TypeOf((*MockActor)(nil).FindNatGatewayByVPC), ctx, vpcId)
 }
 
+// FindPrivateZonesByTags mocks base method.
+func (m *MockActor) FindPrivateZonesByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.PrivateZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrivateZonesByTags", ctx, tags)
+	ret0, _ := ret[0].([]*aliclient.PrivateZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrivateZonesByTags indicates an expected call of FindPrivateZonesByTags.
+func (mr *MockActorMockRecorder) FindPrivateZonesByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrivateZonesByTags", reflect.TypeOf((*MockActor)(nil).FindPrivateZonesByTags), ctx, tags)
+}
+
 // FindSNatEntriesByNatGateway mocks base method.
 func (m *MockActor) FindSNatEntriesByNatGateway(ctx context.Context, ngwId string) ([]*aliclient.SNATEntry, error) {
 	m.ctrl.T.Helper()
@@ -346,6 +693,36 @@ func (mr *MockActorMockRecorder) FindSecurityGroupsByTags(ctx, tags any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroupsByTags", reflect.TypeOf((*MockActor)(nil).FindSecurityGroupsByTags), ctx, tags)
 }
 
+// FindTransitRouterRouteEntry mocks base method.
+func (m *MockActor) FindTransitRouterRouteEntry(ctx context.Context, routeTableId, destinationCidrBlock string) (*aliclient.TransitRouterRouteEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransitRouterRouteEntry", ctx, routeTableId, destinationCidrBlock)
+	ret0, _ := ret[0].(*aliclient.TransitRouterRouteEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransitRouterRouteEntry indicates an expected call of FindTransitRouterRouteEntry.
+func (mr *MockActorMockRecorder) FindTransitRouterRouteEntry(ctx, routeTableId, destinationCidrBlock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransitRouterRouteEntry", reflect.TypeOf((*MockActor)(nil).FindTransitRouterRouteEntry), ctx, routeTableId, destinationCidrBlock)
+}
+
+// FindTransitRouterVpcAttachmentByVPC mocks base method.
+func (m *MockActor) FindTransitRouterVpcAttachmentByVPC(ctx context.Context, transitRouterId, vpcId string) (*aliclient.TransitRouterVpcAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransitRouterVpcAttachmentByVPC", ctx, transitRouterId, vpcId)
+	ret0, _ := ret[0].(*aliclient.TransitRouterVpcAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransitRouterVpcAttachmentByVPC indicates an expected call of FindTransitRouterVpcAttachmentByVPC.
+func (mr *MockActorMockRecorder) FindTransitRouterVpcAttachmentByVPC(ctx, transitRouterId, vpcId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransitRouterVpcAttachmentByVPC", reflect.TypeOf((*MockActor)(nil).FindTransitRouterVpcAttachmentByVPC), ctx, transitRouterId, vpcId)
+}
+
 // FindVSwitchesByTags mocks base method.
 func (m *MockActor) FindVSwitchesByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.VSwitch, error) {
 	m.ctrl.T.Helper()
@@ -376,6 +753,36 @@ func (mr *MockActorMockRecorder) FindVSwitchesByVPC(ctx, vpcId any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVSwitchesByVPC", reflect.TypeOf((*MockActor)(nil).FindVSwitchesByVPC), ctx, vpcId)
 }
 
+// FindVpcEndpointsByTags mocks base method.
+func (m *MockActor) FindVpcEndpointsByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.VPCEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVpcEndpointsByTags", ctx, tags)
+	ret0, _ := ret[0].([]*aliclient.VPCEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVpcEndpointsByTags indicates an expected call of FindVpcEndpointsByTags.
+func (mr *MockActorMockRecorder) FindVpcEndpointsByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcEndpointsByTags", reflect.TypeOf((*MockActor)(nil).FindVpcEndpointsByTags), ctx, tags)
+}
+
+// FindVpcGatewayEndpointsByTags mocks base method.
+func (m *MockActor) FindVpcGatewayEndpointsByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.VPCGatewayEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVpcGatewayEndpointsByTags", ctx, tags)
+	ret0, _ := ret[0].([]*aliclient.VPCGatewayEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVpcGatewayEndpointsByTags indicates an expected call of FindVpcGatewayEndpointsByTags.
+func (mr *MockActorMockRecorder) FindVpcGatewayEndpointsByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcGatewayEndpointsByTags", reflect.TypeOf((*MockActor)(nil).FindVpcGatewayEndpointsByTags), ctx, tags)
+}
+
 // FindVpcsByTags mocks base method.
 func (m *MockActor) FindVpcsByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.VPC, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +798,21 @@ func (mr *MockActorMockRecorder) FindVpcsByTags(ctx, tags any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcsByTags", reflect.TypeOf((*MockActor)(nil).FindVpcsByTags), ctx, tags)
 }
 
+// GetCENChildInstance mocks base method.
+func (m *MockActor) GetCENChildInstance(ctx context.Context, cenId, vpcId, region string) (*aliclient.CENChildInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCENChildInstance", ctx, cenId, vpcId, region)
+	ret0, _ := ret[0].(*aliclient.CENChildInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCENChildInstance indicates an expected call of GetCENChildInstance.
+func (mr *MockActorMockRecorder) GetCENChildInstance(ctx, cenId, vpcId, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCENChildInstance", reflect.TypeOf((*MockActor)(nil).GetCENChildInstance), ctx, cenId, vpcId, region)
+}
+
 // GetEIP mocks base method.
 func (m *MockActor) GetEIP(ctx context.Context, id string) (*aliclient.EIP, error) {
 	m.ctrl.T.Helper()
@@ -421,6 +843,21 @@ func (mr *MockActorMockRecorder) GetEIPByAddress(ctx, ipAddress any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEIPByAddress", reflect.TypeOf((*MockActor)(nil).GetEIPByAddress), ctx, ipAddress)
 }
 
+// GetIPv6Gateway mocks base method.
+func (m *MockActor) GetIPv6Gateway(ctx context.Context, id string) (*aliclient.IPv6Gateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPv6Gateway", ctx, id)
+	ret0, _ := ret[0].(*aliclient.IPv6Gateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPv6Gateway indicates an expected call of GetIPv6Gateway.
+func (mr *MockActorMockRecorder) GetIPv6Gateway(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPv6Gateway", reflect.TypeOf((*MockActor)(nil).GetIPv6Gateway), ctx, id)
+}
+
 // GetNatGateway mocks base method.
 func (m *MockActor) GetNatGateway(ctx context.Context, id string) (*aliclient.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -436,6 +873,21 @@ func (mr *MockActorMockRecorder) GetNatGateway(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNatGateway", reflect.TypeOf((*MockActor)(nil).GetNatGateway), ctx, id)
 }
 
+// GetPrivateZone mocks base method.
+func (m *MockActor) GetPrivateZone(ctx context.Context, id string) (*aliclient.PrivateZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivateZone", ctx, id)
+	ret0, _ := ret[0].(*aliclient.PrivateZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivateZone indicates an expected call of GetPrivateZone.
+func (mr *MockActorMockRecorder) GetPrivateZone(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateZone", reflect.TypeOf((*MockActor)(nil).GetPrivateZone), ctx, id)
+}
+
 // GetSNatEntry mocks base method.
 func (m *MockActor) GetSNatEntry(ctx context.Context, id, snatTableId string) (*aliclient.SNATEntry, error) {
 	m.ctrl.T.Helper()
@@ -466,6 +918,21 @@ func (mr *MockActorMockRecorder) GetSecurityGroup(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroup", reflect.TypeOf((*MockActor)(nil).GetSecurityGroup), ctx, id)
 }
 
+// GetTransitRouterVpcAttachment mocks base method.
+func (m *MockActor) GetTransitRouterVpcAttachment(ctx context.Context, id string) (*aliclient.TransitRouterVpcAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransitRouterVpcAttachment", ctx, id)
+	ret0, _ := ret[0].(*aliclient.TransitRouterVpcAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransitRouterVpcAttachment indicates an expected call of GetTransitRouterVpcAttachment.
+func (mr *MockActorMockRecorder) GetTransitRouterVpcAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitRouterVpcAttachment", reflect.TypeOf((*MockActor)(nil).GetTransitRouterVpcAttachment), ctx, id)
+}
+
 // GetVSwitch mocks base method.
 func (m *MockActor) GetVSwitch(ctx context.Context, id string) (*aliclient.VSwitch, error) {
 	m.ctrl.T.Helper()
@@ -496,6 +963,36 @@ func (mr *MockActorMockRecorder) GetVpc(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpc", reflect.TypeOf((*MockActor)(nil).GetVpc), ctx, id)
 }
 
+// GetVpcEndpoint mocks base method.
+func (m *MockActor) GetVpcEndpoint(ctx context.Context, id string) (*aliclient.VPCEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcEndpoint", ctx, id)
+	ret0, _ := ret[0].(*aliclient.VPCEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcEndpoint indicates an expected call of GetVpcEndpoint.
+func (mr *MockActorMockRecorder) GetVpcEndpoint(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcEndpoint", reflect.TypeOf((*MockActor)(nil).GetVpcEndpoint), ctx, id)
+}
+
+// GetVpcGatewayEndpoint mocks base method.
+func (m *MockActor) GetVpcGatewayEndpoint(ctx context.Context, id string) (*aliclient.VPCGatewayEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcGatewayEndpoint", ctx, id)
+	ret0, _ := ret[0].(*aliclient.VPCGatewayEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcGatewayEndpoint indicates an expected call of GetVpcGatewayEndpoint.
+func (mr *MockActorMockRecorder) GetVpcGatewayEndpoint(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcGatewayEndpoint", reflect.TypeOf((*MockActor)(nil).GetVpcGatewayEndpoint), ctx, id)
+}
+
 // ListEIPs mocks base method.
 func (m *MockActor) ListEIPs(ctx context.Context, ids []string) ([]*aliclient.EIP, error) {
 	m.ctrl.T.Helper()
@@ -541,6 +1038,36 @@ func (mr *MockActorMockRecorder) ListNatGateways(ctx, ids any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNatGateways", reflect.TypeOf((*MockActor)(nil).ListNatGateways), ctx, ids)
 }
 
+// ListPrivateZoneRecords mocks base method.
+func (m *MockActor) ListPrivateZoneRecords(ctx context.Context, zoneId string) ([]*aliclient.PrivateZoneRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrivateZoneRecords", ctx, zoneId)
+	ret0, _ := ret[0].([]*aliclient.PrivateZoneRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrivateZoneRecords indicates an expected call of ListPrivateZoneRecords.
+func (mr *MockActorMockRecorder) ListPrivateZoneRecords(ctx, zoneId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateZoneRecords", reflect.TypeOf((*MockActor)(nil).ListPrivateZoneRecords), ctx, zoneId)
+}
+
+// ListRouteTables mocks base method.
+func (m *MockActor) ListRouteTables(ctx context.Context, vpcId string) ([]*aliclient.RouteTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRouteTables", ctx, vpcId)
+	ret0, _ := ret[0].([]*aliclient.RouteTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRouteTables indicates an expected call of ListRouteTables.
+func (mr *MockActorMockRecorder) ListRouteTables(ctx, vpcId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRouteTables", reflect.TypeOf((*MockActor)(nil).ListRouteTables), ctx, vpcId)
+}
+
 // ListSecurityGroups mocks base method.
 func (m *MockActor) ListSecurityGroups(ctx context.Context, ids []string) ([]*aliclient.SecurityGroup, error) {
 	m.ctrl.T.Helper()
@@ -556,6 +1083,21 @@ func (mr *MockActorMockRecorder) ListSecurityGroups(ctx, ids any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroups", reflect.TypeOf((*MockActor)(nil).ListSecurityGroups), ctx, ids)
 }
 
+// ListTagKeys mocks base method.
+func (m *MockActor) ListTagKeys(ctx context.Context, resourceType, keyword string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagKeys", ctx, resourceType, keyword)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagKeys indicates an expected call of ListTagKeys.
+func (mr *MockActorMockRecorder) ListTagKeys(ctx, resourceType, keyword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagKeys", reflect.TypeOf((*MockActor)(nil).ListTagKeys), ctx, resourceType, keyword)
+}
+
 // ListVSwitches mocks base method.
 func (m *MockActor) ListVSwitches(ctx context.Context, ids []string) ([]*aliclient.VSwitch, error) {
 	m.ctrl.T.Helper()
@@ -600,6 +1142,48 @@ func (mr *MockActorMockRecorder) ModifyEIP(ctx, id, eip any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyEIP", reflect.TypeOf((*MockActor)(nil).ModifyEIP), ctx, id, eip)
 }
 
+// ModifyNatGatewaySpec mocks base method.
+func (m *MockActor) ModifyNatGatewaySpec(ctx context.Context, id, spec string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNatGatewaySpec", ctx, id, spec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyNatGatewaySpec indicates an expected call of ModifyNatGatewaySpec.
+func (mr *MockActorMockRecorder) ModifyNatGatewaySpec(ctx, id, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNatGatewaySpec", reflect.TypeOf((*MockActor)(nil).ModifyNatGatewaySpec), ctx, id, spec)
+}
+
+// MoveResourceGroup mocks base method.
+func (m *MockActor) MoveResourceGroup(ctx context.Context, id, resourceGroupId, resourceType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveResourceGroup", ctx, id, resourceGroupId, resourceType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveResourceGroup indicates an expected call of MoveResourceGroup.
+func (mr *MockActorMockRecorder) MoveResourceGroup(ctx, id, resourceGroupId, resourceType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveResourceGroup", reflect.TypeOf((*MockActor)(nil).MoveResourceGroup), ctx, id, resourceGroupId, resourceType)
+}
+
+// RemoveEIPFromBandwidthPackage mocks base method.
+func (m *MockActor) RemoveEIPFromBandwidthPackage(ctx context.Context, bandwidthPackageId, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEIPFromBandwidthPackage", ctx, bandwidthPackageId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveEIPFromBandwidthPackage indicates an expected call of RemoveEIPFromBandwidthPackage.
+func (mr *MockActorMockRecorder) RemoveEIPFromBandwidthPackage(ctx, bandwidthPackageId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEIPFromBandwidthPackage", reflect.TypeOf((*MockActor)(nil).RemoveEIPFromBandwidthPackage), ctx, bandwidthPackageId, id)
+}
+
 // RevokeSecurityGroupRule mocks base method.
 func (m *MockActor) RevokeSecurityGroupRule(ctx context.Context, sgId, ruleId, direction string) error {
 	m.ctrl.T.Helper()
@@ -628,6 +1212,20 @@ func (mr *MockActorMockRecorder) UnAssociateEIP(ctx, eip any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnAssociateEIP", reflect.TypeOf((*MockActor)(nil).UnAssociateEIP), ctx, eip)
 }
 
+// UpdatePrivateZoneRecord mocks base method.
+func (m *MockActor) UpdatePrivateZoneRecord(ctx context.Context, record *aliclient.PrivateZoneRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivateZoneRecord", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrivateZoneRecord indicates an expected call of UpdatePrivateZoneRecord.
+func (mr *MockActorMockRecorder) UpdatePrivateZoneRecord(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivateZoneRecord", reflect.TypeOf((*MockActor)(nil).UpdatePrivateZoneRecord), ctx, record)
+}
+
 // MockFactory is a mock of Factory interface.
 type MockFactory struct {
 	ctrl     *gomock.Controller
@@ -653,16 +1251,16 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 }
 
 // NewActor mocks base method.
-func (m *MockFactory) NewActor(accessKeyID, secretAccessKey, region string) (aliclient.Actor, error) {
+func (m *MockFactory) NewActor(credentials *alicloud.Credentials, region string) (aliclient.Actor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewActor", accessKeyID, secretAccessKey, region)
+	ret := m.ctrl.Call(m, "NewActor", credentials, region)
 	ret0, _ := ret[0].(aliclient.Actor)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewActor indicates an expected call of NewActor.
-func (mr *MockFactoryMockRecorder) NewActor(accessKeyID, secretAccessKey, region any) *gomock.Call {
+func (mr *MockFactoryMockRecorder) NewActor(credentials, region any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewActor", reflect.TypeOf((*MockFactory)(nil).NewActor), accessKeyID, secretAccessKey, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewActor", reflect.TypeOf((*MockFactory)(nil).NewActor), credentials, region)
 }
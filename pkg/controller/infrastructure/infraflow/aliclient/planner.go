@@ -0,0 +1,1234 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aliclient
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+)
+
+// PlannedIDPrefix is the prefix of the placeholder ids the Planner assigns to the resources it would create.
+const PlannedIDPrefix = "planned-"
+
+// ChangeAction is the kind of a planned change.
+type ChangeAction string
+
+const (
+	// ChangeActionCreate is the action for creating a resource.
+	ChangeActionCreate ChangeAction = "Create"
+	// ChangeActionUpdate is the action for modifying an existing resource.
+	ChangeActionUpdate ChangeAction = "Update"
+	// ChangeActionDelete is the action for deleting an existing resource.
+	ChangeActionDelete ChangeAction = "Delete"
+)
+
+// Change is a mutation of a resource which has been planned instead of applied.
+type Change struct {
+	// Action is the kind of the change.
+	Action ChangeAction
+	// ResourceType is the type of the resource, e.g. VPC or VSwitch.
+	ResourceType string
+	// ID is the id of the resource. For a resource to be created it is a placeholder starting with PlannedIDPrefix.
+	ID string
+	// Description describes the change.
+	Description string
+}
+
+// Planner is an Actor which reads the existing resources with another actor, but records all mutations as changes
+// instead of applying them. It can be used to plan a reconciliation without touching the infrastructure. As the Updater
+// applies all modifications through its actor, an updater created for a planner does not modify anything either.
+//
+// The resources it would create are kept in memory with a placeholder id, so that the flow continues as if they
+// existed. Mutations of these resources are part of their creation and are not recorded separately. Resources it
+// would delete are hidden from later reads.
+type Planner struct {
+	// actor is used for reading the existing resources only. It is deliberately not embedded, so that every method of
+	// the Actor interface has to be implemented explicitly and no mutation can be applied by accident.
+	actor Actor
+
+	lock    sync.Mutex
+	counter int
+	changes []Change
+	deleted sets.Set[string]
+
+	vpcs           map[string]*VPC
+	vswitches      map[string]*VSwitch
+	ipv6Gateways   map[string]*IPv6Gateway
+	natGateways    map[string]*NatGateway
+	eips           map[string]*EIP
+	snatEntries    map[string]*SNATEntry
+	securityGroups map[string]*SecurityGroup
+	cenChildren    map[string]*CENChildInstance
+	trAttachments  map[string]*TransitRouterVpcAttachment
+	trRouteEntries map[string]*TransitRouterRouteEntry
+	vpcEndpoints   map[string]*VPCEndpoint
+	gwEndpoints    map[string]*VPCGatewayEndpoint
+	privateZones   map[string]*PrivateZone
+	zoneRecords    map[string]*PrivateZoneRecord
+}
+
+var _ Actor = &Planner{}
+
+// NewPlanner creates a planner reading the existing resources with the given actor.
+func NewPlanner(actor Actor) *Planner {
+	return &Planner{
+		actor:          actor,
+		deleted:        sets.New[string](),
+		vpcs:           map[string]*VPC{},
+		vswitches:      map[string]*VSwitch{},
+		ipv6Gateways:   map[string]*IPv6Gateway{},
+		natGateways:    map[string]*NatGateway{},
+		eips:           map[string]*EIP{},
+		snatEntries:    map[string]*SNATEntry{},
+		securityGroups: map[string]*SecurityGroup{},
+		cenChildren:    map[string]*CENChildInstance{},
+		trAttachments:  map[string]*TransitRouterVpcAttachment{},
+		trRouteEntries: map[string]*TransitRouterRouteEntry{},
+		vpcEndpoints:   map[string]*VPCEndpoint{},
+		gwEndpoints:    map[string]*VPCGatewayEndpoint{},
+		privateZones:   map[string]*PrivateZone{},
+		zoneRecords:    map[string]*PrivateZoneRecord{},
+	}
+}
+
+// NewPlannerFactory creates a factory returning planners, which read the existing resources with the actors created by
+// the given factory. The created planners are passed to the given callback.
+func NewPlannerFactory(factory Factory, created func(planner *Planner)) Factory {
+	return FactoryFunc(func(credentials *alicloud.Credentials, region string) (Actor, error) {
+		actor, err := factory.NewActor(credentials, region)
+		if err != nil {
+			return nil, err
+		}
+		planner := NewPlanner(actor)
+		created(planner)
+		return planner, nil
+	})
+}
+
+// Changes returns the recorded changes in the order they have been planned.
+func (p *Planner) Changes() []Change {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return slices.Clone(p.changes)
+}
+
+// IsPlannedID returns true if the id is a placeholder for a resource to be created.
+func IsPlannedID(id string) bool {
+	return strings.HasPrefix(id, PlannedIDPrefix)
+}
+
+func (p *Planner) newID(kind string) string {
+	p.counter++
+	return fmt.Sprintf("%s%s-%d", PlannedIDPrefix, kind, p.counter)
+}
+
+func (p *Planner) record(action ChangeAction, resourceType, id, format string, args ...any) {
+	p.changes = append(p.changes, Change{
+		Action:       action,
+		ResourceType: resourceType,
+		ID:           id,
+		Description:  fmt.Sprintf(format, args...),
+	})
+}
+
+// recordUpdate records the modification of an existing resource.
+func (p *Planner) recordUpdate(resourceType, id, format string, args ...any) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.record(ChangeActionUpdate, resourceType, id, format, args...)
+}
+
+// recordDeletion records the deletion of an existing resource and hides it from later reads.
+func (p *Planner) recordDeletion(resourceType, key, id, format string, args ...any) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.deleted.Insert(key)
+	p.record(ChangeActionDelete, resourceType, id, format, args...)
+}
+
+// isPlanned returns true if the given resource is planned to be created.
+func isPlanned[T any](p *Planner, planned map[string]*T, key string) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, ok := planned[key]
+	return ok
+}
+
+// removePlanned removes a planned resource and returns true if it has existed.
+func removePlanned[T any](p *Planner, planned map[string]*T, key string) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, ok := planned[key]
+	delete(planned, key)
+	return ok
+}
+
+// get returns the planned resource with the given key or reads the existing one, unless it is planned to be deleted.
+func get[T any](ctx context.Context, p *Planner, planned map[string]*T, key string,
+	read func(ctx context.Context) (*T, error)) (*T, error) {
+	p.lock.Lock()
+	item, ok := planned[key]
+	deleted := p.deleted.Has(key)
+	p.lock.Unlock()
+	if ok {
+		return item, nil
+	}
+	if deleted || IsPlannedID(key) {
+		return nil, nil
+	}
+	return read(ctx)
+}
+
+// list returns the planned resources with the given ids and reads the existing ones, which are not planned to be
+// deleted.
+func list[T any](ctx context.Context, p *Planner, planned map[string]*T, ids []string,
+	read func(ctx context.Context, ids []string) ([]*T, error)) ([]*T, error) {
+	var (
+		result   []*T
+		existing []string
+	)
+	p.lock.Lock()
+	for _, id := range ids {
+		if item, ok := planned[id]; ok {
+			result = append(result, item)
+		} else if !IsPlannedID(id) && !p.deleted.Has(id) {
+			existing = append(existing, id)
+		}
+	}
+	p.lock.Unlock()
+	if len(existing) == 0 {
+		return result, nil
+	}
+	items, err := read(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, items...), nil
+}
+
+// merge returns the given existing resources, which are not planned to be deleted, together with the matching planned
+// resources.
+func merge[T any](p *Planner, planned map[string]*T, existing []*T, key func(item *T) string, match func(item *T) bool) []*T {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var result []*T
+	for _, item := range existing {
+		if !p.deleted.Has(key(item)) {
+			result = append(result, item)
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(planned)) {
+		if match(planned[k]) {
+			result = append(result, planned[k])
+		}
+	}
+	return result
+}
+
+// find reads the existing resources with the given function, unless the query refers to a planned resource.
+func find[T any](ctx context.Context, p *Planner, planned map[string]*T, refersToPlanned bool, key func(item *T) string,
+	match func(item *T) bool, read func(ctx context.Context) ([]*T, error)) ([]*T, error) {
+	var existing []*T
+	if !refersToPlanned {
+		var err error
+		existing, err = read(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return merge(p, planned, existing, key, match), nil
+}
+
+// findSingle is like find for queries returning a single resource.
+func findSingle[T any](ctx context.Context, p *Planner, planned map[string]*T, refersToPlanned bool, key func(item *T) string,
+	match func(item *T) bool, read func(ctx context.Context) (*T, error)) (*T, error) {
+	found, err := find(ctx, p, planned, refersToPlanned, key, match, func(ctx context.Context) ([]*T, error) {
+		item, err := read(ctx)
+		if item == nil || err != nil {
+			return nil, err
+		}
+		return []*T{item}, nil
+	})
+	if err != nil || len(found) == 0 {
+		return nil, err
+	}
+	return found[0], nil
+}
+
+func hasAllTags(tags, query Tags) bool {
+	for k, v := range query {
+		if tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// tagsOf returns the tags of the planned resource with the given id.
+func (p *Planner) tagsOf(id string) (Tags, bool) {
+	if item, ok := p.vpcs[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.vswitches[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.ipv6Gateways[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.natGateways[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.eips[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.securityGroups[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.trAttachments[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.vpcEndpoints[id]; ok {
+		return item.Tags, true
+	}
+	if item, ok := p.gwEndpoints[id]; ok {
+		return item.Tags, true
+	}
+	return nil, false
+}
+
+// setResourceGroupOf sets the resource group of the planned resource with the given id.
+func (p *Planner) setResourceGroupOf(id, resourceGroupId string) bool {
+	if item, ok := p.vpcs[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	if item, ok := p.vswitches[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	if item, ok := p.ipv6Gateways[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	if item, ok := p.natGateways[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	if item, ok := p.eips[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	if item, ok := p.securityGroups[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	if item, ok := p.vpcEndpoints[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	if item, ok := p.gwEndpoints[id]; ok {
+		item.ResourceGroupId = resourceGroupId
+		return true
+	}
+	return false
+}
+
+func formatTags(tags Tags) string {
+	var pairs []string
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		pairs = append(pairs, k+"="+tags[k])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func cenChildKey(cenId, vpcId string) string {
+	return cenId + "/" + vpcId
+}
+
+func routeEntryKey(routeTableId, destinationCidrBlock string) string {
+	return routeTableId + "/" + destinationCidrBlock
+}
+
+func zoneRecordKey(record *PrivateZoneRecord) string {
+	return fmt.Sprintf("%s/%d", record.ZoneId, record.RecordId)
+}
+
+// VPC
+
+// CreateVpc plans the creation of a VPC.
+func (p *Planner) CreateVpc(_ context.Context, vpc *VPC) (*VPC, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *vpc
+	created.VpcId = p.newID("vpc")
+	created.Tags = vpc.Tags.Clone()
+	created.Status = ptr.To("Available")
+	p.vpcs[created.VpcId] = &created
+	p.record(ChangeActionCreate, "VPC", created.VpcId, "create VPC %s with CIDR %s", created.Name, created.CidrBlock)
+	return &created, nil
+}
+
+// GetVpc returns the planned or existing VPC with the given id.
+func (p *Planner) GetVpc(ctx context.Context, id string) (*VPC, error) {
+	return get(ctx, p, p.vpcs, id, func(ctx context.Context) (*VPC, error) {
+		return p.actor.GetVpc(ctx, id)
+	})
+}
+
+// ListVpcs returns the planned or existing VPCs with the given ids.
+func (p *Planner) ListVpcs(ctx context.Context, ids []string) ([]*VPC, error) {
+	return list(ctx, p, p.vpcs, ids, p.actor.ListVpcs)
+}
+
+// FindVpcsByTags returns the planned or existing VPCs having the given tags.
+func (p *Planner) FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error) {
+	return find(ctx, p, p.vpcs, false, func(item *VPC) string { return item.VpcId },
+		func(item *VPC) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*VPC, error) { return p.actor.FindVpcsByTags(ctx, tags) })
+}
+
+// DeleteVpc plans the deletion of a VPC.
+func (p *Planner) DeleteVpc(ctx context.Context, id string) error {
+	if removePlanned(p, p.vpcs, id) {
+		return nil
+	}
+	current, err := p.GetVpc(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("VPC", id, id, "delete VPC %s", current.Name)
+	return nil
+}
+
+// EnableVpcIPv6 plans enabling IPv6 for a VPC.
+func (p *Planner) EnableVpcIPv6(_ context.Context, id string) error {
+	if isPlanned(p, p.vpcs, id) {
+		return nil
+	}
+	p.recordUpdate("VPC", id, "enable IPv6")
+	return nil
+}
+
+// VSwitch
+
+// CreateVSwitch plans the creation of a vswitch.
+func (p *Planner) CreateVSwitch(_ context.Context, vsw *VSwitch) (*VSwitch, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *vsw
+	created.VSwitchId = p.newID("vsw")
+	created.Tags = vsw.Tags.Clone()
+	created.Status = ptr.To("Available")
+	p.vswitches[created.VSwitchId] = &created
+	p.record(ChangeActionCreate, "VSwitch", created.VSwitchId, "create vswitch %s with CIDR %s in zone %s",
+		created.Name, created.CidrBlock, created.ZoneId)
+	return &created, nil
+}
+
+// GetVSwitch returns the planned or existing vswitch with the given id.
+func (p *Planner) GetVSwitch(ctx context.Context, id string) (*VSwitch, error) {
+	return get(ctx, p, p.vswitches, id, func(ctx context.Context) (*VSwitch, error) {
+		return p.actor.GetVSwitch(ctx, id)
+	})
+}
+
+// ListVSwitches returns the planned or existing vswitches with the given ids.
+func (p *Planner) ListVSwitches(ctx context.Context, ids []string) ([]*VSwitch, error) {
+	return list(ctx, p, p.vswitches, ids, p.actor.ListVSwitches)
+}
+
+// FindVSwitchesByTags returns the planned or existing vswitches having the given tags.
+func (p *Planner) FindVSwitchesByTags(ctx context.Context, tags Tags) ([]*VSwitch, error) {
+	return find(ctx, p, p.vswitches, false, func(item *VSwitch) string { return item.VSwitchId },
+		func(item *VSwitch) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*VSwitch, error) { return p.actor.FindVSwitchesByTags(ctx, tags) })
+}
+
+// FindVSwitchesByVPC returns the planned or existing vswitches of the given VPC.
+func (p *Planner) FindVSwitchesByVPC(ctx context.Context, vpcId string) ([]*VSwitch, error) {
+	return find(ctx, p, p.vswitches, IsPlannedID(vpcId), func(item *VSwitch) string { return item.VSwitchId },
+		func(item *VSwitch) bool { return ptr.Deref(item.VpcId, "") == vpcId },
+		func(ctx context.Context) ([]*VSwitch, error) { return p.actor.FindVSwitchesByVPC(ctx, vpcId) })
+}
+
+// DeleteVSwitch plans the deletion of a vswitch.
+func (p *Planner) DeleteVSwitch(ctx context.Context, id string) error {
+	if removePlanned(p, p.vswitches, id) {
+		return nil
+	}
+	current, err := p.GetVSwitch(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("VSwitch", id, id, "delete vswitch %s with CIDR %s in zone %s", current.Name, current.CidrBlock, current.ZoneId)
+	return nil
+}
+
+// EnableVSwitchIPv6 plans enabling IPv6 for a vswitch.
+func (p *Planner) EnableVSwitchIPv6(_ context.Context, id string, ipv6CidrBlockIndex int32) error {
+	if isPlanned(p, p.vswitches, id) {
+		return nil
+	}
+	p.recordUpdate("VSwitch", id, "enable IPv6 with CIDR block index %d", ipv6CidrBlockIndex)
+	return nil
+}
+
+// IPv6 gateway
+
+// CreateIPv6Gateway plans the creation of an IPv6 gateway.
+func (p *Planner) CreateIPv6Gateway(_ context.Context, gw *IPv6Gateway) (*IPv6Gateway, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *gw
+	created.IPv6GatewayId = p.newID("ipv6gw")
+	created.Tags = gw.Tags.Clone()
+	created.Status = ptr.To("Available")
+	p.ipv6Gateways[created.IPv6GatewayId] = &created
+	p.record(ChangeActionCreate, "IPv6Gateway", created.IPv6GatewayId, "create IPv6 gateway %s in VPC %s", created.Name, created.VpcId)
+	return &created, nil
+}
+
+// GetIPv6Gateway returns the planned or existing IPv6 gateway with the given id.
+func (p *Planner) GetIPv6Gateway(ctx context.Context, id string) (*IPv6Gateway, error) {
+	return get(ctx, p, p.ipv6Gateways, id, func(ctx context.Context) (*IPv6Gateway, error) {
+		return p.actor.GetIPv6Gateway(ctx, id)
+	})
+}
+
+// FindIPv6GatewaysByTags returns the planned or existing IPv6 gateways having the given tags.
+func (p *Planner) FindIPv6GatewaysByTags(ctx context.Context, tags Tags) ([]*IPv6Gateway, error) {
+	return find(ctx, p, p.ipv6Gateways, false, func(item *IPv6Gateway) string { return item.IPv6GatewayId },
+		func(item *IPv6Gateway) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*IPv6Gateway, error) { return p.actor.FindIPv6GatewaysByTags(ctx, tags) })
+}
+
+// FindIPv6GatewayByVPC returns the planned or existing IPv6 gateway of the given VPC.
+func (p *Planner) FindIPv6GatewayByVPC(ctx context.Context, vpcId string) (*IPv6Gateway, error) {
+	return findSingle(ctx, p, p.ipv6Gateways, IsPlannedID(vpcId), func(item *IPv6Gateway) string { return item.IPv6GatewayId },
+		func(item *IPv6Gateway) bool { return item.VpcId == vpcId },
+		func(ctx context.Context) (*IPv6Gateway, error) { return p.actor.FindIPv6GatewayByVPC(ctx, vpcId) })
+}
+
+// DeleteIPv6Gateway plans the deletion of an IPv6 gateway.
+func (p *Planner) DeleteIPv6Gateway(ctx context.Context, id string) error {
+	if removePlanned(p, p.ipv6Gateways, id) {
+		return nil
+	}
+	current, err := p.GetIPv6Gateway(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("IPv6Gateway", id, id, "delete IPv6 gateway %s", current.Name)
+	return nil
+}
+
+// NAT gateway
+
+// ListEnhanhcedNatGatewayAvailableZones returns the zones supporting enhanced NAT gateways in the given region.
+func (p *Planner) ListEnhanhcedNatGatewayAvailableZones(ctx context.Context, region string) ([]string, error) {
+	return p.actor.ListEnhanhcedNatGatewayAvailableZones(ctx, region)
+}
+
+// CreateNatGateway plans the creation of a NAT gateway.
+func (p *Planner) CreateNatGateway(_ context.Context, ngw *NatGateway) (*NatGateway, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *ngw
+	created.NatGatewayId = p.newID("ngw")
+	created.Tags = ngw.Tags.Clone()
+	created.Status = ptr.To("Available")
+	created.SNATTableIDs = []string{p.newID("stb")}
+	p.natGateways[created.NatGatewayId] = &created
+	p.record(ChangeActionCreate, "NatGateway", created.NatGatewayId, "create NAT gateway %s in vswitch %s",
+		created.Name, ptr.Deref(created.VswitchId, ""))
+	return &created, nil
+}
+
+// GetNatGateway returns the planned or existing NAT gateway with the given id.
+func (p *Planner) GetNatGateway(ctx context.Context, id string) (*NatGateway, error) {
+	return get(ctx, p, p.natGateways, id, func(ctx context.Context) (*NatGateway, error) {
+		return p.actor.GetNatGateway(ctx, id)
+	})
+}
+
+// ListNatGateways returns the planned or existing NAT gateways with the given ids.
+func (p *Planner) ListNatGateways(ctx context.Context, ids []string) ([]*NatGateway, error) {
+	return list(ctx, p, p.natGateways, ids, p.actor.ListNatGateways)
+}
+
+// FindNatGatewayByTags returns the planned or existing NAT gateways having the given tags.
+func (p *Planner) FindNatGatewayByTags(ctx context.Context, tags Tags) ([]*NatGateway, error) {
+	return find(ctx, p, p.natGateways, false, func(item *NatGateway) string { return item.NatGatewayId },
+		func(item *NatGateway) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*NatGateway, error) { return p.actor.FindNatGatewayByTags(ctx, tags) })
+}
+
+// FindNatGatewayByVPC returns the planned or existing NAT gateway of the given VPC.
+func (p *Planner) FindNatGatewayByVPC(ctx context.Context, vpcId string) (*NatGateway, error) {
+	return findSingle(ctx, p, p.natGateways, IsPlannedID(vpcId), func(item *NatGateway) string { return item.NatGatewayId },
+		func(item *NatGateway) bool { return ptr.Deref(item.VpcId, "") == vpcId },
+		func(ctx context.Context) (*NatGateway, error) { return p.actor.FindNatGatewayByVPC(ctx, vpcId) })
+}
+
+// DeleteNatGateway plans the deletion of a NAT gateway.
+func (p *Planner) DeleteNatGateway(ctx context.Context, id string) error {
+	if removePlanned(p, p.natGateways, id) {
+		return nil
+	}
+	current, err := p.GetNatGateway(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("NatGateway", id, id, "delete NAT gateway %s", current.Name)
+	return nil
+}
+
+// ModifyNatGatewaySpec plans changing the specification of a NAT gateway.
+func (p *Planner) ModifyNatGatewaySpec(_ context.Context, id, spec string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.natGateways[id]; ok {
+		item.Spec = spec
+		return nil
+	}
+	p.record(ChangeActionUpdate, "NatGateway", id, "change specification to %s", spec)
+	return nil
+}
+
+// EIP
+
+// CreateEIP plans the creation of an elastic IP.
+func (p *Planner) CreateEIP(_ context.Context, eip *EIP) (*EIP, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *eip
+	created.EipId = p.newID("eip")
+	created.Tags = eip.Tags.Clone()
+	created.Status = ptr.To("Available")
+	p.eips[created.EipId] = &created
+	p.record(ChangeActionCreate, "EIP", created.EipId, "create elastic IP %s with bandwidth %s", created.Name, created.Bandwidth)
+	return &created, nil
+}
+
+// GetEIP returns the planned or existing elastic IP with the given id.
+func (p *Planner) GetEIP(ctx context.Context, id string) (*EIP, error) {
+	return get(ctx, p, p.eips, id, func(ctx context.Context) (*EIP, error) {
+		return p.actor.GetEIP(ctx, id)
+	})
+}
+
+// GetEIPByAddress returns the existing elastic IP with the given address, as the address of a planned elastic IP is
+// unknown.
+func (p *Planner) GetEIPByAddress(ctx context.Context, ipAddress string) (*EIP, error) {
+	return findSingle(ctx, p, p.eips, false, func(item *EIP) string { return item.EipId },
+		func(_ *EIP) bool { return false },
+		func(ctx context.Context) (*EIP, error) { return p.actor.GetEIPByAddress(ctx, ipAddress) })
+}
+
+// ListEIPs returns the planned or existing elastic IPs with the given ids.
+func (p *Planner) ListEIPs(ctx context.Context, ids []string) ([]*EIP, error) {
+	return list(ctx, p, p.eips, ids, p.actor.ListEIPs)
+}
+
+// FindEIPsByTags returns the planned or existing elastic IPs having the given tags.
+func (p *Planner) FindEIPsByTags(ctx context.Context, tags Tags) ([]*EIP, error) {
+	return find(ctx, p, p.eips, false, func(item *EIP) string { return item.EipId },
+		func(item *EIP) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*EIP, error) { return p.actor.FindEIPsByTags(ctx, tags) })
+}
+
+// DeleteEIP plans the release of an elastic IP.
+func (p *Planner) DeleteEIP(ctx context.Context, id string) error {
+	if removePlanned(p, p.eips, id) {
+		return nil
+	}
+	current, err := p.GetEIP(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("EIP", id, id, "release elastic IP %s with address %s", current.Name, current.IpAddress)
+	return nil
+}
+
+// ModifyEIP plans changing the bandwidth of an elastic IP.
+func (p *Planner) ModifyEIP(_ context.Context, id string, eip *EIP) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.eips[id]; ok {
+		item.Bandwidth = eip.Bandwidth
+		return nil
+	}
+	p.record(ChangeActionUpdate, "EIP", id, "change bandwidth to %s", eip.Bandwidth)
+	return nil
+}
+
+// AddEIPToBandwidthPackage plans adding an elastic IP to a bandwidth package.
+func (p *Planner) AddEIPToBandwidthPackage(_ context.Context, bandwidthPackageId, id string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.eips[id]; ok {
+		item.BandwidthPackageId = bandwidthPackageId
+		return nil
+	}
+	p.record(ChangeActionUpdate, "EIP", id, "add to bandwidth package %s", bandwidthPackageId)
+	return nil
+}
+
+// RemoveEIPFromBandwidthPackage plans removing an elastic IP from a bandwidth package.
+func (p *Planner) RemoveEIPFromBandwidthPackage(_ context.Context, bandwidthPackageId, id string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.eips[id]; ok {
+		item.BandwidthPackageId = ""
+		return nil
+	}
+	p.record(ChangeActionUpdate, "EIP", id, "remove from bandwidth package %s", bandwidthPackageId)
+	return nil
+}
+
+// AssociateEIP plans associating an elastic IP with an instance.
+func (p *Planner) AssociateEIP(_ context.Context, id, to, insType string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.eips[id]; ok {
+		item.Status = ptr.To("InUse")
+		item.InstanceId = ptr.To(to)
+		item.InstanceType = ptr.To(insType)
+		return nil
+	}
+	p.record(ChangeActionUpdate, "EIP", id, "associate with %s %s", insType, to)
+	return nil
+}
+
+// UnAssociateEIP plans dissociating an elastic IP from its instance.
+func (p *Planner) UnAssociateEIP(_ context.Context, eip *EIP) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.eips[eip.EipId]; ok {
+		item.Status = ptr.To("Available")
+		item.InstanceId = nil
+		item.InstanceType = nil
+		return nil
+	}
+	p.record(ChangeActionUpdate, "EIP", eip.EipId, "dissociate from %s %s", ptr.Deref(eip.InstanceType, ""), ptr.Deref(eip.InstanceId, ""))
+	return nil
+}
+
+// SNAT entry
+
+// CreateSNatEntry plans the creation of a SNAT entry.
+func (p *Planner) CreateSNatEntry(_ context.Context, entry *SNATEntry) (*SNATEntry, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *entry
+	created.SnatEntryId = p.newID("snat")
+	created.Status = ptr.To("Available")
+	p.snatEntries[created.SnatEntryId] = &created
+	p.record(ChangeActionCreate, "SNATEntry", created.SnatEntryId, "create SNAT entry %s for vswitch %s in NAT gateway %s",
+		created.Name, created.VSwitchId, created.NatGatewayId)
+	return &created, nil
+}
+
+// GetSNatEntry returns the planned or existing SNAT entry with the given id.
+func (p *Planner) GetSNatEntry(ctx context.Context, id, snatTableId string) (*SNATEntry, error) {
+	if IsPlannedID(snatTableId) && !IsPlannedID(id) {
+		return nil, nil
+	}
+	return get(ctx, p, p.snatEntries, id, func(ctx context.Context) (*SNATEntry, error) {
+		return p.actor.GetSNatEntry(ctx, id, snatTableId)
+	})
+}
+
+// FindSNatEntriesByNatGateway returns the planned or existing SNAT entries of the given NAT gateway.
+func (p *Planner) FindSNatEntriesByNatGateway(ctx context.Context, ngwId string) ([]*SNATEntry, error) {
+	return find(ctx, p, p.snatEntries, IsPlannedID(ngwId), func(item *SNATEntry) string { return item.SnatEntryId },
+		func(item *SNATEntry) bool { return item.NatGatewayId == ngwId },
+		func(ctx context.Context) ([]*SNATEntry, error) {
+			return p.actor.FindSNatEntriesByNatGateway(ctx, ngwId)
+		})
+}
+
+// DeleteSNatEntry plans the deletion of a SNAT entry.
+func (p *Planner) DeleteSNatEntry(ctx context.Context, id, snatTableId string) error {
+	if removePlanned(p, p.snatEntries, id) {
+		return nil
+	}
+	current, err := p.GetSNatEntry(ctx, id, snatTableId)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("SNATEntry", id, id, "delete SNAT entry %s for vswitch %s", current.Name, current.VSwitchId)
+	return nil
+}
+
+// Tags and resource groups
+
+// ListTagKeys returns the tag keys containing the given keyword which are used by resources of the given type.
+func (p *Planner) ListTagKeys(ctx context.Context, resourceType, keyword string) ([]string, error) {
+	return p.actor.ListTagKeys(ctx, resourceType, keyword)
+}
+
+// CreateTags plans adding tags to resources.
+func (p *Planner) CreateTags(_ context.Context, resources []string, tags Tags, resourceType string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, id := range resources {
+		if current, ok := p.tagsOf(id); ok {
+			for k, v := range tags {
+				current[k] = v
+			}
+			continue
+		}
+		p.record(ChangeActionUpdate, resourceType, id, "add tags %s", formatTags(tags))
+	}
+	return nil
+}
+
+// DeleteTags plans removing tags from resources.
+func (p *Planner) DeleteTags(_ context.Context, resources []string, tags Tags, resourceType string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, id := range resources {
+		if current, ok := p.tagsOf(id); ok {
+			for k := range tags {
+				delete(current, k)
+			}
+			continue
+		}
+		p.record(ChangeActionUpdate, resourceType, id, "remove tags %s", formatTags(tags))
+	}
+	return nil
+}
+
+// MoveResourceGroup plans moving a resource to another resource group.
+func (p *Planner) MoveResourceGroup(_ context.Context, id, resourceGroupId, resourceType string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.setResourceGroupOf(id, resourceGroupId) {
+		return nil
+	}
+	p.record(ChangeActionUpdate, resourceType, id, "move to resource group %s", resourceGroupId)
+	return nil
+}
+
+// Security group
+
+// CreateSecurityGroup plans the creation of a security group.
+func (p *Planner) CreateSecurityGroup(_ context.Context, sg *SecurityGroup) (*SecurityGroup, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *sg
+	created.SecurityGroupId = p.newID("sg")
+	created.Tags = sg.Tags.Clone()
+	created.Status = ptr.To("Available")
+	created.Rules = nil
+	p.securityGroups[created.SecurityGroupId] = &created
+	p.record(ChangeActionCreate, "SecurityGroup", created.SecurityGroupId, "create security group %s in VPC %s", created.Name, created.VpcId)
+	return &created, nil
+}
+
+// GetSecurityGroup returns the planned or existing security group with the given id.
+func (p *Planner) GetSecurityGroup(ctx context.Context, id string) (*SecurityGroup, error) {
+	return get(ctx, p, p.securityGroups, id, func(ctx context.Context) (*SecurityGroup, error) {
+		return p.actor.GetSecurityGroup(ctx, id)
+	})
+}
+
+// ListSecurityGroups returns the planned or existing security groups with the given ids.
+func (p *Planner) ListSecurityGroups(ctx context.Context, ids []string) ([]*SecurityGroup, error) {
+	return list(ctx, p, p.securityGroups, ids, p.actor.ListSecurityGroups)
+}
+
+// FindSecurityGroupsByTags returns the planned or existing security groups having the given tags.
+func (p *Planner) FindSecurityGroupsByTags(ctx context.Context, tags Tags) ([]*SecurityGroup, error) {
+	return find(ctx, p, p.securityGroups, false, func(item *SecurityGroup) string { return item.SecurityGroupId },
+		func(item *SecurityGroup) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*SecurityGroup, error) {
+			return p.actor.FindSecurityGroupsByTags(ctx, tags)
+		})
+}
+
+// DeleteSecurityGroup plans the deletion of a security group.
+func (p *Planner) DeleteSecurityGroup(ctx context.Context, id string) error {
+	if removePlanned(p, p.securityGroups, id) {
+		return nil
+	}
+	current, err := p.GetSecurityGroup(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("SecurityGroup", id, id, "delete security group %s", current.Name)
+	return nil
+}
+
+// AuthorizeSecurityGroupRule plans adding a rule to a security group.
+func (p *Planner) AuthorizeSecurityGroupRule(_ context.Context, sgId string, rule SecurityGroupRule) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.securityGroups[sgId]; ok {
+		item.Rules = append(item.Rules, &rule)
+		return nil
+	}
+	p.record(ChangeActionUpdate, "SecurityGroup", sgId, "add %s rule %s", rule.Direction, securityGroupRuleKey(&rule))
+	return nil
+}
+
+// RevokeSecurityGroupRule plans removing a rule from a security group.
+func (p *Planner) RevokeSecurityGroupRule(_ context.Context, sgId, ruleId, direction string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.securityGroups[sgId]; ok {
+		item.Rules = slices.DeleteFunc(item.Rules, func(rule *SecurityGroupRule) bool {
+			return rule.SecurityGroupRuleId == ruleId
+		})
+		return nil
+	}
+	p.record(ChangeActionUpdate, "SecurityGroup", sgId, "remove %s rule %s", direction, ruleId)
+	return nil
+}
+
+// CEN
+
+// AttachCENChildInstance plans attaching a VPC to a CEN instance.
+func (p *Planner) AttachCENChildInstance(_ context.Context, cenId, vpcId, region string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.cenChildren[cenChildKey(cenId, vpcId)] = &CENChildInstance{
+		CenId:           cenId,
+		ChildInstanceId: vpcId,
+		RegionId:        region,
+		Status:          "Attached",
+	}
+	p.record(ChangeActionCreate, "CENChildInstance", vpcId, "attach VPC to CEN instance %s", cenId)
+	return nil
+}
+
+// GetCENChildInstance returns the planned or existing attachment of a VPC to a CEN instance.
+func (p *Planner) GetCENChildInstance(ctx context.Context, cenId, vpcId, region string) (*CENChildInstance, error) {
+	if IsPlannedID(vpcId) && !isPlanned(p, p.cenChildren, cenChildKey(cenId, vpcId)) {
+		return nil, nil
+	}
+	return get(ctx, p, p.cenChildren, cenChildKey(cenId, vpcId), func(ctx context.Context) (*CENChildInstance, error) {
+		return p.actor.GetCENChildInstance(ctx, cenId, vpcId, region)
+	})
+}
+
+// DetachCENChildInstance plans detaching a VPC from a CEN instance.
+func (p *Planner) DetachCENChildInstance(ctx context.Context, cenId, vpcId, region string) error {
+	key := cenChildKey(cenId, vpcId)
+	if removePlanned(p, p.cenChildren, key) {
+		return nil
+	}
+	current, err := p.GetCENChildInstance(ctx, cenId, vpcId, region)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("CENChildInstance", key, vpcId, "detach VPC from CEN instance %s", cenId)
+	return nil
+}
+
+// CreateTransitRouterVpcAttachment plans the creation of a transit router VPC attachment.
+func (p *Planner) CreateTransitRouterVpcAttachment(_ context.Context, attachment *TransitRouterVpcAttachment) (*TransitRouterVpcAttachment, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *attachment
+	created.TransitRouterAttachmentId = p.newID("tr-attach")
+	created.Tags = attachment.Tags.Clone()
+	created.ZoneMappings = maps.Clone(attachment.ZoneMappings)
+	created.Status = "Attached"
+	p.trAttachments[created.TransitRouterAttachmentId] = &created
+	p.record(ChangeActionCreate, "TransitRouterVpcAttachment", created.TransitRouterAttachmentId,
+		"attach VPC %s to transit router %s", created.VpcId, created.TransitRouterId)
+	return &created, nil
+}
+
+// GetTransitRouterVpcAttachment returns the planned or existing transit router VPC attachment with the given id.
+func (p *Planner) GetTransitRouterVpcAttachment(ctx context.Context, id string) (*TransitRouterVpcAttachment, error) {
+	return get(ctx, p, p.trAttachments, id, func(ctx context.Context) (*TransitRouterVpcAttachment, error) {
+		return p.actor.GetTransitRouterVpcAttachment(ctx, id)
+	})
+}
+
+// FindTransitRouterVpcAttachmentByVPC returns the planned or existing attachment of the given VPC to the transit router.
+func (p *Planner) FindTransitRouterVpcAttachmentByVPC(ctx context.Context, transitRouterId, vpcId string) (*TransitRouterVpcAttachment, error) {
+	return findSingle(ctx, p, p.trAttachments, IsPlannedID(vpcId),
+		func(item *TransitRouterVpcAttachment) string { return item.TransitRouterAttachmentId },
+		func(item *TransitRouterVpcAttachment) bool {
+			return item.TransitRouterId == transitRouterId && item.VpcId == vpcId
+		},
+		func(ctx context.Context) (*TransitRouterVpcAttachment, error) {
+			return p.actor.FindTransitRouterVpcAttachmentByVPC(ctx, transitRouterId, vpcId)
+		})
+}
+
+// AddZonesToTransitRouterVpcAttachment plans adding zones to a transit router VPC attachment.
+func (p *Planner) AddZonesToTransitRouterVpcAttachment(_ context.Context, id string, zoneMappings map[string]string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.trAttachments[id]; ok {
+		maps.Copy(item.ZoneMappings, zoneMappings)
+		return nil
+	}
+	p.record(ChangeActionUpdate, "TransitRouterVpcAttachment", id, "add zones %s", strings.Join(slices.Sorted(maps.Keys(zoneMappings)), ", "))
+	return nil
+}
+
+// DeleteTransitRouterVpcAttachment plans the deletion of a transit router VPC attachment.
+func (p *Planner) DeleteTransitRouterVpcAttachment(ctx context.Context, id string) error {
+	if removePlanned(p, p.trAttachments, id) {
+		return nil
+	}
+	current, err := p.GetTransitRouterVpcAttachment(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("TransitRouterVpcAttachment", id, id, "detach VPC %s from transit router %s", current.VpcId, current.TransitRouterId)
+	return nil
+}
+
+// CreateTransitRouterRouteEntry plans the creation of a transit router route entry.
+func (p *Planner) CreateTransitRouterRouteEntry(_ context.Context, entry *TransitRouterRouteEntry) (*TransitRouterRouteEntry, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *entry
+	created.TransitRouterRouteEntryId = p.newID("rte")
+	created.Status = "Active"
+	p.trRouteEntries[routeEntryKey(created.TransitRouterRouteTableId, created.DestinationCidrBlock)] = &created
+	p.record(ChangeActionCreate, "TransitRouterRouteEntry", created.TransitRouterRouteEntryId,
+		"route %s to %s in route table %s", created.DestinationCidrBlock, created.NextHopId, created.TransitRouterRouteTableId)
+	return &created, nil
+}
+
+// FindTransitRouterRouteEntry returns the planned or existing static route entry for the given destination.
+func (p *Planner) FindTransitRouterRouteEntry(ctx context.Context, routeTableId, destinationCidrBlock string) (*TransitRouterRouteEntry, error) {
+	return get(ctx, p, p.trRouteEntries, routeEntryKey(routeTableId, destinationCidrBlock), func(ctx context.Context) (*TransitRouterRouteEntry, error) {
+		return p.actor.FindTransitRouterRouteEntry(ctx, routeTableId, destinationCidrBlock)
+	})
+}
+
+// DeleteTransitRouterRouteEntry plans the deletion of a static route entry.
+func (p *Planner) DeleteTransitRouterRouteEntry(ctx context.Context, routeTableId, destinationCidrBlock string) error {
+	key := routeEntryKey(routeTableId, destinationCidrBlock)
+	if removePlanned(p, p.trRouteEntries, key) {
+		return nil
+	}
+	current, err := p.FindTransitRouterRouteEntry(ctx, routeTableId, destinationCidrBlock)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("TransitRouterRouteEntry", key, current.TransitRouterRouteEntryId,
+		"remove route %s from route table %s", destinationCidrBlock, routeTableId)
+	return nil
+}
+
+// VPC endpoint
+
+// CreateVpcEndpoint plans the creation of a VPC endpoint.
+func (p *Planner) CreateVpcEndpoint(_ context.Context, endpoint *VPCEndpoint) (*VPCEndpoint, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *endpoint
+	created.EndpointId = p.newID("ep")
+	created.Tags = endpoint.Tags.Clone()
+	created.ZoneMappings = maps.Clone(endpoint.ZoneMappings)
+	created.Status = "Active"
+	p.vpcEndpoints[created.EndpointId] = &created
+	p.record(ChangeActionCreate, "VPCEndpoint", created.EndpointId, "create endpoint %s for service %s", created.Name, created.ServiceName)
+	return &created, nil
+}
+
+// GetVpcEndpoint returns the planned or existing VPC endpoint with the given id.
+func (p *Planner) GetVpcEndpoint(ctx context.Context, id string) (*VPCEndpoint, error) {
+	return get(ctx, p, p.vpcEndpoints, id, func(ctx context.Context) (*VPCEndpoint, error) {
+		return p.actor.GetVpcEndpoint(ctx, id)
+	})
+}
+
+// FindVpcEndpointsByTags returns the planned or existing VPC endpoints having the given tags.
+func (p *Planner) FindVpcEndpointsByTags(ctx context.Context, tags Tags) ([]*VPCEndpoint, error) {
+	return find(ctx, p, p.vpcEndpoints, false, func(item *VPCEndpoint) string { return item.EndpointId },
+		func(item *VPCEndpoint) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*VPCEndpoint, error) { return p.actor.FindVpcEndpointsByTags(ctx, tags) })
+}
+
+// AddZonesToVpcEndpoint plans adding zones to a VPC endpoint.
+func (p *Planner) AddZonesToVpcEndpoint(_ context.Context, id string, zoneMappings map[string]string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.vpcEndpoints[id]; ok {
+		maps.Copy(item.ZoneMappings, zoneMappings)
+		return nil
+	}
+	p.record(ChangeActionUpdate, "VPCEndpoint", id, "add zones %s", strings.Join(slices.Sorted(maps.Keys(zoneMappings)), ", "))
+	return nil
+}
+
+// DeleteVpcEndpoint plans the deletion of a VPC endpoint.
+func (p *Planner) DeleteVpcEndpoint(ctx context.Context, id string) error {
+	if removePlanned(p, p.vpcEndpoints, id) {
+		return nil
+	}
+	current, err := p.GetVpcEndpoint(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("VPCEndpoint", id, id, "delete endpoint %s for service %s", current.Name, current.ServiceName)
+	return nil
+}
+
+// VPC gateway endpoint
+
+// ListRouteTables returns the route tables of the VPC with the given id. For a planned VPC the system route table,
+// which is created together with the VPC, is returned with a placeholder id.
+func (p *Planner) ListRouteTables(ctx context.Context, vpcId string) ([]*RouteTable, error) {
+	if IsPlannedID(vpcId) {
+		return []*RouteTable{{RouteTableId: vpcId + "-vtb", RouteTableType: "System"}}, nil
+	}
+	return p.actor.ListRouteTables(ctx, vpcId)
+}
+
+// CreateVpcGatewayEndpoint plans the creation of a VPC gateway endpoint.
+func (p *Planner) CreateVpcGatewayEndpoint(_ context.Context, endpoint *VPCGatewayEndpoint) (*VPCGatewayEndpoint, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *endpoint
+	created.EndpointId = p.newID("vpce")
+	created.Tags = endpoint.Tags.Clone()
+	created.RouteTableIds = slices.Clone(endpoint.RouteTableIds)
+	created.Status = "Created"
+	p.gwEndpoints[created.EndpointId] = &created
+	p.record(ChangeActionCreate, "VPCGatewayEndpoint", created.EndpointId, "create gateway endpoint %s for service %s",
+		created.Name, created.ServiceName)
+	return &created, nil
+}
+
+// GetVpcGatewayEndpoint returns the planned or existing VPC gateway endpoint with the given id.
+func (p *Planner) GetVpcGatewayEndpoint(ctx context.Context, id string) (*VPCGatewayEndpoint, error) {
+	return get(ctx, p, p.gwEndpoints, id, func(ctx context.Context) (*VPCGatewayEndpoint, error) {
+		return p.actor.GetVpcGatewayEndpoint(ctx, id)
+	})
+}
+
+// FindVpcGatewayEndpointsByTags returns the planned or existing VPC gateway endpoints having the given tags.
+func (p *Planner) FindVpcGatewayEndpointsByTags(ctx context.Context, tags Tags) ([]*VPCGatewayEndpoint, error) {
+	return find(ctx, p, p.gwEndpoints, false, func(item *VPCGatewayEndpoint) string { return item.EndpointId },
+		func(item *VPCGatewayEndpoint) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*VPCGatewayEndpoint, error) {
+			return p.actor.FindVpcGatewayEndpointsByTags(ctx, tags)
+		})
+}
+
+// AssociateRouteTablesWithVpcGatewayEndpoint plans the association of route tables with a VPC gateway endpoint.
+func (p *Planner) AssociateRouteTablesWithVpcGatewayEndpoint(_ context.Context, id string, routeTableIds []string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.gwEndpoints[id]; ok {
+		item.RouteTableIds = append(item.RouteTableIds, routeTableIds...)
+		return nil
+	}
+	p.record(ChangeActionUpdate, "VPCGatewayEndpoint", id, "associate route tables %s", strings.Join(routeTableIds, ", "))
+	return nil
+}
+
+// DeleteVpcGatewayEndpoint plans the deletion of a VPC gateway endpoint.
+func (p *Planner) DeleteVpcGatewayEndpoint(ctx context.Context, id string) error {
+	if removePlanned(p, p.gwEndpoints, id) {
+		return nil
+	}
+	current, err := p.GetVpcGatewayEndpoint(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("VPCGatewayEndpoint", id, id, "delete gateway endpoint %s for service %s", current.Name, current.ServiceName)
+	return nil
+}
+
+// Private zone
+
+// CreatePrivateZone plans the creation of a private zone.
+func (p *Planner) CreatePrivateZone(_ context.Context, zone *PrivateZone) (*PrivateZone, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	created := *zone
+	created.ZoneId = p.newID("pvtz")
+	created.Tags = zone.Tags.Clone()
+	created.VpcIds = slices.Clone(zone.VpcIds)
+	p.privateZones[created.ZoneId] = &created
+	p.record(ChangeActionCreate, "PrivateZone", created.ZoneId, "create private zone %s bound to VPCs %s",
+		created.Name, strings.Join(created.VpcIds, ", "))
+	return &created, nil
+}
+
+// GetPrivateZone returns the planned or existing private zone with the given id.
+func (p *Planner) GetPrivateZone(ctx context.Context, id string) (*PrivateZone, error) {
+	return get(ctx, p, p.privateZones, id, func(ctx context.Context) (*PrivateZone, error) {
+		return p.actor.GetPrivateZone(ctx, id)
+	})
+}
+
+// FindPrivateZonesByTags returns the planned or existing private zones having the given tags.
+func (p *Planner) FindPrivateZonesByTags(ctx context.Context, tags Tags) ([]*PrivateZone, error) {
+	return find(ctx, p, p.privateZones, false, func(item *PrivateZone) string { return item.ZoneId },
+		func(item *PrivateZone) bool { return hasAllTags(item.Tags, tags) },
+		func(ctx context.Context) ([]*PrivateZone, error) { return p.actor.FindPrivateZonesByTags(ctx, tags) })
+}
+
+// DeletePrivateZone plans the deletion of a private zone.
+func (p *Planner) DeletePrivateZone(ctx context.Context, id string) error {
+	if removePlanned(p, p.privateZones, id) {
+		return nil
+	}
+	current, err := p.GetPrivateZone(ctx, id)
+	if current == nil || err != nil {
+		return err
+	}
+	p.recordDeletion("PrivateZone", id, id, "delete private zone %s", current.Name)
+	return nil
+}
+
+// ListPrivateZoneRecords returns the planned or existing records of the private zone with the given id.
+func (p *Planner) ListPrivateZoneRecords(ctx context.Context, zoneId string) ([]*PrivateZoneRecord, error) {
+	return find(ctx, p, p.zoneRecords, IsPlannedID(zoneId), zoneRecordKey,
+		func(item *PrivateZoneRecord) bool { return item.ZoneId == zoneId },
+		func(ctx context.Context) ([]*PrivateZoneRecord, error) {
+			return p.actor.ListPrivateZoneRecords(ctx, zoneId)
+		})
+}
+
+// CreatePrivateZoneRecord plans the creation of a private zone record. As record ids are numbers, a planned record
+// gets a negative placeholder id.
+func (p *Planner) CreatePrivateZoneRecord(_ context.Context, record *PrivateZoneRecord) (*PrivateZoneRecord, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.counter++
+	created := *record
+	created.RecordId = -int64(p.counter)
+	p.zoneRecords[zoneRecordKey(&created)] = &created
+	p.record(ChangeActionCreate, "PrivateZoneRecord", zoneRecordKey(&created), "create %s record %s -> %s in private zone %s",
+		created.Type, created.Rr, created.Value, created.ZoneId)
+	return &created, nil
+}
+
+// UpdatePrivateZoneRecord plans the modification of a private zone record.
+func (p *Planner) UpdatePrivateZoneRecord(_ context.Context, record *PrivateZoneRecord) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if item, ok := p.zoneRecords[zoneRecordKey(record)]; ok {
+		*item = *record
+		return nil
+	}
+	p.record(ChangeActionUpdate, "PrivateZoneRecord", zoneRecordKey(record), "change %s record %s to %s in private zone %s",
+		record.Type, record.Rr, record.Value, record.ZoneId)
+	return nil
+}
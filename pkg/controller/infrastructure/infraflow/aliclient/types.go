@@ -4,27 +4,34 @@
 
 package aliclient
 
+import (
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+)
+
 // Factory creates instances of Interface.
 type Factory interface {
-	// NewClient creates a new instance of Actor for the given alicloud credentials and region.
-	NewActor(accessKeyID, secretAccessKey, region string) (Actor, error)
+	// NewActor creates a new instance of Actor for the given alicloud credentials and region.
+	NewActor(credentials *alicloud.Credentials, region string) (Actor, error)
 }
 
 // FactoryFunc is a function that implements Factory.
-type FactoryFunc func(accessKeyID, secretAccessKey, region string) (Actor, error)
+type FactoryFunc func(credentials *alicloud.Credentials, region string) (Actor, error)
 
 // NewActor creates a new instance of Actor for the given Alicloud credentials and region.
-func (f FactoryFunc) NewActor(accessKeyID, secretAccessKey, region string) (Actor, error) {
-	return f(accessKeyID, secretAccessKey, region)
+func (f FactoryFunc) NewActor(credentials *alicloud.Credentials, region string) (Actor, error) {
+	return f(credentials, region)
 }
 
 // VPC is the struct for a vpc object
 type VPC struct {
 	Tags
-	Name      string
-	VpcId     string
-	CidrBlock string
-	Status    *string
+	Name            string
+	VpcId           string
+	CidrBlock       string
+	EnableIPv6      bool
+	IPv6CidrBlock   string
+	Status          *string
+	ResourceGroupId string
 }
 
 // VSwitch is the struct for a vswitch object
@@ -34,8 +41,23 @@ type VSwitch struct {
 	VSwitchId string
 	VpcId     *string
 	CidrBlock string
-	ZoneId    string
-	Status    *string
+	// IPv6CidrBlockIndex is the index of the /64 IPv6 CIDR block within the IPv6 CIDR block of the VPC.
+	// It is only used for creating or updating a vswitch.
+	IPv6CidrBlockIndex *int32
+	IPv6CidrBlock      string
+	ZoneId             string
+	Status             *string
+	ResourceGroupId    string
+}
+
+// IPv6Gateway is the struct for an IPv6 gateway object
+type IPv6Gateway struct {
+	Tags
+	Name            string
+	IPv6GatewayId   string
+	VpcId           string
+	Status          *string
+	ResourceGroupId string
 }
 
 // NatGateway is the struct for a nat gateway object
@@ -46,8 +68,11 @@ type NatGateway struct {
 	VpcId              *string
 	VswitchId          *string
 	Status             *string
+	InternetChargeType string
+	Spec               string
 	AvailableVSwitches []string
 	SNATTableIDs       []string
+	ResourceGroupId    string
 }
 
 // EIP is the struct for a eip object
@@ -56,12 +81,15 @@ type EIP struct {
 	Name               string
 	Bandwidth          string
 	InternetChargeType string
+	ISP                string
+	BandwidthPackageId string
 	ZoneId             string
 	Status             *string
 	EipId              string
 	InstanceType       *string
 	InstanceId         *string
 	IpAddress          string
+	ResourceGroupId    string
 }
 
 // SNATEntry is the struct for a snat entry object
@@ -84,6 +112,7 @@ type SecurityGroup struct {
 	SecurityGroupId string
 	Status          *string
 	Rules           []*SecurityGroupRule
+	ResourceGroupId string
 }
 
 // SecurityGroupRule is the struct for a SecurityGroupRule object
@@ -95,5 +124,91 @@ type SecurityGroupRule struct {
 	PortRange           string
 	DestCidrIp          string
 	SourceCidrIp        string
+	Ipv6DestCidrIp      string
+	Ipv6SourceCidrIp    string
 	Direction           string
 }
+
+// CENChildInstance is the struct for a VPC attached to a CEN instance
+type CENChildInstance struct {
+	CenId           string
+	ChildInstanceId string
+	RegionId        string
+	Status          string
+}
+
+// TransitRouterVpcAttachment is the struct for a VPC attachment of a transit router
+type TransitRouterVpcAttachment struct {
+	Tags
+	Name                      string
+	CenId                     string
+	TransitRouterId           string
+	TransitRouterAttachmentId string
+	VpcId                     string
+	// ZoneMappings maps zone ids to the vswitch used by the attachment in this zone.
+	ZoneMappings map[string]string
+	Status       string
+}
+
+// TransitRouterRouteEntry is the struct for a static route entry in a transit router route table
+type TransitRouterRouteEntry struct {
+	Name                      string
+	TransitRouterRouteTableId string
+	TransitRouterRouteEntryId string
+	DestinationCidrBlock      string
+	NextHopId                 string
+	Status                    string
+}
+
+// VPCEndpoint is the struct for an interface endpoint of a PrivateLink endpoint service
+type VPCEndpoint struct {
+	Tags
+	Name             string
+	ServiceName      string
+	EndpointId       string
+	VpcId            string
+	SecurityGroupIds []string
+	// ZoneMappings maps zone ids to the vswitch the endpoint is reachable in.
+	ZoneMappings    map[string]string
+	Domain          string
+	Status          string
+	ResourceGroupId string
+}
+
+// VPCGatewayEndpoint is the struct for a VPC gateway endpoint, which is routed via route tables of its VPC
+type VPCGatewayEndpoint struct {
+	Tags
+	Name            string
+	ServiceName     string
+	EndpointId      string
+	VpcId           string
+	RouteTableIds   []string
+	Status          string
+	ResourceGroupId string
+}
+
+// RouteTable is the struct for a route table of a VPC
+type RouteTable struct {
+	RouteTableId   string
+	RouteTableType string
+	VSwitchIds     []string
+}
+
+// PrivateZone is the struct for a zone of the PrivateZone (private DNS) service
+type PrivateZone struct {
+	Tags
+	Name            string
+	ZoneId          string
+	VpcIds          []string
+	ResourceGroupId string
+}
+
+// PrivateZoneRecord is the struct for a DNS record of a private zone
+type PrivateZoneRecord struct {
+	RecordId int64
+	ZoneId   string
+	Rr       string
+	Type     string
+	Value    string
+	Ttl      int
+}
@@ -7,6 +7,7 @@ package aliclient
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
 )
 
@@ -18,6 +19,7 @@ type Updater interface {
 	UpdateEIP(ctx context.Context, desired, current *EIP) (modified bool, err error)
 	UpdateSNATEntry(ctx context.Context, desired, current *SNATEntry) (modified bool, err error)
 	UpdateSecurityGroup(ctx context.Context, desired, current *SecurityGroup) (modified bool, err error)
+	UpdateIPv6Gateway(ctx context.Context, desired, current *IPv6Gateway) (modified bool, err error)
 }
 
 type updater struct {
@@ -35,6 +37,16 @@ func NewUpdater(actor Actor) Updater {
 
 func (u *updater) UpdateSecurityGroup(ctx context.Context, desired, current *SecurityGroup) (modified bool, err error) {
 	modified, err = u.updateTags(ctx, current.SecurityGroupId, desired.Tags, current.Tags, "securitygroup")
+	if err != nil {
+		return
+	}
+	groupModified, err := u.updateResourceGroup(ctx, current.SecurityGroupId, desired.ResourceGroupId, current.ResourceGroupId, "securitygroup")
+	if err != nil {
+		return
+	}
+	modified = modified || groupModified
+	rulesModified, err := u.updateSecurityGroupRules(ctx, current.SecurityGroupId, desired.Rules, current.Rules)
+	modified = modified || rulesModified
 	return
 }
 
@@ -43,7 +55,23 @@ func (u *updater) UpdateSNATEntry(_ context.Context, _, _ *SNATEntry) (modified
 }
 
 func (u *updater) UpdateEIP(ctx context.Context, desired, current *EIP) (modified bool, err error) {
-	if desired.Bandwidth != current.Bandwidth {
+	if desired.BandwidthPackageId != current.BandwidthPackageId {
+		if current.BandwidthPackageId != "" {
+			err = u.actor.RemoveEIPFromBandwidthPackage(ctx, current.BandwidthPackageId, current.EipId)
+			if err != nil {
+				return
+			}
+		}
+		if desired.BandwidthPackageId != "" {
+			err = u.actor.AddEIPToBandwidthPackage(ctx, desired.BandwidthPackageId, current.EipId)
+			if err != nil {
+				return
+			}
+		}
+		modified = true
+	}
+	// the bandwidth of an EIP in a bandwidth package is determined by the package
+	if desired.BandwidthPackageId == "" && desired.Bandwidth != current.Bandwidth {
 		err = u.actor.ModifyEIP(ctx, current.EipId, desired)
 		if err != nil {
 			return
@@ -54,26 +82,86 @@ func (u *updater) UpdateEIP(ctx context.Context, desired, current *EIP) (modifie
 	if err != nil {
 		return
 	}
-	modified = modified || tagModified
+	groupModified, err := u.updateResourceGroup(ctx, current.EipId, desired.ResourceGroupId, current.ResourceGroupId, "EIP")
+	if err != nil {
+		return
+	}
+	modified = modified || tagModified || groupModified
 
 	return
 }
 
+// UpdateNatgateway updates the specification, tags and resource group of the NAT gateway. The billing method of an
+// existing NAT gateway cannot be changed, hence the desired internet charge type is only applied on creation.
 func (u *updater) UpdateNatgateway(ctx context.Context, desired, current *NatGateway) (modified bool, err error) {
-	modified, err = u.updateTags(ctx, current.NatGatewayId, desired.Tags, current.Tags, "NATGATEWAY")
+	if desired.Spec != "" && desired.Spec != current.Spec {
+		err = u.actor.ModifyNatGatewaySpec(ctx, current.NatGatewayId, desired.Spec)
+		if err != nil {
+			return
+		}
+		modified = true
+	}
+	tagModified, err := u.updateTags(ctx, current.NatGatewayId, desired.Tags, current.Tags, "NATGATEWAY")
+	if err != nil {
+		return
+	}
+	groupModified, err := u.updateResourceGroup(ctx, current.NatGatewayId, desired.ResourceGroupId, current.ResourceGroupId, "NATGATEWAY")
+	if err != nil {
+		return
+	}
+	modified = modified || tagModified || groupModified
 	return
 }
 
 func (u *updater) UpdateVSwitch(ctx context.Context, desired, current *VSwitch) (modified bool, err error) {
-	modified, err = u.updateTags(ctx, current.VSwitchId, desired.Tags, current.Tags, "VSWITCH")
+	if desired.IPv6CidrBlockIndex != nil && current.IPv6CidrBlock == "" {
+		err = u.actor.EnableVSwitchIPv6(ctx, current.VSwitchId, *desired.IPv6CidrBlockIndex)
+		if err != nil {
+			return
+		}
+		modified = true
+	}
+	tagModified, err := u.updateTags(ctx, current.VSwitchId, desired.Tags, current.Tags, "VSWITCH")
+	if err != nil {
+		return
+	}
+	groupModified, err := u.updateResourceGroup(ctx, current.VSwitchId, desired.ResourceGroupId, current.ResourceGroupId, "VSWITCH")
+	if err != nil {
+		return
+	}
+	modified = modified || tagModified || groupModified
 	return
 }
 
 func (u *updater) UpdateVpc(ctx context.Context, desired, current *VPC) (modified bool, err error) {
-	modified, err = u.updateTags(ctx, current.VpcId, desired.Tags, current.Tags, "VPC")
+	if desired.EnableIPv6 && current.IPv6CidrBlock == "" {
+		err = u.actor.EnableVpcIPv6(ctx, current.VpcId)
+		if err != nil {
+			return
+		}
+		modified = true
+	}
+	tagModified, err := u.updateTags(ctx, current.VpcId, desired.Tags, current.Tags, "VPC")
+	if err != nil {
+		return
+	}
+	groupModified, err := u.updateResourceGroup(ctx, current.VpcId, desired.ResourceGroupId, current.ResourceGroupId, "VPC")
+	if err != nil {
+		return
+	}
+	modified = modified || tagModified || groupModified
 	return
 }
 
+func (u *updater) UpdateIPv6Gateway(ctx context.Context, desired, current *IPv6Gateway) (modified bool, err error) {
+	modified, err = u.updateTags(ctx, current.IPv6GatewayId, desired.Tags, current.Tags, "IPV6GATEWAY")
+	if err != nil {
+		return
+	}
+	moved, err := u.updateResourceGroup(ctx, current.IPv6GatewayId, desired.ResourceGroupId, current.ResourceGroupId, "IPV6GATEWAY")
+	return modified || moved, err
+}
+
 func (u *updater) equalJSON(a, b string) (bool, error) {
 	ma := map[string]any{}
 	mb := map[string]any{}
@@ -125,6 +213,59 @@ func (u *updater) updateTags(ctx context.Context, id string, desired, current Ta
 	return modified, nil
 }
 
+// updateResourceGroup moves the resource into the desired resource group if it has drifted out of it. Nothing is done
+// if no resource group is desired.
+func (u *updater) updateResourceGroup(ctx context.Context, id, desired, current, resourceType string) (bool, error) {
+	if desired == "" || desired == current {
+		return false, nil
+	}
+	if err := u.actor.MoveResourceGroup(ctx, id, desired, resourceType); err != nil {
+		return false, fmt.Errorf("failed to move %s %s to resource group %s: %w", resourceType, id, desired, err)
+	}
+	return true, nil
+}
+
+// updateSecurityGroupRules revokes all current rules which are not desired and authorizes the missing desired rules.
+func (u *updater) updateSecurityGroupRules(ctx context.Context, sgId string, desired, current []*SecurityGroupRule) (bool, error) {
+	desiredKeys := map[string]struct{}{}
+	for _, rule := range desired {
+		desiredKeys[securityGroupRuleKey(rule)] = struct{}{}
+	}
+
+	modified := false
+	currentKeys := map[string]struct{}{}
+	for _, rule := range current {
+		key := securityGroupRuleKey(rule)
+		if _, ok := desiredKeys[key]; ok {
+			currentKeys[key] = struct{}{}
+			continue
+		}
+		if err := u.actor.RevokeSecurityGroupRule(ctx, sgId, rule.SecurityGroupRuleId, rule.Direction); err != nil {
+			return modified, err
+		}
+		modified = true
+	}
+
+	for _, rule := range desired {
+		key := securityGroupRuleKey(rule)
+		if _, ok := currentKeys[key]; ok {
+			continue
+		}
+		if err := u.actor.AuthorizeSecurityGroupRule(ctx, sgId, *rule); err != nil {
+			return modified, err
+		}
+		// the same rule cannot be authorized twice
+		currentKeys[key] = struct{}{}
+		modified = true
+	}
+
+	return modified, nil
+}
+
+func securityGroupRuleKey(rule *SecurityGroupRule) string {
+	return rule.Direction + "-" + rule.Policy + "-" + rule.SourceCidrIp + "-" + rule.DestCidrIp + "-" + rule.Ipv6SourceCidrIp + "-" + rule.Ipv6DestCidrIp + "-" + rule.PortRange + "-" + rule.IpProtocol + "-" + rule.Priority
+}
+
 func (u *updater) ignoreTag(_ string) bool {
 	return false
 }
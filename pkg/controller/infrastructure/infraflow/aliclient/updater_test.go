@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aliclient_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+)
+
+var _ = Describe("Updater", func() {
+	var (
+		ctx     context.Context
+		ctrl    *gomock.Controller
+		actor   *mockaliclient.MockActor
+		updater Updater
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+		updater = NewUpdater(actor)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#UpdateNatgateway", func() {
+		var current *NatGateway
+
+		BeforeEach(func() {
+			current = &NatGateway{
+				NatGatewayId:       "ngw-1",
+				VpcId:              ptr.To("vpc-1"),
+				VswitchId:          ptr.To("vsw-1"),
+				InternetChargeType: "PayBySpec",
+				Spec:               "Small",
+				Tags:               Tags{"foo": "bar"},
+				ResourceGroupId:    "rg-1",
+			}
+		})
+
+		It("should do nothing if the NAT gateway is up-to-date", func() {
+			modified, err := updater.UpdateNatgateway(ctx, current, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeFalse())
+		})
+
+		It("should change the spec of the NAT gateway", func() {
+			desired := *current
+			desired.Spec = "Large"
+			actor.EXPECT().ModifyNatGatewaySpec(ctx, "ngw-1", "Large")
+
+			modified, err := updater.UpdateNatgateway(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeTrue())
+		})
+
+		It("should ignore a different internet charge type of an existing NAT gateway", func() {
+			desired := *current
+			desired.InternetChargeType = "PayByLcu"
+			desired.Spec = ""
+
+			modified, err := updater.UpdateNatgateway(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeFalse())
+		})
+
+		It("should update the tags and the resource group", func() {
+			desired := *current
+			desired.Tags = Tags{"foo": "baz"}
+			desired.ResourceGroupId = "rg-2"
+			gomock.InOrder(
+				actor.EXPECT().DeleteTags(ctx, []string{"ngw-1"}, Tags{"foo": "bar"}, "NATGATEWAY"),
+				actor.EXPECT().CreateTags(ctx, []string{"ngw-1"}, Tags{"foo": "baz"}, "NATGATEWAY"),
+				actor.EXPECT().MoveResourceGroup(ctx, "ngw-1", "rg-2", "NATGATEWAY"),
+			)
+
+			modified, err := updater.UpdateNatgateway(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeTrue())
+		})
+	})
+
+	Describe("#UpdateSecurityGroup", func() {
+		var (
+			nodePorts, ssh, egress *SecurityGroupRule
+			current                *SecurityGroup
+		)
+
+		BeforeEach(func() {
+			nodePorts = &SecurityGroupRule{Direction: "ingress", Policy: "Accept", Priority: "1", IpProtocol: "TCP", PortRange: "30000/32767", SourceCidrIp: "0.0.0.0/0"}
+			ssh = &SecurityGroupRule{Direction: "ingress", Policy: "Accept", Priority: "1", IpProtocol: "TCP", PortRange: "22/22", SourceCidrIp: "10.250.0.0/16"}
+			egress = &SecurityGroupRule{Direction: "egress", Policy: "Drop", Priority: "10", IpProtocol: "TCP", PortRange: "25/25", Ipv6DestCidrIp: "::/0"}
+			current = &SecurityGroup{
+				SecurityGroupId: "sg-1",
+				Tags:            Tags{"foo": "bar"},
+				ResourceGroupId: "rg-1",
+				Rules:           []*SecurityGroupRule{withRuleId(nodePorts, "sgr-1"), withRuleId(ssh, "sgr-2")},
+			}
+		})
+
+		It("should do nothing if the rules are up-to-date regardless of their ids", func() {
+			desired := *current
+			desired.Rules = []*SecurityGroupRule{ssh, nodePorts}
+
+			modified, err := updater.UpdateSecurityGroup(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeFalse())
+		})
+
+		It("should authorize a missing rule and leave the existing rules alone", func() {
+			desired := *current
+			desired.Rules = []*SecurityGroupRule{nodePorts, ssh, egress}
+			actor.EXPECT().AuthorizeSecurityGroupRule(ctx, "sg-1", *egress)
+
+			modified, err := updater.UpdateSecurityGroup(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeTrue())
+		})
+
+		It("should revoke a rule which is no longer desired and leave the other rules alone", func() {
+			desired := *current
+			desired.Rules = []*SecurityGroupRule{nodePorts}
+			actor.EXPECT().RevokeSecurityGroupRule(ctx, "sg-1", "sgr-2", "ingress")
+
+			modified, err := updater.UpdateSecurityGroup(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeTrue())
+		})
+
+		It("should replace a rule whose settings have changed by revoking it before authorizing the new one", func() {
+			changed := *ssh
+			changed.Policy = "Drop"
+			desired := *current
+			desired.Rules = []*SecurityGroupRule{nodePorts, &changed}
+			gomock.InOrder(
+				actor.EXPECT().RevokeSecurityGroupRule(ctx, "sg-1", "sgr-2", "ingress"),
+				actor.EXPECT().AuthorizeSecurityGroupRule(ctx, "sg-1", changed),
+			)
+
+			modified, err := updater.UpdateSecurityGroup(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeTrue())
+		})
+
+		It("should authorize a rule which is desired twice only once", func() {
+			desired := *current
+			desired.Rules = []*SecurityGroupRule{nodePorts, ssh, egress, egress}
+			actor.EXPECT().AuthorizeSecurityGroupRule(ctx, "sg-1", *egress)
+
+			modified, err := updater.UpdateSecurityGroup(ctx, &desired, current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(modified).To(BeTrue())
+		})
+
+		It("should stop at the first rule which cannot be revoked", func() {
+			desired := *current
+			desired.Rules = []*SecurityGroupRule{egress}
+			actor.EXPECT().RevokeSecurityGroupRule(ctx, "sg-1", "sgr-1", "ingress").Return(errors.New("fake"))
+
+			_, err := updater.UpdateSecurityGroup(ctx, &desired, current)
+			Expect(err).To(MatchError("fake"))
+		})
+	})
+})
+
+func withRuleId(rule *SecurityGroupRule, id string) *SecurityGroupRule {
+	out := *rule
+	out.SecurityGroupRuleId = id
+	return &out
+}
@@ -11,9 +11,11 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
 )
@@ -28,6 +30,8 @@ const (
 
 	// ChildIdZones is the child key for the zones
 	ChildIdZones = "Zones"
+	// ChildIdVPCEndpoints is the child key for the VPC endpoints, which are keyed by their service name
+	ChildIdVPCEndpoints = "VPCEndpoints"
 
 	// IdentifierVPC is the key for the VPC id
 	IdentifierVPC = "VPC"
@@ -43,6 +47,35 @@ const (
 	ZoneNATGWElasticIPAddress = "NATGatewayElasticIPAddress"
 	// IdentifierNodesSecurityGroup is the key for the id of the nodes security group
 	IdentifierNodesSecurityGroup = "NodesSecurityGroup"
+	// IdentifierIPv6Gateway is the key for the id of the IPv6 gateway
+	IdentifierIPv6Gateway = "IPv6Gateway"
+	// IdentifierCENChildInstance is the key for the id of the CEN instance the VPC is attached to as child instance
+	IdentifierCENChildInstance = "CENChildInstance"
+	// IdentifierTransitRouterAttachment is the key for the id of the transit router VPC attachment
+	IdentifierTransitRouterAttachment = "TransitRouterAttachment"
+	// IdentifierTransitRouterRouteTable is the key for the id of the transit router route table containing the route entry
+	IdentifierTransitRouterRouteTable = "TransitRouterRouteTable"
+	// TransitRouterRouteEntryCIDR is the destination CIDR block of the transit router route entry
+	TransitRouterRouteEntryCIDR = "TransitRouterRouteEntryCIDR"
+	// TransitRouterRouteEntryNextHop is the next hop of the transit router route entry
+	TransitRouterRouteEntryNextHop = "TransitRouterRouteEntryNextHop"
+	// IdentifierVPCEndpoint is the key for the id of a VPC endpoint
+	IdentifierVPCEndpoint = "VPCEndpoint"
+	// VPCEndpointDomain is the domain name of a VPC endpoint
+	VPCEndpointDomain = "VPCEndpointDomain"
+	// IdentifierPrivateZone is the key for the id of the PrivateZone resolving the private DNS name of a VPC endpoint
+	IdentifierPrivateZone = "PrivateZone"
+	// VPCIPv6CIDR is the IPv6 CIDR block of the VPC
+	VPCIPv6CIDR = "VPCIPv6CIDR"
+	// ZoneVSwitchIPv6CIDR is the IPv6 CIDR block of the vswitch
+	ZoneVSwitchIPv6CIDR = "VSwitchIPv6CIDR"
+	// MarkerZoneVSwitchExisting is the key for marking the vswitch of a zone as existing vswitch provided by the user,
+	// which is never deleted
+	MarkerZoneVSwitchExisting = "VSwitchExisting"
+
+	// DefaultResourceGroupID is the key for the default resource group of the seed which has been applied when the
+	// infrastructure was created
+	DefaultResourceGroupID = "DefaultResourceGroupID"
 
 	// IdentifierZoneSuffix is the key for the suffix used for a zone
 	IdentifierZoneSuffix = "Suffix"
@@ -61,16 +94,17 @@ type FlowContext struct {
 	infraSpec  extensionsv1alpha1.InfrastructureSpec
 	config     *aliapi.InfrastructureConfig
 	commonTags aliclient.Tags
+	userTags   aliclient.Tags
 	updater    aliclient.Updater
 	actor      aliclient.Actor
 	cluster    *extensioncontroller.Cluster
 }
 
-// NewFlowContext creates a new FlowContext object
-func NewFlowContext(log logr.Logger, credentials *alicloud.Credentials,
+// NewFlowContext creates a new FlowContext object. The actor for the Alicloud API is created by the given factory.
+func NewFlowContext(log logr.Logger, clientFactory aliclient.Factory, credentials *alicloud.Credentials,
 	infra *extensionsv1alpha1.Infrastructure, config *aliapi.InfrastructureConfig,
 	oldState shared.FlatMap, persistor shared.FlowStatePersistor, cluster *extensioncontroller.Cluster) (*FlowContext, error) {
-	actor, err := aliclient.NewActor(credentials.AccessKeyID, credentials.AccessKeySecret, infra.Spec.Region)
+	actor, err := clientFactory.NewActor(credentials, infra.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +128,11 @@ func NewFlowContext(log logr.Logger, credentials *alicloud.Credentials,
 		flowContext.tagKeyCluster(): TagValueCluster,
 		TagKeyName:                  infra.Namespace,
 	}
+	var shootAnnotations map[string]string
+	if cluster != nil && cluster.Shoot != nil {
+		shootAnnotations = cluster.Shoot.Annotations
+	}
+	flowContext.userTags = helper.UserTags(config, shootAnnotations)
 	if config.Networks.VPC.ID != nil {
 		flowContext.state.SetPtr(IdentifierVPC, config.Networks.VPC.ID)
 	}
@@ -112,12 +151,28 @@ func (c *FlowContext) hasNatGateway() bool {
 	return !c.state.IsAlreadyDeleted(IdentifierNatGateway)
 }
 
+// resourceGroupID returns the ID of the resource group the resources are placed in. It is empty if the resources are
+// left in the default resource group of the account.
+func (c *FlowContext) resourceGroupID() string {
+	return ptr.Deref(c.config.ResourceGroupID, "")
+}
+
 func (c *FlowContext) commonTagsWithSuffix(suffix string) aliclient.Tags {
 	tags := c.commonTags.Clone()
 	tags[TagKeyName] = fmt.Sprintf("%s-%s", c.namespace, suffix)
 	return tags
 }
 
+// withUserTags returns a copy of the given tags extended by the user-defined tags. The user-defined tags are only used
+// for the desired state of the resources, they are never used to find existing resources.
+func (c *FlowContext) withUserTags(tags aliclient.Tags) aliclient.Tags {
+	tags = tags.Clone()
+	for k, v := range c.userTags {
+		tags[k] = v
+	}
+	return tags
+}
+
 func (c *FlowContext) getZoneSuffix(zoneName string) string {
 	zoneChild := c.state.GetChild(ChildIdZones).GetChild(zoneName)
 	if suffix := zoneChild.Get(IdentifierZoneSuffix); suffix != nil {
@@ -30,17 +30,29 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 	deleteVPC := c.config.Networks.VPC.ID == nil
 	g := flow.NewGraph("Alicloud infrastructure destruction")
 
+	detachCEN := c.AddTask(g, "delete CEN attachment",
+		c.detachCEN,
+		Timeout(defaultLongTimeout))
+
+	deleteVPCEndpoints := c.AddTask(g, "delete VPC endpoints",
+		c.deleteVPCEndpoints,
+		Timeout(defaultLongTimeout))
+
 	deleteZones := c.AddTask(g, "delete vswitch",
 		c.deleteZones,
-		Timeout(defaultTimeout))
+		Timeout(defaultTimeout), Dependencies(detachCEN, deleteVPCEndpoints))
 
 	deleteSecurityGroup := c.AddTask(g, "delete security group",
 		c.deleteSecurityGroup,
-		Timeout(defaultTimeout))
+		Timeout(defaultTimeout), Dependencies(deleteVPCEndpoints))
+
+	deleteIPv6Gateway := c.AddTask(g, "delete IPv6 gateway",
+		c.deleteIPv6Gateway,
+		DoIf(c.config.Networks.IPv6 != nil || c.state.Get(IdentifierIPv6Gateway) != nil), Timeout(defaultLongTimeout), Dependencies(deleteZones))
 
 	_ = c.AddTask(g, "delete VPC",
 		c.deleteVpc,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones, deleteSecurityGroup))
+		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(detachCEN, deleteZones, deleteSecurityGroup, deleteIPv6Gateway))
 
 	return g
 }
@@ -70,6 +82,26 @@ func (c *FlowContext) deleteSecurityGroup(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) deleteIPv6Gateway(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierIPv6Gateway) {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	// only the IPv6 gateway created by the flow is deleted, it is found by its tags
+	gws, err := c.actor.FindIPv6GatewaysByTags(ctx, c.commonTagsWithSuffix("ipv6gw"))
+	if err != nil {
+		return err
+	}
+	for _, gw := range gws {
+		log.Info("deleting IPv6 gateway ...", "Ipv6GatewayId", gw.IPv6GatewayId)
+		if err := c.actor.DeleteIPv6Gateway(ctx, gw.IPv6GatewayId); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(IdentifierIPv6Gateway)
+	return nil
+}
+
 func (c *FlowContext) deleteVpc(ctx context.Context) error {
 	if c.state.IsAlreadyDeleted(IdentifierVPC) {
 		return nil
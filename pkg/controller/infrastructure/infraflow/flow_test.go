@@ -0,0 +1,491 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/fake"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	namespace = "shoot--foo--bar"
+	region    = "eu-central-1"
+	zone      = "eu-central-1a"
+)
+
+var _ = Describe("FlowContext", func() {
+	var (
+		ctx         context.Context
+		backend     *fake.Backend
+		credentials *alicloud.Credentials
+		infra       *extensionsv1alpha1.Infrastructure
+		config      *aliapi.InfrastructureConfig
+		cluster     *extensionscontroller.Cluster
+		state       shared.FlatMap
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		backend = fake.NewBackend(region)
+		backend.PendingReads = 1
+		credentials = &alicloud.Credentials{AccessKeyID: "ak", AccessKeySecret: "sk"}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: region},
+		}
+		config = &aliapi.InfrastructureConfig{
+			Networks: aliapi.Networks{
+				VPC:   aliapi.VPC{CIDR: ptr.To("10.250.0.0/16")},
+				Zones: []aliapi.Zone{{Name: zone, Workers: "10.250.0.0/19"}},
+			},
+		}
+		cluster = nil
+		state = nil
+	})
+
+	newFlowContext := func() *infraflow.FlowContext {
+		persistor := func(_ context.Context, flatMap shared.FlatMap) error {
+			state = flatMap
+			return nil
+		}
+		flowContext, err := infraflow.NewFlowContext(logr.Discard(), fake.NewFactory(backend), credentials, infra, config, state, persistor, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		return flowContext
+	}
+
+	newPlanningFlowContext := func() (*infraflow.FlowContext, func() []aliclient.Change) {
+		var planner *aliclient.Planner
+		clientFactory := aliclient.NewPlannerFactory(fake.NewFactory(backend), func(created *aliclient.Planner) {
+			planner = created
+		})
+		persistor := func(_ context.Context, _ shared.FlatMap) error {
+			return nil
+		}
+		flowContext, err := infraflow.NewFlowContext(logr.Discard(), clientFactory, credentials, infra, config, state, persistor, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return flowContext, func() []aliclient.Change { return planner.Changes() }
+	}
+
+	It("should create the infrastructure and delete it again", func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+		Expect(backend.VPCs()).To(ConsistOf(HaveField("CidrBlock", "10.250.0.0/16")))
+		Expect(backend.VSwitches()).To(ConsistOf(HaveField("CidrBlock", "10.250.0.0/19")))
+		Expect(backend.NatGateways()).To(HaveLen(1))
+		Expect(backend.EIPs()).To(ConsistOf(HaveField("InstanceId", HaveValue(Equal(backend.NatGateways()[0].NatGatewayId)))))
+		Expect(backend.SNATEntries()).To(HaveLen(1))
+		Expect(backend.SecurityGroups()).To(HaveLen(1))
+		Expect(state).To(HaveKeyWithValue(infraflow.IdentifierVPC, backend.VPCs()[0].VpcId))
+
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(backend.IsEmpty()).To(BeTrue())
+	})
+
+	It("should not look up IPv6 gateways when deleting an infrastructure without IPv6", func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+
+		Expect(backend.Calls("DescribeIpv6Gateways")).To(BeZero())
+		Expect(backend.IsEmpty()).To(BeTrue())
+	})
+
+	It("should create the IPv6 gateway of a dual-stack infrastructure and delete it again", func() {
+		config.Networks.IPv6 = &aliapi.IPv6{}
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(backend.IPv6Gateways()).To(HaveLen(1))
+
+		Expect(newFlowContext().Delete(ctx)).To(Succeed())
+		Expect(backend.IsEmpty()).To(BeTrue())
+	})
+
+	It("should not create resources twice", func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		state = nil
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(backend.VPCs()).To(HaveLen(1))
+		Expect(backend.VSwitches()).To(HaveLen(1))
+		Expect(backend.NatGateways()).To(HaveLen(1))
+		Expect(backend.EIPs()).To(HaveLen(1))
+		Expect(backend.SecurityGroups()).To(HaveLen(1))
+	})
+
+	It("should complete the infrastructure after a failed reconciliation", func() {
+		backend.InjectFault("CreateNatGateway", fake.ErrOutOfStock, 1)
+
+		Expect(newFlowContext().Reconcile(ctx)).To(MatchError(ContainSubstring("OperationDenied.NoStock")))
+		Expect(backend.NatGateways()).To(BeEmpty())
+
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(backend.NatGateways()).To(HaveLen(1))
+		Expect(backend.SNATEntries()).To(HaveLen(1))
+	})
+
+	It("should not fail for an existing NAT gateway with a different billing method", func() {
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(backend.NatGateways()).To(ConsistOf(HaveField("InternetChargeType", "PayByLcu")))
+
+		config.Networks.VPC.NatGateway = &aliapi.ManagedNatGatewayConfig{InternetChargeType: ptr.To("PayBySpec")}
+		Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+		Expect(backend.NatGateways()).To(ConsistOf(HaveField("InternetChargeType", "PayByLcu")))
+	})
+
+	Describe("existing vswitches", func() {
+		var vpc *aliclient.VPC
+		var vsw *aliclient.VSwitch
+
+		BeforeEach(func() {
+			actor := fake.NewActor(backend)
+			var err error
+			vpc, err = actor.CreateVpc(ctx, &aliclient.VPC{Name: "user-vpc", CidrBlock: "10.250.0.0/16"})
+			Expect(err).NotTo(HaveOccurred())
+			vsw, err = actor.CreateVSwitch(ctx, &aliclient.VSwitch{Name: "user-vsw", VpcId: ptr.To(vpc.VpcId), ZoneId: zone, CidrBlock: "10.250.0.0/19"})
+			Expect(err).NotTo(HaveOccurred())
+
+			config.Networks.VPC = aliapi.VPC{ID: ptr.To(vpc.VpcId), GardenerManagedNATGateway: ptr.To(true)}
+			config.Networks.Zones = []aliapi.Zone{{Name: zone, WorkersVSwitchID: ptr.To(vsw.VSwitchId)}}
+		})
+
+		It("should not delete an existing vswitch which is no longer configured", func() {
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.VSwitches()).To(ConsistOf(HaveField("VSwitchId", vsw.VSwitchId)))
+
+			config.Networks.Zones = []aliapi.Zone{{Name: zone, Workers: "10.250.32.0/19"}}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.VSwitches()).To(ConsistOf(
+				HaveField("VSwitchId", vsw.VSwitchId),
+				HaveField("CidrBlock", "10.250.32.0/19"),
+			))
+
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(backend.VSwitches()).To(ConsistOf(HaveField("VSwitchId", vsw.VSwitchId)))
+		})
+
+		It("should not delete an existing vswitch if the configuration is changed before the deletion", func() {
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+			config.Networks.Zones = []aliapi.Zone{{Name: zone, Workers: "10.250.0.0/19"}}
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(backend.VSwitches()).To(ConsistOf(HaveField("VSwitchId", vsw.VSwitchId)))
+		})
+	})
+
+	Describe("security group rules", func() {
+		// rulesWithoutIds returns the rules of the security group without their ids, which are assigned by Alicloud.
+		rulesWithoutIds := func() []aliclient.SecurityGroupRule {
+			Expect(backend.SecurityGroups()).To(HaveLen(1))
+			var rules []aliclient.SecurityGroupRule
+			for _, rule := range backend.SecurityGroups()[0].Rules {
+				r := *rule
+				r.SecurityGroupRuleId = ""
+				rules = append(rules, r)
+			}
+			return rules
+		}
+
+		ruleIds := func() map[string]string {
+			ids := map[string]string{}
+			for _, rule := range backend.SecurityGroups()[0].Rules {
+				ids[rule.Direction+" "+rule.IpProtocol+" "+rule.PortRange+" "+rule.SourceCidrIp] = rule.SecurityGroupRuleId
+			}
+			return ids
+		}
+
+		It("should convert the configured rules for both directions and IP families", func() {
+			config.Networks.SecurityGroupRules = []aliapi.SecurityGroupRule{
+				{Direction: aliapi.SecurityGroupRuleDirectionIngress, Protocol: "TCP", PortRange: "443/443", CIDR: "203.0.113.0/24"},
+				{Direction: aliapi.SecurityGroupRuleDirectionIngress, Protocol: "TCP", PortRange: "443/443", CIDR: "2001:db8::/32"},
+				{Direction: aliapi.SecurityGroupRuleDirectionEgress, Protocol: "TCP", PortRange: "25/25", CIDR: "0.0.0.0/0",
+					Policy: ptr.To(aliapi.SecurityGroupRulePolicyDrop), Priority: ptr.To(int32(10))},
+				{Direction: aliapi.SecurityGroupRuleDirectionEgress, Protocol: "UDP", PortRange: "53/53", CIDR: "::/0"},
+			}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+			Expect(rulesWithoutIds()).To(ContainElements(
+				aliclient.SecurityGroupRule{Direction: "ingress", Policy: "Accept", Priority: "1", IpProtocol: "TCP", PortRange: "443/443", SourceCidrIp: "203.0.113.0/24"},
+				aliclient.SecurityGroupRule{Direction: "ingress", Policy: "Accept", Priority: "1", IpProtocol: "TCP", PortRange: "443/443", Ipv6SourceCidrIp: "2001:db8::/32"},
+				aliclient.SecurityGroupRule{Direction: "egress", Policy: "Drop", Priority: "10", IpProtocol: "TCP", PortRange: "25/25", DestCidrIp: "0.0.0.0/0"},
+				aliclient.SecurityGroupRule{Direction: "egress", Policy: "Accept", Priority: "1", IpProtocol: "UDP", PortRange: "53/53", Ipv6DestCidrIp: "::/0"},
+			))
+		})
+
+		It("should open the NodePort range for IPv6 only if allowed", func() {
+			nodePortIPv6 := aliclient.SecurityGroupRule{Direction: "ingress", Policy: "Accept", Priority: "1", IpProtocol: "TCP", PortRange: "30000/32767", Ipv6SourceCidrIp: "::/0"}
+			config.Networks.IPv6 = &aliapi.IPv6{}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(rulesWithoutIds()).NotTo(ContainElement(nodePortIPv6))
+
+			config.Networks.IPv6.AllowNodePortIPv6 = ptr.To(true)
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(rulesWithoutIds()).To(ContainElement(nodePortIPv6))
+		})
+
+		It("should add and revoke configured rules and leave the other rules alone", func() {
+			config.Networks.SecurityGroupRules = []aliapi.SecurityGroupRule{
+				{Direction: aliapi.SecurityGroupRuleDirectionIngress, Protocol: "TCP", PortRange: "443/443", CIDR: "203.0.113.0/24"},
+			}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			before := ruleIds()
+			Expect(before).To(HaveKey("ingress TCP 443/443 203.0.113.0/24"))
+
+			config.Networks.SecurityGroupRules = []aliapi.SecurityGroupRule{
+				{Direction: aliapi.SecurityGroupRuleDirectionIngress, Protocol: "TCP", PortRange: "8443/8443", CIDR: "203.0.113.0/24"},
+			}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			after := ruleIds()
+			Expect(after).NotTo(HaveKey("ingress TCP 443/443 203.0.113.0/24"))
+			Expect(after).To(HaveKey("ingress TCP 8443/8443 203.0.113.0/24"))
+			delete(before, "ingress TCP 443/443 203.0.113.0/24")
+			delete(after, "ingress TCP 8443/8443 203.0.113.0/24")
+			Expect(after).To(Equal(before))
+		})
+	})
+
+	Describe("CEN", func() {
+		BeforeEach(func() {
+			config.Networks.CEN = &aliapi.CEN{ID: "cen-1", TransitRouter: &aliapi.TransitRouter{ID: "tr-1", RouteTableID: "vtb-tr-1"}}
+			cluster = &extensionscontroller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Networking: &gardencorev1beta1.Networking{
+							Nodes:    ptr.To("10.250.0.0/16"),
+							Pods:     ptr.To("100.96.0.0/11"),
+							Services: ptr.To("100.64.0.0/13"),
+						},
+					},
+				},
+			}
+		})
+
+		It("should route the nodes CIDR to the transit router attachment and delete the route entry again", func() {
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.TransitRouterVpcAttachments()).To(HaveLen(1))
+			Expect(backend.TransitRouterRouteEntries()).To(ConsistOf(And(
+				HaveField("DestinationCidrBlock", "10.250.0.0/16"),
+				HaveField("NextHopId", backend.TransitRouterVpcAttachments()[0].TransitRouterAttachmentId),
+			)))
+
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(backend.IsEmpty()).To(BeTrue())
+		})
+
+		It("should add zones configured later to the transit router attachment", func() {
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			attachmentId := backend.TransitRouterVpcAttachments()[0].TransitRouterAttachmentId
+
+			config.Networks.Zones = append(config.Networks.Zones, aliapi.Zone{Name: region + "b", Workers: "10.250.32.0/19"})
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.TransitRouterVpcAttachments()).To(ConsistOf(And(
+				HaveField("TransitRouterAttachmentId", attachmentId),
+				HaveField("ZoneMappings", HaveKey(region+"b")),
+				HaveField("ZoneMappings", HaveLen(2)),
+			)))
+
+			// a second reconciliation does not modify the attachment
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.Calls("UpdateTransitRouterVpcAttachmentZones")).To(Equal(1))
+		})
+
+		It("should not replace the route entry of another shoot with the same nodes CIDR", func() {
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			routeEntryId := backend.TransitRouterRouteEntries()[0].TransitRouterRouteEntryId
+
+			infra.Namespace = "shoot--foo--other"
+			state = nil
+			Expect(newFlowContext().Reconcile(ctx)).To(MatchError(ContainSubstring("conflicting transit router route entry " + routeEntryId)))
+			Expect(backend.TransitRouterRouteEntries()).To(ConsistOf(HaveField("TransitRouterRouteEntryId", routeEntryId)))
+		})
+	})
+
+	Describe("VPC endpoints", func() {
+		const (
+			interfaceService = "com.aliyuncs.privatelink." + region + ".kms"
+			gatewayService   = "com.aliyun." + region + ".oss"
+		)
+
+		endpointKey := func(serviceName, key string) string {
+			return infraflow.ChildIdVPCEndpoints + shared.Separator + serviceName + shared.Separator + key
+		}
+
+		It("should create a gateway endpoint associated with the route table of the vswitches and delete it again", func() {
+			config.Networks.VPCEndpoints = []aliapi.VPCEndpoint{{Type: ptr.To(aliapi.VPCEndpointTypeGateway), ServiceName: gatewayService}}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+			Expect(backend.VPCEndpoints()).To(BeEmpty())
+			Expect(backend.VPCGatewayEndpoints()).To(ConsistOf(And(
+				HaveField("ServiceName", gatewayService),
+				HaveField("VpcId", backend.VPCs()[0].VpcId),
+				HaveField("RouteTableIds", HaveLen(1)),
+				HaveField("Status", "Created"),
+			)))
+			Expect(state).To(HaveKeyWithValue(endpointKey(gatewayService, infraflow.IdentifierVPCEndpoint), backend.VPCGatewayEndpoints()[0].EndpointId))
+
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(backend.IsEmpty()).To(BeTrue())
+		})
+
+		It("should delete a gateway endpoint which is no longer configured", func() {
+			config.Networks.VPCEndpoints = []aliapi.VPCEndpoint{{Type: ptr.To(aliapi.VPCEndpointTypeGateway), ServiceName: gatewayService}}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+			config.Networks.VPCEndpoints = nil
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.VPCGatewayEndpoints()).To(BeEmpty())
+			Expect(state).NotTo(HaveKey(endpointKey(gatewayService, infraflow.IdentifierVPCEndpoint)))
+		})
+
+		It("should resolve the private DNS name of an interface endpoint with a PrivateZone and delete it again", func() {
+			config.Networks.VPCEndpoints = []aliapi.VPCEndpoint{{ServiceName: interfaceService, PrivateDNSName: ptr.To("kms." + region + ".aliyuncs.com")}}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+			Expect(backend.VPCEndpoints()).To(HaveLen(1))
+			endpoint := backend.VPCEndpoints()[0]
+			Expect(backend.PrivateZones()).To(ConsistOf(And(
+				HaveField("Name", "kms."+region+".aliyuncs.com"),
+				HaveField("VpcIds", ConsistOf(backend.VPCs()[0].VpcId)),
+			)))
+			zoneId := backend.PrivateZones()[0].ZoneId
+			Expect(backend.PrivateZoneRecords(zoneId)).To(ConsistOf(And(
+				HaveField("Rr", "@"),
+				HaveField("Type", "CNAME"),
+				HaveField("Value", endpoint.Domain),
+			)))
+			Expect(state).To(HaveKeyWithValue(endpointKey(interfaceService, infraflow.IdentifierPrivateZone), zoneId))
+
+			// a second reconciliation neither creates nor modifies the record
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.Calls("AddZoneRecord")).To(Equal(1))
+			Expect(backend.Calls("UpdateZoneRecord")).To(BeZero())
+
+			Expect(newFlowContext().Delete(ctx)).To(Succeed())
+			Expect(backend.IsEmpty()).To(BeTrue())
+		})
+
+		It("should replace the PrivateZone if the private DNS name changes and delete it if it is removed", func() {
+			config.Networks.VPCEndpoints = []aliapi.VPCEndpoint{{ServiceName: interfaceService, PrivateDNSName: ptr.To("kms." + region + ".aliyuncs.com")}}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+
+			config.Networks.VPCEndpoints[0].PrivateDNSName = ptr.To("kms-vpc." + region + ".aliyuncs.com")
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.PrivateZones()).To(ConsistOf(HaveField("Name", "kms-vpc."+region+".aliyuncs.com")))
+			Expect(backend.PrivateZoneRecords(backend.PrivateZones()[0].ZoneId)).To(HaveLen(1))
+
+			config.Networks.VPCEndpoints[0].PrivateDNSName = nil
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.PrivateZones()).To(BeEmpty())
+			Expect(backend.VPCEndpoints()).To(HaveLen(1))
+			Expect(state).NotTo(HaveKey(endpointKey(interfaceService, infraflow.IdentifierPrivateZone)))
+		})
+
+		It("should add zones configured later to an interface endpoint", func() {
+			config.Networks.VPCEndpoints = []aliapi.VPCEndpoint{{ServiceName: interfaceService}}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			endpointId := backend.VPCEndpoints()[0].EndpointId
+
+			config.Networks.Zones = append(config.Networks.Zones, aliapi.Zone{Name: region + "b", Workers: "10.250.32.0/19"})
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.VPCEndpoints()).To(ConsistOf(And(
+				HaveField("EndpointId", endpointId),
+				HaveField("ZoneMappings", HaveKey(region+"b")),
+				HaveField("ZoneMappings", HaveLen(2)),
+			)))
+
+			// a second reconciliation does not modify the endpoint
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			Expect(backend.Calls("AddZoneToVpcEndpoint")).To(Equal(1))
+		})
+
+		It("should plan adding zones to an existing interface endpoint", func() {
+			config.Networks.VPCEndpoints = []aliapi.VPCEndpoint{{ServiceName: interfaceService}}
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			endpointId := backend.VPCEndpoints()[0].EndpointId
+
+			config.Networks.Zones = append(config.Networks.Zones, aliapi.Zone{Name: region + "b", Workers: "10.250.32.0/19"})
+			flowContext, changes := newPlanningFlowContext()
+			Expect(flowContext.Reconcile(ctx)).To(Succeed())
+			Expect(changes()).To(ContainElement(And(
+				HaveField("Action", aliclient.ChangeActionUpdate),
+				HaveField("ResourceType", "VPCEndpoint"),
+				HaveField("ID", endpointId),
+			)))
+			Expect(backend.VPCEndpoints()[0].ZoneMappings).To(HaveLen(1))
+		})
+
+		It("should plan the creation of the endpoints and the PrivateZone", func() {
+			config.Networks.VPCEndpoints = []aliapi.VPCEndpoint{
+				{ServiceName: interfaceService, PrivateDNSName: ptr.To("kms." + region + ".aliyuncs.com")},
+				{Type: ptr.To(aliapi.VPCEndpointTypeGateway), ServiceName: gatewayService},
+			}
+			flowContext, changes := newPlanningFlowContext()
+			Expect(flowContext.Reconcile(ctx)).To(Succeed())
+
+			Expect(backend.IsEmpty()).To(BeTrue())
+			var resourceTypes []string
+			for _, change := range changes() {
+				resourceTypes = append(resourceTypes, change.ResourceType)
+			}
+			Expect(resourceTypes).To(ContainElements("VPCEndpoint", "PrivateZone", "PrivateZoneRecord", "VPCGatewayEndpoint"))
+		})
+	})
+
+	Describe("plan mode", func() {
+		It("should plan the creation of the infrastructure without creating it", func() {
+			flowContext, changes := newPlanningFlowContext()
+			Expect(flowContext.Reconcile(ctx)).To(Succeed())
+
+			Expect(backend.IsEmpty()).To(BeTrue())
+			var resourceTypes []string
+			for _, change := range changes() {
+				Expect(change.Action).To(Equal(aliclient.ChangeActionCreate))
+				Expect(aliclient.IsPlannedID(change.ID)).To(BeTrue())
+				resourceTypes = append(resourceTypes, change.ResourceType)
+			}
+			Expect(resourceTypes).To(ConsistOf("VPC", "SecurityGroup", "VSwitch", "NatGateway", "EIP", "SNATEntry"))
+			Expect(state).To(BeNil())
+		})
+
+		It("should not plan any changes for an up-to-date infrastructure", func() {
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			calls := backend.Calls("CreateVpc")
+
+			flowContext, changes := newPlanningFlowContext()
+			Expect(flowContext.Reconcile(ctx)).To(Succeed())
+			Expect(changes()).To(BeEmpty())
+			Expect(backend.Calls("CreateVpc")).To(Equal(calls))
+		})
+
+		It("should plan the changes of a modified configuration", func() {
+			Expect(newFlowContext().Reconcile(ctx)).To(Succeed())
+			eip := backend.EIPs()[0]
+
+			config.Networks.Zones[0].NatGateway = &aliapi.NatGatewayConfig{EIPBandwidth: ptr.To(int32(200))}
+			config.Tags = map[string]string{"CostCenter": "4711"}
+			flowContext, changes := newPlanningFlowContext()
+			Expect(flowContext.Reconcile(ctx)).To(Succeed())
+
+			Expect(changes()).To(ContainElements(
+				aliclient.Change{Action: aliclient.ChangeActionUpdate, ResourceType: "EIP", ID: eip.EipId, Description: "change bandwidth to 200"},
+				aliclient.Change{Action: aliclient.ChangeActionUpdate, ResourceType: "VPC", ID: backend.VPCs()[0].VpcId, Description: "add tags CostCenter=4711"},
+			))
+			Expect(backend.EIPs()[0].Bandwidth).To(Equal(eip.Bandwidth))
+			Expect(backend.VPCs()[0].Tags).NotTo(HaveKey("CostCenter"))
+		})
+	})
+})
@@ -7,10 +7,14 @@ package infraflow
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
+	netutils "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
 )
@@ -37,10 +41,14 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureVpc,
 		Timeout(defaultTimeout))
 
-	_ = c.AddTask(g, "ensure SecurityGroup",
+	ensureSecurityGroup := c.AddTask(g, "ensure SecurityGroup",
 		c.ensureSecurityGroup,
 		Timeout(defaultLongTimeout), Dependencies(ensureVpc))
 
+	_ = c.AddTask(g, "ensure IPv6 gateway",
+		c.ensureIPv6Gateway,
+		DoIf(c.config.Networks.IPv6 != nil), Timeout(defaultLongTimeout), Dependencies(ensureVpc))
+
 	ensureVSwitches := c.AddTask(g, "ensure vswitch",
 		c.ensureVSwitches,
 		Timeout(defaultLongTimeout), Dependencies(ensureVpc))
@@ -53,6 +61,14 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureZones,
 		Timeout(defaultTimeout), Dependencies(ensureNatGateway))
 
+	_ = c.AddTask(g, "ensure CEN attachment",
+		c.ensureCENAttachment,
+		Timeout(defaultLongTimeout), Dependencies(ensureVSwitches))
+
+	_ = c.AddTask(g, "ensure VPC endpoints",
+		c.ensureVPCEndpoints,
+		Timeout(defaultLongTimeout), Dependencies(ensureSecurityGroup, ensureVSwitches))
+
 	return g
 }
 
@@ -71,19 +87,13 @@ func (c *FlowContext) ensureSecurityGroup(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	groupName := fmt.Sprintf("%s-sg", c.namespace)
 	desired := &aliclient.SecurityGroup{
-		Tags:        c.commonTagsWithSuffix("sg"),
+		Tags:        c.withUserTags(c.commonTagsWithSuffix("sg")),
 		Name:        groupName,
 		VpcId:       vpc.VpcId,
 		Description: fmt.Sprintf("Security group for %s", c.namespace),
+
+		ResourceGroupId: c.resourceGroupID(),
 		Rules: []*aliclient.SecurityGroupRule{
-			{
-				Direction:    "ingress",
-				Policy:       "Accept",
-				Priority:     "1",
-				IpProtocol:   "TCP",
-				PortRange:    "30000/32767",
-				SourceCidrIp: "0.0.0.0/0",
-			},
 			{
 				Direction:    "ingress",
 				Policy:       "Accept",
@@ -145,6 +155,49 @@ func (c *FlowContext) ensureSecurityGroup(ctx context.Context) error {
 			SourceCidrIp: *c.cluster.Shoot.Spec.Networking.Pods,
 		})
 	}
+
+	nodePortSourceCIDRs := c.config.Networks.NodePortSourceCIDRs
+	if len(nodePortSourceCIDRs) == 0 {
+		nodePortSourceCIDRs = []string{"0.0.0.0/0"}
+	}
+	if ipv6 := c.config.Networks.IPv6; ipv6 != nil {
+		if vpc.IPv6CidrBlock == "" {
+			return fmt.Errorf("IPv6 is not enabled for VPC %s", vpc.VpcId)
+		}
+		// IPv6 traffic within the VPC is always allowed.
+		desired.Rules = append(desired.Rules, &aliclient.SecurityGroupRule{
+			Direction:        "ingress",
+			Policy:           "Accept",
+			Priority:         "1",
+			IpProtocol:       "ALL",
+			PortRange:        "-1/-1",
+			Ipv6SourceCidrIp: vpc.IPv6CidrBlock,
+		})
+		// Node ports are only reachable via IPv6 from outside the VPC if explicitly allowed.
+		if ptr.Deref(ipv6.AllowNodePortIPv6, false) && len(c.config.Networks.NodePortSourceCIDRs) == 0 {
+			nodePortSourceCIDRs = append(nodePortSourceCIDRs, "::/0")
+		}
+	}
+	for _, cidr := range nodePortSourceCIDRs {
+		rule := &aliclient.SecurityGroupRule{
+			Direction:  "ingress",
+			Policy:     "Accept",
+			Priority:   "1",
+			IpProtocol: "TCP",
+			PortRange:  "30000/32767",
+		}
+		if netutils.IsIPv6CIDRString(cidr) {
+			rule.Ipv6SourceCidrIp = cidr
+		} else {
+			rule.SourceCidrIp = cidr
+		}
+		desired.Rules = append(desired.Rules, rule)
+	}
+
+	for _, rule := range c.config.Networks.SecurityGroupRules {
+		desired.Rules = append(desired.Rules, toSecurityGroupRule(rule))
+	}
+
 	current, err := findExisting(ctx, c.state.Get(IdentifierNodesSecurityGroup), c.commonTagsWithSuffix("sg"),
 		c.actor.GetSecurityGroup, c.actor.FindSecurityGroupsByTags)
 	if err != nil {
@@ -164,20 +217,74 @@ func (c *FlowContext) ensureSecurityGroup(ctx context.Context) error {
 	if _, err := c.updater.UpdateSecurityGroup(ctx, desired, current); err != nil {
 		return err
 	}
-	toBeDeleted, toBeCreated, _ := diffByID(desired.Rules, current.Rules, func(item *aliclient.SecurityGroupRule) string {
-		return item.Direction + "-" + item.Policy + "-" + item.SourceCidrIp + "-" + item.DestCidrIp + "-" + item.PortRange + "-" + item.IpProtocol + "-" + item.Priority
-	})
-	for _, rule := range toBeDeleted {
-		if err := c.actor.RevokeSecurityGroupRule(ctx, current.SecurityGroupId, rule.SecurityGroupRuleId, rule.Direction); err != nil {
+	return c.PersistState(ctx, true)
+}
+
+// toSecurityGroupRule converts a rule of the infrastructure config into a security group rule.
+func toSecurityGroupRule(rule aliapi.SecurityGroupRule) *aliclient.SecurityGroupRule {
+	sgRule := &aliclient.SecurityGroupRule{
+		Direction:  string(rule.Direction),
+		Policy:     string(ptr.Deref(rule.Policy, aliapi.SecurityGroupRulePolicyAccept)),
+		Priority:   strconv.Itoa(int(ptr.Deref(rule.Priority, 1))),
+		IpProtocol: rule.Protocol,
+		PortRange:  rule.PortRange,
+	}
+	ipv6 := netutils.IsIPv6CIDRString(rule.CIDR)
+	switch {
+	case rule.Direction == aliapi.SecurityGroupRuleDirectionEgress && ipv6:
+		sgRule.Ipv6DestCidrIp = rule.CIDR
+	case rule.Direction == aliapi.SecurityGroupRuleDirectionEgress:
+		sgRule.DestCidrIp = rule.CIDR
+	case ipv6:
+		sgRule.Ipv6SourceCidrIp = rule.CIDR
+	default:
+		sgRule.SourceCidrIp = rule.CIDR
+	}
+	return sgRule
+}
+
+func (c *FlowContext) ensureIPv6Gateway(ctx context.Context) error {
+	vpcId := c.state.Get(IdentifierVPC)
+	if vpcId == nil {
+		return fmt.Errorf("IdentifierVPC is nil")
+	}
+	log := c.LogFromContext(ctx)
+	desired := &aliclient.IPv6Gateway{
+		Tags:            c.withUserTags(c.commonTagsWithSuffix("ipv6gw")),
+		Name:            c.namespace + "-ipv6gw",
+		VpcId:           *vpcId,
+		ResourceGroupId: c.resourceGroupID(),
+	}
+
+	current, err := findExisting(ctx, c.state.Get(IdentifierIPv6Gateway), c.commonTagsWithSuffix("ipv6gw"),
+		c.actor.GetIPv6Gateway, c.actor.FindIPv6GatewaysByTags)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		// a VPC can only have a single IPv6 gateway, which may already be provided with an existing VPC
+		current, err = c.actor.FindIPv6GatewayByVPC(ctx, *vpcId)
+		if err != nil {
 			return err
 		}
 	}
-
-	for _, rule := range toBeCreated {
-		if err := c.actor.AuthorizeSecurityGroupRule(ctx, current.SecurityGroupId, *rule); err != nil {
+	if current == nil {
+		log.Info("creating IPv6 gateway ...")
+		current, err = c.actor.CreateIPv6Gateway(ctx, desired)
+		if err != nil {
+			return fmt.Errorf("create IPv6 gateway failed %w", err)
+		}
+		if current == nil {
+			return fmt.Errorf("failed to create IPv6 gateway")
+		}
+	}
+	// an IPv6 gateway provided with an existing VPC is left in its resource group and keeps its tags
+	if current.Tags[c.tagKeyCluster()] == TagValueCluster {
+		if _, err := c.updater.UpdateIPv6Gateway(ctx, desired, current); err != nil {
 			return err
 		}
 	}
+	c.state.Set(IdentifierIPv6Gateway, current.IPv6GatewayId)
 	return c.PersistState(ctx, true)
 }
 
@@ -199,7 +306,11 @@ func (c *FlowContext) ensureExistingVpc(ctx context.Context) error {
 	if current == nil {
 		return fmt.Errorf("VPC %s has not been found", vpcID)
 	}
+	if c.config.Networks.IPv6 != nil && current.IPv6CidrBlock == "" {
+		return fmt.Errorf("IPv6 is not enabled for VPC %s", vpcID)
+	}
 	c.state.Set(IdentifierVPC, vpcID)
+	c.state.Set(VPCIPv6CIDR, current.IPv6CidrBlock)
 	return c.PersistState(ctx, true)
 }
 
@@ -212,9 +323,12 @@ func (c *FlowContext) ensureManagedVpc(ctx context.Context) error {
 	}
 
 	desired := &aliclient.VPC{
-		Tags:      c.commonTags,
-		CidrBlock: *c.config.Networks.VPC.CIDR,
-		Name:      c.namespace + "-vpc",
+		Tags:       c.withUserTags(c.commonTags),
+		CidrBlock:  *c.config.Networks.VPC.CIDR,
+		Name:       c.namespace + "-vpc",
+		EnableIPv6: c.config.Networks.IPv6 != nil,
+
+		ResourceGroupId: c.resourceGroupID(),
 	}
 
 	current, err := findExisting(ctx, c.state.Get(IdentifierVPC), c.commonTags,
@@ -230,6 +344,9 @@ func (c *FlowContext) ensureManagedVpc(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if err := c.setVpcIPv6CIDR(ctx, desired, current); err != nil {
+			return err
+		}
 	} else {
 		log.Info("creating vpc ...")
 		created, err := c.actor.CreateVpc(ctx, desired)
@@ -245,10 +362,29 @@ func (c *FlowContext) ensureManagedVpc(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if err := c.setVpcIPv6CIDR(ctx, desired, created); err != nil {
+			return err
+		}
 	}
 	return c.PersistState(ctx, true)
 }
 
+// setVpcIPv6CIDR records the IPv6 CIDR block of the VPC, which may have just been allocated by the updater.
+func (c *FlowContext) setVpcIPv6CIDR(ctx context.Context, desired, current *aliclient.VPC) error {
+	if desired.EnableIPv6 && current.IPv6CidrBlock == "" {
+		updated, err := c.actor.GetVpc(ctx, current.VpcId)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return fmt.Errorf("not find the recorded VPC %s", current.VpcId)
+		}
+		current = updated
+	}
+	c.state.Set(VPCIPv6CIDR, current.IPv6CidrBlock)
+	return nil
+}
+
 func (c *FlowContext) collectExistingVSwitches(ctx context.Context) ([]*aliclient.VSwitch, error) {
 	child := c.state.GetChild(ChildIdZones)
 	var ids []string
@@ -319,10 +455,15 @@ func (c *FlowContext) ensureManagedNatGateway(ctx context.Context) error {
 		return fmt.Errorf("IdentifierVPC is nil")
 	}
 	desired := &aliclient.NatGateway{
-		Tags:               c.commonTagsWithSuffix("natgw"),
+		Tags:               c.withUserTags(c.commonTagsWithSuffix("natgw")),
 		Name:               c.namespace + "-natgw",
 		VpcId:              vpcId,
 		AvailableVSwitches: availableVSwitches,
+		ResourceGroupId:    c.resourceGroupID(),
+	}
+	if natGateway := c.config.Networks.VPC.NatGateway; natGateway != nil {
+		desired.InternetChargeType = ptr.Deref(natGateway.InternetChargeType, "")
+		desired.Spec = ptr.Deref(natGateway.Spec, "")
 	}
 
 	current, err := findExisting(ctx, c.state.Get(IdentifierNatGateway), c.commonTagsWithSuffix("natgw"),
@@ -336,6 +477,10 @@ func (c *FlowContext) ensureManagedNatGateway(ctx context.Context) error {
 		if !contains(desired.AvailableVSwitches, *current.VswitchId) {
 			return fmt.Errorf("the natgateway should be deleted")
 		}
+		if desired.InternetChargeType != "" && desired.InternetChargeType != current.InternetChargeType {
+			log.Info("the billing method of an existing natgateway cannot be changed, ignoring the configured one",
+				"NatGatewayId", current.NatGatewayId, "current", current.InternetChargeType, "configured", desired.InternetChargeType)
+		}
 		_, err := c.updater.UpdateNatgateway(ctx, desired, current)
 		if err != nil {
 			return err
@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// ensureCENAttachment attaches the VPC to the configured CEN instance or transit router. If the CEN section has been
+// removed from the configuration, an existing attachment is detached.
+func (c *FlowContext) ensureCENAttachment(ctx context.Context) error {
+	cen := c.config.Networks.CEN
+	if cen == nil {
+		return c.detachCEN(ctx)
+	}
+	vpcId := c.state.Get(IdentifierVPC)
+	if vpcId == nil {
+		return fmt.Errorf("IdentifierVPC is nil")
+	}
+	if cen.TransitRouter == nil {
+		if err := c.deleteTransitRouterAttachment(ctx); err != nil {
+			return err
+		}
+		return c.ensureCENChildInstance(ctx, cen.ID, *vpcId)
+	}
+	if err := c.deleteCENChildInstance(ctx); err != nil {
+		return err
+	}
+	if err := c.ensureTransitRouterAttachment(ctx, cen, *vpcId); err != nil {
+		return err
+	}
+	return c.ensureTransitRouterRouteEntry(ctx, cen.TransitRouter)
+}
+
+func (c *FlowContext) ensureCENChildInstance(ctx context.Context, cenId, vpcId string) error {
+	log := c.LogFromContext(ctx)
+	current, err := c.actor.GetCENChildInstance(ctx, cenId, vpcId, c.infraSpec.Region)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		log.Info("attaching VPC to CEN instance ...", "CenId", cenId, "VpcId", vpcId)
+		if err := c.actor.AttachCENChildInstance(ctx, cenId, vpcId, c.infraSpec.Region); err != nil {
+			return fmt.Errorf("attach VPC to CEN instance failed %w", err)
+		}
+	} else if current.Status != "Attached" {
+		return fmt.Errorf("VPC %s is in state %s in CEN instance %s", vpcId, current.Status, cenId)
+	}
+	c.state.Set(IdentifierCENChildInstance, cenId)
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) ensureTransitRouterAttachment(ctx context.Context, cen *aliapi.CEN, vpcId string) error {
+	log := c.LogFromContext(ctx)
+	var (
+		current *aliclient.TransitRouterVpcAttachment
+		err     error
+	)
+	if attachmentId := c.state.Get(IdentifierTransitRouterAttachment); attachmentId != nil {
+		current, err = c.actor.GetTransitRouterVpcAttachment(ctx, *attachmentId)
+		if err != nil {
+			return err
+		}
+	}
+	if current == nil {
+		current, err = c.actor.FindTransitRouterVpcAttachmentByVPC(ctx, cen.TransitRouter.ID, vpcId)
+		if err != nil {
+			return err
+		}
+	}
+	if current != nil && current.TransitRouterId != cen.TransitRouter.ID {
+		// the VPC is still attached to a previously configured transit router
+		if err := c.deleteTransitRouterAttachment(ctx); err != nil {
+			return err
+		}
+		current = nil
+	}
+	zoneVSwitches, err := c.getZoneVSwitches()
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		desired := &aliclient.TransitRouterVpcAttachment{
+			Tags:            c.withUserTags(c.commonTagsWithSuffix("tr-attachment")),
+			Name:            c.namespace,
+			CenId:           cen.ID,
+			TransitRouterId: cen.TransitRouter.ID,
+			VpcId:           vpcId,
+			ZoneMappings:    zoneVSwitches,
+		}
+		log.Info("creating transit router VPC attachment ...", "TransitRouterId", cen.TransitRouter.ID, "VpcId", vpcId)
+		current, err = c.actor.CreateTransitRouterVpcAttachment(ctx, desired)
+		if err != nil {
+			return fmt.Errorf("create transit router VPC attachment failed %w", err)
+		}
+		if current == nil {
+			return fmt.Errorf("failed to create transit router VPC attachment")
+		}
+	} else if missing := getMissingZoneMappings(current.ZoneMappings, zoneVSwitches); len(missing) > 0 {
+		log.Info("adding zones to transit router VPC attachment ...", "TransitRouterAttachmentId", current.TransitRouterAttachmentId)
+		if err := c.actor.AddZonesToTransitRouterVpcAttachment(ctx, current.TransitRouterAttachmentId, missing); err != nil {
+			return fmt.Errorf("add zones to transit router VPC attachment failed %w", err)
+		}
+	}
+	c.state.Set(IdentifierTransitRouterAttachment, current.TransitRouterAttachmentId)
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) ensureTransitRouterRouteEntry(ctx context.Context, tr *aliapi.TransitRouter) error {
+	attachmentId := c.state.Get(IdentifierTransitRouterAttachment)
+	if attachmentId == nil {
+		return fmt.Errorf("IdentifierTransitRouterAttachment is nil")
+	}
+	nodesCIDR := c.nodesCIDR()
+	if nodesCIDR == "" {
+		return fmt.Errorf("missing nodes CIDR of the shoot to route to the transit router attachment")
+	}
+	if routeTableId := c.state.Get(IdentifierTransitRouterRouteTable); routeTableId != nil && *routeTableId != tr.RouteTableID {
+		// the route entry is maintained in a previously configured route table
+		if err := c.deleteTransitRouterRouteEntry(ctx); err != nil {
+			return err
+		}
+	}
+
+	log := c.LogFromContext(ctx)
+	current, err := c.actor.FindTransitRouterRouteEntry(ctx, tr.RouteTableID, nodesCIDR)
+	if err != nil {
+		return err
+	}
+	if current != nil && current.NextHopId != *attachmentId {
+		// only a route entry created by this shoot for a previous attachment is replaced, the route entry of someone
+		// else, e.g. of another shoot with the same nodes CIDR attached to the transit router, is left untouched
+		if current.NextHopId != ptr.Deref(c.state.Get(TransitRouterRouteEntryNextHop), "") {
+			return fmt.Errorf("conflicting transit router route entry %s for the nodes CIDR %s in route table %s: its next hop %s is not the transit router attachment of the shoot",
+				current.TransitRouterRouteEntryId, nodesCIDR, tr.RouteTableID, current.NextHopId)
+		}
+		log.Info("deleting outdated transit router route entry ...", "TransitRouterRouteEntryId", current.TransitRouterRouteEntryId)
+		if err := c.actor.DeleteTransitRouterRouteEntry(ctx, tr.RouteTableID, nodesCIDR); err != nil {
+			return err
+		}
+		current = nil
+	}
+	if current == nil {
+		log.Info("creating transit router route entry ...", "DestinationCidrBlock", nodesCIDR)
+		desired := &aliclient.TransitRouterRouteEntry{
+			Name:                      c.namespace,
+			TransitRouterRouteTableId: tr.RouteTableID,
+			DestinationCidrBlock:      nodesCIDR,
+			NextHopId:                 *attachmentId,
+		}
+		if _, err := c.actor.CreateTransitRouterRouteEntry(ctx, desired); err != nil {
+			return fmt.Errorf("create transit router route entry failed %w", err)
+		}
+	}
+	c.state.Set(IdentifierTransitRouterRouteTable, tr.RouteTableID)
+	c.state.Set(TransitRouterRouteEntryCIDR, nodesCIDR)
+	c.state.Set(TransitRouterRouteEntryNextHop, *attachmentId)
+	return c.PersistState(ctx, true)
+}
+
+// detachCEN removes the route entry, the transit router attachment and the CEN child instance recorded in the state.
+func (c *FlowContext) detachCEN(ctx context.Context) error {
+	if err := c.deleteTransitRouterAttachment(ctx); err != nil {
+		return err
+	}
+	return c.deleteCENChildInstance(ctx)
+}
+
+func (c *FlowContext) deleteCENChildInstance(ctx context.Context) error {
+	cenId := c.state.Get(IdentifierCENChildInstance)
+	if cenId == nil {
+		return nil
+	}
+	if vpcId := c.state.Get(IdentifierVPC); vpcId != nil {
+		c.LogFromContext(ctx).Info("detaching VPC from CEN instance ...", "CenId", *cenId, "VpcId", *vpcId)
+		if err := c.actor.DetachCENChildInstance(ctx, *cenId, *vpcId, c.infraSpec.Region); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(IdentifierCENChildInstance)
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) deleteTransitRouterAttachment(ctx context.Context) error {
+	if err := c.deleteTransitRouterRouteEntry(ctx); err != nil {
+		return err
+	}
+	attachmentId := c.state.Get(IdentifierTransitRouterAttachment)
+	if attachmentId == nil {
+		return nil
+	}
+	c.LogFromContext(ctx).Info("deleting transit router VPC attachment ...", "TransitRouterAttachmentId", *attachmentId)
+	if err := c.actor.DeleteTransitRouterVpcAttachment(ctx, *attachmentId); err != nil {
+		return err
+	}
+	c.state.SetAsDeleted(IdentifierTransitRouterAttachment)
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) deleteTransitRouterRouteEntry(ctx context.Context) error {
+	routeTableId := c.state.Get(IdentifierTransitRouterRouteTable)
+	cidr := c.state.Get(TransitRouterRouteEntryCIDR)
+	if routeTableId == nil || cidr == nil {
+		return nil
+	}
+	current, err := c.actor.FindTransitRouterRouteEntry(ctx, *routeTableId, *cidr)
+	if err != nil {
+		return err
+	}
+	// only the route entry created by this shoot is removed
+	nextHop := ptr.Deref(c.state.Get(TransitRouterRouteEntryNextHop), ptr.Deref(c.state.Get(IdentifierTransitRouterAttachment), ""))
+	if current != nil && current.NextHopId == nextHop {
+		c.LogFromContext(ctx).Info("deleting transit router route entry ...", "TransitRouterRouteEntryId", current.TransitRouterRouteEntryId)
+		if err := c.actor.DeleteTransitRouterRouteEntry(ctx, *routeTableId, *cidr); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(IdentifierTransitRouterRouteTable)
+	c.state.SetAsDeleted(TransitRouterRouteEntryCIDR)
+	c.state.SetAsDeleted(TransitRouterRouteEntryNextHop)
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) nodesCIDR() string {
+	if c.cluster == nil || c.cluster.Shoot == nil || c.cluster.Shoot.Spec.Networking == nil {
+		return ""
+	}
+	return ptr.Deref(c.cluster.Shoot.Spec.Networking.Nodes, "")
+}
@@ -0,0 +1,362 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const (
+	// privateZoneRecordTTL is the TTL in seconds of the record resolving the private DNS name of a VPC endpoint.
+	privateZoneRecordTTL = 60
+	// routeTableTypeSystem is the type of the route table which is created together with a VPC.
+	routeTableTypeSystem = "System"
+)
+
+// ensureVPCEndpoints creates the configured interface and gateway endpoints together with the PrivateZones resolving
+// the private DNS names of the interface endpoints, and deletes the ones which are no longer configured.
+func (c *FlowContext) ensureVPCEndpoints(ctx context.Context) error {
+	vpcId := c.state.Get(IdentifierVPC)
+	if vpcId == nil {
+		return fmt.Errorf("IdentifierVPC is nil")
+	}
+	sgId := c.state.Get(IdentifierNodesSecurityGroup)
+	if sgId == nil {
+		return fmt.Errorf("IdentifierNodesSecurityGroup is nil")
+	}
+	var interfaceEndpoints, gatewayEndpoints []aliapi.VPCEndpoint
+	for _, endpoint := range c.config.Networks.VPCEndpoints {
+		if helper.VPCEndpointType(endpoint) == aliapi.VPCEndpointTypeGateway {
+			gatewayEndpoints = append(gatewayEndpoints, endpoint)
+		} else {
+			interfaceEndpoints = append(interfaceEndpoints, endpoint)
+		}
+	}
+
+	domains, err := c.ensureInterfaceVPCEndpoints(ctx, interfaceEndpoints, *vpcId, *sgId)
+	if err != nil {
+		return err
+	}
+	if err := c.ensurePrivateZones(ctx, interfaceEndpoints, domains, *vpcId); err != nil {
+		return err
+	}
+	if err := c.ensureGatewayVPCEndpoints(ctx, gatewayEndpoints, *vpcId); err != nil {
+		return err
+	}
+
+	children := c.state.GetChild(ChildIdVPCEndpoints)
+	desiredServices := sets.New[string]()
+	for _, endpoint := range c.config.Networks.VPCEndpoints {
+		desiredServices.Insert(endpoint.ServiceName)
+	}
+	for _, serviceName := range children.GetChildrenKeys() {
+		if !desiredServices.Has(serviceName) {
+			children.CleanChild(serviceName)
+		}
+	}
+	return c.PersistState(ctx, true)
+}
+
+// ensureInterfaceVPCEndpoints ensures the PrivateLink interface endpoints and returns their domains keyed by the
+// service names.
+func (c *FlowContext) ensureInterfaceVPCEndpoints(ctx context.Context, endpoints []aliapi.VPCEndpoint, vpcId, sgId string) (map[string]string, error) {
+	log := c.LogFromContext(ctx)
+	// only the endpoints created by the flow are managed, they are found by their tags
+	existing, err := c.actor.FindVpcEndpointsByTags(ctx, c.commonTagsWithSuffix("vpce"))
+	if err != nil {
+		return nil, err
+	}
+	current := map[string]*aliclient.VPCEndpoint{}
+	for _, endpoint := range existing {
+		current[endpoint.ServiceName] = endpoint
+	}
+
+	zoneVSwitches, err := c.getZoneVSwitches()
+	if err != nil {
+		return nil, err
+	}
+	children := c.state.GetChild(ChildIdVPCEndpoints)
+	domains := map[string]string{}
+	desiredServices := sets.New[string]()
+	for _, endpoint := range endpoints {
+		desiredServices.Insert(endpoint.ServiceName)
+		if current[endpoint.ServiceName] == nil {
+			desired := &aliclient.VPCEndpoint{
+				Tags:             c.withUserTags(c.commonTagsWithSuffix("vpce")),
+				Name:             c.namespace + "-vpce",
+				ServiceName:      endpoint.ServiceName,
+				VpcId:            vpcId,
+				SecurityGroupIds: []string{sgId},
+				ZoneMappings:     zoneVSwitches,
+				ResourceGroupId:  c.resourceGroupID(),
+			}
+			log.Info("creating VPC endpoint ...", "ServiceName", endpoint.ServiceName)
+			created, err := c.actor.CreateVpcEndpoint(ctx, desired)
+			if err != nil {
+				return nil, fmt.Errorf("create VPC endpoint for service %s failed %w", endpoint.ServiceName, err)
+			}
+			if created == nil {
+				return nil, fmt.Errorf("failed to create VPC endpoint for service %s", endpoint.ServiceName)
+			}
+			current[endpoint.ServiceName] = created
+		} else if missing := getMissingZoneMappings(current[endpoint.ServiceName].ZoneMappings, zoneVSwitches); len(missing) > 0 {
+			// zones are only added, zones removed from the configuration are not removed from the endpoint
+			log.Info("adding zones to VPC endpoint ...", "ServiceName", endpoint.ServiceName, "EndpointId", current[endpoint.ServiceName].EndpointId)
+			if err := c.actor.AddZonesToVpcEndpoint(ctx, current[endpoint.ServiceName].EndpointId, missing); err != nil {
+				return nil, fmt.Errorf("add zones to VPC endpoint for service %s failed %w", endpoint.ServiceName, err)
+			}
+		}
+		domains[endpoint.ServiceName] = current[endpoint.ServiceName].Domain
+		child := children.GetChild(endpoint.ServiceName)
+		child.Set(IdentifierVPCEndpoint, current[endpoint.ServiceName].EndpointId)
+		child.Set(VPCEndpointDomain, current[endpoint.ServiceName].Domain)
+		if err := c.PersistState(ctx, true); err != nil {
+			return nil, err
+		}
+	}
+
+	for serviceName, endpoint := range current {
+		if desiredServices.Has(serviceName) {
+			continue
+		}
+		log.Info("deleting VPC endpoint ...", "ServiceName", serviceName, "EndpointId", endpoint.EndpointId)
+		if err := c.actor.DeleteVpcEndpoint(ctx, endpoint.EndpointId); err != nil {
+			return nil, err
+		}
+	}
+	return domains, nil
+}
+
+// ensurePrivateZones ensures a PrivateZone bound to the VPC for each interface endpoint with a private DNS name. The
+// apex of the zone is a CNAME record for the domain of the endpoint. Zones are identified by their names, hence a
+// zone is replaced if the private DNS name of an endpoint changes.
+func (c *FlowContext) ensurePrivateZones(ctx context.Context, endpoints []aliapi.VPCEndpoint, domains map[string]string, vpcId string) error {
+	log := c.LogFromContext(ctx)
+	// only the zones created by the flow are managed, they are found by their tags
+	existing, err := c.actor.FindPrivateZonesByTags(ctx, c.commonTagsWithSuffix("pvtz"))
+	if err != nil {
+		return err
+	}
+	current := map[string]*aliclient.PrivateZone{}
+	for _, zone := range existing {
+		current[zone.Name] = zone
+	}
+
+	children := c.state.GetChild(ChildIdVPCEndpoints)
+	desiredNames := sets.New[string]()
+	for _, endpoint := range endpoints {
+		child := children.GetChild(endpoint.ServiceName)
+		if endpoint.PrivateDNSName == nil {
+			child.Set(IdentifierPrivateZone, "")
+			continue
+		}
+		name := *endpoint.PrivateDNSName
+		desiredNames.Insert(name)
+		if current[name] == nil {
+			log.Info("creating PrivateZone ...", "ServiceName", endpoint.ServiceName, "ZoneName", name)
+			created, err := c.actor.CreatePrivateZone(ctx, &aliclient.PrivateZone{
+				Tags:            c.withUserTags(c.commonTagsWithSuffix("pvtz")),
+				Name:            name,
+				VpcIds:          []string{vpcId},
+				ResourceGroupId: c.resourceGroupID(),
+			})
+			if err != nil {
+				return fmt.Errorf("create PrivateZone %s failed %w", name, err)
+			}
+			if created == nil {
+				return fmt.Errorf("failed to create PrivateZone %s", name)
+			}
+			current[name] = created
+		}
+		if err := c.ensurePrivateZoneRecord(ctx, current[name].ZoneId, domains[endpoint.ServiceName]); err != nil {
+			return err
+		}
+		child.Set(IdentifierPrivateZone, current[name].ZoneId)
+		if err := c.PersistState(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	for name, zone := range current {
+		if desiredNames.Has(name) {
+			continue
+		}
+		log.Info("deleting PrivateZone ...", "ZoneName", name, "ZoneId", zone.ZoneId)
+		if err := c.actor.DeletePrivateZone(ctx, zone.ZoneId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensurePrivateZoneRecord ensures the CNAME record of the zone apex pointing to the given domain.
+func (c *FlowContext) ensurePrivateZoneRecord(ctx context.Context, zoneId, domain string) error {
+	records, err := c.actor.ListPrivateZoneRecords(ctx, zoneId)
+	if err != nil {
+		return err
+	}
+	desired := &aliclient.PrivateZoneRecord{
+		ZoneId: zoneId,
+		Rr:     "@",
+		Type:   "CNAME",
+		Value:  domain,
+		Ttl:    privateZoneRecordTTL,
+	}
+	for _, record := range records {
+		if record.Rr != desired.Rr || record.Type != desired.Type {
+			continue
+		}
+		if record.Value == desired.Value && record.Ttl == desired.Ttl {
+			return nil
+		}
+		desired.RecordId = record.RecordId
+		return c.actor.UpdatePrivateZoneRecord(ctx, desired)
+	}
+	_, err = c.actor.CreatePrivateZoneRecord(ctx, desired)
+	return err
+}
+
+// ensureGatewayVPCEndpoints ensures the VPC gateway endpoints and their association with the route tables of the
+// VPC used by the vswitches of the zones.
+func (c *FlowContext) ensureGatewayVPCEndpoints(ctx context.Context, endpoints []aliapi.VPCEndpoint, vpcId string) error {
+	log := c.LogFromContext(ctx)
+	// only the endpoints created by the flow are managed, they are found by their tags
+	existing, err := c.actor.FindVpcGatewayEndpointsByTags(ctx, c.commonTagsWithSuffix("vpcgwe"))
+	if err != nil {
+		return err
+	}
+	current := map[string]*aliclient.VPCGatewayEndpoint{}
+	for _, endpoint := range existing {
+		current[endpoint.ServiceName] = endpoint
+	}
+
+	var routeTableIds []string
+	if len(endpoints) > 0 {
+		if routeTableIds, err = c.zoneRouteTableIds(ctx, vpcId); err != nil {
+			return err
+		}
+	}
+	children := c.state.GetChild(ChildIdVPCEndpoints)
+	desiredServices := sets.New[string]()
+	for _, endpoint := range endpoints {
+		desiredServices.Insert(endpoint.ServiceName)
+		if current[endpoint.ServiceName] == nil {
+			log.Info("creating VPC gateway endpoint ...", "ServiceName", endpoint.ServiceName)
+			created, err := c.actor.CreateVpcGatewayEndpoint(ctx, &aliclient.VPCGatewayEndpoint{
+				Tags:            c.withUserTags(c.commonTagsWithSuffix("vpcgwe")),
+				Name:            c.namespace + "-vpcgwe",
+				ServiceName:     endpoint.ServiceName,
+				VpcId:           vpcId,
+				RouteTableIds:   routeTableIds,
+				ResourceGroupId: c.resourceGroupID(),
+			})
+			if err != nil {
+				return fmt.Errorf("create VPC gateway endpoint for service %s failed %w", endpoint.ServiceName, err)
+			}
+			if created == nil {
+				return fmt.Errorf("failed to create VPC gateway endpoint for service %s", endpoint.ServiceName)
+			}
+			current[endpoint.ServiceName] = created
+		} else {
+			var missing []string
+			for _, id := range routeTableIds {
+				if !slices.Contains(current[endpoint.ServiceName].RouteTableIds, id) {
+					missing = append(missing, id)
+				}
+			}
+			if len(missing) > 0 {
+				log.Info("associating route tables with VPC gateway endpoint ...", "EndpointId", current[endpoint.ServiceName].EndpointId, "RouteTableIds", missing)
+				if err := c.actor.AssociateRouteTablesWithVpcGatewayEndpoint(ctx, current[endpoint.ServiceName].EndpointId, missing); err != nil {
+					return err
+				}
+			}
+		}
+		child := children.GetChild(endpoint.ServiceName)
+		child.Set(IdentifierVPCEndpoint, current[endpoint.ServiceName].EndpointId)
+		child.Set(VPCEndpointDomain, "")
+		if err := c.PersistState(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	for serviceName, endpoint := range current {
+		if desiredServices.Has(serviceName) {
+			continue
+		}
+		log.Info("deleting VPC gateway endpoint ...", "ServiceName", serviceName, "EndpointId", endpoint.EndpointId)
+		if err := c.actor.DeleteVpcGatewayEndpoint(ctx, endpoint.EndpointId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// zoneRouteTableIds returns the ids of the system route table of the VPC and of the custom route tables any vswitch of
+// the zones is associated with.
+func (c *FlowContext) zoneRouteTableIds(ctx context.Context, vpcId string) ([]string, error) {
+	vswitchIds := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if id := c.getZoneChild(zone.Name).Get(IdentifierZoneVSwitch); id != nil {
+			vswitchIds.Insert(*id)
+		}
+	}
+	routeTables, err := c.actor.ListRouteTables(ctx, vpcId)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, routeTable := range routeTables {
+		if routeTable.RouteTableType == routeTableTypeSystem || vswitchIds.HasAny(routeTable.VSwitchIds...) {
+			ids = append(ids, routeTable.RouteTableId)
+		}
+	}
+	return ids, nil
+}
+
+// deleteVPCEndpoints deletes the PrivateZones, the interface endpoints and the gateway endpoints created by the flow.
+func (c *FlowContext) deleteVPCEndpoints(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	// only the resources created by the flow are deleted, they are found by their tags
+	zones, err := c.actor.FindPrivateZonesByTags(ctx, c.commonTagsWithSuffix("pvtz"))
+	if err != nil {
+		return err
+	}
+	for _, zone := range zones {
+		log.Info("deleting PrivateZone ...", "ZoneName", zone.Name, "ZoneId", zone.ZoneId)
+		if err := c.actor.DeletePrivateZone(ctx, zone.ZoneId); err != nil {
+			return err
+		}
+	}
+	endpoints, err := c.actor.FindVpcEndpointsByTags(ctx, c.commonTagsWithSuffix("vpce"))
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpoints {
+		log.Info("deleting VPC endpoint ...", "ServiceName", endpoint.ServiceName, "EndpointId", endpoint.EndpointId)
+		if err := c.actor.DeleteVpcEndpoint(ctx, endpoint.EndpointId); err != nil {
+			return err
+		}
+	}
+	gwEndpoints, err := c.actor.FindVpcGatewayEndpointsByTags(ctx, c.commonTagsWithSuffix("vpcgwe"))
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range gwEndpoints {
+		log.Info("deleting VPC gateway endpoint ...", "ServiceName", endpoint.ServiceName, "EndpointId", endpoint.EndpointId)
+		if err := c.actor.DeleteVpcGatewayEndpoint(ctx, endpoint.EndpointId); err != nil {
+			return err
+		}
+	}
+	c.state.CleanChild(ChildIdVPCEndpoints)
+	return nil
+}
@@ -7,17 +7,25 @@ package infraflow
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
 )
 
+// defaultEIPBandwidth is the default bandwidth of the EIPs of the NAT gateway in Mbit/s.
+const defaultEIPBandwidth = 100
+
 func (c *FlowContext) ensureZones(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	log.Info("begin ensure zones")
@@ -38,10 +46,9 @@ func (c *FlowContext) ensureZones(ctx context.Context) error {
 
 func (c *FlowContext) getEipInternetChargeType(ctx context.Context) string {
 	var eipId *string
-	eipId = nil
 	for _, zone := range c.config.Networks.Zones {
-		if zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil {
-			eipId = zone.NatGateway.EIPAllocationID
+		if ids := helper.GetEIPAllocationIDs(zone.NatGateway); len(ids) > 0 {
+			eipId = &ids[0]
 			break
 		}
 	}
@@ -66,31 +73,91 @@ func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zoneName, eipIntenetC
 		Timeout(defaultLongTimeout), Dependencies(ensureEipAssociation))
 }
 
+// elasticIPKeys returns the keys of the id and the address of the elastic IP with the given index of a zone. The keys
+// of the first elastic IP are kept unchanged for compatibility with existing states.
+func elasticIPKeys(index int) (string, string) {
+	if index == 0 {
+		return IdentifierZoneNATGWElasticIP, ZoneNATGWElasticIPAddress
+	}
+	return fmt.Sprintf("%s-%d", IdentifierZoneNATGWElasticIP, index), fmt.Sprintf("%s-%d", ZoneNATGWElasticIPAddress, index)
+}
+
+// recordedElasticIPIndexes returns the sorted indexes of all elastic IP ids or addresses (depending on the given base
+// key) recorded in the zone child.
+func recordedElasticIPIndexes(child Whiteboard, baseKey string) []int {
+	var indexes []int
+	for _, key := range child.Keys() {
+		if child.Get(key) == nil {
+			continue
+		}
+		if key == baseKey {
+			indexes = append(indexes, 0)
+			continue
+		}
+		if suffix, ok := strings.CutPrefix(key, baseKey+"-"); ok {
+			if index, err := strconv.Atoi(suffix); err == nil {
+				indexes = append(indexes, index)
+			}
+		}
+	}
+	slices.Sort(indexes)
+	return indexes
+}
+
+func (c *FlowContext) elasticIPSuffix(zoneName string, index int) string {
+	eipSuffix := fmt.Sprintf("eip-natgw-%s", c.getZoneSuffix(zoneName))
+	if index > 0 {
+		eipSuffix = fmt.Sprintf("%s-%d", eipSuffix, index)
+	}
+	return eipSuffix
+}
+
+// getZoneElasticIPIds returns the ids of the elastic IPs bound to the NAT gateway for the given zone. These are either
+// the configured existing elastic IPs or the ones managed by Gardener.
+func (c *FlowContext) getZoneElasticIPIds(zone *alicloud.Zone) ([]string, error) {
+	if ids := helper.GetEIPAllocationIDs(zone.NatGateway); len(ids) > 0 {
+		return ids, nil
+	}
+	child := c.getZoneChild(zone.Name)
+	var ids []string
+	for i := range helper.GetManagedEIPCount(zone.NatGateway) {
+		idKey, _ := elasticIPKeys(i)
+		id := child.Get(idKey)
+		if id == nil {
+			return nil, fmt.Errorf("no Eip exist @ zone %s", zone.Name)
+		}
+		ids = append(ids, *id)
+	}
+	return ids, nil
+}
+
 func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 	return func(ctx context.Context) error {
 		log := c.LogFromContext(ctx)
 		log.Info("ensureSnatEntry", "zoneName", zoneName)
 		zone := c.getZoneConfig(zoneName)
-		child := c.getZoneChild(zoneName)
-		managed_eipId := child.Get(IdentifierZoneNATGWElasticIP)
+		if zone == nil {
+			return fmt.Errorf("can not get zone config for %s", zoneName)
+		}
 		ngwId := c.state.Get(IdentifierNatGateway)
-		vswitchId := child.Get(IdentifierZoneVSwitch)
+		vswitchId := c.getZoneChild(zoneName).Get(IdentifierZoneVSwitch)
 		if vswitchId == nil {
 			return fmt.Errorf("IdentifierZoneVSwitch is nil")
 		}
-		eipId := managed_eipId
-		if zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil {
-			eipId = zone.NatGateway.EIPAllocationID
-		}
-		if eipId == nil {
-			return fmt.Errorf("no Eip exist @ zone %s", zoneName)
-		}
-		eip, err := c.actor.GetEIP(ctx, *eipId)
+		eipIds, err := c.getZoneElasticIPIds(zone)
 		if err != nil {
 			return err
 		}
-		if eip == nil {
-			return fmt.Errorf("not find the recorded EIP %s", *eipId)
+		var ipAddresses []string
+		for _, eipId := range eipIds {
+			eip, err := c.actor.GetEIP(ctx, eipId)
+			if err != nil {
+				return err
+			}
+			if eip == nil {
+				return fmt.Errorf("not find the recorded EIP %s", eipId)
+			}
+			ipAddresses = append(ipAddresses, eip.IpAddress)
 		}
 		if ngwId == nil {
 			return fmt.Errorf("IdentifierNatGateway is nil")
@@ -111,7 +178,7 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 				Name:         c.namespace + "-" + snatSuffix + "-" + snateTableId,
 				NatGatewayId: *ngwId,
 				VSwitchId:    *vswitchId,
-				IpAddress:    eip.IpAddress,
+				IpAddress:    strings.Join(ipAddresses, ","),
 				SnatTableId:  snateTableId,
 			})
 		}
@@ -138,10 +205,12 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 			}
 			_, _ = c.updater.UpdateSNATEntry(ctx, desired, created)
 		}
+		desiredIPAddresses := sets.New(ipAddresses...)
 		toUnAssociateEIPs := sets.New[string]()
 		for _, item := range toBeChecked {
-			if item.desired.IpAddress != item.current.IpAddress {
-				toUnAssociateEIPs.Insert(item.current.IpAddress)
+			currentIPAddresses := sets.New(strings.Split(item.current.IpAddress, ",")...)
+			if !desiredIPAddresses.Equal(currentIPAddresses) {
+				toUnAssociateEIPs.Insert(currentIPAddresses.Difference(desiredIPAddresses).UnsortedList()...)
 
 				waiter := informOnWaiting(log, 5*time.Second, "still deleting snate entry ...", "SnatEntryId", item.current.SnatEntryId, "SnatTableId", item.current.SnatTableId)
 				err := c.actor.DeleteSNatEntry(ctx, item.current.SnatEntryId, item.current.SnatTableId)
@@ -176,30 +245,47 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 			}
 		}
 
-		if managed_eipId != nil && eipId != managed_eipId {
-			managed_eip, err := c.actor.GetEIP(ctx, *managed_eipId)
-			if err != nil {
-				return err
-			}
+		return c.deleteObsoleteElasticIPs(ctx, zoneName, sets.New(eipIds...))
+	}
+}
 
-			if managed_eip != nil {
-				log := c.LogFromContext(ctx)
-				log.Info("deleting...", "AllocationId", managed_eip.EipId)
-				waiter := informOnWaiting(log, 5*time.Second, "still deleting...", "AllocationId", managed_eip.EipId)
-				err = c.actor.DeleteEIP(ctx, managed_eip.EipId)
-				waiter.Done(err)
-				if err != nil {
+// deleteObsoleteElasticIPs deletes all elastic IPs managed by Gardener for the given zone, which are not in use anymore.
+func (c *FlowContext) deleteObsoleteElasticIPs(ctx context.Context, zoneName string, inUse sets.Set[string]) error {
+	log := c.LogFromContext(ctx)
+	child := c.getZoneChild(zoneName)
+	deleted := false
+	for _, index := range recordedElasticIPIndexes(child, IdentifierZoneNATGWElasticIP) {
+		idKey, _ := elasticIPKeys(index)
+		managed_eipId := child.Get(idKey)
+		if managed_eipId == nil || inUse.Has(*managed_eipId) {
+			continue
+		}
+		managed_eip, err := c.actor.GetEIP(ctx, *managed_eipId)
+		if err != nil {
+			return err
+		}
+
+		if managed_eip != nil {
+			if *managed_eip.Status == "InUse" {
+				if err := c.actor.UnAssociateEIP(ctx, managed_eip); err != nil {
 					return err
 				}
 			}
-			child.SetAsDeleted(IdentifierZoneNATGWElasticIP)
-			if err := c.PersistState(ctx, true); err != nil {
+			log.Info("deleting...", "AllocationId", managed_eip.EipId)
+			waiter := informOnWaiting(log, 5*time.Second, "still deleting...", "AllocationId", managed_eip.EipId)
+			err = c.actor.DeleteEIP(ctx, managed_eip.EipId)
+			waiter.Done(err)
+			if err != nil {
 				return err
 			}
 		}
-
+		child.SetAsDeleted(idKey)
+		deleted = true
+	}
+	if !deleted {
 		return nil
 	}
+	return c.PersistState(ctx, true)
 }
 
 func (c *FlowContext) getCurrentSnatEntryForZone(ctx context.Context, zoneName string) ([]*aliclient.SNATEntry, error) {
@@ -228,40 +314,41 @@ func (c *FlowContext) ensureEipAssociation(zoneName string) flow.TaskFn {
 		log := c.LogFromContext(ctx)
 
 		zone := c.getZoneConfig(zoneName)
-		child := c.getZoneChild(zoneName)
-		eipId := child.Get(IdentifierZoneNATGWElasticIP)
+		if zone == nil {
+			return fmt.Errorf("can not get zone config for %s", zoneName)
+		}
 		ngwId := c.state.Get(IdentifierNatGateway)
 		if ngwId == nil {
 			return fmt.Errorf("IdentifierNatGateway is nil")
 		}
 
-		if zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil {
-			eipId = zone.NatGateway.EIPAllocationID
-		}
-		if eipId == nil {
-			return fmt.Errorf("no Eip exist @ zone %s", zoneName)
-		}
-		log.Info("ensure eip associated to zone for NatGateway", "eipId", *eipId, "zoneName", zoneName, "ngwId", *ngwId)
-		eip, err := c.actor.GetEIP(ctx, *eipId)
+		eipIds, err := c.getZoneElasticIPIds(zone)
 		if err != nil {
 			return err
 		}
-		if eip == nil {
-			return fmt.Errorf("not find the recorded EIP %s", *eipId)
-		}
-		switch *eip.Status {
-		case "Available":
-			// association
-			err := c.actor.AssociateEIP(ctx, *eipId, *ngwId, "Nat")
+		for _, eipId := range eipIds {
+			log.Info("ensure eip associated to zone for NatGateway", "eipId", eipId, "zoneName", zoneName, "ngwId", *ngwId)
+			eip, err := c.actor.GetEIP(ctx, eipId)
 			if err != nil {
 				return err
 			}
-		case "InUse":
-			if *eip.InstanceId != *ngwId {
-				return fmt.Errorf("the eip %s is not associated to natgateway %s", *eipId, *ngwId)
+			if eip == nil {
+				return fmt.Errorf("not find the recorded EIP %s", eipId)
+			}
+			switch *eip.Status {
+			case "Available":
+				// association
+				err := c.actor.AssociateEIP(ctx, eipId, *ngwId, "Nat")
+				if err != nil {
+					return err
+				}
+			case "InUse":
+				if *eip.InstanceId != *ngwId {
+					return fmt.Errorf("the eip %s is not associated to natgateway %s", eipId, *ngwId)
+				}
+			default:
+				return fmt.Errorf(" eip %s status %s not allowed", eipId, *eip.Status)
 			}
-		default:
-			return fmt.Errorf(" eip %s status %s not allowed", *eipId, *eip.Status)
 		}
 		return nil
 	}
@@ -275,58 +362,86 @@ func (c *FlowContext) ensureElasticIP(zoneName, eipIntenetChargeType string) flo
 		}
 		log := c.LogFromContext(ctx)
 		child := c.getZoneChild(zone.Name)
-		if zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil {
-			eipId := *zone.NatGateway.EIPAllocationID
-			log.Info("using configured EIP", "eipId", eipId)
-			current, err := c.actor.GetEIP(ctx, eipId)
-			if err != nil {
-				return err
-			}
-			if current == nil {
-				return fmt.Errorf("configured EIP %s has not been found", eipId)
+		if eipIds := helper.GetEIPAllocationIDs(zone.NatGateway); len(eipIds) > 0 {
+			for i, eipId := range eipIds {
+				log.Info("using configured EIP", "eipId", eipId)
+				current, err := c.actor.GetEIP(ctx, eipId)
+				if err != nil {
+					return err
+				}
+				if current == nil {
+					return fmt.Errorf("configured EIP %s has not been found", eipId)
+				}
+				_, addressKey := elasticIPKeys(i)
+				child.Set(addressKey, current.IpAddress)
 			}
-			child.Set(ZoneNATGWElasticIPAddress, current.IpAddress)
+			cleanupElasticIPAddresses(child, len(eipIds))
 			return c.PersistState(ctx, true)
 		}
-		zoneSuffix := c.getZoneSuffix(zone.Name)
-		eipSuffix := fmt.Sprintf("eip-natgw-%s", zoneSuffix)
 
-		desired := &aliclient.EIP{
-			Name:               c.namespace + "-" + eipSuffix,
-			Tags:               c.commonTagsWithSuffix(eipSuffix),
-			Bandwidth:          "100",
-			InternetChargeType: eipIntenetChargeType,
-		}
-		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGWElasticIP), desired.Tags, c.actor.GetEIP, c.actor.FindEIPsByTags)
-		if err != nil {
-			return err
-		}
-
-		if current != nil {
-			child.Set(IdentifierZoneNATGWElasticIP, current.EipId)
-			child.Set(ZoneNATGWElasticIPAddress, current.IpAddress)
-			if _, err := c.updater.UpdateEIP(ctx, desired, current); err != nil {
-				return err
+		count := helper.GetManagedEIPCount(zone.NatGateway)
+		for i := range count {
+			eipSuffix := c.elasticIPSuffix(zone.Name, i)
+			desired := &aliclient.EIP{
+				Name:               c.namespace + "-" + eipSuffix,
+				Tags:               c.withUserTags(c.commonTagsWithSuffix(eipSuffix)),
+				Bandwidth:          strconv.Itoa(defaultEIPBandwidth),
+				InternetChargeType: eipIntenetChargeType,
+				ResourceGroupId:    c.resourceGroupID(),
+			}
+			if natGateway := zone.NatGateway; natGateway != nil {
+				desired.Bandwidth = strconv.Itoa(int(ptr.Deref(natGateway.EIPBandwidth, defaultEIPBandwidth)))
+				desired.ISP = ptr.Deref(natGateway.EIPISP, "")
+				desired.BandwidthPackageId = ptr.Deref(natGateway.EIPBandwidthPackageID, "")
 			}
-		} else {
-			log.Info("creating eip ...")
-			created, err := c.actor.CreateEIP(ctx, desired)
+			idKey, addressKey := elasticIPKeys(i)
+			current, err := findExisting(ctx, child.Get(idKey), c.commonTagsWithSuffix(eipSuffix), c.actor.GetEIP, c.actor.FindEIPsByTags)
 			if err != nil {
 				return err
 			}
-			if created == nil {
-				return fmt.Errorf("failed to create EIP")
+
+			if current != nil {
+				child.Set(idKey, current.EipId)
+				child.Set(addressKey, current.IpAddress)
+				if _, err := c.updater.UpdateEIP(ctx, desired, current); err != nil {
+					return err
+				}
+			} else {
+				log.Info("creating eip ...", "name", desired.Name)
+				created, err := c.actor.CreateEIP(ctx, desired)
+				if err != nil {
+					return err
+				}
+				if created == nil {
+					return fmt.Errorf("failed to create EIP")
+				}
+				child.Set(idKey, created.EipId)
+				child.Set(addressKey, created.IpAddress)
+				if _, err := c.updater.UpdateEIP(ctx, desired, created); err != nil {
+					return err
+				}
 			}
-			child.Set(IdentifierZoneNATGWElasticIP, created.EipId)
-			child.Set(ZoneNATGWElasticIPAddress, created.IpAddress)
-			if _, err := c.updater.UpdateEIP(ctx, desired, created); err != nil {
+			if err := c.PersistState(ctx, true); err != nil {
 				return err
 			}
 		}
+		// surplus elastic IPs are deleted after they have been removed from the SNAT entries
+		cleanupElasticIPAddresses(child, count)
 		return c.PersistState(ctx, true)
 	}
 }
 
+// cleanupElasticIPAddresses removes all recorded elastic IP addresses of a zone beyond the given count, so that they
+// are not reported as egress CIDRs anymore.
+func cleanupElasticIPAddresses(child Whiteboard, count int) {
+	for _, index := range recordedElasticIPIndexes(child, ZoneNATGWElasticIPAddress) {
+		if index >= count {
+			_, addressKey := elasticIPKeys(index)
+			child.Set(addressKey, "")
+		}
+	}
+}
+
 func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 	vpcId := c.state.Get(IdentifierVPC)
 	if vpcId == nil {
@@ -334,33 +449,63 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 	}
 	log := c.LogFromContext(ctx)
 	var desired []*aliclient.VSwitch
-	for _, zone := range c.config.Networks.Zones {
+	for i, zone := range c.config.Networks.Zones {
+		if zone.WorkersVSwitchID != nil {
+			if err := c.ensureExistingVSwitch(ctx, zone.Name, *zone.WorkersVSwitchID); err != nil {
+				return err
+			}
+			continue
+		}
 		zoneSuffix := c.getZoneSuffix(zone.Name)
 		workerSuffix := fmt.Sprintf("nodes-%s", zoneSuffix)
-		desired = append(desired,
-			&aliclient.VSwitch{
-				Name:      c.namespace + "-" + zone.Name + "-vsw",
-				CidrBlock: zone.Workers,
-				VpcId:     vpcId,
-				Tags:      c.commonTagsWithSuffix(workerSuffix),
-				ZoneId:    zone.Name,
-			})
+		vsw := &aliclient.VSwitch{
+			Name:      c.namespace + "-" + zone.Name + "-vsw",
+			CidrBlock: zone.Workers,
+			VpcId:     vpcId,
+			Tags:      c.withUserTags(c.commonTagsWithSuffix(workerSuffix)),
+			ZoneId:    zone.Name,
+
+			ResourceGroupId: c.resourceGroupID(),
+		}
+		if c.config.Networks.IPv6 != nil {
+			vsw.IPv6CidrBlockIndex = ptr.To(helper.GetWorkersIPv6Index(zone, i))
+		}
+		desired = append(desired, vsw)
 	}
 
-	current, err := c.collectExistingVSwitches(ctx)
+	collected, err := c.collectExistingVSwitches(ctx)
 	if err != nil {
 		return err
 	}
-	vpc_vsw, err := c.actor.FindVSwitchesByVPC(ctx, *vpcId)
+	// existing vswitches provided by the user are neither updated nor deleted. If they are no longer configured, only the
+	// resources of their zones are deleted.
+	var current, released []*aliclient.VSwitch
+	for _, vsw := range collected {
+		switch {
+		case c.isConfiguredVSwitch(vsw.VSwitchId):
+		case c.isExistingVSwitch(vsw.VSwitchId):
+			released = append(released, vsw)
+		default:
+			current = append(current, vsw)
+		}
+	}
+	found, err := c.actor.FindVSwitchesByVPC(ctx, *vpcId)
 	if err != nil {
 		return err
 	}
+	// existing vswitches provided by the user are never reused as managed vswitches
+	var vpc_vsw []*aliclient.VSwitch
+	for _, vsw := range found {
+		if !c.isExistingVSwitch(vsw.VSwitchId) {
+			vpc_vsw = append(vpc_vsw, vsw)
+		}
+	}
 
 	toBeDeleted, toBeCreated, toBeChecked := diffByID_Ex(desired, current, vpc_vsw, func(item *aliclient.VSwitch) string {
 		return item.ZoneId + "-" + item.CidrBlock
 	})
 
-	if err := c.DeleteZoneByVSwitches(ctx, toBeDeleted); err != nil {
+	if err := c.DeleteZoneByVSwitches(ctx, append(released, toBeDeleted...)); err != nil {
 		return err
 	}
 
@@ -374,10 +519,14 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 			return fmt.Errorf("failed to create vswitch")
 		}
 		c.state.GetChild(ChildIdZones).GetChild(desired.ZoneId).Set(IdentifierZoneVSwitch, created.VSwitchId)
+		c.state.GetChild(ChildIdZones).GetChild(desired.ZoneId).Set(MarkerZoneVSwitchExisting, "")
 		_, err = c.updater.UpdateVSwitch(ctx, desired, created)
 		if err != nil {
 			return err
 		}
+		if err := c.setVSwitchIPv6CIDR(ctx, desired, created); err != nil {
+			return err
+		}
 	}
 	for _, vsw := range toBeChecked {
 		c.state.GetChild(ChildIdZones).GetChild(vsw.current.ZoneId).Set(IdentifierZoneVSwitch, vsw.current.VSwitchId)
@@ -385,8 +534,70 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if err := c.setVSwitchIPv6CIDR(ctx, vsw.desired, vsw.current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureExistingVSwitch records the existing vswitch provided by the user for the workers of the given zone.
+func (c *FlowContext) ensureExistingVSwitch(ctx context.Context, zoneName, vswitchId string) error {
+	current, err := c.actor.GetVSwitch(ctx, vswitchId)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("configured vswitch %s has not been found", vswitchId)
+	}
+	child := c.getZoneChild(zoneName)
+	child.Set(IdentifierZoneVSwitch, current.VSwitchId)
+	child.Set(ZoneVSwitchIPv6CIDR, current.IPv6CidrBlock)
+	child.Set(MarkerZoneVSwitchExisting, "true")
+	return nil
+}
+
+// isConfiguredVSwitch returns true if the vswitch with the given id is configured by the user for the workers of a zone.
+func (c *FlowContext) isConfiguredVSwitch(vswitchId string) bool {
+	for _, zone := range c.config.Networks.Zones {
+		if ptr.Deref(zone.WorkersVSwitchID, "") == vswitchId {
+			return true
+		}
+	}
+	return false
+}
+
+// isExistingVSwitch returns true if the vswitch with the given id has been provided by the user for the workers of a
+// zone. This is also the case if the vswitch is no longer configured, but has been recorded as existing vswitch in the
+// state.
+func (c *FlowContext) isExistingVSwitch(vswitchId string) bool {
+	if c.isConfiguredVSwitch(vswitchId) {
+		return true
+	}
+	zones := c.state.GetChild(ChildIdZones)
+	for _, key := range zones.GetChildrenKeys() {
+		zoneChild := zones.GetChild(key)
+		if ptr.Deref(zoneChild.Get(IdentifierZoneVSwitch), "") == vswitchId && ptr.Deref(zoneChild.Get(MarkerZoneVSwitchExisting), "") == "true" {
+			return true
+		}
 	}
+	return false
+}
 
+// setVSwitchIPv6CIDR records the IPv6 CIDR block of the vswitch, which may have just been allocated by the updater.
+func (c *FlowContext) setVSwitchIPv6CIDR(ctx context.Context, desired, current *aliclient.VSwitch) error {
+	if desired.IPv6CidrBlockIndex != nil && current.IPv6CidrBlock == "" {
+		updated, err := c.actor.GetVSwitch(ctx, current.VSwitchId)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return fmt.Errorf("not find the recorded vswitch %s", current.VSwitchId)
+		}
+		current = updated
+	}
+	c.state.GetChild(ChildIdZones).GetChild(current.ZoneId).Set(ZoneVSwitchIPv6CIDR, current.IPv6CidrBlock)
 	return nil
 }
 
@@ -417,6 +628,10 @@ func (c *FlowContext) DeleteZoneByVSwitches(ctx context.Context, toBeDeleted []*
 		DoIf(c.hasNatGateway()), Timeout(defaultLongTimeout), Dependencies(dependencies...))
 
 	for _, vsw := range toBeDeleted {
+		if c.isExistingVSwitch(vsw.VSwitchId) {
+			// only the resources of the zone are deleted, but never the existing vswitch provided by the user
+			continue
+		}
 		c.AddTask(g, "delete vswitch resource "+getZoneName(vsw),
 			c.deleteVSwitch(vsw),
 			Timeout(defaultTimeout), Dependencies(deleteNatGateway))
@@ -476,27 +691,29 @@ func (c *FlowContext) deleteSNatEntryForZone(zoneName string) flow.TaskFn {
 func (c *FlowContext) deleteEipAssociation(zoneName string) flow.TaskFn {
 	return func(ctx context.Context) error {
 		log := c.LogFromContext(ctx)
-		zone := c.getZoneConfig(zoneName)
 		child := c.getZoneChild(zoneName)
-		eipId := child.Get(IdentifierZoneNATGWElasticIP)
-		if eipId == nil && zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil {
-			eipId = zone.NatGateway.EIPAllocationID
-		}
-		if eipId == nil {
-			return nil
+		eipIds := sets.New[string]()
+		for _, index := range recordedElasticIPIndexes(child, IdentifierZoneNATGWElasticIP) {
+			idKey, _ := elasticIPKeys(index)
+			eipIds.Insert(*child.Get(idKey))
 		}
-		log.Info("delete eip association", "eipId", *eipId)
-		eip, err := c.actor.GetEIP(ctx, *eipId)
-		if err != nil {
-			return err
-		}
-		if eip == nil {
-			return nil
+		if zone := c.getZoneConfig(zoneName); zone != nil {
+			eipIds.Insert(helper.GetEIPAllocationIDs(zone.NatGateway)...)
 		}
-		if *eip.Status == "InUse" {
-			if err := c.actor.UnAssociateEIP(ctx, eip); err != nil {
+		for _, eipId := range sets.List(eipIds) {
+			log.Info("delete eip association", "eipId", eipId)
+			eip, err := c.actor.GetEIP(ctx, eipId)
+			if err != nil {
 				return err
 			}
+			if eip == nil {
+				continue
+			}
+			if *eip.Status == "InUse" {
+				if err := c.actor.UnAssociateEIP(ctx, eip); err != nil {
+					return err
+				}
+			}
 		}
 		return nil
 	}
@@ -505,27 +722,38 @@ func (c *FlowContext) deleteEipAssociation(zoneName string) flow.TaskFn {
 func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
 	return func(ctx context.Context) error {
 		child := c.getZoneChild(zoneName)
-		if child.IsAlreadyDeleted(IdentifierZoneNATGWElasticIP) {
-			return nil
-		}
-		zoneSuffix := c.getZoneSuffix(zoneName)
-		eipSuffix := fmt.Sprintf("eip-natgw-%s", zoneSuffix)
-		tags := c.commonTagsWithSuffix(eipSuffix)
-		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGWElasticIP), tags, c.actor.GetEIP, c.actor.FindEIPsByTags)
-		if err != nil {
-			return err
-		}
-		if current != nil {
-			log := c.LogFromContext(ctx)
-			log.Info("deleting...", "AllocationId", current.EipId)
-			waiter := informOnWaiting(log, 5*time.Second, "still deleting...", "AllocationId", current.EipId)
-			err = c.actor.DeleteEIP(ctx, current.EipId)
-			waiter.Done(err)
+		var natGateway *alicloud.NatGatewayConfig
+		if zone := c.getZoneConfig(zoneName); zone != nil {
+			natGateway = zone.NatGateway
+		}
+		// the first managed elastic IP is always looked up by its tags, as it may exist from an earlier configuration
+		indexes := sets.New(0)
+		for i := range helper.GetManagedEIPCount(natGateway) {
+			indexes.Insert(i)
+		}
+		indexes.Insert(recordedElasticIPIndexes(child, IdentifierZoneNATGWElasticIP)...)
+		for _, index := range sets.List(indexes) {
+			idKey, _ := elasticIPKeys(index)
+			if child.IsAlreadyDeleted(idKey) {
+				continue
+			}
+			tags := c.commonTagsWithSuffix(c.elasticIPSuffix(zoneName, index))
+			current, err := findExisting(ctx, child.Get(idKey), tags, c.actor.GetEIP, c.actor.FindEIPsByTags)
 			if err != nil {
 				return err
 			}
+			if current != nil {
+				log := c.LogFromContext(ctx)
+				log.Info("deleting...", "AllocationId", current.EipId)
+				waiter := informOnWaiting(log, 5*time.Second, "still deleting...", "AllocationId", current.EipId)
+				err = c.actor.DeleteEIP(ctx, current.EipId)
+				waiter.Done(err)
+				if err != nil {
+					return err
+				}
+			}
+			child.SetAsDeleted(idKey)
 		}
-		child.SetAsDeleted(IdentifierZoneNATGWElasticIP)
 		return nil
 	}
 }
@@ -622,3 +850,27 @@ func (c *FlowContext) getZoneConfig(zoneName string) *alicloud.Zone {
 func (c *FlowContext) getZoneChild(zoneName string) Whiteboard {
 	return c.state.GetChild(ChildIdZones).GetChild(zoneName)
 }
+
+// getZoneVSwitches returns the vswitches of the configured zones keyed by the zone names.
+func (c *FlowContext) getZoneVSwitches() (map[string]string, error) {
+	zoneVSwitches := map[string]string{}
+	for _, zone := range c.config.Networks.Zones {
+		vswitchId := c.getZoneChild(zone.Name).Get(IdentifierZoneVSwitch)
+		if vswitchId == nil {
+			return nil, fmt.Errorf("missing vswitch of zone %s", zone.Name)
+		}
+		zoneVSwitches[zone.Name] = *vswitchId
+	}
+	return zoneVSwitches, nil
+}
+
+// getMissingZoneMappings returns the zone mappings of the given desired ones, which are not in the current ones.
+func getMissingZoneMappings(current, desired map[string]string) map[string]string {
+	missing := map[string]string{}
+	for zoneName, vswitchId := range desired {
+		if _, ok := current[zoneName]; !ok {
+			missing[zoneName] = vswitchId
+		}
+	}
+	return missing
+}
@@ -11,6 +11,7 @@ import (
 
 	extensioncontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/utils/ptr"
@@ -47,17 +48,17 @@ func (a *actuator) ensureImagesForShootProviderAccount(ctx context.Context, log
 		return nil, err
 	}
 
-	shootAlicloudECSClient, err := a.newClientFactory.NewECSClient(infra.Spec.Region, shootCloudProviderCredentials.AccessKeyID, shootCloudProviderCredentials.AccessKeySecret)
+	shootAlicloudECSClient, err := a.newClientFactory.NewECSClient(infra.Spec.Region, shootCloudProviderCredentials)
 	if err != nil {
 		return nil, err
 	}
 
-	shootAlicloudROSClient, err := a.newClientFactory.NewROSClient(infra.Spec.Region, shootCloudProviderCredentials.AccessKeyID, shootCloudProviderCredentials.AccessKeySecret)
+	shootAlicloudROSClient, err := a.newClientFactory.NewROSClient(infra.Spec.Region, shootCloudProviderCredentials)
 	if err != nil {
 		return nil, err
 	}
 
-	shootAlicloudSTSClient, err := a.newClientFactory.NewSTSClient(infra.Spec.Region, shootCloudProviderCredentials.AccessKeyID, shootCloudProviderCredentials.AccessKeySecret)
+	shootAlicloudSTSClient, err := a.newClientFactory.NewSTSClient(infra.Spec.Region, shootCloudProviderCredentials)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +73,11 @@ func (a *actuator) ensureImagesForShootProviderAccount(ctx context.Context, log
 		return nil, err
 	}
 
+	controlPlaneConfig, err := helper.ControlPlaneConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Info("Preparing virtual machine images for Shoot's Alicloud account", "infrastructure", infra.Name)
 	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
 		var machineImage *apisalicloud.MachineImage
@@ -80,7 +86,12 @@ func (a *actuator) ensureImagesForShootProviderAccount(ctx context.Context, log
 			return nil, err
 		}
 		if useEncrytedDisk {
-			if machineImage, err = a.ensureEncryptedImageForShootProviderAccount(ctx, log, cloudProfileConfig, worker, infra, shootAlicloudROSClient, shootAlicloudECSClient, shootCloudProviderAccountID); err != nil {
+			workerConfig, err := a.decodeWorkerConfig(worker)
+			if err != nil {
+				return nil, err
+			}
+			kmsKeyID := helper.SystemDiskKMSKeyID(workerConfig, controlPlaneConfig)
+			if machineImage, err = a.ensureEncryptedImageForShootProviderAccount(ctx, log, cloudProfileConfig, worker, kmsKeyID, infra, shootAlicloudROSClient, shootAlicloudECSClient, shootCloudProviderAccountID); err != nil {
 				return nil, err
 			}
 		} else {
@@ -100,10 +111,12 @@ func (a *actuator) ensureEncryptedImageForShootProviderAccount(
 	log logr.Logger,
 	cloudProfileConfig *apisalicloud.CloudProfileConfig,
 	worker gardencorev1beta1.Worker,
+	kmsKeyID string,
 	infra *extensionsv1alpha1.Infrastructure,
 	shootROSClient alicloudclient.ROS,
 	shootECSClient alicloudclient.ECS,
 	shootCloudProviderAccountID string) (*apisalicloud.MachineImage, error) {
+	architecture := ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
 	infrastructureStatus := &apisalicloud.InfrastructureStatus{}
 	if infra.Status.ProviderStatus != nil {
 		if _, _, err := a.decoder.Decode(infra.Status.ProviderStatus.Raw, nil, infrastructureStatus); err != nil {
@@ -111,15 +124,15 @@ func (a *actuator) ensureEncryptedImageForShootProviderAccount(
 		}
 	}
 
-	if machineImage, err := helper.FindMachineImage(infrastructureStatus.MachineImages, worker.Machine.Image.Name, *worker.Machine.Image.Version, true); err == nil {
+	if machineImage, err := helper.FindMachineImage(infrastructureStatus.MachineImages, worker.Machine.Image.Name, *worker.Machine.Image.Version, true, kmsKeyID, architecture); err == nil {
 		return machineImage, nil
 	}
 
 	// Encrypted image is not found
 	// Find from cloud profile first, if not found then from status
-	imageID, err := helper.FindImageForRegionFromCloudProfile(cloudProfileConfig, worker.Machine.Image.Name, *worker.Machine.Image.Version, infra.Spec.Region)
+	imageID, err := helper.FindImageForRegionFromCloudProfile(cloudProfileConfig, worker.Machine.Image.Name, *worker.Machine.Image.Version, infra.Spec.Region, architecture)
 	if err != nil {
-		if machineImage, err := helper.FindMachineImage(infrastructureStatus.MachineImages, worker.Machine.Image.Name, *worker.Machine.Image.Version, false); err != nil {
+		if machineImage, err := helper.FindMachineImage(infrastructureStatus.MachineImages, worker.Machine.Image.Name, *worker.Machine.Image.Version, false, "", architecture); err != nil {
 			return nil, err
 		} else {
 			imageID = machineImage.ID
@@ -144,23 +157,31 @@ func (a *actuator) ensureEncryptedImageForShootProviderAccount(
 	// else {} it is private shared
 
 	// It may block 10 minutes
-	log.Info("Preparing encrypted image for shoot account", "name", worker.Machine.Image.Name, "version", *worker.Machine.Image.Version)
-	encryptor := common.NewImageEncryptor(shootROSClient, infra.Spec.Region, worker.Machine.Image.Name, *worker.Machine.Image.Version, imageID)
+	log.Info("Preparing encrypted image for shoot account", "name", worker.Machine.Image.Name, "version", *worker.Machine.Image.Version, "kmsKeyID", kmsKeyID)
+	// The stack name is derived from the image name, hence non-default architectures need a distinct one.
+	stackImageName := worker.Machine.Image.Name
+	if architecture != v1beta1constants.ArchitectureAMD64 {
+		stackImageName = fmt.Sprintf("%s-%s", stackImageName, architecture)
+	}
+	encryptor := common.NewImageEncryptor(shootROSClient, infra.Spec.Region, stackImageName, *worker.Machine.Image.Version, imageID, kmsKeyID)
 	encryptedImageID, err := encryptor.TryToGetEncryptedImageID(ctx, 15*time.Minute, 10*time.Second)
 	if err != nil {
 		return nil, err
 	}
 
 	return &apisalicloud.MachineImage{
-		Name:      worker.Machine.Image.Name,
-		Version:   *worker.Machine.Image.Version,
-		ID:        encryptedImageID,
-		Encrypted: ptr.To(true),
+		Name:         worker.Machine.Image.Name,
+		Version:      *worker.Machine.Image.Version,
+		ID:           encryptedImageID,
+		Encrypted:    ptr.To(true),
+		Architecture: ptr.To(architecture),
+		KMSKeyID:     kmsKeyIDOrNil(kmsKeyID),
 	}, nil
 }
 
 func (a *actuator) ensurePlainImageForShootProviderAccount(ctx context.Context, log logr.Logger, cloudProfileConfig *apisalicloud.CloudProfileConfig, worker gardencorev1beta1.Worker, infra *extensionsv1alpha1.Infrastructure, shootECSClient alicloudclient.ECS, shootCloudProviderAccountID string) (*apisalicloud.MachineImage, error) {
-	imageID, err := helper.FindImageForRegionFromCloudProfile(cloudProfileConfig, worker.Machine.Image.Name, *worker.Machine.Image.Version, infra.Spec.Region)
+	architecture := ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
+	imageID, err := helper.FindImageForRegionFromCloudProfile(cloudProfileConfig, worker.Machine.Image.Name, *worker.Machine.Image.Version, infra.Spec.Region, architecture)
 	if err != nil {
 		providerStatus := infra.Status.ProviderStatus
 		if providerStatus == nil {
@@ -170,7 +191,7 @@ func (a *actuator) ensurePlainImageForShootProviderAccount(ctx context.Context,
 		if _, _, err := a.decoder.Decode(providerStatus.Raw, nil, infrastructureStatus); err != nil {
 			return nil, fmt.Errorf("could not decode infrastructure status of infrastructure '%s': %w", client.ObjectKeyFromObject(infra), err)
 		}
-		if machineImage, err := helper.FindMachineImage(infrastructureStatus.MachineImages, worker.Machine.Image.Name, *worker.Machine.Image.Version, false); err != nil {
+		if machineImage, err := helper.FindMachineImage(infrastructureStatus.MachineImages, worker.Machine.Image.Name, *worker.Machine.Image.Version, false, "", architecture); err != nil {
 			return nil, err
 		} else {
 			imageID = machineImage.ID
@@ -182,9 +203,10 @@ func (a *actuator) ensurePlainImageForShootProviderAccount(ctx context.Context,
 	}
 
 	return &apisalicloud.MachineImage{
-		Name:    worker.Machine.Image.Name,
-		Version: *worker.Machine.Image.Version,
-		ID:      imageID,
+		Name:         worker.Machine.Image.Name,
+		Version:      *worker.Machine.Image.Version,
+		ID:           imageID,
+		Architecture: ptr.To(architecture),
 	}, nil
 }
 
@@ -211,3 +233,22 @@ func (a *actuator) makeImageVisibleForShoot(ctx context.Context, log logr.Logger
 
 	return a.alicloudECSClient.ShareImageToAccount(ctx, region, imageID, shootAccountID)
 }
+
+func (a *actuator) decodeWorkerConfig(worker gardencorev1beta1.Worker) (*apisalicloud.WorkerConfig, error) {
+	workerConfig := &apisalicloud.WorkerConfig{}
+	if worker.ProviderConfig == nil || worker.ProviderConfig.Raw == nil {
+		return workerConfig, nil
+	}
+
+	if _, _, err := a.decoder.Decode(worker.ProviderConfig.Raw, nil, workerConfig); err != nil {
+		return nil, fmt.Errorf("could not decode provider config of worker pool %q: %w", worker.Name, err)
+	}
+	return workerConfig, nil
+}
+
+func kmsKeyIDOrNil(kmsKeyID string) *string {
+	if kmsKeyID == "" {
+		return nil
+	}
+	return &kmsKeyID
+}
@@ -117,3 +117,10 @@ func hasFlowState(state *runtime.RawExtension) (bool, error) {
 
 	return false, nil
 }
+
+// GetPlanAnnotationValue returns the boolean value of the plan annotation. Returns false if the annotation was not found,
+// if it couldn't be converted to bool, or had a "false" value.
+func GetPlanAnnotationValue(o metav1.Object) bool {
+	v, err := strconv.ParseBool(o.GetAnnotations()[aliapi.AnnotationKeyPlan])
+	return err == nil && v
+}
@@ -225,7 +225,7 @@ func (t *TerraformReconciler) getInitializerValues(
 	config *alicloudv1alpha1.InfrastructureConfig,
 	credentials *alicloud.Credentials,
 ) (*InitializerValues, error) {
-	vpcClient, err := t.actuator.newClientFactory.NewVPCClient(infra.Spec.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	vpcClient, err := t.actuator.newClientFactory.NewVPCClient(infra.Spec.Region, credentials)
 	if err != nil {
 		return nil, err
 	}
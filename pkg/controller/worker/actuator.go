@@ -22,6 +22,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
 	api "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 )
@@ -32,6 +34,8 @@ type delegateFactory struct {
 	decoder      runtime.Decoder
 	restConfig   *rest.Config
 	scheme       *runtime.Scheme
+
+	clientFactory alicloudclient.ClientFactory
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
@@ -42,6 +46,8 @@ func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actu
 		decoder:      serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		restConfig:   mgr.GetConfig(),
 		scheme:       mgr.GetScheme(),
+
+		clientFactory: alicloudclient.NewClientFactory(),
 	}
 
 	return genericactuator.NewActuator(
@@ -77,6 +83,7 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 
 		seedChartApplier,
 		serverVersion.GitVersion,
+		d.clientFactory,
 
 		worker,
 		cluster,
@@ -90,6 +97,9 @@ type workerDelegate struct {
 
 	seedChartApplier gardener.ChartApplier
 	serverVersion    string
+	clientFactory    alicloudclient.ClientFactory
+	credentials      *alicloud.Credentials
+	ecsClient        alicloudclient.ECS
 
 	cloudProfileConfig *api.CloudProfileConfig
 	cluster            *extensionscontroller.Cluster
@@ -108,6 +118,7 @@ func NewWorkerDelegate(
 
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
+	clientFactory alicloudclient.ClientFactory,
 
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
@@ -123,6 +134,7 @@ func NewWorkerDelegate(
 
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
+		clientFactory:    clientFactory,
 
 		cloudProfileConfig: config,
 		cluster:            cluster,
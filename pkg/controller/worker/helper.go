@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +31,20 @@ func (w *workerDelegate) decodeWorkerProviderStatus() (*api.WorkerStatus, error)
 	return workerStatus, nil
 }
 
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*api.WorkerConfig, error) {
+	workerConfig := &api.WorkerConfig{}
+
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return workerConfig, nil
+	}
+
+	if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+		return nil, fmt.Errorf("could not decode provider config of worker pool %q: %w", pool.Name, err)
+	}
+
+	return workerConfig, nil
+}
+
 func (w *workerDelegate) updateWorkerProviderStatus(ctx context.Context, workerStatus *api.WorkerStatus) error {
 	var workerStatusV1alpha1 = &v1alpha1.WorkerStatus{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	api "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+)
+
+// maxTagResourceIDs is the maximum number of resource IDs of a single ListTagResources, TagResources or
+// UntagResources request.
+const maxTagResourceIDs = 50
+
+// userTagResourceTypes are the types of the resources of the worker pools which carry the user-defined tags.
+var userTagResourceTypes = []string{"instance", "disk"}
+
+// reconcileUserTags applies the user-defined tags of the infrastructure to the existing instances and disks of the
+// worker pools and removes the user-defined tags which have been applied before but are no longer desired. New
+// instances get the tags from their machine class, hence the nodes are not rolled if the tags change. The applied
+// tags are recorded in the worker provider status.
+func (w *workerDelegate) reconcileUserTags(ctx context.Context) error {
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return fmt.Errorf("unable to decode the worker provider status: %w", err)
+	}
+	infrastructureStatus := &api.InfrastructureStatus{}
+	if w.worker.Spec.InfrastructureProviderStatus != nil {
+		if _, _, err := w.decoder.Decode(w.worker.Spec.InfrastructureProviderStatus.Raw, nil, infrastructureStatus); err != nil {
+			return err
+		}
+	}
+
+	desired := infrastructureStatus.Tags
+	var removed []string
+	for key := range workerStatus.Tags {
+		if _, ok := desired[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	slices.Sort(removed)
+	if len(desired) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	ecsClient, err := w.getECSClient(ctx)
+	if err != nil {
+		return err
+	}
+	for _, resourceType := range userTagResourceTypes {
+		if err := w.reconcileResourceTags(ecsClient, resourceType, desired, removed); err != nil {
+			return err
+		}
+	}
+
+	if maps.Equal(workerStatus.Tags, desired) {
+		return nil
+	}
+	workerStatus.Tags = desired
+	if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+		return fmt.Errorf("unable to update worker provider status: %w", err)
+	}
+	return nil
+}
+
+// reconcileResourceTags adds the desired tags to and removes the given tag keys from all resources of the given type
+// carrying the cluster tag of the shoot, if necessary.
+func (w *workerDelegate) reconcileResourceTags(ecsClient alicloudclient.ECS, resourceType string, desired map[string]string, removed []string) error {
+	resourceTags, err := w.listClusterResourceTags(ecsClient, resourceType)
+	if err != nil {
+		return err
+	}
+
+	var toBeTagged, toBeUntagged []string
+	for _, id := range slices.Sorted(maps.Keys(resourceTags)) {
+		tags := resourceTags[id]
+		for key, value := range desired {
+			if current, ok := tags[key]; !ok || current != value {
+				toBeTagged = append(toBeTagged, id)
+				break
+			}
+		}
+		for _, key := range removed {
+			if _, ok := tags[key]; ok {
+				toBeUntagged = append(toBeUntagged, id)
+				break
+			}
+		}
+	}
+
+	for ids := range slices.Chunk(toBeTagged, maxTagResourceIDs) {
+		request := ecs.CreateTagResourcesRequest()
+		request.SetScheme("HTTPS")
+		request.RegionId = w.worker.Spec.Region
+		request.ResourceType = resourceType
+		request.ResourceId = &ids
+		var tags []ecs.TagResourcesTag
+		for _, key := range slices.Sorted(maps.Keys(desired)) {
+			tags = append(tags, ecs.TagResourcesTag{Key: key, Value: desired[key]})
+		}
+		request.Tag = &tags
+		if _, err := ecsClient.TagResources(request); err != nil {
+			return fmt.Errorf("failed to tag resources of type %s: %w", resourceType, err)
+		}
+	}
+	for ids := range slices.Chunk(toBeUntagged, maxTagResourceIDs) {
+		request := ecs.CreateUntagResourcesRequest()
+		request.SetScheme("HTTPS")
+		request.RegionId = w.worker.Spec.Region
+		request.ResourceType = resourceType
+		request.ResourceId = &ids
+		request.TagKey = &removed
+		if _, err := ecsClient.UntagResources(request); err != nil {
+			return fmt.Errorf("failed to untag resources of type %s: %w", resourceType, err)
+		}
+	}
+	return nil
+}
+
+// listClusterResourceTags returns the tags of all resources of the given type carrying the cluster tag of the shoot,
+// keyed by the IDs of the resources.
+func (w *workerDelegate) listClusterResourceTags(ecsClient alicloudclient.ECS, resourceType string) (map[string]map[string]string, error) {
+	var ids []string
+	clusterTag := []ecs.ListTagResourcesTag{{Key: fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace), Value: "1"}}
+	if err := w.listTagResources(ecsClient, resourceType, func(request *ecs.ListTagResourcesRequest) {
+		request.Tag = &clusterTag
+	}, func(tagResource ecs.TagResource) {
+		if !slices.Contains(ids, tagResource.ResourceId) {
+			ids = append(ids, tagResource.ResourceId)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	// the resources are listed again by their IDs, as the tag filter may restrict the tags which are returned
+	result := map[string]map[string]string{}
+	for chunk := range slices.Chunk(ids, maxTagResourceIDs) {
+		if err := w.listTagResources(ecsClient, resourceType, func(request *ecs.ListTagResourcesRequest) {
+			request.ResourceId = &chunk
+		}, func(tagResource ecs.TagResource) {
+			if result[tagResource.ResourceId] == nil {
+				result[tagResource.ResourceId] = map[string]string{}
+			}
+			result[tagResource.ResourceId][tagResource.TagKey] = tagResource.TagValue
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (w *workerDelegate) listTagResources(ecsClient alicloudclient.ECS, resourceType string, mutate func(*ecs.ListTagResourcesRequest), visit func(ecs.TagResource)) error {
+	nextToken := ""
+	for {
+		request := ecs.CreateListTagResourcesRequest()
+		request.SetScheme("HTTPS")
+		request.RegionId = w.worker.Spec.Region
+		request.ResourceType = resourceType
+		request.NextToken = nextToken
+		mutate(request)
+		response, err := ecsClient.ListTagResources(request)
+		if err != nil {
+			return fmt.Errorf("failed to list tags of resources of type %s: %w", resourceType, err)
+		}
+		for _, tagResource := range response.TagResources.TagResource {
+			visit(tagResource)
+		}
+		if response.NextToken == "" {
+			return nil
+		}
+		nextToken = response.NextToken
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+)
+
+// instanceTypeFallbackExpiry is the duration after which a worker pool returns from a fallback instance type to its
+// machine type in a zone.
+const instanceTypeFallbackExpiry = 24 * time.Hour
+
+// deployInstanceTypeFallbacks switches the worker pools to their next fallback instance type in all zones in which
+// machines could not be created because the current instance type is out of stock. Fallbacks which are no longer
+// configured for a worker pool are dropped, i.e. the worker pool returns to its machine type in that zone. Fallbacks
+// which are older than instanceTypeFallbackExpiry are reverted to the machine type of the worker pool to probe whether
+// it is available again. The revert is recorded as fallback to the machine type, so that only machines failing after
+// the revert switch the worker pool to its first fallback instance type again.
+func (w *workerDelegate) deployInstanceTypeFallbacks(ctx context.Context, workerStatus *api.WorkerStatus) error {
+	if w.worker.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var fallbacks []api.InstanceTypeFallback
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+		if len(workerConfig.FallbackInstanceTypes) == 0 {
+			continue
+		}
+
+		instanceTypes := append([]string{pool.MachineType}, workerConfig.FallbackInstanceTypes...)
+		for _, zone := range pool.Zones {
+			var (
+				index int
+				since time.Time
+			)
+			fallback := findInstanceTypeFallback(workerStatus.InstanceTypeFallbacks, pool.Name, zone)
+			if fallback != nil {
+				i := slices.Index(instanceTypes, fallback.InstanceType)
+				switch {
+				case i < 0:
+					fallback = nil
+				case time.Since(fallback.LastUpdateTime.Time) < instanceTypeFallbackExpiry:
+					index, since = i, fallback.LastUpdateTime.Time
+				case i > 0:
+					fallback = &api.InstanceTypeFallback{
+						PoolName:       pool.Name,
+						Zone:           zone,
+						InstanceType:   pool.MachineType,
+						LastUpdateTime: metav1.Now(),
+					}
+					since = fallback.LastUpdateTime.Time
+				default:
+					// the machine type has been probed successfully
+					fallback = nil
+				}
+			}
+
+			if index < len(instanceTypes)-1 {
+				depleted, err := w.isInstanceTypeDepleted(ctx, machineDeploymentName(w.worker.Namespace, pool.Name, zone), since)
+				if err != nil {
+					return err
+				}
+				if depleted {
+					index++
+					fallback = &api.InstanceTypeFallback{
+						PoolName:       pool.Name,
+						Zone:           zone,
+						InstanceType:   instanceTypes[index],
+						LastUpdateTime: metav1.Now(),
+					}
+				}
+			}
+
+			if fallback != nil {
+				fallbacks = append(fallbacks, *fallback)
+			}
+		}
+	}
+
+	if equality.Semantic.DeepEqual(fallbacks, workerStatus.InstanceTypeFallbacks) {
+		return nil
+	}
+
+	workerStatus.InstanceTypeFallbacks = fallbacks
+	if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+		return fmt.Errorf("unable to update worker provider status: %w", err)
+	}
+	return nil
+}
+
+// isInstanceTypeDepleted returns true if the creation of a machine of the given machine deployment failed after the
+// given time because the instance type is out of stock in the zone.
+func (w *workerDelegate) isInstanceTypeDepleted(ctx context.Context, deploymentName string, since time.Time) (bool, error) {
+	machines := &machinev1alpha1.MachineList{}
+	if err := w.client.List(ctx, machines, client.InNamespace(w.worker.Namespace), client.MatchingLabels{worker.LabelKeyMachineDeploymentName: deploymentName}); err != nil {
+		return false, fmt.Errorf("failed to list machines of machine deployment %q: %w", deploymentName, err)
+	}
+
+	for _, machine := range machines.Items {
+		lastOperation := machine.Status.LastOperation
+		if lastOperation.Type != machinev1alpha1.MachineOperationCreate || lastOperation.State != machinev1alpha1.MachineStateFailed {
+			continue
+		}
+		if !lastOperation.LastUpdateTime.After(since) {
+			continue
+		}
+		if lastOperation.ErrorCode == codes.ResourceExhausted.String() || helper.KnownCodes[gardencorev1beta1.ErrorInfraResourcesDepleted](lastOperation.Description) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// instanceTypeForZone returns the instance type used by the given worker pool in the given zone.
+func instanceTypeForZone(fallbacks []api.InstanceTypeFallback, pool, zone, machineType string) string {
+	if fallback := findInstanceTypeFallback(fallbacks, pool, zone); fallback != nil {
+		return fallback.InstanceType
+	}
+	return machineType
+}
+
+func findInstanceTypeFallback(fallbacks []api.InstanceTypeFallback, poolName, zone string) *api.InstanceTypeFallback {
+	for _, fallback := range fallbacks {
+		if fallback.PoolName == poolName && fallback.Zone == zone {
+			return &fallback
+		}
+	}
+	return nil
+}
+
+func machineDeploymentName(namespace, poolName, zone string) string {
+	return fmt.Sprintf("%s-%s-%s", namespace, poolName, zone)
+}
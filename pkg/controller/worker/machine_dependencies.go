@@ -6,19 +6,673 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	netutils "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	api "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 )
 
 // DeployMachineDependencies implements genericactuator.WorkerDelegate.
-func (w *workerDelegate) DeployMachineDependencies(_ context.Context) error {
-	return nil
+// It switches worker pools to their fallback instance types in zones that are out of stock and creates the deployment
+// sets and security groups of the worker pools. All of them are recorded in the worker provider status.
+func (w *workerDelegate) DeployMachineDependencies(ctx context.Context) error {
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return fmt.Errorf("unable to decode the worker provider status: %w", err)
+	}
+
+	if err := w.checkInstanceTypeArchitectures(ctx, workerStatus); err != nil {
+		return err
+	}
+
+	if err := w.deployInstanceTypeFallbacks(ctx, workerStatus); err != nil {
+		return err
+	}
+
+	if err := w.deployDeploymentSets(ctx, workerStatus); err != nil {
+		return err
+	}
+
+	return w.deploySecurityGroups(ctx, workerStatus)
 }
 
 // CleanupMachineDependencies implements genericactuator.WorkerDelegate.
-func (w *workerDelegate) CleanupMachineDependencies(_ context.Context) error {
+// It deletes all deployment sets and security groups recorded in the worker provider status that are no longer used by
+// any worker pool.
+func (w *workerDelegate) CleanupMachineDependencies(ctx context.Context) error {
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return fmt.Errorf("unable to decode the worker provider status: %w", err)
+	}
+
+	deploymentSetsModified, deploymentSetsErr := w.cleanupDeploymentSets(ctx, workerStatus)
+	securityGroupsModified, securityGroupsErr := w.cleanupSecurityGroups(ctx, workerStatus)
+
+	errs := []error{deploymentSetsErr, securityGroupsErr}
+	if deploymentSetsModified || securityGroupsModified {
+		if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+			errs = append(errs, fmt.Errorf("unable to update worker provider status: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// PreReconcileHook implements genericactuator.WorkerDelegate.
+func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
+	return w.DeployMachineDependencies(ctx)
+}
+
+// PostReconcileHook implements genericactuator.WorkerDelegate.
+func (w *workerDelegate) PostReconcileHook(ctx context.Context) error {
+	if err := w.CleanupMachineDependencies(ctx); err != nil {
+		return err
+	}
+	return w.reconcileUserTags(ctx)
+}
+
+func (w *workerDelegate) PreDeleteHook(_ context.Context) error { return nil }
+
+// PostDeleteHook implements genericactuator.WorkerDelegate.
+func (w *workerDelegate) PostDeleteHook(ctx context.Context) error {
+	return w.CleanupMachineDependencies(ctx)
+}
+
+func (w *workerDelegate) getCredentials(ctx context.Context) (*alicloud.Credentials, error) {
+	if w.credentials != nil {
+		return w.credentials, nil
+	}
+
+	credentials, err := alicloud.ReadCredentialsFromSecretRef(ctx, w.client, &w.worker.Spec.SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials: %w", err)
+	}
+	w.credentials = credentials
+	return w.credentials, nil
+}
+
+func (w *workerDelegate) getECSClient(ctx context.Context) (alicloudclient.ECS, error) {
+	if w.ecsClient != nil {
+		return w.ecsClient, nil
+	}
+
+	credentials, err := w.getCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	w.ecsClient, err = w.clientFactory.NewECSClient(w.worker.Spec.Region, credentials)
+	return w.ecsClient, err
+}
+
+// instanceTypeArchitectures maps the CPU architectures reported by ECS to the Gardener machine architectures.
+var instanceTypeArchitectures = map[string]string{
+	"X86": v1beta1constants.ArchitectureAMD64,
+	"ARM": v1beta1constants.ArchitectureARM64,
+}
+
+// maxDescribeInstanceTypes is the maximum number of instance types of a single DescribeInstanceTypes request.
+const maxDescribeInstanceTypes = 10
+
+// checkInstanceTypeArchitectures verifies that the CPU architecture of the instance types of every worker pool matches
+// the architecture of the pool, e.g. that Yitian (g8y) instance types are only used by arm64 pools. The architectures of
+// the instance types are recorded in the worker provider status, so that only instance types which are used for the
+// first time are described.
+func (w *workerDelegate) checkInstanceTypeArchitectures(ctx context.Context, workerStatus *api.WorkerStatus) error {
+	if w.worker.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var (
+		machineTypes     []string
+		poolMachineTypes = make(map[string][]string, len(w.worker.Spec.Pools))
+	)
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
+		for _, machineType := range append([]string{pool.MachineType}, workerConfig.FallbackInstanceTypes...) {
+			if machineType == "" {
+				continue
+			}
+			poolMachineTypes[pool.Name] = append(poolMachineTypes[pool.Name], machineType)
+			if !slices.Contains(machineTypes, machineType) {
+				machineTypes = append(machineTypes, machineType)
+			}
+		}
+	}
+
+	architectures := make(map[string]string, len(machineTypes))
+	var unknown []string
+	for _, machineType := range machineTypes {
+		if architecture, ok := workerStatus.InstanceTypeArchitectures[machineType]; ok {
+			architectures[machineType] = architecture
+		} else {
+			unknown = append(unknown, machineType)
+		}
+	}
+
+	if len(unknown) > 0 {
+		ecsClient, err := w.getECSClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		for instanceTypes := range slices.Chunk(unknown, maxDescribeInstanceTypes) {
+			request := ecs.CreateDescribeInstanceTypesRequest()
+			request.SetScheme("HTTPS")
+			request.InstanceTypes = &instanceTypes
+			response, err := ecsClient.DescribeInstanceTypes(request)
+			if err != nil {
+				return fmt.Errorf("failed to describe instance types: %w", err)
+			}
+			for _, instanceType := range response.InstanceTypes.InstanceType {
+				// unsupported architectures are recorded as well, so that the instance type is not described again
+				architectures[instanceType.InstanceTypeId] = instanceTypeArchitectures[instanceType.CpuArchitecture]
+			}
+		}
+	}
+
+	if len(architectures) == 0 {
+		architectures = nil
+	}
+	if !maps.Equal(architectures, workerStatus.InstanceTypeArchitectures) {
+		workerStatus.InstanceTypeArchitectures = architectures
+		if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+			return fmt.Errorf("unable to update worker provider status: %w", err)
+		}
+	}
+
+	for _, pool := range w.worker.Spec.Pools {
+		expected := ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
+		for _, machineType := range poolMachineTypes[pool.Name] {
+			if actual := architectures[machineType]; actual != "" && actual != expected {
+				return fmt.Errorf("instance type %q of worker pool %q has CPU architecture %q but the pool requires %q", machineType, pool.Name, actual, expected)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *workerDelegate) deployDeploymentSets(ctx context.Context, workerStatus *api.WorkerStatus) error {
+	wantedDeploymentSets, err := w.wantedDeploymentSets()
+	if err != nil {
+		return err
+	}
+
+	for _, deploymentSet := range wantedDeploymentSets {
+		if findDeploymentSet(workerStatus.DeploymentSets, deploymentSet.PoolName, deploymentSet.Zone, deploymentSet.Strategy) != nil {
+			continue
+		}
+
+		ecsClient, err := w.getECSClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		id, err := w.ensureDeploymentSet(ecsClient, deploymentSet)
+		if err != nil {
+			return fmt.Errorf("failed to ensure deployment set for worker pool %q in zone %q: %w", deploymentSet.PoolName, deploymentSet.Zone, err)
+		}
+
+		deploymentSet.ID = id
+		workerStatus.DeploymentSets = append(workerStatus.DeploymentSets, deploymentSet)
+		if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+			return fmt.Errorf("unable to update worker provider status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *workerDelegate) cleanupDeploymentSets(ctx context.Context, workerStatus *api.WorkerStatus) (bool, error) {
+	if len(workerStatus.DeploymentSets) == 0 {
+		return false, nil
+	}
+
+	wantedDeploymentSets, err := w.wantedDeploymentSets()
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		remaining []api.DeploymentSet
+		errs      []error
+	)
+	for _, deploymentSet := range workerStatus.DeploymentSets {
+		if findDeploymentSet(wantedDeploymentSets, deploymentSet.PoolName, deploymentSet.Zone, deploymentSet.Strategy) != nil {
+			remaining = append(remaining, deploymentSet)
+			continue
+		}
+
+		ecsClient, err := w.getECSClient(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if err := w.deleteDeploymentSet(ecsClient, deploymentSet.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete deployment set %q of worker pool %q in zone %q: %w", deploymentSet.ID, deploymentSet.PoolName, deploymentSet.Zone, err))
+			remaining = append(remaining, deploymentSet)
+		}
+	}
+
+	modified := len(remaining) != len(workerStatus.DeploymentSets)
+	workerStatus.DeploymentSets = remaining
+	return modified, errors.Join(errs...)
+}
+
+// wantedDeploymentSets returns the deployment sets that need to exist for the worker pools. It returns nothing if the
+// worker is being deleted.
+func (w *workerDelegate) wantedDeploymentSets() ([]api.DeploymentSet, error) {
+	if w.worker.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	var deploymentSets []api.DeploymentSet
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return nil, err
+		}
+
+		if workerConfig.Placement == nil || workerConfig.Placement.DeploymentSetStrategy == nil {
+			continue
+		}
+
+		for _, zone := range pool.Zones {
+			deploymentSets = append(deploymentSets, api.DeploymentSet{
+				PoolName: pool.Name,
+				Zone:     zone,
+				Strategy: *workerConfig.Placement.DeploymentSetStrategy,
+			})
+		}
+	}
+
+	return deploymentSets, nil
+}
+
+// ensureDeploymentSet returns the ID of the deployment set for the given worker pool and zone. An existing deployment
+// set with the expected name and strategy is adopted, otherwise a new one is created.
+func (w *workerDelegate) ensureDeploymentSet(ecsClient alicloudclient.ECS, deploymentSet api.DeploymentSet) (string, error) {
+	name := fmt.Sprintf("%s-%s-%s", w.worker.Namespace, deploymentSet.PoolName, deploymentSet.Zone)
+
+	describeRequest := ecs.CreateDescribeDeploymentSetsRequest()
+	describeRequest.SetScheme("HTTPS")
+	describeRequest.RegionId = w.worker.Spec.Region
+	describeRequest.DeploymentSetName = name
+	describeRequest.Strategy = string(deploymentSet.Strategy)
+	describeResponse, err := ecsClient.DescribeDeploymentSets(describeRequest)
+	if err != nil {
+		return "", err
+	}
+	for _, existing := range describeResponse.DeploymentSets.DeploymentSet {
+		if existing.DeploymentSetName == name && existing.Strategy == string(deploymentSet.Strategy) {
+			return existing.DeploymentSetId, nil
+		}
+	}
+
+	createRequest := ecs.CreateCreateDeploymentSetRequest()
+	createRequest.SetScheme("HTTPS")
+	createRequest.RegionId = w.worker.Spec.Region
+	createRequest.DeploymentSetName = name
+	createRequest.Strategy = string(deploymentSet.Strategy)
+	createRequest.Description = fmt.Sprintf("Deployment set of worker pool %s in zone %s of cluster %s", deploymentSet.PoolName, deploymentSet.Zone, w.worker.Namespace)
+	createResponse, err := ecsClient.CreateDeploymentSet(createRequest)
+	if err != nil {
+		return "", err
+	}
+
+	return createResponse.DeploymentSetId, nil
+}
+
+// deleteDeploymentSet deletes the deployment set with the given ID if it still exists.
+func (w *workerDelegate) deleteDeploymentSet(ecsClient alicloudclient.ECS, id string) error {
+	describeRequest := ecs.CreateDescribeDeploymentSetsRequest()
+	describeRequest.SetScheme("HTTPS")
+	describeRequest.RegionId = w.worker.Spec.Region
+	describeRequest.DeploymentSetIds = fmt.Sprintf("[%q]", id)
+	describeResponse, err := ecsClient.DescribeDeploymentSets(describeRequest)
+	if err != nil {
+		return err
+	}
+	if len(describeResponse.DeploymentSets.DeploymentSet) == 0 {
+		return nil
+	}
+
+	deleteRequest := ecs.CreateDeleteDeploymentSetRequest()
+	deleteRequest.SetScheme("HTTPS")
+	deleteRequest.RegionId = w.worker.Spec.Region
+	deleteRequest.DeploymentSetId = id
+	_, err = ecsClient.DeleteDeploymentSet(deleteRequest)
+	return err
+}
+
+func findDeploymentSet(deploymentSets []api.DeploymentSet, poolName, zone string, strategy api.DeploymentSetStrategy) *api.DeploymentSet {
+	for _, deploymentSet := range deploymentSets {
+		if deploymentSet.PoolName == poolName && deploymentSet.Zone == zone && deploymentSet.Strategy == strategy {
+			return &deploymentSet
+		}
+	}
+	return nil
+}
+
+func (w *workerDelegate) deploySecurityGroups(ctx context.Context, workerStatus *api.WorkerStatus) error {
+	wantedSecurityGroupRules, err := w.wantedSecurityGroupRules()
+	if err != nil {
+		return err
+	}
+	if len(wantedSecurityGroupRules) == 0 {
+		return nil
+	}
+
+	infrastructureStatus := &api.InfrastructureStatus{}
+	if _, _, err := w.decoder.Decode(w.worker.Spec.InfrastructureProviderStatus.Raw, nil, infrastructureStatus); err != nil {
+		return err
+	}
+
+	ecsClient, err := w.getECSClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range w.worker.Spec.Pools {
+		rules, ok := wantedSecurityGroupRules[pool.Name]
+		if !ok {
+			continue
+		}
+
+		securityGroup := findPoolSecurityGroup(workerStatus.SecurityGroups, pool.Name)
+		if securityGroup == nil {
+			workerConfig, err := w.decodeWorkerConfig(pool)
+			if err != nil {
+				return err
+			}
+
+			id, err := w.ensureSecurityGroup(ecsClient, infrastructureStatus.VPC.ID, pool.Name, helper.WorkerResourceGroupID(workerConfig, infrastructureStatus))
+			if err != nil {
+				return fmt.Errorf("failed to ensure security group for worker pool %q: %w", pool.Name, err)
+			}
+
+			securityGroup = &api.PoolSecurityGroup{PoolName: pool.Name, ID: id}
+			workerStatus.SecurityGroups = append(workerStatus.SecurityGroups, *securityGroup)
+			if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+				return fmt.Errorf("unable to update worker provider status: %w", err)
+			}
+		}
+
+		if err := w.reconcileSecurityGroupRules(ecsClient, securityGroup.ID, rules); err != nil {
+			return fmt.Errorf("failed to reconcile rules of security group %q of worker pool %q: %w", securityGroup.ID, pool.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *workerDelegate) cleanupSecurityGroups(ctx context.Context, workerStatus *api.WorkerStatus) (bool, error) {
+	if len(workerStatus.SecurityGroups) == 0 {
+		return false, nil
+	}
+
+	wantedSecurityGroupRules, err := w.wantedSecurityGroupRules()
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		remaining []api.PoolSecurityGroup
+		errs      []error
+	)
+	for _, securityGroup := range workerStatus.SecurityGroups {
+		if _, ok := wantedSecurityGroupRules[securityGroup.PoolName]; ok {
+			remaining = append(remaining, securityGroup)
+			continue
+		}
+
+		ecsClient, err := w.getECSClient(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if err := w.deleteSecurityGroup(ecsClient, securityGroup.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete security group %q of worker pool %q: %w", securityGroup.ID, securityGroup.PoolName, err))
+			remaining = append(remaining, securityGroup)
+		}
+	}
+
+	modified := len(remaining) != len(workerStatus.SecurityGroups)
+	workerStatus.SecurityGroups = remaining
+	return modified, errors.Join(errs...)
+}
+
+// wantedSecurityGroupRules returns the security group rules of all worker pools that need a security group. It returns
+// nothing if the worker is being deleted.
+func (w *workerDelegate) wantedSecurityGroupRules() (map[string][]api.SecurityGroupRule, error) {
+	if w.worker.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	rules := map[string][]api.SecurityGroupRule{}
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(workerConfig.SecurityGroupRules) > 0 {
+			rules[pool.Name] = workerConfig.SecurityGroupRules
+		}
+	}
+
+	return rules, nil
+}
+
+// ensureSecurityGroup returns the ID of the security group for the given worker pool. An existing security group with
+// the expected name is adopted, otherwise a new one is created in the given resource group.
+func (w *workerDelegate) ensureSecurityGroup(ecsClient alicloudclient.ECS, vpcID, poolName, resourceGroupID string) (string, error) {
+	name := fmt.Sprintf("%s-%s-sg", w.worker.Namespace, poolName)
+
+	describeRequest := ecs.CreateDescribeSecurityGroupsRequest()
+	describeRequest.SetScheme("HTTPS")
+	describeRequest.RegionId = w.worker.Spec.Region
+	describeRequest.VpcId = vpcID
+	describeRequest.SecurityGroupName = name
+	describeResponse, err := ecsClient.DescribeSecurityGroups(describeRequest)
+	if err != nil {
+		return "", err
+	}
+	for _, existing := range describeResponse.SecurityGroups.SecurityGroup {
+		if existing.SecurityGroupName == name {
+			return existing.SecurityGroupId, nil
+		}
+	}
+
+	createRequest := ecs.CreateCreateSecurityGroupRequest()
+	createRequest.SetScheme("HTTPS")
+	createRequest.RegionId = w.worker.Spec.Region
+	createRequest.VpcId = vpcID
+	createRequest.SecurityGroupName = name
+	createRequest.Description = fmt.Sprintf("Security group of worker pool %s of cluster %s", poolName, w.worker.Namespace)
+	createRequest.ResourceGroupId = resourceGroupID
+	createResponse, err := ecsClient.CreateSecurityGroup(createRequest)
+	if err != nil {
+		return "", err
+	}
+
+	return createResponse.SecurityGroupId, nil
+}
+
+// reconcileSecurityGroupRules revokes all rules of the given security group which are not desired and authorizes the
+// missing ones.
+func (w *workerDelegate) reconcileSecurityGroupRules(ecsClient alicloudclient.ECS, securityGroupID string, rules []api.SecurityGroupRule) error {
+	describeRequest := ecs.CreateDescribeSecurityGroupAttributeRequest()
+	describeRequest.SetScheme("HTTPS")
+	describeRequest.RegionId = w.worker.Spec.Region
+	describeRequest.SecurityGroupId = securityGroupID
+	describeRequest.Direction = "all"
+	describeResponse, err := ecsClient.DescribeSecurityGroupAttribute(describeRequest)
+	if err != nil {
+		return err
+	}
+
+	desired := map[string]api.SecurityGroupRule{}
+	for _, rule := range rules {
+		desired[securityGroupRuleKey(string(rule.Direction), rule.Protocol, rule.PortRange, rule.CIDR,
+			string(ptr.Deref(rule.Policy, api.SecurityGroupRulePolicyAccept)), strconv.Itoa(int(ptr.Deref(rule.Priority, 1))))] = rule
+	}
+
+	for _, permission := range describeResponse.Permissions.Permission {
+		cidr := permission.SourceCidrIp + permission.Ipv6SourceCidrIp
+		if permission.Direction == string(api.SecurityGroupRuleDirectionEgress) {
+			cidr = permission.DestCidrIp + permission.Ipv6DestCidrIp
+		}
+		key := securityGroupRuleKey(permission.Direction, permission.IpProtocol, permission.PortRange, cidr, permission.Policy, permission.Priority)
+		if _, ok := desired[key]; ok {
+			delete(desired, key)
+			continue
+		}
+
+		if err := w.revokeSecurityGroupRule(ecsClient, securityGroupID, permission); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range desired {
+		if err := w.authorizeSecurityGroupRule(ecsClient, securityGroupID, rule); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (w *workerDelegate) PreReconcileHook(_ context.Context) error  { return nil }
-func (w *workerDelegate) PostReconcileHook(_ context.Context) error { return nil }
-func (w *workerDelegate) PreDeleteHook(_ context.Context) error     { return nil }
-func (w *workerDelegate) PostDeleteHook(_ context.Context) error    { return nil }
+func (w *workerDelegate) authorizeSecurityGroupRule(ecsClient alicloudclient.ECS, securityGroupID string, rule api.SecurityGroupRule) error {
+	var (
+		policy   = string(ptr.Deref(rule.Policy, api.SecurityGroupRulePolicyAccept))
+		priority = strconv.Itoa(int(ptr.Deref(rule.Priority, 1)))
+		ipv6     = netutils.IsIPv6CIDRString(rule.CIDR)
+	)
+
+	if rule.Direction == api.SecurityGroupRuleDirectionEgress {
+		permission := ecs.AuthorizeSecurityGroupEgressPermissions{
+			Policy:     policy,
+			Priority:   priority,
+			IpProtocol: rule.Protocol,
+			PortRange:  rule.PortRange,
+		}
+		if ipv6 {
+			permission.Ipv6DestCidrIp = rule.CIDR
+		} else {
+			permission.DestCidrIp = rule.CIDR
+		}
+		request := ecs.CreateAuthorizeSecurityGroupEgressRequest()
+		request.SetScheme("HTTPS")
+		request.RegionId = w.worker.Spec.Region
+		request.SecurityGroupId = securityGroupID
+		request.Permissions = &[]ecs.AuthorizeSecurityGroupEgressPermissions{permission}
+		_, err := ecsClient.AuthorizeSecurityGroupEgress(request)
+		return err
+	}
+
+	permission := ecs.AuthorizeSecurityGroupPermissions{
+		Policy:     policy,
+		Priority:   priority,
+		IpProtocol: rule.Protocol,
+		PortRange:  rule.PortRange,
+	}
+	if ipv6 {
+		permission.Ipv6SourceCidrIp = rule.CIDR
+	} else {
+		permission.SourceCidrIp = rule.CIDR
+	}
+	request := ecs.CreateAuthorizeSecurityGroupRequest()
+	request.SetScheme("HTTPS")
+	request.RegionId = w.worker.Spec.Region
+	request.SecurityGroupId = securityGroupID
+	request.Permissions = &[]ecs.AuthorizeSecurityGroupPermissions{permission}
+	_, err := ecsClient.AuthorizeSecurityGroup(request)
+	return err
+}
+
+func (w *workerDelegate) revokeSecurityGroupRule(ecsClient alicloudclient.ECS, securityGroupID string, permission ecs.Permission) error {
+	if permission.Direction == string(api.SecurityGroupRuleDirectionEgress) {
+		request := ecs.CreateRevokeSecurityGroupEgressRequest()
+		request.SetScheme("HTTPS")
+		request.RegionId = w.worker.Spec.Region
+		request.SecurityGroupId = securityGroupID
+		request.SecurityGroupRuleId = &[]string{permission.SecurityGroupRuleId}
+		_, err := ecsClient.RevokeSecurityGroupEgress(request)
+		return err
+	}
+
+	request := ecs.CreateRevokeSecurityGroupRequest()
+	request.SetScheme("HTTPS")
+	request.RegionId = w.worker.Spec.Region
+	request.SecurityGroupId = securityGroupID
+	request.SecurityGroupRuleId = &[]string{permission.SecurityGroupRuleId}
+	_, err := ecsClient.RevokeSecurityGroup(request)
+	return err
+}
+
+// deleteSecurityGroup deletes the security group with the given ID if it still exists. Instances of the rolled out
+// machines may still be terminating, hence it fails as long as any instance is attached to the security group and the
+// deletion is retried with the next reconciliation.
+func (w *workerDelegate) deleteSecurityGroup(ecsClient alicloudclient.ECS, id string) error {
+	describeRequest := ecs.CreateDescribeSecurityGroupsRequest()
+	describeRequest.SetScheme("HTTPS")
+	describeRequest.RegionId = w.worker.Spec.Region
+	describeRequest.SecurityGroupId = id
+	describeResponse, err := ecsClient.DescribeSecurityGroups(describeRequest)
+	if err != nil {
+		return err
+	}
+	if len(describeResponse.SecurityGroups.SecurityGroup) == 0 {
+		return nil
+	}
+
+	instancesRequest := ecs.CreateDescribeInstancesRequest()
+	instancesRequest.SetScheme("HTTPS")
+	instancesRequest.RegionId = w.worker.Spec.Region
+	instancesRequest.SecurityGroupId = id
+	instancesResponse, err := ecsClient.DescribeInstances(instancesRequest)
+	if err != nil {
+		return err
+	}
+	if instancesResponse.TotalCount > 0 {
+		return fmt.Errorf("security group is still attached to %d instance(s)", instancesResponse.TotalCount)
+	}
+
+	deleteRequest := ecs.CreateDeleteSecurityGroupRequest()
+	deleteRequest.SetScheme("HTTPS")
+	deleteRequest.RegionId = w.worker.Spec.Region
+	deleteRequest.SecurityGroupId = id
+	_, err = ecsClient.DeleteSecurityGroup(deleteRequest)
+	return err
+}
+
+func findPoolSecurityGroup(securityGroups []api.PoolSecurityGroup, poolName string) *api.PoolSecurityGroup {
+	for _, securityGroup := range securityGroups {
+		if securityGroup.PoolName == poolName {
+			return &securityGroup
+		}
+	}
+	return nil
+}
+
+func securityGroupRuleKey(direction, protocol, portRange, cidr, policy, priority string) string {
+	return strings.ToLower(strings.Join([]string{direction, protocol, portRange, cidr, policy, priority}, "|"))
+}
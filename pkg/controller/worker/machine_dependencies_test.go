@@ -0,0 +1,882 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	api "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/worker"
+	mockalicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
+)
+
+var _ = Describe("MachineDependencies", func() {
+	const (
+		namespace       = "shoot--foo--bar"
+		region          = "cn-beijing"
+		zone1           = "cn-beijing-a"
+		zone2           = "cn-beijing-b"
+		accessKeyID     = "access-key-id"
+		accessKeySecret = "access-key-secret"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl          *gomock.Controller
+		c             *mockclient.MockClient
+		statusWriter  *mockclient.MockStatusWriter
+		clientFactory *mockalicloudclient.MockClientFactory
+		ecsClient     *mockalicloudclient.MockECS
+
+		scheme  *runtime.Scheme
+		decoder runtime.Decoder
+		w       *extensionsv1alpha1.Worker
+
+		workerDelegate genericactuator.WorkerDelegate
+		patchedStatus  *apiv1alpha1.WorkerStatus
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		c = mockclient.NewMockClient(ctrl)
+		statusWriter = mockclient.NewMockStatusWriter(ctrl)
+		clientFactory = mockalicloudclient.NewMockClientFactory(ctrl)
+		ecsClient = mockalicloudclient.NewMockECS(ctrl)
+
+		scheme = runtime.NewScheme()
+		Expect(api.AddToScheme(scheme)).To(Succeed())
+		Expect(apiv1alpha1.AddToScheme(scheme)).To(Succeed())
+		decoder = serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder()
+
+		w = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "worker",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				SecretRef: corev1.SecretReference{
+					Name:      "cloudprovider",
+					Namespace: namespace,
+				},
+				Region: region,
+				Pools: []extensionsv1alpha1.WorkerPool{
+					{
+						Name: "pool-1",
+						ProviderConfig: &runtime.RawExtension{
+							Raw: encode(&apiv1alpha1.WorkerConfig{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "WorkerConfig",
+								},
+								Placement: &apiv1alpha1.PlacementConfig{
+									DeploymentSetStrategy: ptr.To(apiv1alpha1.DeploymentSetStrategyAvailability),
+								},
+							}),
+						},
+						Zones: []string{zone1, zone2},
+					},
+					{
+						Name:  "pool-2",
+						Zones: []string{zone1},
+					},
+				},
+			},
+		}
+
+		patchedStatus = nil
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newWorkerDelegate := func() {
+		var err error
+		workerDelegate, err = NewWorkerDelegate(c, decoder, scheme, nil, "", clientFactory, w, &extensionscontroller.Cluster{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	setWorkerStatus := func(deploymentSets ...apiv1alpha1.DeploymentSet) {
+		w.Status.ProviderStatus = &runtime.RawExtension{
+			Raw: encode(&apiv1alpha1.WorkerStatus{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerStatus",
+				},
+				DeploymentSets: deploymentSets,
+			}),
+		}
+	}
+
+	expectECSClient := func() {
+		c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "cloudprovider"}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+			func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+				secret.Data = map[string][]byte{
+					alicloud.AccessKeyID:     []byte(accessKeyID),
+					alicloud.AccessKeySecret: []byte(accessKeySecret),
+				}
+				return nil
+			},
+		)
+		clientFactory.EXPECT().NewECSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: accessKeySecret}).Return(ecsClient, nil)
+	}
+
+	expectStatusPatches := func(times int) {
+		c.EXPECT().Status().Return(statusWriter).Times(times)
+		statusWriter.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{}), gomock.Any()).DoAndReturn(
+			func(_ context.Context, obj *extensionsv1alpha1.Worker, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+				patchedStatus = obj.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				return nil
+			},
+		).Times(times)
+	}
+
+	describeDeploymentSetsResponse := func(deploymentSets ...ecs.DeploymentSet) *ecs.DescribeDeploymentSetsResponse {
+		response := ecs.CreateDescribeDeploymentSetsResponse()
+		response.DeploymentSets.DeploymentSet = deploymentSets
+		return response
+	}
+
+	Describe("#PreReconcileHook", func() {
+		It("should create the missing deployment sets and adopt existing ones", func() {
+			setWorkerStatus(apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-1", Strategy: apiv1alpha1.DeploymentSetStrategyLowLatency})
+			newWorkerDelegate()
+
+			expectECSClient()
+			gomock.InOrder(
+				ecsClient.EXPECT().DescribeDeploymentSets(gomock.Any()).DoAndReturn(func(request *ecs.DescribeDeploymentSetsRequest) (*ecs.DescribeDeploymentSetsResponse, error) {
+					Expect(request.DeploymentSetName).To(Equal(namespace + "-pool-1-" + zone1))
+					Expect(request.Strategy).To(Equal("Availability"))
+					return describeDeploymentSetsResponse(), nil
+				}),
+				ecsClient.EXPECT().CreateDeploymentSet(gomock.Any()).DoAndReturn(func(request *ecs.CreateDeploymentSetRequest) (*ecs.CreateDeploymentSetResponse, error) {
+					Expect(request.RegionId).To(Equal(region))
+					Expect(request.DeploymentSetName).To(Equal(namespace + "-pool-1-" + zone1))
+					Expect(request.Strategy).To(Equal("Availability"))
+					response := ecs.CreateCreateDeploymentSetResponse()
+					response.DeploymentSetId = "ds-2"
+					return response, nil
+				}),
+				ecsClient.EXPECT().DescribeDeploymentSets(gomock.Any()).Return(describeDeploymentSetsResponse(ecs.DeploymentSet{
+					DeploymentSetId:   "ds-3",
+					DeploymentSetName: namespace + "-pool-1-" + zone2,
+					Strategy:          "Availability",
+				}), nil),
+			)
+			expectStatusPatches(2)
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			Expect(patchedStatus.DeploymentSets).To(ConsistOf(
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-1", Strategy: apiv1alpha1.DeploymentSetStrategyLowLatency},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-2", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone2, ID: "ds-3", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+			))
+		})
+
+		It("should do nothing if all deployment sets exist", func() {
+			setWorkerStatus(
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-1", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone2, ID: "ds-2", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+			)
+			newWorkerDelegate()
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should do nothing if no worker pool uses a deployment set strategy", func() {
+			w.Spec.Pools = w.Spec.Pools[1:]
+			newWorkerDelegate()
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		Context("instance type architecture", func() {
+			BeforeEach(func() {
+				w.Spec.Pools = w.Spec.Pools[1:]
+				w.Spec.Pools[0].MachineType = "ecs.g8y.large"
+			})
+
+			describeInstanceTypes := func(cpuArchitecture string) {
+				ecsClient.EXPECT().DescribeInstanceTypes(gomock.Any()).DoAndReturn(func(request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
+					Expect(*request.InstanceTypes).To(ConsistOf("ecs.g8y.large"))
+					response := ecs.CreateDescribeInstanceTypesResponse()
+					response.InstanceTypes.InstanceType = []ecs.InstanceType{{InstanceTypeId: "ecs.g8y.large", CpuArchitecture: cpuArchitecture}}
+					return response, nil
+				})
+			}
+
+			It("should succeed if the instance type matches the pool architecture", func() {
+				w.Spec.Pools[0].Architecture = ptr.To("arm64")
+				newWorkerDelegate()
+
+				expectECSClient()
+				describeInstanceTypes("ARM")
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.InstanceTypeArchitectures).To(Equal(map[string]string{"ecs.g8y.large": "arm64"}))
+			})
+
+			It("should fail if an arm64 instance type is used by an amd64 pool", func() {
+				newWorkerDelegate()
+
+				expectECSClient()
+				describeInstanceTypes("ARM")
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(MatchError(ContainSubstring(`has CPU architecture "arm64" but the pool requires "amd64"`)))
+			})
+
+			It("should not describe instance types whose architecture has been recorded", func() {
+				w.Status.ProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerStatus",
+						},
+						InstanceTypeArchitectures: map[string]string{"ecs.g8y.large": "arm64", "ecs.g7.large": "amd64"},
+					}),
+				}
+				newWorkerDelegate()
+
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(MatchError(ContainSubstring(`has CPU architecture "arm64" but the pool requires "amd64"`)))
+				Expect(patchedStatus.InstanceTypeArchitectures).To(Equal(map[string]string{"ecs.g8y.large": "arm64"}))
+			})
+
+			It("should describe new instance types in batches", func() {
+				var fallbacks []string
+				for i := range 11 {
+					fallbacks = append(fallbacks, fmt.Sprintf("ecs.g7.size-%d", i))
+				}
+				w.Spec.Pools[0].MachineType = "ecs.g7.large"
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						FallbackInstanceTypes: fallbacks,
+					}),
+				}
+				newWorkerDelegate()
+
+				expectECSClient()
+				var described []string
+				ecsClient.EXPECT().DescribeInstanceTypes(gomock.Any()).DoAndReturn(func(request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
+					Expect(len(*request.InstanceTypes)).To(BeNumerically("<=", 10))
+					response := ecs.CreateDescribeInstanceTypesResponse()
+					for _, instanceType := range *request.InstanceTypes {
+						described = append(described, instanceType)
+						response.InstanceTypes.InstanceType = append(response.InstanceTypes.InstanceType, ecs.InstanceType{InstanceTypeId: instanceType, CpuArchitecture: "X86"})
+					}
+					return response, nil
+				}).Times(2)
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace), client.MatchingLabels{"name": namespace + "-pool-2-" + zone1})
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(described).To(ConsistOf(append(fallbacks, "ecs.g7.large")))
+				Expect(patchedStatus.InstanceTypeArchitectures).To(HaveLen(12))
+			})
+		})
+	})
+
+	Describe("#PostReconcileHook", func() {
+		It("should delete the deployment sets which are no longer needed", func() {
+			setWorkerStatus(
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-1", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone2, ID: "ds-2", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-3", Strategy: apiv1alpha1.DeploymentSetStrategyLowLatency},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-old", Zone: zone1, ID: "ds-4", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+			)
+			newWorkerDelegate()
+
+			expectECSClient()
+			gomock.InOrder(
+				ecsClient.EXPECT().DescribeDeploymentSets(gomock.Any()).DoAndReturn(func(request *ecs.DescribeDeploymentSetsRequest) (*ecs.DescribeDeploymentSetsResponse, error) {
+					Expect(request.DeploymentSetIds).To(Equal(`["ds-3"]`))
+					return describeDeploymentSetsResponse(ecs.DeploymentSet{DeploymentSetId: "ds-3"}), nil
+				}),
+				ecsClient.EXPECT().DeleteDeploymentSet(gomock.Any()).DoAndReturn(func(request *ecs.DeleteDeploymentSetRequest) (*ecs.DeleteDeploymentSetResponse, error) {
+					Expect(request.DeploymentSetId).To(Equal("ds-3"))
+					return ecs.CreateDeleteDeploymentSetResponse(), nil
+				}),
+				ecsClient.EXPECT().DescribeDeploymentSets(gomock.Any()).Return(describeDeploymentSetsResponse(), nil),
+			)
+			expectStatusPatches(1)
+
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+			Expect(patchedStatus.DeploymentSets).To(ConsistOf(
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-1", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone2, ID: "ds-2", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+			))
+		})
+
+		It("should do nothing if no deployment sets have been created", func() {
+			newWorkerDelegate()
+
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		Context("user-defined tags", func() {
+			clusterTag := "kubernetes.io/cluster/" + namespace
+
+			setInfrastructureTags := func(tags map[string]string) {
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.InfrastructureStatus{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureStatus",
+						},
+						Tags: tags,
+					}),
+				}
+			}
+
+			setAppliedTags := func(tags map[string]string) {
+				w.Status.ProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerStatus",
+						},
+						Tags: tags,
+					}),
+				}
+			}
+
+			listTagResourcesResponse := func(resourceType string, tags map[string]map[string]string) *ecs.ListTagResourcesResponse {
+				response := ecs.CreateListTagResourcesResponse()
+				for id, resourceTags := range tags {
+					for key, value := range resourceTags {
+						response.TagResources.TagResource = append(response.TagResources.TagResource, ecs.TagResource{
+							ResourceType: resourceType,
+							ResourceId:   id,
+							TagKey:       key,
+							TagValue:     value,
+						})
+					}
+				}
+				return response
+			}
+
+			// expectListTagResources expects the resources of the given type to be listed by the cluster tag and by
+			// their IDs afterwards, if there are any.
+			expectListTagResources := func(resourceType string, tags map[string]map[string]string) {
+				times := 2
+				if len(tags) == 0 {
+					times = 1
+				}
+				ecsClient.EXPECT().ListTagResources(gomock.Any()).DoAndReturn(func(request *ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error) {
+					Expect(request.ResourceType).To(Equal(resourceType))
+					if request.Tag != nil {
+						Expect(*request.Tag).To(ConsistOf(ecs.ListTagResourcesTag{Key: clusterTag, Value: "1"}))
+						clusterTags := map[string]map[string]string{}
+						for id := range tags {
+							clusterTags[id] = map[string]string{clusterTag: "1"}
+						}
+						return listTagResourcesResponse(resourceType, clusterTags), nil
+					}
+					Expect(request.ResourceId).NotTo(BeNil())
+					result := map[string]map[string]string{}
+					for _, id := range *request.ResourceId {
+						result[id] = tags[id]
+					}
+					return listTagResourcesResponse(resourceType, result), nil
+				}).Times(times)
+			}
+
+			It("should add missing tags to the existing instances and disks and record them", func() {
+				setInfrastructureTags(map[string]string{"CostCenter": "4711"})
+				newWorkerDelegate()
+
+				expectECSClient()
+				expectListTagResources("instance", map[string]map[string]string{
+					"i-1": {clusterTag: "1"},
+					"i-2": {clusterTag: "1", "CostCenter": "4711"},
+				})
+				ecsClient.EXPECT().TagResources(gomock.Any()).DoAndReturn(func(request *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error) {
+					Expect(request.ResourceType).To(Equal("instance"))
+					Expect(*request.ResourceId).To(ConsistOf("i-1"))
+					Expect(*request.Tag).To(ConsistOf(ecs.TagResourcesTag{Key: "CostCenter", Value: "4711"}))
+					return ecs.CreateTagResourcesResponse(), nil
+				})
+				expectListTagResources("disk", map[string]map[string]string{
+					"d-1": {clusterTag: "1", "CostCenter": "0815"},
+				})
+				ecsClient.EXPECT().TagResources(gomock.Any()).DoAndReturn(func(request *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error) {
+					Expect(request.ResourceType).To(Equal("disk"))
+					Expect(*request.ResourceId).To(ConsistOf("d-1"))
+					return ecs.CreateTagResourcesResponse(), nil
+				})
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.Tags).To(Equal(map[string]string{"CostCenter": "4711"}))
+			})
+
+			It("should remove the tags which are no longer desired", func() {
+				setInfrastructureTags(map[string]string{"CostCenter": "4711"})
+				setAppliedTags(map[string]string{"CostCenter": "4711", "Team": "foo"})
+				newWorkerDelegate()
+
+				expectECSClient()
+				expectListTagResources("instance", map[string]map[string]string{
+					"i-1": {clusterTag: "1", "CostCenter": "4711", "Team": "foo"},
+					"i-2": {clusterTag: "1", "CostCenter": "4711", "Owner": "bar"},
+				})
+				ecsClient.EXPECT().UntagResources(gomock.Any()).DoAndReturn(func(request *ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error) {
+					Expect(request.ResourceType).To(Equal("instance"))
+					Expect(*request.ResourceId).To(ConsistOf("i-1"))
+					Expect(*request.TagKey).To(ConsistOf("Team"))
+					return ecs.CreateUntagResourcesResponse(), nil
+				})
+				expectListTagResources("disk", nil)
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.Tags).To(Equal(map[string]string{"CostCenter": "4711"}))
+			})
+
+			It("should not call the API if no tags are desired or have been applied", func() {
+				setInfrastructureTags(nil)
+				newWorkerDelegate()
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("#PostDeleteHook", func() {
+		It("should delete all deployment sets", func() {
+			w.DeletionTimestamp = ptr.To(metav1.Now())
+			setWorkerStatus(
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone1, ID: "ds-1", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+				apiv1alpha1.DeploymentSet{PoolName: "pool-1", Zone: zone2, ID: "ds-2", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+			)
+			newWorkerDelegate()
+
+			expectECSClient()
+			ecsClient.EXPECT().DescribeDeploymentSets(gomock.Any()).Return(describeDeploymentSetsResponse(ecs.DeploymentSet{DeploymentSetId: "ds"}), nil).Times(2)
+			ecsClient.EXPECT().DeleteDeploymentSet(gomock.Any()).Return(ecs.CreateDeleteDeploymentSetResponse(), nil).Times(2)
+			expectStatusPatches(1)
+
+			Expect(workerDelegate.PostDeleteHook(ctx)).To(Succeed())
+			Expect(patchedStatus.DeploymentSets).To(BeEmpty())
+		})
+	})
+
+	Context("instance type fallbacks", func() {
+		var (
+			switchTime  = metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			expiredTime = metav1.NewTime(time.Now().Add(-25 * time.Hour).Truncate(time.Second))
+		)
+
+		setInstanceTypeFallbacks := func(fallbacks ...apiv1alpha1.InstanceTypeFallback) {
+			w.Status.ProviderStatus = &runtime.RawExtension{
+				Raw: encode(&apiv1alpha1.WorkerStatus{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerStatus",
+					},
+					InstanceTypeFallbacks: fallbacks,
+				}),
+			}
+		}
+
+		expectMachines := func(machines ...machinev1alpha1.Machine) {
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace), client.MatchingLabels{"name": namespace + "-pool-1-" + zone1}).DoAndReturn(
+				func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+					list.Items = machines
+					return nil
+				},
+			)
+		}
+
+		failedMachine := func(description string, lastUpdateTime time.Time) machinev1alpha1.Machine {
+			return machinev1alpha1.Machine{
+				Status: machinev1alpha1.MachineStatus{
+					LastOperation: machinev1alpha1.LastOperation{
+						Description:    description,
+						LastUpdateTime: metav1.NewTime(lastUpdateTime),
+						State:          machinev1alpha1.MachineStateFailed,
+						Type:           machinev1alpha1.MachineOperationCreate,
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			w.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+				{
+					Name:        "pool-1",
+					MachineType: "ecs.g7.large",
+					ProviderConfig: &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							FallbackInstanceTypes: []string{"ecs.g6.large", "ecs.g5.large"},
+						}),
+					},
+					Zones: []string{zone1},
+				},
+			}
+
+			expectECSClient()
+			ecsClient.EXPECT().DescribeInstanceTypes(gomock.Any()).DoAndReturn(func(request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
+				Expect(*request.InstanceTypes).To(ConsistOf("ecs.g7.large", "ecs.g6.large", "ecs.g5.large"))
+				return ecs.CreateDescribeInstanceTypesResponse(), nil
+			})
+		})
+
+		Describe("#PreReconcileHook", func() {
+			It("should switch to the first fallback instance type if the machine type is out of stock", func() {
+				newWorkerDelegate()
+
+				expectMachines(
+					failedMachine("Cloud provider message - OperationDenied.NoStock: The requested resource is sold out in the specified zone", switchTime.Time),
+				)
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.InstanceTypeFallbacks).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"PoolName":     Equal("pool-1"),
+						"Zone":         Equal(zone1),
+						"InstanceType": Equal("ecs.g6.large"),
+					}),
+				))
+			})
+
+			It("should keep the current fallback instance type if no machine failed since the switch", func() {
+				setInstanceTypeFallbacks(apiv1alpha1.InstanceTypeFallback{PoolName: "pool-1", Zone: zone1, InstanceType: "ecs.g6.large", LastUpdateTime: switchTime})
+				newWorkerDelegate()
+
+				expectMachines(
+					failedMachine("out of stock", switchTime.Add(-time.Minute)),
+					failedMachine("InvalidParameter", switchTime.Add(time.Minute)),
+				)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should drop fallbacks which are no longer configured", func() {
+				setInstanceTypeFallbacks(apiv1alpha1.InstanceTypeFallback{PoolName: "pool-1", Zone: zone1, InstanceType: "ecs.g4.large", LastUpdateTime: switchTime})
+				newWorkerDelegate()
+
+				expectMachines()
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.InstanceTypeFallbacks).To(BeEmpty())
+			})
+
+			It("should revert an expired fallback to the machine type", func() {
+				setInstanceTypeFallbacks(apiv1alpha1.InstanceTypeFallback{PoolName: "pool-1", Zone: zone1, InstanceType: "ecs.g6.large", LastUpdateTime: expiredTime})
+				newWorkerDelegate()
+
+				expectMachines(
+					failedMachine("out of stock", expiredTime.Add(-time.Minute)),
+				)
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.InstanceTypeFallbacks).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"PoolName":       Equal("pool-1"),
+						"Zone":           Equal(zone1),
+						"InstanceType":   Equal("ecs.g7.large"),
+						"LastUpdateTime": WithTransform(func(t metav1.Time) time.Time { return t.Time }, BeTemporally("~", time.Now(), time.Minute)),
+					}),
+				))
+			})
+
+			It("should switch to the first fallback instance type again if the machine type is still out of stock after the revert", func() {
+				setInstanceTypeFallbacks(apiv1alpha1.InstanceTypeFallback{PoolName: "pool-1", Zone: zone1, InstanceType: "ecs.g7.large", LastUpdateTime: switchTime})
+				newWorkerDelegate()
+
+				expectMachines(
+					failedMachine("OperationDenied.NoStock", switchTime.Add(time.Minute)),
+				)
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.InstanceTypeFallbacks).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"PoolName":     Equal("pool-1"),
+						"Zone":         Equal(zone1),
+						"InstanceType": Equal("ecs.g6.large"),
+					}),
+				))
+			})
+
+			It("should drop an expired revert to the machine type", func() {
+				setInstanceTypeFallbacks(apiv1alpha1.InstanceTypeFallback{PoolName: "pool-1", Zone: zone1, InstanceType: "ecs.g7.large", LastUpdateTime: expiredTime})
+				newWorkerDelegate()
+
+				expectMachines()
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.InstanceTypeFallbacks).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("security groups", func() {
+		const vpcID = "vpc-1"
+
+		setSecurityGroups := func(securityGroups ...apiv1alpha1.PoolSecurityGroup) {
+			w.Status.ProviderStatus = &runtime.RawExtension{
+				Raw: encode(&apiv1alpha1.WorkerStatus{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerStatus",
+					},
+					SecurityGroups: securityGroups,
+				}),
+			}
+		}
+
+		describeSecurityGroupsResponse := func(securityGroups ...ecs.SecurityGroup) *ecs.DescribeSecurityGroupsResponse {
+			response := ecs.CreateDescribeSecurityGroupsResponse()
+			response.SecurityGroups.SecurityGroup = securityGroups
+			return response
+		}
+
+		BeforeEach(func() {
+			w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+				Raw: encode(&apiv1alpha1.InfrastructureStatus{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureStatus",
+					},
+					VPC: apiv1alpha1.VPCStatus{ID: vpcID},
+				}),
+			}
+			w.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+				{
+					Name: "pool-1",
+					ProviderConfig: &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							SecurityGroupRules: []apiv1alpha1.SecurityGroupRule{
+								{
+									Direction: apiv1alpha1.SecurityGroupRuleDirectionIngress,
+									Protocol:  "TCP",
+									PortRange: "22/22",
+									CIDR:      "10.0.0.0/8",
+								},
+								{
+									Direction: apiv1alpha1.SecurityGroupRuleDirectionEgress,
+									Protocol:  "ALL",
+									PortRange: "-1/-1",
+									CIDR:      "0.0.0.0/0",
+									Policy:    ptr.To(apiv1alpha1.SecurityGroupRulePolicyDrop),
+									Priority:  ptr.To[int32](10),
+								},
+							},
+						}),
+					},
+					Zones: []string{zone1},
+				},
+			}
+		})
+
+		Describe("#PreReconcileHook", func() {
+			It("should create the security group and reconcile its rules", func() {
+				newWorkerDelegate()
+
+				expectECSClient()
+				gomock.InOrder(
+					ecsClient.EXPECT().DescribeSecurityGroups(gomock.Any()).DoAndReturn(func(request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
+						Expect(request.VpcId).To(Equal(vpcID))
+						Expect(request.SecurityGroupName).To(Equal(namespace + "-pool-1-sg"))
+						return describeSecurityGroupsResponse(), nil
+					}),
+					ecsClient.EXPECT().CreateSecurityGroup(gomock.Any()).DoAndReturn(func(request *ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error) {
+						Expect(request.VpcId).To(Equal(vpcID))
+						Expect(request.SecurityGroupName).To(Equal(namespace + "-pool-1-sg"))
+						response := ecs.CreateCreateSecurityGroupResponse()
+						response.SecurityGroupId = "sg-1"
+						return response, nil
+					}),
+					ecsClient.EXPECT().DescribeSecurityGroupAttribute(gomock.Any()).DoAndReturn(func(request *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+						Expect(request.SecurityGroupId).To(Equal("sg-1"))
+						return ecs.CreateDescribeSecurityGroupAttributeResponse(), nil
+					}),
+				)
+				ecsClient.EXPECT().AuthorizeSecurityGroup(gomock.Any()).DoAndReturn(func(request *ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error) {
+					Expect(request.SecurityGroupId).To(Equal("sg-1"))
+					Expect(*request.Permissions).To(ConsistOf(ecs.AuthorizeSecurityGroupPermissions{
+						Policy:       "Accept",
+						Priority:     "1",
+						IpProtocol:   "TCP",
+						PortRange:    "22/22",
+						SourceCidrIp: "10.0.0.0/8",
+					}))
+					return ecs.CreateAuthorizeSecurityGroupResponse(), nil
+				})
+				ecsClient.EXPECT().AuthorizeSecurityGroupEgress(gomock.Any()).DoAndReturn(func(request *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error) {
+					Expect(request.SecurityGroupId).To(Equal("sg-1"))
+					Expect(*request.Permissions).To(ConsistOf(ecs.AuthorizeSecurityGroupEgressPermissions{
+						Policy:     "Drop",
+						Priority:   "10",
+						IpProtocol: "ALL",
+						PortRange:  "-1/-1",
+						DestCidrIp: "0.0.0.0/0",
+					}))
+					return ecs.CreateAuthorizeSecurityGroupEgressResponse(), nil
+				})
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.SecurityGroups).To(ConsistOf(apiv1alpha1.PoolSecurityGroup{PoolName: "pool-1", ID: "sg-1"}))
+			})
+
+			It("should revoke undesired rules of an existing security group", func() {
+				setSecurityGroups(apiv1alpha1.PoolSecurityGroup{PoolName: "pool-1", ID: "sg-1"})
+				newWorkerDelegate()
+
+				expectECSClient()
+				ecsClient.EXPECT().DescribeSecurityGroupAttribute(gomock.Any()).DoAndReturn(func(_ *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+					response := ecs.CreateDescribeSecurityGroupAttributeResponse()
+					response.Permissions.Permission = []ecs.Permission{
+						{SecurityGroupRuleId: "sgr-1", Direction: "ingress", IpProtocol: "TCP", PortRange: "22/22", SourceCidrIp: "10.0.0.0/8", Policy: "Accept", Priority: "1"},
+						{SecurityGroupRuleId: "sgr-2", Direction: "egress", IpProtocol: "ALL", PortRange: "-1/-1", DestCidrIp: "0.0.0.0/0", Policy: "Drop", Priority: "10"},
+						{SecurityGroupRuleId: "sgr-3", Direction: "ingress", IpProtocol: "TCP", PortRange: "80/80", SourceCidrIp: "0.0.0.0/0", Policy: "Accept", Priority: "1"},
+					}
+					return response, nil
+				})
+				ecsClient.EXPECT().RevokeSecurityGroup(gomock.Any()).DoAndReturn(func(request *ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error) {
+					Expect(request.SecurityGroupId).To(Equal("sg-1"))
+					Expect(*request.SecurityGroupRuleId).To(ConsistOf("sgr-3"))
+					return ecs.CreateRevokeSecurityGroupResponse(), nil
+				})
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should reconcile IPv6 rules using the IPv6 CIDR fields", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						SecurityGroupRules: []apiv1alpha1.SecurityGroupRule{
+							{
+								Direction: apiv1alpha1.SecurityGroupRuleDirectionIngress,
+								Protocol:  "TCP",
+								PortRange: "22/22",
+								CIDR:      "2001:db8::/32",
+							},
+							{
+								Direction: apiv1alpha1.SecurityGroupRuleDirectionEgress,
+								Protocol:  "ALL",
+								PortRange: "-1/-1",
+								CIDR:      "::/0",
+							},
+						},
+					}),
+				}
+				setSecurityGroups(apiv1alpha1.PoolSecurityGroup{PoolName: "pool-1", ID: "sg-1"})
+				newWorkerDelegate()
+
+				expectECSClient()
+				ecsClient.EXPECT().DescribeSecurityGroupAttribute(gomock.Any()).DoAndReturn(func(_ *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+					response := ecs.CreateDescribeSecurityGroupAttributeResponse()
+					response.Permissions.Permission = []ecs.Permission{
+						{SecurityGroupRuleId: "sgr-1", Direction: "ingress", IpProtocol: "TCP", PortRange: "22/22", Ipv6SourceCidrIp: "2001:db8::/32", Policy: "Accept", Priority: "1"},
+					}
+					return response, nil
+				})
+				ecsClient.EXPECT().AuthorizeSecurityGroupEgress(gomock.Any()).DoAndReturn(func(request *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error) {
+					Expect(*request.Permissions).To(ConsistOf(ecs.AuthorizeSecurityGroupEgressPermissions{
+						Policy:         "Accept",
+						Priority:       "1",
+						IpProtocol:     "ALL",
+						PortRange:      "-1/-1",
+						Ipv6DestCidrIp: "::/0",
+					}))
+					return ecs.CreateAuthorizeSecurityGroupEgressResponse(), nil
+				})
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#PostReconcileHook", func() {
+			It("should delete the security groups which are no longer needed", func() {
+				setSecurityGroups(
+					apiv1alpha1.PoolSecurityGroup{PoolName: "pool-1", ID: "sg-1"},
+					apiv1alpha1.PoolSecurityGroup{PoolName: "pool-old", ID: "sg-2"},
+				)
+				newWorkerDelegate()
+
+				expectECSClient()
+				ecsClient.EXPECT().DescribeSecurityGroups(gomock.Any()).DoAndReturn(func(request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
+					Expect(request.SecurityGroupId).To(Equal("sg-2"))
+					return describeSecurityGroupsResponse(ecs.SecurityGroup{SecurityGroupId: "sg-2"}), nil
+				})
+				ecsClient.EXPECT().DescribeInstances(gomock.Any()).DoAndReturn(func(request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error) {
+					Expect(request.SecurityGroupId).To(Equal("sg-2"))
+					return ecs.CreateDescribeInstancesResponse(), nil
+				})
+				ecsClient.EXPECT().DeleteSecurityGroup(gomock.Any()).DoAndReturn(func(request *ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error) {
+					Expect(request.SecurityGroupId).To(Equal("sg-2"))
+					return ecs.CreateDeleteSecurityGroupResponse(), nil
+				})
+				expectStatusPatches(1)
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+				Expect(patchedStatus.SecurityGroups).To(ConsistOf(apiv1alpha1.PoolSecurityGroup{PoolName: "pool-1", ID: "sg-1"}))
+			})
+
+			It("should keep security groups which are still attached to instances", func() {
+				setSecurityGroups(apiv1alpha1.PoolSecurityGroup{PoolName: "pool-old", ID: "sg-2"})
+				newWorkerDelegate()
+
+				expectECSClient()
+				ecsClient.EXPECT().DescribeSecurityGroups(gomock.Any()).Return(describeSecurityGroupsResponse(ecs.SecurityGroup{SecurityGroupId: "sg-2"}), nil)
+				instancesResponse := ecs.CreateDescribeInstancesResponse()
+				instancesResponse.TotalCount = 2
+				ecsClient.EXPECT().DescribeInstances(gomock.Any()).Return(instancesResponse, nil)
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(MatchError(ContainSubstring("still attached to 2 instance(s)")))
+			})
+		})
+	})
+})
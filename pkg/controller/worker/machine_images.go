@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"k8s.io/utils/ptr"
 
@@ -39,33 +40,40 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	return nil
 }
 
-func (w *workerDelegate) findMachineImage(workerPool extensionsv1alpha1.WorkerPool, infraStatus *api.InfrastructureStatus, region string) (*api.MachineImage, error) {
+func (w *workerDelegate) findMachineImage(workerPool extensionsv1alpha1.WorkerPool, infraStatus *api.InfrastructureStatus, region, kmsKeyID string) (*api.MachineImage, error) {
 	name := workerPool.MachineImage.Name
 	version := workerPool.MachineImage.Version
+	architecture := ptr.Deref(workerPool.Architecture, v1beta1constants.ArchitectureAMD64)
 	encrypted, err := common.UseEncryptedSystemDisk(workerPool.Volume)
 	if err != nil {
 		return nil, err
 	}
 
 	if !encrypted {
-		machineImageID, err := helper.FindImageForRegionFromCloudProfile(w.cloudProfileConfig, name, version, region)
+		// The KMS key only applies to the images of encrypted system disks.
+		kmsKeyID = ""
+		machineImageID, err := helper.FindImageForRegionFromCloudProfile(w.cloudProfileConfig, name, version, region, architecture)
 		if err == nil {
 			return &api.MachineImage{
-				Name:      name,
-				Version:   version,
-				ID:        machineImageID,
-				Encrypted: ptr.To(encrypted),
+				Name:         name,
+				Version:      version,
+				ID:           machineImageID,
+				Encrypted:    ptr.To(encrypted),
+				Architecture: ptr.To(architecture),
 			}, nil
 		}
 	}
 
-	machineImage, err := helper.FindMachineImage(infraStatus.MachineImages, name, version, encrypted)
+	machineImage, err := helper.FindMachineImage(infraStatus.MachineImages, name, version, encrypted, kmsKeyID, architecture)
 	if err != nil {
 		opt := "unencrypted"
 		if encrypted {
 			opt = "encrypted"
 		}
-		return nil, worker.ErrorMachineImageNotFound(name, version, opt)
+		if kmsKeyID != "" {
+			opt = fmt.Sprintf("encrypted with KMS key %q", kmsKeyID)
+		}
+		return nil, worker.ErrorMachineImageNotFound(name, version, opt, architecture)
 	}
 
 	return machineImage, nil
@@ -28,6 +28,14 @@ import (
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 )
 
+const (
+	defaultInstanceChargeType      = apisalicloud.InstanceChargeTypePostPaid
+	defaultInternetChargeType      = apisalicloud.InternetChargeTypePayByTraffic
+	defaultInternetMaxBandwidthIn  = int32(5)
+	defaultInternetMaxBandwidthOut = int32(5)
+	defaultSpotStrategy            = "NoSpot"
+)
+
 // MachineClassKind yields the name of the machine class kind used by Alicloud provider.
 func (w *workerDelegate) MachineClassKind() string {
 	return "MachineClass"
@@ -81,23 +89,39 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return fmt.Errorf("unable to decode the worker provider status: %w", err)
+	}
+
+	controlPlaneConfig, err := helper.ControlPlaneConfigFromCluster(w.cluster)
+	if err != nil {
+		return err
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
 		zoneLen := int32(len(pool.Zones)) // #nosec: G115
 
-		additionalHashData := computeAdditionalHashData(pool)
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
+		resourceGroupID := helper.WorkerResourceGroupID(workerConfig, infrastructureStatus)
+		additionalHashData := computeAdditionalHashData(pool, workerConfig, controlPlaneConfig, resourceGroupID)
 		workerPoolHash, err := worker.WorkerPoolHash(pool, w.cluster, additionalHashData, additionalHashData, nil)
 		if err != nil {
 			return err
 		}
 
-		machineImage, err := w.findMachineImage(pool, infrastructureStatus, w.worker.Spec.Region)
+		machineImage, err := w.findMachineImage(pool, infrastructureStatus, w.worker.Spec.Region, helper.SystemDiskKMSKeyID(workerConfig, controlPlaneConfig))
 		if err != nil {
 			return err
 		}
 
 		machineImages = helper.AppendMachineImage(machineImages, *machineImage)
 
-		disks, err := computeDisks(w.worker.Namespace, pool)
+		disks, err := computeDisks(w.worker.Namespace, pool, workerConfig, controlPlaneConfig)
 		if err != nil {
 			return err
 		}
@@ -107,31 +131,42 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
+		securityGroupIDs := append([]string{}, workerConfig.AdditionalSecurityGroupIDs...)
+		if len(workerConfig.SecurityGroupRules) > 0 {
+			securityGroup := findPoolSecurityGroup(workerStatus.SecurityGroups, pool.Name)
+			if securityGroup == nil {
+				return fmt.Errorf("security group of worker pool %q has not been created yet", pool.Name)
+			}
+			securityGroupIDs = append(securityGroupIDs, securityGroup.ID)
+		}
+
 		for zoneIndex, zone := range pool.Zones {
 			zoneIdx := int32(zoneIndex) // #nosec: G115
 			nodesVSwitch, err := helper.FindVSwitchForPurposeAndZone(infrastructureStatus.VPC.VSwitches, apisalicloud.PurposeNodes, zone)
 			if err != nil {
 				return err
 			}
+			instanceType := instanceTypeForZone(workerStatus.InstanceTypeFallbacks, pool.Name, zone, pool.MachineType)
 
 			machineClassSpec := utils.MergeMaps(map[string]interface{}{
 				"imageID":                 machineImage.ID,
-				"instanceType":            pool.MachineType,
+				"instanceType":            instanceType,
 				"region":                  w.worker.Spec.Region,
 				"zoneID":                  zone,
 				"securityGroupID":         nodesSecurityGroup.ID,
 				"vSwitchID":               nodesVSwitch.ID,
-				"instanceChargeType":      "PostPaid",
-				"internetChargeType":      "PayByTraffic",
-				"internetMaxBandwidthIn":  5,
-				"internetMaxBandwidthOut": 5,
-				"spotStrategy":            "NoSpot",
+				"instanceChargeType":      string(ptr.Deref(workerConfig.InstanceChargeType, defaultInstanceChargeType)),
+				"internetChargeType":      string(ptr.Deref(workerConfig.InternetChargeType, defaultInternetChargeType)),
+				"internetMaxBandwidthIn":  int(ptr.Deref(workerConfig.InternetMaxBandwidthIn, defaultInternetMaxBandwidthIn)),
+				"internetMaxBandwidthOut": int(ptr.Deref(workerConfig.InternetMaxBandwidthOut, defaultInternetMaxBandwidthOut)),
+				"spotStrategy":            defaultSpotStrategy,
 				"tags": utils.MergeStringMaps(
 					map[string]string{
 						fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace):     "1",
 						fmt.Sprintf("kubernetes.io/role/worker/%s", w.worker.Namespace): "1",
 					},
 					getLabelsWithValue(pool.Labels),
+					infrastructureStatus.Tags,
 				),
 				"secret": map[string]interface{}{
 					"userData": string(userData),
@@ -142,8 +177,67 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				},
 			}, disks)
 
+			if subscription := workerConfig.Subscription; subscription != nil {
+				machineClassSpec["period"] = int(subscription.Period)
+				machineClassSpec["periodUnit"] = string(ptr.Deref(subscription.PeriodUnit, apisalicloud.PeriodUnitMonth))
+				machineClassSpec["autoRenew"] = ptr.Deref(subscription.AutoRenew, false)
+				if subscription.AutoRenewPeriod != nil {
+					machineClassSpec["autoRenewPeriod"] = int(*subscription.AutoRenewPeriod)
+				}
+			}
+
+			if spot := workerConfig.Spot; spot != nil {
+				machineClassSpec["spotStrategy"] = string(spot.Strategy)
+				if spot.PriceLimit != nil {
+					machineClassSpec["spotPriceLimit"] = *spot.PriceLimit
+				}
+				if spot.Duration != nil {
+					machineClassSpec["spotDuration"] = int(*spot.Duration)
+				}
+			}
+
+			if len(securityGroupIDs) > 0 {
+				machineClassSpec["securityGroupIDs"] = securityGroupIDs
+			}
+
+			if placement := workerConfig.Placement; placement != nil {
+				if placement.DeploymentSetID != nil {
+					machineClassSpec["deploymentSetID"] = *placement.DeploymentSetID
+				}
+				if placement.DeploymentSetStrategy != nil {
+					deploymentSet := findDeploymentSet(workerStatus.DeploymentSets, pool.Name, zone, *placement.DeploymentSetStrategy)
+					if deploymentSet == nil {
+						return fmt.Errorf("deployment set of worker pool %q in zone %q has not been created yet", pool.Name, zone)
+					}
+					machineClassSpec["deploymentSetID"] = deploymentSet.ID
+				}
+				if placement.DedicatedHostID != nil {
+					machineClassSpec["dedicatedHostID"] = *placement.DedicatedHostID
+				}
+				if placement.DedicatedHostClusterID != nil {
+					machineClassSpec["dedicatedHostClusterID"] = *placement.DedicatedHostClusterID
+				}
+			}
+
+			if metadataOptions := workerConfig.InstanceMetadataOptions; metadataOptions != nil {
+				if metadataOptions.HTTPTokens != nil {
+					machineClassSpec["httpTokens"] = string(*metadataOptions.HTTPTokens)
+				}
+				if metadataOptions.HTTPPutResponseHopLimit != nil {
+					machineClassSpec["httpPutResponseHopLimit"] = int(*metadataOptions.HTTPPutResponseHopLimit)
+				}
+			}
+
+			if workerConfig.RAMRoleName != nil {
+				machineClassSpec["ramRoleName"] = *workerConfig.RAMRoleName
+			}
+
+			if resourceGroupID != "" {
+				machineClassSpec["resourceGroupID"] = resourceGroupID
+			}
+
 			var (
-				deploymentName = fmt.Sprintf("%s-%s-%s", w.worker.Namespace, pool.Name, zone)
+				deploymentName = machineDeploymentName(w.worker.Namespace, pool.Name, zone)
 				className      = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
 			)
 
@@ -189,15 +283,12 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				ClusterAutoscalerAnnotations: extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler),
 			})
 
-			if pool.NodeTemplate != nil {
-				arch := ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
-				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-					Capacity:     pool.NodeTemplate.Capacity,
-					InstanceType: pool.MachineType,
-					Region:       w.worker.Spec.Region,
-					Zone:         zone,
-					Architecture: ptr.To(arch),
-				}
+			nodeTemplate, err := w.computeNodeTemplate(ctx, pool, instanceType, zone)
+			if err != nil {
+				return err
+			}
+			if nodeTemplate != nil {
+				machineClassSpec["nodeTemplate"] = *nodeTemplate
 			}
 
 			machineClassSpec["name"] = className
@@ -230,7 +321,7 @@ func getLabelsWithValue(labels map[string]string) map[string]string {
 	}
 	return out
 }
-func computeDisks(namespace string, pool extensionsv1alpha1.WorkerPool) (map[string]interface{}, error) {
+func computeDisks(namespace string, pool extensionsv1alpha1.WorkerPool, workerConfig *apisalicloud.WorkerConfig, controlPlaneConfig *apisalicloud.ControlPlaneConfig) (map[string]interface{}, error) {
 	// handle root disk
 	volumeSize, err := worker.DiskSize(pool.Volume.Size)
 	if err != nil {
@@ -242,6 +333,14 @@ func computeDisks(namespace string, pool extensionsv1alpha1.WorkerPool) (map[str
 	if pool.Volume.Type != nil {
 		systemDisk["category"] = *pool.Volume.Type
 	}
+	if ptr.Deref(pool.Volume.Encrypted, false) {
+		if kmsKeyID := helper.SystemDiskKMSKeyID(workerConfig, controlPlaneConfig); kmsKeyID != "" {
+			systemDisk["kmsKeyID"] = kmsKeyID
+		}
+	}
+	if volume := workerConfig.Volume; volume != nil {
+		addDiskPerformanceSettings(systemDisk, volume.PerformanceLevel, volume.ProvisionedIOPS, volume.BurstingEnabled, volume.AutoSnapshotPolicyID)
+	}
 
 	disks := map[string]interface{}{
 		"systemDisk": systemDisk,
@@ -265,6 +364,12 @@ func computeDisks(namespace string, pool extensionsv1alpha1.WorkerPool) (map[str
 			}
 			if vol.Encrypted != nil {
 				dataDisk["encrypted"] = *vol.Encrypted
+				if kmsKeyID := helper.DataDiskKMSKeyID(workerConfig, controlPlaneConfig, vol.Name); *vol.Encrypted && kmsKeyID != "" {
+					dataDisk["kmsKeyID"] = kmsKeyID
+				}
+			}
+			if dataVolume := helper.FindDataVolumeConfig(workerConfig, vol.Name); dataVolume != nil {
+				addDiskPerformanceSettings(dataDisk, dataVolume.PerformanceLevel, dataVolume.ProvisionedIOPS, dataVolume.BurstingEnabled, dataVolume.AutoSnapshotPolicyID)
 			}
 			dataDisks = append(dataDisks, dataDisk)
 		}
@@ -275,12 +380,50 @@ func computeDisks(namespace string, pool extensionsv1alpha1.WorkerPool) (map[str
 	return disks, nil
 }
 
-func computeAdditionalHashData(pool extensionsv1alpha1.WorkerPool) []string {
+func addDiskPerformanceSettings(disk map[string]interface{}, performanceLevel *apisalicloud.PerformanceLevel, provisionedIOPS *int64, burstingEnabled *bool, autoSnapshotPolicyID *string) {
+	if performanceLevel != nil {
+		disk["performanceLevel"] = string(*performanceLevel)
+	}
+	if provisionedIOPS != nil {
+		disk["provisionedIOPS"] = *provisionedIOPS
+	}
+	if burstingEnabled != nil {
+		disk["burstingEnabled"] = *burstingEnabled
+	}
+	if autoSnapshotPolicyID != nil {
+		disk["autoSnapshotPolicyID"] = *autoSnapshotPolicyID
+	}
+}
+
+func diskPerformanceHashData(performanceLevel *apisalicloud.PerformanceLevel, provisionedIOPS *int64, burstingEnabled *bool, autoSnapshotPolicyID *string) []string {
+	var additionalData []string
+	if performanceLevel != nil {
+		additionalData = append(additionalData, "performanceLevel="+string(*performanceLevel))
+	}
+	if provisionedIOPS != nil {
+		additionalData = append(additionalData, "provisionedIOPS="+strconv.FormatInt(*provisionedIOPS, 10))
+	}
+	if burstingEnabled != nil {
+		additionalData = append(additionalData, "burstingEnabled="+strconv.FormatBool(*burstingEnabled))
+	}
+	if autoSnapshotPolicyID != nil {
+		additionalData = append(additionalData, "autoSnapshotPolicyID="+*autoSnapshotPolicyID)
+	}
+	return additionalData
+}
+
+func computeAdditionalHashData(pool extensionsv1alpha1.WorkerPool, workerConfig *apisalicloud.WorkerConfig, controlPlaneConfig *apisalicloud.ControlPlaneConfig, resourceGroupID string) []string {
 	var additionalData []string
 
 	// Volume.Encrypted needs to be included when calculating the hash
 	if pool.Volume.Encrypted != nil {
 		additionalData = append(additionalData, strconv.FormatBool(*pool.Volume.Encrypted))
+		if kmsKeyID := helper.SystemDiskKMSKeyID(workerConfig, controlPlaneConfig); *pool.Volume.Encrypted && kmsKeyID != "" {
+			additionalData = append(additionalData, "kmsKeyID="+kmsKeyID)
+		}
+	}
+	if volume := workerConfig.Volume; volume != nil {
+		additionalData = append(additionalData, diskPerformanceHashData(volume.PerformanceLevel, volume.ProvisionedIOPS, volume.BurstingEnabled, volume.AutoSnapshotPolicyID)...)
 	}
 
 	for _, dv := range pool.DataVolumes {
@@ -292,9 +435,97 @@ func computeAdditionalHashData(pool extensionsv1alpha1.WorkerPool) []string {
 
 		if dv.Encrypted != nil {
 			additionalData = append(additionalData, strconv.FormatBool(*dv.Encrypted))
+			if kmsKeyID := helper.DataDiskKMSKeyID(workerConfig, controlPlaneConfig, dv.Name); *dv.Encrypted && kmsKeyID != "" {
+				additionalData = append(additionalData, "kmsKeyID="+kmsKeyID)
+			}
+		}
+
+		if dataVolume := helper.FindDataVolumeConfig(workerConfig, dv.Name); dataVolume != nil {
+			additionalData = append(additionalData, diskPerformanceHashData(dataVolume.PerformanceLevel, dataVolume.ProvisionedIOPS, dataVolume.BurstingEnabled, dataVolume.AutoSnapshotPolicyID)...)
+		}
+	}
+
+	// the entries derived from the worker config are prefixed with the names of the machine class fields, otherwise
+	// e.g. the inbound and the outbound bandwidth or the strategy and the ID of a deployment set could not be told apart
+	// if only one of them is set; the defaults are not included, so setting them explicitly does not roll the nodes
+	if chargeType := ptr.Deref(workerConfig.InstanceChargeType, defaultInstanceChargeType); chargeType != defaultInstanceChargeType {
+		additionalData = append(additionalData, "instanceChargeType="+string(chargeType))
+	}
+
+	if subscription := workerConfig.Subscription; subscription != nil {
+		additionalData = append(additionalData,
+			"period="+strconv.Itoa(int(subscription.Period)),
+			"periodUnit="+string(ptr.Deref(subscription.PeriodUnit, apisalicloud.PeriodUnitMonth)),
+		)
+		if subscription.AutoRenew != nil {
+			additionalData = append(additionalData, "autoRenew="+strconv.FormatBool(*subscription.AutoRenew))
+		}
+		if subscription.AutoRenewPeriod != nil {
+			additionalData = append(additionalData, "autoRenewPeriod="+strconv.Itoa(int(*subscription.AutoRenewPeriod)))
 		}
 	}
 
+	if chargeType := ptr.Deref(workerConfig.InternetChargeType, defaultInternetChargeType); chargeType != defaultInternetChargeType {
+		additionalData = append(additionalData, "internetChargeType="+string(chargeType))
+	}
+	if bandwidthIn := ptr.Deref(workerConfig.InternetMaxBandwidthIn, defaultInternetMaxBandwidthIn); bandwidthIn != defaultInternetMaxBandwidthIn {
+		additionalData = append(additionalData, "internetMaxBandwidthIn="+strconv.Itoa(int(bandwidthIn)))
+	}
+	if bandwidthOut := ptr.Deref(workerConfig.InternetMaxBandwidthOut, defaultInternetMaxBandwidthOut); bandwidthOut != defaultInternetMaxBandwidthOut {
+		additionalData = append(additionalData, "internetMaxBandwidthOut="+strconv.Itoa(int(bandwidthOut)))
+	}
+
+	if spot := workerConfig.Spot; spot != nil {
+		additionalData = append(additionalData, "spotStrategy="+string(spot.Strategy))
+		if spot.PriceLimit != nil {
+			additionalData = append(additionalData, "spotPriceLimit="+*spot.PriceLimit)
+		}
+		if spot.Duration != nil {
+			additionalData = append(additionalData, "spotDuration="+strconv.Itoa(int(*spot.Duration)))
+		}
+	}
+
+	if placement := workerConfig.Placement; placement != nil {
+		if placement.DeploymentSetID != nil {
+			additionalData = append(additionalData, "deploymentSetID="+*placement.DeploymentSetID)
+		}
+		if placement.DeploymentSetStrategy != nil {
+			additionalData = append(additionalData, "deploymentSetStrategy="+string(*placement.DeploymentSetStrategy))
+		}
+		if placement.DedicatedHostID != nil {
+			additionalData = append(additionalData, "dedicatedHostID="+*placement.DedicatedHostID)
+		}
+		if placement.DedicatedHostClusterID != nil {
+			additionalData = append(additionalData, "dedicatedHostClusterID="+*placement.DedicatedHostClusterID)
+		}
+	}
+
+	for _, securityGroupID := range workerConfig.AdditionalSecurityGroupIDs {
+		additionalData = append(additionalData, "securityGroupID="+securityGroupID)
+	}
+	// the rules of the security group of the worker pool are reconciled in place, but the instances only join or leave
+	// it when they are created, hence only its presence rolls the nodes
+	if len(workerConfig.SecurityGroupRules) > 0 {
+		additionalData = append(additionalData, "poolSecurityGroup=true")
+	}
+
+	if metadataOptions := workerConfig.InstanceMetadataOptions; metadataOptions != nil {
+		if metadataOptions.HTTPTokens != nil {
+			additionalData = append(additionalData, "httpTokens="+string(*metadataOptions.HTTPTokens))
+		}
+		if metadataOptions.HTTPPutResponseHopLimit != nil {
+			additionalData = append(additionalData, "httpPutResponseHopLimit="+strconv.Itoa(int(*metadataOptions.HTTPPutResponseHopLimit)))
+		}
+	}
+
+	if workerConfig.RAMRoleName != nil {
+		additionalData = append(additionalData, "ramRoleName="+*workerConfig.RAMRoleName)
+	}
+
+	if resourceGroupID != "" {
+		additionalData = append(additionalData, "resourceGroupID="+resourceGroupID)
+	}
+
 	return additionalData
 }
 
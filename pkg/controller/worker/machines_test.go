@@ -6,6 +6,7 @@ package worker_test
 
 import (
 	"context"
+	"embed"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -39,6 +41,7 @@ import (
 	api "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/worker"
+	mockalicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
 )
 
 var _ = Describe("Machines", func() {
@@ -64,7 +67,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate, _ := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate, _ := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil, nil)
 
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
@@ -324,8 +327,14 @@ var _ = Describe("Machines", func() {
 									Version: machineImageVersion,
 									Regions: []apiv1alpha1.RegionIDMapping{
 										{
-											Name: region,
-											ID:   machineImageID,
+											Name:         region,
+											ID:           machineImageID,
+											Architecture: ptr.To(archAMD),
+										},
+										{
+											Name:         region,
+											ID:           machineImageID,
+											Architecture: ptr.To(archARM),
 										},
 									},
 								},
@@ -382,10 +391,18 @@ var _ = Describe("Machines", func() {
 								},
 								MachineImages: []api.MachineImage{
 									{
-										Name:      machineImageName,
-										Version:   machineImageVersion,
-										Encrypted: ptr.To(true),
-										ID:        encryptedImageID,
+										Name:         machineImageName,
+										Version:      machineImageVersion,
+										Encrypted:    ptr.To(true),
+										ID:           encryptedImageID,
+										Architecture: ptr.To(archAMD),
+									},
+									{
+										Name:         machineImageName,
+										Version:      machineImageVersion,
+										Encrypted:    ptr.To(true),
+										ID:           encryptedImageID,
+										Architecture: ptr.To(archARM),
 									},
 								},
 							}),
@@ -545,7 +562,7 @@ var _ = Describe("Machines", func() {
 				workerPoolHash3, _ = worker.WorkerPoolHash(w.Spec.Pools[2], cluster, nil, nil, nil)
 				workerPoolHash4, _ = worker.WorkerPoolHash(w.Spec.Pools[3], cluster, nil, nil, nil)
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, clusterWithoutImages)
 			})
 
 			expectedUserDataSecretRefRead := func() {
@@ -874,7 +891,7 @@ var _ = Describe("Machines", func() {
 				})
 
 				It("should return the expected machine deployments for profile image types", func() {
-					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 					expectedUserDataSecretRefRead()
 					expectedUserDataSecretRefRead()
@@ -903,16 +920,18 @@ var _ = Describe("Machines", func() {
 						},
 						MachineImages: []apiv1alpha1.MachineImage{
 							{
-								Name:      machineImageName,
-								Version:   machineImageVersion,
-								ID:        machineImageID,
-								Encrypted: ptr.To(false),
+								Name:         machineImageName,
+								Version:      machineImageVersion,
+								ID:           machineImageID,
+								Encrypted:    ptr.To(false),
+								Architecture: ptr.To(archAMD),
 							},
 							{
-								Name:      machineImageName,
-								Version:   machineImageVersion,
-								ID:        encryptedImageID,
-								Encrypted: ptr.To(true),
+								Name:         machineImageName,
+								Version:      machineImageVersion,
+								ID:           encryptedImageID,
+								Encrypted:    ptr.To(true),
+								Architecture: ptr.To(archARM),
 							},
 						},
 					}
@@ -942,7 +961,7 @@ var _ = Describe("Machines", func() {
 			})
 
 			It("should return err when the infrastructure provider status cannot be decoded", func() {
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				// Deliberately setting InfrastructureProviderStatus to empty
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
@@ -952,7 +971,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				clusterWithoutImages.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -962,7 +981,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -976,7 +995,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -984,7 +1003,7 @@ var _ = Describe("Machines", func() {
 			})
 
 			It("should fail because the machine image cannot be found", func() {
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, clusterWithoutImages)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1006,7 +1025,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				expectedUserDataSecretRefRead()
 
@@ -1018,7 +1037,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -1039,7 +1058,7 @@ var _ = Describe("Machines", func() {
 					NodeConditions:         testNodeConditions,
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				expectedUserDataSecretRefRead()
 				expectedUserDataSecretRefRead()
@@ -1067,7 +1086,7 @@ var _ = Describe("Machines", func() {
 					ScaleDownUtilizationThreshold:    ptr.To("0.6"),
 				}
 				w.Spec.Pools[1].ClusterAutoscaler = nil
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
 
 				expectedUserDataSecretRefRead()
 				expectedUserDataSecretRefRead()
@@ -1099,6 +1118,484 @@ var _ = Describe("Machines", func() {
 				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnreadyTimeAnnotation]).To(Equal("3m0s"))
 				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation]).To(Equal("0.6"))
 			})
+
+			Describe("worker config", func() {
+				var machineClasses []map[string]interface{}
+
+				BeforeEach(func() {
+					machineClasses = nil
+					chartApplier.EXPECT().
+						ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join(charts.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							machineClasses = machineClassesFromApplyOptions(opts...)
+							return nil
+						}).AnyTimes()
+				})
+
+				deployMachineClasses := func() {
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
+					for range w.Spec.Pools {
+						expectedUserDataSecretRefRead()
+					}
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+				}
+
+				It("should use the defaults if no worker config is given", func() {
+					deployMachineClasses()
+
+					Expect(machineClasses[0]).To(HaveKeyWithValue("internetChargeType", internetChargeType))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("internetMaxBandwidthIn", internetMaxBandwidthIn))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("internetMaxBandwidthOut", internetMaxBandwidthOut))
+				})
+
+				It("should use the settings of the worker config", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						InternetChargeType:      ptr.To(apiv1alpha1.InternetChargeTypePayByBandwidth),
+						InternetMaxBandwidthIn:  ptr.To[int32](10),
+						InternetMaxBandwidthOut: ptr.To[int32](20),
+					})
+					deployMachineClasses()
+
+					for _, machineClass := range machineClasses[:2] {
+						Expect(machineClass).To(HaveKeyWithValue("internetChargeType", "PayByBandwidth"))
+						Expect(machineClass).To(HaveKeyWithValue("internetMaxBandwidthIn", 10))
+						Expect(machineClass).To(HaveKeyWithValue("internetMaxBandwidthOut", 20))
+						Expect(machineClass["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					}
+					Expect(machineClasses[2]).To(HaveKeyWithValue("internetChargeType", internetChargeType))
+				})
+
+				Context("worker pool hash v2", func() {
+					// the v2 hash only covers the node agent secret and the additional data, but not the provider config
+					deployWithWorkerConfig := func(workerConfig *apiv1alpha1.WorkerConfig) string {
+						w.Spec.Pools[0].NodeAgentSecretName = ptr.To("node-agent-secret")
+						withWorkerConfig(&w.Spec.Pools[0], workerConfig)
+						deployMachineClasses()
+						return machineClasses[0]["name"].(string)
+					}
+
+					It("should tell the inbound and the outbound bandwidth apart", func() {
+						Expect(deployWithWorkerConfig(&apiv1alpha1.WorkerConfig{InternetMaxBandwidthIn: ptr.To[int32](10)})).
+							NotTo(Equal(deployWithWorkerConfig(&apiv1alpha1.WorkerConfig{InternetMaxBandwidthOut: ptr.To[int32](10)})))
+					})
+
+					It("should tell the dedicated host and the dedicated host cluster apart", func() {
+						Expect(deployWithWorkerConfig(&apiv1alpha1.WorkerConfig{Placement: &apiv1alpha1.PlacementConfig{DedicatedHostID: ptr.To("dh-1234")}})).
+							NotTo(Equal(deployWithWorkerConfig(&apiv1alpha1.WorkerConfig{Placement: &apiv1alpha1.PlacementConfig{DedicatedHostClusterID: ptr.To("dh-1234")}})))
+					})
+
+					It("should not roll the nodes if an empty worker config is added", func() {
+						w.Spec.Pools[0].NodeAgentSecretName = ptr.To("node-agent-secret")
+						w.Spec.Pools[0].ProviderConfig = nil
+						deployMachineClasses()
+						name := machineClasses[0]["name"]
+						Expect(deployWithWorkerConfig(&apiv1alpha1.WorkerConfig{})).To(Equal(name))
+					})
+
+					It("should roll the nodes if the worker pool gets a security group but not if its rules change", func() {
+						w.Status.ProviderStatus = &runtime.RawExtension{
+							Raw: encode(&apiv1alpha1.WorkerStatus{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "WorkerStatus",
+								},
+								SecurityGroups: []apiv1alpha1.PoolSecurityGroup{
+									{PoolName: namePool1, ID: "sg-pool"},
+								},
+							}),
+						}
+						withRules := func(cidrs ...string) *apiv1alpha1.WorkerConfig {
+							workerConfig := &apiv1alpha1.WorkerConfig{}
+							for _, cidr := range cidrs {
+								workerConfig.SecurityGroupRules = append(workerConfig.SecurityGroupRules, apiv1alpha1.SecurityGroupRule{
+									Direction: apiv1alpha1.SecurityGroupRuleDirectionIngress,
+									Protocol:  "TCP",
+									PortRange: "22/22",
+									CIDR:      cidr,
+								})
+							}
+							return workerConfig
+						}
+
+						withoutSecurityGroup := deployWithWorkerConfig(withRules())
+						withSecurityGroup := deployWithWorkerConfig(withRules("10.0.0.0/8"))
+						Expect(withSecurityGroup).NotTo(Equal(withoutSecurityGroup))
+						Expect(deployWithWorkerConfig(withRules("10.0.0.0/8", "192.168.0.0/16"))).To(Equal(withSecurityGroup))
+					})
+
+					It("should not roll the nodes if the defaults are set explicitly", func() {
+						Expect(deployWithWorkerConfig(&apiv1alpha1.WorkerConfig{
+							InstanceChargeType:      ptr.To(apiv1alpha1.InstanceChargeTypePostPaid),
+							InternetChargeType:      ptr.To(apiv1alpha1.InternetChargeTypePayByTraffic),
+							InternetMaxBandwidthIn:  ptr.To[int32](5),
+							InternetMaxBandwidthOut: ptr.To[int32](5),
+						})).To(Equal(deployWithWorkerConfig(&apiv1alpha1.WorkerConfig{})))
+					})
+				})
+
+				It("should configure spot instances", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						Spot: &apiv1alpha1.SpotConfig{
+							Strategy:   apiv1alpha1.SpotStrategySpotWithPriceLimit,
+							PriceLimit: ptr.To("0.25"),
+							Duration:   ptr.To[int32](0),
+						},
+					})
+					deployMachineClasses()
+
+					Expect(machineClasses[0]).To(HaveKeyWithValue("spotStrategy", "SpotWithPriceLimit"))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("spotPriceLimit", "0.25"))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("spotDuration", 0))
+					Expect(machineClasses[0]["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					Expect(machineClasses[2]).To(HaveKeyWithValue("spotStrategy", spotStrategy))
+					Expect(machineClasses[2]).NotTo(HaveKey("spotPriceLimit"))
+				})
+
+				It("should configure subscription instances", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						InstanceChargeType: ptr.To(apiv1alpha1.InstanceChargeTypePrePaid),
+						Subscription: &apiv1alpha1.SubscriptionConfig{
+							Period:          3,
+							AutoRenew:       ptr.To(true),
+							AutoRenewPeriod: ptr.To[int32](1),
+						},
+					})
+					deployMachineClasses()
+
+					Expect(machineClasses[0]).To(HaveKeyWithValue("instanceChargeType", "PrePaid"))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("period", 3))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("periodUnit", "Month"))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("autoRenew", true))
+					Expect(machineClasses[0]).To(HaveKeyWithValue("autoRenewPeriod", 1))
+					Expect(machineClasses[0]["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					Expect(machineClasses[2]).To(HaveKeyWithValue("instanceChargeType", instanceChargeType))
+					Expect(machineClasses[2]).NotTo(HaveKey("period"))
+				})
+
+				It("should configure the placement of the instances", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						Placement: &apiv1alpha1.PlacementConfig{
+							DeploymentSetStrategy: ptr.To(apiv1alpha1.DeploymentSetStrategyAvailability),
+						},
+					})
+					withWorkerConfig(&w.Spec.Pools[1], &apiv1alpha1.WorkerConfig{
+						Placement: &apiv1alpha1.PlacementConfig{
+							DedicatedHostClusterID: ptr.To("dc-1234"),
+						},
+					})
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerStatus",
+							},
+							DeploymentSets: []apiv1alpha1.DeploymentSet{
+								{PoolName: namePool1, Zone: zone1, ID: "ds-1", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+								{PoolName: namePool1, Zone: zone2, ID: "ds-2", Strategy: apiv1alpha1.DeploymentSetStrategyAvailability},
+							},
+						}),
+					}
+					deployMachineClasses()
+
+					Expect(machineClasses[0]).To(HaveKeyWithValue("deploymentSetID", "ds-1"))
+					Expect(machineClasses[1]).To(HaveKeyWithValue("deploymentSetID", "ds-2"))
+					Expect(machineClasses[0]["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					Expect(machineClasses[2]).To(HaveKeyWithValue("dedicatedHostClusterID", "dc-1234"))
+					Expect(machineClasses[2]).NotTo(HaveKey("deploymentSetID"))
+					Expect(machineClasses[2]["name"]).NotTo(HaveSuffix(workerPoolHash2))
+				})
+
+				It("should fail if the deployment set of a worker pool has not been created yet", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						Placement: &apiv1alpha1.PlacementConfig{
+							DeploymentSetStrategy: ptr.To(apiv1alpha1.DeploymentSetStrategyAvailability),
+						},
+					})
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
+					expectedUserDataSecretRefRead()
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(MatchError(ContainSubstring("has not been created yet")))
+				})
+
+				It("should attach additional and pool-specific security groups", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						AdditionalSecurityGroupIDs: []string{"sg-extra"},
+						SecurityGroupRules: []apiv1alpha1.SecurityGroupRule{
+							{
+								Direction: apiv1alpha1.SecurityGroupRuleDirectionIngress,
+								Protocol:  "TCP",
+								PortRange: "22/22",
+								CIDR:      "10.0.0.0/8",
+							},
+						},
+					})
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerStatus",
+							},
+							SecurityGroups: []apiv1alpha1.PoolSecurityGroup{
+								{PoolName: namePool1, ID: "sg-pool"},
+							},
+						}),
+					}
+					deployMachineClasses()
+
+					Expect(machineClasses[0]).To(HaveKeyWithValue("securityGroupIDs", []string{"sg-extra", "sg-pool"}))
+					Expect(machineClasses[1]).To(HaveKeyWithValue("securityGroupIDs", []string{"sg-extra", "sg-pool"}))
+					Expect(machineClasses[0]["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					Expect(machineClasses[2]).NotTo(HaveKey("securityGroupIDs"))
+				})
+
+				It("should fail if the security group of a worker pool has not been created yet", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						SecurityGroupRules: []apiv1alpha1.SecurityGroupRule{
+							{
+								Direction: apiv1alpha1.SecurityGroupRuleDirectionIngress,
+								Protocol:  "TCP",
+								PortRange: "22/22",
+								CIDR:      "10.0.0.0/8",
+							},
+						},
+					})
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
+					expectedUserDataSecretRefRead()
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(MatchError(ContainSubstring("security group of worker pool")))
+				})
+
+				It("should fail if the worker config cannot be decoded", func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","foo":"bar"}`)}
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", nil, w, cluster)
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).NotTo(Succeed())
+				})
+
+				It("should use customer-managed KMS keys for encrypted data disks", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						DataVolumes: []apiv1alpha1.DataVolume{
+							{Name: dataVolume1Name, KMSKeyID: ptr.To("key-1")},
+							{Name: dataVolume2Name, KMSKeyID: ptr.To("key-2")},
+						},
+					})
+					deployMachineClasses()
+
+					dataDisks := machineClasses[0]["dataDisks"].([]map[string]interface{})
+					Expect(dataDisks[0]).NotTo(HaveKey("kmsKeyID"))
+					Expect(dataDisks[1]).To(HaveKeyWithValue("kmsKeyID", "key-2"))
+					Expect(machineClasses[0]["name"]).NotTo(HaveSuffix(workerPoolHash1))
+				})
+
+				It("should configure the performance settings of the disks", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						Volume: &apiv1alpha1.Volume{
+							PerformanceLevel:     ptr.To(apiv1alpha1.PerformanceLevelPL2),
+							AutoSnapshotPolicyID: ptr.To("sp-1"),
+						},
+						DataVolumes: []apiv1alpha1.DataVolume{
+							{Name: dataVolume2Name, ProvisionedIOPS: ptr.To[int64](10000), BurstingEnabled: ptr.To(true)},
+						},
+					})
+					deployMachineClasses()
+
+					Expect(machineClasses[0]["systemDisk"]).To(HaveKeyWithValue("performanceLevel", "PL2"))
+					Expect(machineClasses[0]["systemDisk"]).To(HaveKeyWithValue("autoSnapshotPolicyID", "sp-1"))
+					dataDisks := machineClasses[0]["dataDisks"].([]map[string]interface{})
+					Expect(dataDisks[0]).NotTo(HaveKey("provisionedIOPS"))
+					Expect(dataDisks[1]).To(HaveKeyWithValue("provisionedIOPS", int64(10000)))
+					Expect(dataDisks[1]).To(HaveKeyWithValue("burstingEnabled", true))
+					Expect(machineClasses[0]["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					Expect(machineClasses[2]["systemDisk"]).NotTo(HaveKey("performanceLevel"))
+				})
+
+				It("should configure the instance metadata options and the RAM role", func() {
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						InstanceMetadataOptions: &apiv1alpha1.InstanceMetadataOptions{
+							HTTPTokens:              ptr.To(apiv1alpha1.HTTPTokensRequired),
+							HTTPPutResponseHopLimit: ptr.To[int32](2),
+						},
+						RAMRoleName: ptr.To("node-role"),
+					})
+					deployMachineClasses()
+
+					for _, machineClass := range machineClasses[:2] {
+						Expect(machineClass).To(HaveKeyWithValue("httpTokens", "required"))
+						Expect(machineClass).To(HaveKeyWithValue("httpPutResponseHopLimit", 2))
+						Expect(machineClass).To(HaveKeyWithValue("ramRoleName", "node-role"))
+						Expect(machineClass["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					}
+					Expect(machineClasses[2]).NotTo(HaveKey("httpTokens"))
+					Expect(machineClasses[2]).NotTo(HaveKey("ramRoleName"))
+				})
+
+				It("should place the instances in the resource group of the worker config or the infrastructure", func() {
+					infrastructureStatus := &api.InfrastructureStatus{}
+					Expect(json.Unmarshal(w.Spec.InfrastructureProviderStatus.Raw, infrastructureStatus)).To(Succeed())
+					infrastructureStatus.ResourceGroupID = "rg-infrastructure"
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+					withWorkerConfig(&w.Spec.Pools[0], &apiv1alpha1.WorkerConfig{
+						ResourceGroupID: ptr.To("rg-workers"),
+					})
+					deployMachineClasses()
+
+					for _, machineClass := range machineClasses[:2] {
+						Expect(machineClass).To(HaveKeyWithValue("resourceGroupID", "rg-workers"))
+						Expect(machineClass["name"]).NotTo(HaveSuffix(workerPoolHash1))
+					}
+					Expect(machineClasses[2]).To(HaveKeyWithValue("resourceGroupID", "rg-infrastructure"))
+				})
+
+				It("should add the user-defined tags of the infrastructure to the instances", func() {
+					infrastructureStatus := &api.InfrastructureStatus{}
+					Expect(json.Unmarshal(w.Spec.InfrastructureProviderStatus.Raw, infrastructureStatus)).To(Succeed())
+					infrastructureStatus.Tags = map[string]string{"CostCenter": "4711"}
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+					deployMachineClasses()
+
+					for _, machineClass := range machineClasses {
+						Expect(machineClass["tags"]).To(HaveKeyWithValue("CostCenter", "4711"))
+						Expect(machineClass["tags"]).To(HaveKeyWithValue("kubernetes.io/cluster/"+namespace, "1"))
+					}
+					// the tags of existing instances are reconciled in place, hence the nodes are not rolled
+					Expect(machineClasses[0]["name"]).To(HaveSuffix(workerPoolHash1))
+				})
+
+				It("should use the default KMS key of the shoot for encrypted system disks", func() {
+					cluster.Shoot = cluster.Shoot.DeepCopy()
+					cluster.Shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.ControlPlaneConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "ControlPlaneConfig",
+							},
+							DiskEncryption: &apiv1alpha1.DiskEncryption{KMSKeyID: ptr.To("key-default")},
+						}),
+					}
+					infrastructureStatus := &api.InfrastructureStatus{}
+					Expect(json.Unmarshal(w.Spec.InfrastructureProviderStatus.Raw, infrastructureStatus)).To(Succeed())
+					infrastructureStatus.MachineImages = append(infrastructureStatus.MachineImages, api.MachineImage{
+						Name:         machineImageName,
+						Version:      machineImageVersion,
+						Encrypted:    ptr.To(true),
+						ID:           "ami-kms",
+						Architecture: ptr.To(archARM),
+						KMSKeyID:     ptr.To("key-default"),
+					})
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+					deployMachineClasses()
+
+					Expect(machineClasses[0]["systemDisk"]).NotTo(HaveKey("kmsKeyID"))
+					Expect(machineClasses[0]["dataDisks"].([]map[string]interface{})[1]).To(HaveKeyWithValue("kmsKeyID", "key-default"))
+					Expect(machineClasses[2]).To(HaveKeyWithValue("imageID", "ami-kms"))
+					Expect(machineClasses[2]["systemDisk"]).To(HaveKeyWithValue("kmsKeyID", "key-default"))
+				})
+
+				It("should derive the node template from the fallback instance type of a zone", func() {
+					cluster.CloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{
+						{Name: "ecs.g6.large", CPU: resource.MustParse("2"), GPU: resource.MustParse("0"), Memory: resource.MustParse("8Gi")},
+					}
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerStatus",
+							},
+							InstanceTypeFallbacks: []apiv1alpha1.InstanceTypeFallback{
+								{PoolName: namePool1, Zone: zone1, InstanceType: "ecs.g6.large", LastUpdateTime: metav1.Now()},
+							},
+						}),
+					}
+					deployMachineClasses()
+
+					Expect(machineClasses[0]).To(HaveKeyWithValue("instanceType", "ecs.g6.large"))
+					Expect(machineClasses[0]["nodeTemplate"]).To(BeComparableTo(machinev1alpha1.NodeTemplate{
+						Capacity: corev1.ResourceList{
+							"cpu":               resource.MustParse("2"),
+							"gpu":               resource.MustParse("0"),
+							"memory":            resource.MustParse("8Gi"),
+							"ephemeral-storage": resource.MustParse("20Gi"),
+						},
+						InstanceType: "ecs.g6.large",
+						Region:       region,
+						Zone:         zone1,
+						Architecture: ptr.To(archAMD),
+					}))
+					Expect(machineClasses[1]["nodeTemplate"]).To(Equal(nodeTemplatePool1Zone2))
+				})
+
+				Context("node template", func() {
+					BeforeEach(func() {
+						w.Spec.Pools[0].NodeTemplate = nil
+					})
+
+					It("should not add a node template for machine types of the cloud profile", func() {
+						cluster.CloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{
+							{Name: machineType, CPU: resource.MustParse("4"), GPU: resource.MustParse("0"), Memory: resource.MustParse("16Gi")},
+						}
+						deployMachineClasses()
+
+						Expect(machineClasses[0]).NotTo(HaveKey("nodeTemplate"))
+						Expect(machineClasses[1]).NotTo(HaveKey("nodeTemplate"))
+						Expect(machineClasses[2]["nodeTemplate"]).To(Equal(nodeTemplatePool2Zone1))
+					})
+
+					deployMachineClassesWithCatalog := func(accessKeyID string, cpuCoreCount int) {
+						clientFactory := mockalicloudclient.NewMockClientFactory(ctrl)
+						ecsClient := mockalicloudclient.NewMockECS(ctrl)
+						c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: w.Spec.SecretRef.Name}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+							func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+								secret.Data = map[string][]byte{
+									alicloud.AccessKeyID:     []byte(accessKeyID),
+									alicloud.AccessKeySecret: []byte("access-key-secret"),
+								}
+								return nil
+							},
+						)
+						clientFactory.EXPECT().NewECSClient(region, &alicloud.Credentials{AccessKeyID: accessKeyID, AccessKeySecret: "access-key-secret"}).Return(ecsClient, nil).MaxTimes(1)
+						ecsClient.EXPECT().ListAllInstanceType().DoAndReturn(func() (*ecs.DescribeInstanceTypesResponse, error) {
+							response := ecs.CreateDescribeInstanceTypesResponse()
+							response.InstanceTypes.InstanceType = []ecs.InstanceType{
+								{InstanceTypeId: machineType, CpuCoreCount: cpuCoreCount, MemorySize: 32, GPUAmount: 1},
+							}
+							return response, nil
+						}).MaxTimes(1)
+
+						workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", clientFactory, w, cluster)
+						for range w.Spec.Pools {
+							expectedUserDataSecretRefRead()
+						}
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					}
+
+					It("should derive the node template from the ECS instance type catalog", func() {
+						deployMachineClassesWithCatalog("access-key-id", 8)
+
+						Expect(machineClasses[0]["nodeTemplate"]).To(BeComparableTo(machinev1alpha1.NodeTemplate{
+							Capacity: corev1.ResourceList{
+								"cpu":               resource.MustParse("8"),
+								"gpu":               resource.MustParse("1"),
+								"memory":            resource.MustParse("32Gi"),
+								"ephemeral-storage": resource.MustParse("20Gi"),
+							},
+							InstanceType: machineType,
+							Region:       region,
+							Zone:         zone1,
+							Architecture: ptr.To(archAMD),
+						}))
+					})
+
+					It("should cache the ECS instance type catalog per account", func() {
+						deployMachineClassesWithCatalog("access-key-id-1", 8)
+						cpu := machineClasses[0]["nodeTemplate"].(machinev1alpha1.NodeTemplate).Capacity[corev1.ResourceCPU]
+						Expect(cpu.Value()).To(BeEquivalentTo(8))
+
+						deployMachineClassesWithCatalog("access-key-id-2", 16)
+						cpu = machineClasses[0]["nodeTemplate"].(machinev1alpha1.NodeTemplate).Capacity[corev1.ResourceCPU]
+						Expect(cpu.Value()).To(BeEquivalentTo(16))
+					})
+				})
+			})
 		})
 	})
 })
@@ -1108,6 +1605,14 @@ func encode(obj runtime.Object) []byte {
 	return data
 }
 
+func withWorkerConfig(pool *extensionsv1alpha1.WorkerPool, workerConfig *apiv1alpha1.WorkerConfig) {
+	workerConfig.TypeMeta = metav1.TypeMeta{
+		APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "WorkerConfig",
+	}
+	pool.ProviderConfig = &runtime.RawExtension{Raw: encode(workerConfig)}
+}
+
 func useDefaultMachineClass(def map[string]interface{}, keyValues ...interface{}) map[string]interface{} {
 	out := make(map[string]interface{}, len(def)+1)
 
@@ -1122,6 +1627,14 @@ func useDefaultMachineClass(def map[string]interface{}, keyValues ...interface{}
 	return out
 }
 
+func machineClassesFromApplyOptions(opts ...kubernetes.ApplyOption) []map[string]interface{} {
+	applyOpts := &kubernetes.ApplyOptions{}
+	for _, opt := range opts {
+		opt.MutateApplyOptions(applyOpts)
+	}
+	return applyOpts.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+}
+
 func addNodeTemplateToMachineClass(class map[string]interface{}, nodeTemplate machinev1alpha1.NodeTemplate) {
 	class["nodeTemplate"] = nodeTemplate
 }
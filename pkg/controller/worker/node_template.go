@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// resourceGPU is the name of the GPU resource in the node templates evaluated by the cluster-autoscaler.
+	resourceGPU corev1.ResourceName = "gpu"
+	// instanceTypeCatalogTTL is the time after which the cached ECS instance type catalog of a region is refreshed.
+	instanceTypeCatalogTTL = 6 * time.Hour
+)
+
+// instanceTypeCatalogSnapshot is a snapshot of the capacities of all ECS instance types of a region.
+type instanceTypeCatalogSnapshot struct {
+	capacities map[string]corev1.ResourceList
+	fetched    time.Time
+}
+
+// instanceTypeCatalog caches the ECS instance type catalogs across reconciliations, as describing all instance types is
+// an expensive call. The catalogs are keyed by the region and the account, as the offered instance types may differ
+// between accounts.
+var instanceTypeCatalog = struct {
+	sync.Mutex
+	snapshots map[string]instanceTypeCatalogSnapshot
+}{snapshots: map[string]instanceTypeCatalogSnapshot{}}
+
+// computeNodeTemplate returns the node template for the machine class of the given worker pool in the given zone. The
+// node template of the worker pool is used for its machine type. Otherwise, i.e. if the worker pool does not specify a
+// node template or uses a fallback instance type in the zone, the capacity is derived from the machine types of the
+// cloud profile or the ECS instance type catalog of the region, so that the cluster-autoscaler can scale the pool from
+// zero. It returns nil for worker pools without node template whose instance type is offered by the cloud profile,
+// i.e. the machine classes of such worker pools are not changed, and if the capacity of the instance type is unknown.
+func (w *workerDelegate) computeNodeTemplate(ctx context.Context, pool extensionsv1alpha1.WorkerPool, instanceType, zone string) (*machinev1alpha1.NodeTemplate, error) {
+	var capacity corev1.ResourceList
+	if pool.NodeTemplate != nil && instanceType == pool.MachineType {
+		capacity = pool.NodeTemplate.Capacity
+	} else {
+		cloudProfileCapacity := w.cloudProfileCapacity(instanceType)
+		if pool.NodeTemplate == nil && cloudProfileCapacity != nil {
+			return nil, nil
+		}
+
+		capacity = cloudProfileCapacity
+		if capacity == nil {
+			var err error
+			if capacity, err = w.catalogCapacity(ctx, instanceType); err != nil {
+				return nil, fmt.Errorf("failed to determine the capacity of instance type %q of worker pool %q: %w", instanceType, pool.Name, err)
+			}
+			if capacity == nil {
+				return nil, nil
+			}
+		}
+
+		if pool.Volume != nil {
+			volumeSize, err := worker.DiskSize(pool.Volume.Size)
+			if err != nil {
+				return nil, err
+			}
+			capacity[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(int64(volumeSize)<<30, resource.BinarySI)
+		}
+	}
+
+	return &machinev1alpha1.NodeTemplate{
+		Capacity:     capacity,
+		InstanceType: instanceType,
+		Region:       w.worker.Spec.Region,
+		Zone:         zone,
+		Architecture: ptr.To(ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)),
+	}, nil
+}
+
+// cloudProfileCapacity returns the CPU, GPU and memory capacity of the given instance type if it is offered by the
+// cloud profile.
+func (w *workerDelegate) cloudProfileCapacity(instanceType string) corev1.ResourceList {
+	if w.cluster == nil || w.cluster.CloudProfile == nil {
+		return nil
+	}
+	for _, machineType := range w.cluster.CloudProfile.Spec.MachineTypes {
+		if machineType.Name == instanceType {
+			return corev1.ResourceList{
+				corev1.ResourceCPU:    machineType.CPU,
+				corev1.ResourceMemory: machineType.Memory,
+				resourceGPU:           machineType.GPU,
+			}
+		}
+	}
+	return nil
+}
+
+// catalogCapacity returns the CPU, GPU and memory capacity of the given instance type from the ECS instance type
+// catalog.
+func (w *workerDelegate) catalogCapacity(ctx context.Context, instanceType string) (corev1.ResourceList, error) {
+	capacities, err := w.instanceTypeCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if capacity, ok := capacities[instanceType]; ok {
+		return capacity.DeepCopy(), nil
+	}
+	return nil, nil
+}
+
+// instanceTypeCatalog returns the capacities of all ECS instance types of the region of the worker which are offered to
+// the account of the worker. The catalog is only described again once the cached snapshot has expired.
+func (w *workerDelegate) instanceTypeCatalog(ctx context.Context) (map[string]corev1.ResourceList, error) {
+	credentials, err := w.getCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the account is identified by the access key and the assumed role, if any
+	key := w.worker.Spec.Region + "/" + credentials.AccessKeyID + "/" + credentials.RoleARN
+
+	instanceTypeCatalog.Lock()
+	defer instanceTypeCatalog.Unlock()
+
+	if snapshot, ok := instanceTypeCatalog.snapshots[key]; ok && time.Since(snapshot.fetched) < instanceTypeCatalogTTL {
+		return snapshot.capacities, nil
+	}
+
+	ecsClient, err := w.getECSClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ecsClient.ListAllInstanceType()
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe instance types: %w", err)
+	}
+
+	capacities := make(map[string]corev1.ResourceList, len(response.InstanceTypes.InstanceType))
+	for _, instanceType := range response.InstanceTypes.InstanceType {
+		capacities[instanceType.InstanceTypeId] = corev1.ResourceList{
+			corev1.ResourceCPU:    *resource.NewQuantity(int64(instanceType.CpuCoreCount), resource.DecimalSI),
+			corev1.ResourceMemory: *resource.NewQuantity(int64(instanceType.MemorySize*(1<<30)), resource.BinarySI),
+			resourceGPU:           *resource.NewQuantity(int64(instanceType.GPUAmount), resource.DecimalSI),
+		}
+	}
+
+	instanceTypeCatalog.snapshots[key] = instanceTypeCatalogSnapshot{capacities: capacities, fetched: time.Now()}
+	return capacities, nil
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client (interfaces: ClientFactory,ECS,STS,SLB,VPC,OSS,RAM,ROS)
+// Source: github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client (interfaces: ClientFactory,ECS,STS,SLB,VPC,OSS,RAM,ROS,CBN,PrivateLink,PVTZ)
 //
 // Generated by this command:
 //
-//	mockgen -package=client -destination=mocks.go github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client ClientFactory,ECS,STS,SLB,VPC,OSS,RAM,ROS
+//	mockgen -package=client -destination=mocks.go github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client ClientFactory,ECS,STS,SLB,VPC,OSS,RAM,ROS,CBN,PrivateLink,PVTZ
 //
 
 // Package client is a generated GoMock package.
@@ -13,10 +13,14 @@ import (
 	context "context"
 	reflect "reflect"
 
+	cbn "github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
 	ecs "github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	privatelink "github.com/aliyun/alibaba-cloud-sdk-go/services/privatelink"
+	pvtz "github.com/aliyun/alibaba-cloud-sdk-go/services/pvtz"
 	resourcemanager "github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
 	vpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	oss "github.com/aliyun/aliyun-oss-go-sdk/oss"
+	alicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	client "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
 	ros "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/ros"
 	gomock "go.uber.org/mock/gomock"
@@ -48,49 +52,64 @@ func (m *MockClientFactory) EXPECT() *MockClientFactoryMockRecorder {
 	return m.recorder
 }
 
+// NewCBNClient mocks base method.
+func (m *MockClientFactory) NewCBNClient(region string, credentials *alicloud.Credentials) (client.CBN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCBNClient", region, credentials)
+	ret0, _ := ret[0].(client.CBN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewCBNClient indicates an expected call of NewCBNClient.
+func (mr *MockClientFactoryMockRecorder) NewCBNClient(region, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCBNClient", reflect.TypeOf((*MockClientFactory)(nil).NewCBNClient), region, credentials)
+}
+
 // NewDNSClient mocks base method.
-func (m *MockClientFactory) NewDNSClient(region, accessKeyID, accessKeySecret string) (client.DNS, error) {
+func (m *MockClientFactory) NewDNSClient(region string, credentials *alicloud.Credentials) (client.DNS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewDNSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewDNSClient", region, credentials)
 	ret0, _ := ret[0].(client.DNS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewDNSClient indicates an expected call of NewDNSClient.
-func (mr *MockClientFactoryMockRecorder) NewDNSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewDNSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDNSClient", reflect.TypeOf((*MockClientFactory)(nil).NewDNSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDNSClient", reflect.TypeOf((*MockClientFactory)(nil).NewDNSClient), region, credentials)
 }
 
 // NewECSClient mocks base method.
-func (m *MockClientFactory) NewECSClient(region, accessKeyID, accessKeySecret string) (client.ECS, error) {
+func (m *MockClientFactory) NewECSClient(region string, credentials *alicloud.Credentials) (client.ECS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewECSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewECSClient", region, credentials)
 	ret0, _ := ret[0].(client.ECS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewECSClient indicates an expected call of NewECSClient.
-func (mr *MockClientFactoryMockRecorder) NewECSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewECSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewECSClient", reflect.TypeOf((*MockClientFactory)(nil).NewECSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewECSClient", reflect.TypeOf((*MockClientFactory)(nil).NewECSClient), region, credentials)
 }
 
 // NewOSSClient mocks base method.
-func (m *MockClientFactory) NewOSSClient(endpoint, accessKeyID, accessKeySecret string) (client.OSS, error) {
+func (m *MockClientFactory) NewOSSClient(endpoint string, credentials *alicloud.Credentials) (client.OSS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewOSSClient", endpoint, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewOSSClient", endpoint, credentials)
 	ret0, _ := ret[0].(client.OSS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewOSSClient indicates an expected call of NewOSSClient.
-func (mr *MockClientFactoryMockRecorder) NewOSSClient(endpoint, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewOSSClient(endpoint, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOSSClient", reflect.TypeOf((*MockClientFactory)(nil).NewOSSClient), endpoint, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOSSClient", reflect.TypeOf((*MockClientFactory)(nil).NewOSSClient), endpoint, credentials)
 }
 
 // NewOSSClientFromSecretRef mocks base method.
@@ -108,79 +127,109 @@ func (mr *MockClientFactoryMockRecorder) NewOSSClientFromSecretRef(ctx, c, secre
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOSSClientFromSecretRef", reflect.TypeOf((*MockClientFactory)(nil).NewOSSClientFromSecretRef), ctx, c, secretRef, region)
 }
 
+// NewPVTZClient mocks base method.
+func (m *MockClientFactory) NewPVTZClient(region string, credentials *alicloud.Credentials) (client.PVTZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPVTZClient", region, credentials)
+	ret0, _ := ret[0].(client.PVTZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewPVTZClient indicates an expected call of NewPVTZClient.
+func (mr *MockClientFactoryMockRecorder) NewPVTZClient(region, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPVTZClient", reflect.TypeOf((*MockClientFactory)(nil).NewPVTZClient), region, credentials)
+}
+
+// NewPrivateLinkClient mocks base method.
+func (m *MockClientFactory) NewPrivateLinkClient(region string, credentials *alicloud.Credentials) (client.PrivateLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPrivateLinkClient", region, credentials)
+	ret0, _ := ret[0].(client.PrivateLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewPrivateLinkClient indicates an expected call of NewPrivateLinkClient.
+func (mr *MockClientFactoryMockRecorder) NewPrivateLinkClient(region, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPrivateLinkClient", reflect.TypeOf((*MockClientFactory)(nil).NewPrivateLinkClient), region, credentials)
+}
+
 // NewRAMClient mocks base method.
-func (m *MockClientFactory) NewRAMClient(region, accessKeyID, accessKeySecret string) (client.RAM, error) {
+func (m *MockClientFactory) NewRAMClient(region string, credentials *alicloud.Credentials) (client.RAM, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewRAMClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewRAMClient", region, credentials)
 	ret0, _ := ret[0].(client.RAM)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewRAMClient indicates an expected call of NewRAMClient.
-func (mr *MockClientFactoryMockRecorder) NewRAMClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewRAMClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRAMClient", reflect.TypeOf((*MockClientFactory)(nil).NewRAMClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRAMClient", reflect.TypeOf((*MockClientFactory)(nil).NewRAMClient), region, credentials)
 }
 
 // NewROSClient mocks base method.
-func (m *MockClientFactory) NewROSClient(region, accessKeyID, accessKeySecret string) (client.ROS, error) {
+func (m *MockClientFactory) NewROSClient(region string, credentials *alicloud.Credentials) (client.ROS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewROSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewROSClient", region, credentials)
 	ret0, _ := ret[0].(client.ROS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewROSClient indicates an expected call of NewROSClient.
-func (mr *MockClientFactoryMockRecorder) NewROSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewROSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewROSClient", reflect.TypeOf((*MockClientFactory)(nil).NewROSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewROSClient", reflect.TypeOf((*MockClientFactory)(nil).NewROSClient), region, credentials)
 }
 
 // NewSLBClient mocks base method.
-func (m *MockClientFactory) NewSLBClient(region, accessKeyID, accessKeySecret string) (client.SLB, error) {
+func (m *MockClientFactory) NewSLBClient(region string, credentials *alicloud.Credentials) (client.SLB, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSLBClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewSLBClient", region, credentials)
 	ret0, _ := ret[0].(client.SLB)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewSLBClient indicates an expected call of NewSLBClient.
-func (mr *MockClientFactoryMockRecorder) NewSLBClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewSLBClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLBClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLBClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLBClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLBClient), region, credentials)
 }
 
 // NewSTSClient mocks base method.
-func (m *MockClientFactory) NewSTSClient(region, accessKeyID, accessKeySecret string) (client.STS, error) {
+func (m *MockClientFactory) NewSTSClient(region string, credentials *alicloud.Credentials) (client.STS, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSTSClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewSTSClient", region, credentials)
 	ret0, _ := ret[0].(client.STS)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewSTSClient indicates an expected call of NewSTSClient.
-func (mr *MockClientFactoryMockRecorder) NewSTSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewSTSClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSTSClient", reflect.TypeOf((*MockClientFactory)(nil).NewSTSClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSTSClient", reflect.TypeOf((*MockClientFactory)(nil).NewSTSClient), region, credentials)
 }
 
 // NewVPCClient mocks base method.
-func (m *MockClientFactory) NewVPCClient(region, accessKeyID, accessKeySecret string) (client.VPC, error) {
+func (m *MockClientFactory) NewVPCClient(region string, credentials *alicloud.Credentials) (client.VPC, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewVPCClient", region, accessKeyID, accessKeySecret)
+	ret := m.ctrl.Call(m, "NewVPCClient", region, credentials)
 	ret0, _ := ret[0].(client.VPC)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewVPCClient indicates an expected call of NewVPCClient.
-func (mr *MockClientFactoryMockRecorder) NewVPCClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) NewVPCClient(region, credentials any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVPCClient", reflect.TypeOf((*MockClientFactory)(nil).NewVPCClient), region, accessKeyID, accessKeySecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVPCClient", reflect.TypeOf((*MockClientFactory)(nil).NewVPCClient), region, credentials)
 }
 
 // MockECS is a mock of ECS interface.
@@ -282,6 +331,21 @@ func (mr *MockECSMockRecorder) CheckIfImageOwnedByAliCloud(imageID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfImageOwnedByAliCloud", reflect.TypeOf((*MockECS)(nil).CheckIfImageOwnedByAliCloud), imageID)
 }
 
+// CreateDeploymentSet mocks base method.
+func (m *MockECS) CreateDeploymentSet(request *ecs.CreateDeploymentSetRequest) (*ecs.CreateDeploymentSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeploymentSet", request)
+	ret0, _ := ret[0].(*ecs.CreateDeploymentSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeploymentSet indicates an expected call of CreateDeploymentSet.
+func (mr *MockECSMockRecorder) CreateDeploymentSet(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeploymentSet", reflect.TypeOf((*MockECS)(nil).CreateDeploymentSet), request)
+}
+
 // CreateEgressRule mocks base method.
 func (m *MockECS) CreateEgressRule(request *ecs.AuthorizeSecurityGroupEgressRequest) error {
 	m.ctrl.T.Helper()
@@ -355,6 +419,21 @@ func (mr *MockECSMockRecorder) CreateSecurityGroups(vpcId, name any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroups", reflect.TypeOf((*MockECS)(nil).CreateSecurityGroups), vpcId, name)
 }
 
+// DeleteDeploymentSet mocks base method.
+func (m *MockECS) DeleteDeploymentSet(request *ecs.DeleteDeploymentSetRequest) (*ecs.DeleteDeploymentSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeploymentSet", request)
+	ret0, _ := ret[0].(*ecs.DeleteDeploymentSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDeploymentSet indicates an expected call of DeleteDeploymentSet.
+func (mr *MockECSMockRecorder) DeleteDeploymentSet(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeploymentSet", reflect.TypeOf((*MockECS)(nil).DeleteDeploymentSet), request)
+}
+
 // DeleteInstances mocks base method.
 func (m *MockECS) DeleteInstances(id string, force bool) error {
 	m.ctrl.T.Helper()
@@ -398,6 +477,51 @@ func (mr *MockECSMockRecorder) DeleteSecurityGroups(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroups", reflect.TypeOf((*MockECS)(nil).DeleteSecurityGroups), id)
 }
 
+// DescribeDeploymentSets mocks base method.
+func (m *MockECS) DescribeDeploymentSets(request *ecs.DescribeDeploymentSetsRequest) (*ecs.DescribeDeploymentSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeploymentSets", request)
+	ret0, _ := ret[0].(*ecs.DescribeDeploymentSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeploymentSets indicates an expected call of DescribeDeploymentSets.
+func (mr *MockECSMockRecorder) DescribeDeploymentSets(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeploymentSets", reflect.TypeOf((*MockECS)(nil).DescribeDeploymentSets), request)
+}
+
+// DescribeInstanceTypes mocks base method.
+func (m *MockECS) DescribeInstanceTypes(request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceTypes", request)
+	ret0, _ := ret[0].(*ecs.DescribeInstanceTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceTypes indicates an expected call of DescribeInstanceTypes.
+func (mr *MockECSMockRecorder) DescribeInstanceTypes(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceTypes", reflect.TypeOf((*MockECS)(nil).DescribeInstanceTypes), request)
+}
+
+// DescribeInstances mocks base method.
+func (m *MockECS) DescribeInstances(request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstances", request)
+	ret0, _ := ret[0].(*ecs.DescribeInstancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstances indicates an expected call of DescribeInstances.
+func (mr *MockECSMockRecorder) DescribeInstances(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstances", reflect.TypeOf((*MockECS)(nil).DescribeInstances), request)
+}
+
 // DescribeKeyPairs mocks base method.
 func (m *MockECS) DescribeKeyPairs(request *ecs.DescribeKeyPairsRequest) (*ecs.DescribeKeyPairsResponse, error) {
 	m.ctrl.T.Helper()
@@ -532,6 +656,21 @@ func (mr *MockECSMockRecorder) GetSecurityGroupWithID(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupWithID", reflect.TypeOf((*MockECS)(nil).GetSecurityGroupWithID), id)
 }
 
+// JoinResourceGroup mocks base method.
+func (m *MockECS) JoinResourceGroup(request *ecs.JoinResourceGroupRequest) (*ecs.JoinResourceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinResourceGroup", request)
+	ret0, _ := ret[0].(*ecs.JoinResourceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinResourceGroup indicates an expected call of JoinResourceGroup.
+func (mr *MockECSMockRecorder) JoinResourceGroup(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinResourceGroup", reflect.TypeOf((*MockECS)(nil).JoinResourceGroup), request)
+}
+
 // ListAllInstanceType mocks base method.
 func (m *MockECS) ListAllInstanceType() (*ecs.DescribeInstanceTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -785,6 +924,34 @@ func (mr *MockSLBMockRecorder) SetLoadBalancerDeleteProtection(ctx, region, load
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerDeleteProtection", reflect.TypeOf((*MockSLB)(nil).SetLoadBalancerDeleteProtection), ctx, region, loadBalancerID, protection)
 }
 
+// TagLoadBalancer mocks base method.
+func (m *MockSLB) TagLoadBalancer(ctx context.Context, region, loadBalancerID string, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagLoadBalancer", ctx, region, loadBalancerID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagLoadBalancer indicates an expected call of TagLoadBalancer.
+func (mr *MockSLBMockRecorder) TagLoadBalancer(ctx, region, loadBalancerID, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagLoadBalancer", reflect.TypeOf((*MockSLB)(nil).TagLoadBalancer), ctx, region, loadBalancerID, tags)
+}
+
+// UntagLoadBalancer mocks base method.
+func (m *MockSLB) UntagLoadBalancer(ctx context.Context, region, loadBalancerID string, tagKeys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagLoadBalancer", ctx, region, loadBalancerID, tagKeys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UntagLoadBalancer indicates an expected call of UntagLoadBalancer.
+func (mr *MockSLBMockRecorder) UntagLoadBalancer(ctx, region, loadBalancerID, tagKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagLoadBalancer", reflect.TypeOf((*MockSLB)(nil).UntagLoadBalancer), ctx, region, loadBalancerID, tagKeys)
+}
+
 // MockVPC is a mock of VPC interface.
 type MockVPC struct {
 	ctrl     *gomock.Controller
@@ -809,6 +976,21 @@ func (m *MockVPC) EXPECT() *MockVPCMockRecorder {
 	return m.recorder
 }
 
+// AddCommonBandwidthPackageIp mocks base method.
+func (m *MockVPC) AddCommonBandwidthPackageIp(request *vpc.AddCommonBandwidthPackageIpRequest) (*vpc.AddCommonBandwidthPackageIpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCommonBandwidthPackageIp", request)
+	ret0, _ := ret[0].(*vpc.AddCommonBandwidthPackageIpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCommonBandwidthPackageIp indicates an expected call of AddCommonBandwidthPackageIp.
+func (mr *MockVPCMockRecorder) AddCommonBandwidthPackageIp(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCommonBandwidthPackageIp", reflect.TypeOf((*MockVPC)(nil).AddCommonBandwidthPackageIp), request)
+}
+
 // AllocateEipAddress mocks base method.
 func (m *MockVPC) AllocateEipAddress(request *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -839,6 +1021,36 @@ func (mr *MockVPCMockRecorder) AssociateEipAddress(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateEipAddress", reflect.TypeOf((*MockVPC)(nil).AssociateEipAddress), request)
 }
 
+// AssociateRouteTablesWithVpcGatewayEndpoint mocks base method.
+func (m *MockVPC) AssociateRouteTablesWithVpcGatewayEndpoint(request *vpc.AssociateRouteTablesWithVpcGatewayEndpointRequest) (*vpc.AssociateRouteTablesWithVpcGatewayEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateRouteTablesWithVpcGatewayEndpoint", request)
+	ret0, _ := ret[0].(*vpc.AssociateRouteTablesWithVpcGatewayEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateRouteTablesWithVpcGatewayEndpoint indicates an expected call of AssociateRouteTablesWithVpcGatewayEndpoint.
+func (mr *MockVPCMockRecorder) AssociateRouteTablesWithVpcGatewayEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateRouteTablesWithVpcGatewayEndpoint", reflect.TypeOf((*MockVPC)(nil).AssociateRouteTablesWithVpcGatewayEndpoint), request)
+}
+
+// CreateIpv6Gateway mocks base method.
+func (m *MockVPC) CreateIpv6Gateway(request *vpc.CreateIpv6GatewayRequest) (*vpc.CreateIpv6GatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv6Gateway", request)
+	ret0, _ := ret[0].(*vpc.CreateIpv6GatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv6Gateway indicates an expected call of CreateIpv6Gateway.
+func (mr *MockVPCMockRecorder) CreateIpv6Gateway(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6Gateway", reflect.TypeOf((*MockVPC)(nil).CreateIpv6Gateway), request)
+}
+
 // CreateNatGateway mocks base method.
 func (m *MockVPC) CreateNatGateway(request *vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -899,6 +1111,36 @@ func (mr *MockVPCMockRecorder) CreateVpc(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpc", reflect.TypeOf((*MockVPC)(nil).CreateVpc), request)
 }
 
+// CreateVpcGatewayEndpoint mocks base method.
+func (m *MockVPC) CreateVpcGatewayEndpoint(request *vpc.CreateVpcGatewayEndpointRequest) (*vpc.CreateVpcGatewayEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpcGatewayEndpoint", request)
+	ret0, _ := ret[0].(*vpc.CreateVpcGatewayEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpcGatewayEndpoint indicates an expected call of CreateVpcGatewayEndpoint.
+func (mr *MockVPCMockRecorder) CreateVpcGatewayEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcGatewayEndpoint", reflect.TypeOf((*MockVPC)(nil).CreateVpcGatewayEndpoint), request)
+}
+
+// DeleteIpv6Gateway mocks base method.
+func (m *MockVPC) DeleteIpv6Gateway(request *vpc.DeleteIpv6GatewayRequest) (*vpc.DeleteIpv6GatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv6Gateway", request)
+	ret0, _ := ret[0].(*vpc.DeleteIpv6GatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv6Gateway indicates an expected call of DeleteIpv6Gateway.
+func (mr *MockVPCMockRecorder) DeleteIpv6Gateway(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6Gateway", reflect.TypeOf((*MockVPC)(nil).DeleteIpv6Gateway), request)
+}
+
 // DeleteNatGateway mocks base method.
 func (m *MockVPC) DeleteNatGateway(request *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -959,6 +1201,21 @@ func (mr *MockVPCMockRecorder) DeleteVpc(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpc", reflect.TypeOf((*MockVPC)(nil).DeleteVpc), request)
 }
 
+// DeleteVpcGatewayEndpoint mocks base method.
+func (m *MockVPC) DeleteVpcGatewayEndpoint(request *vpc.DeleteVpcGatewayEndpointRequest) (*vpc.DeleteVpcGatewayEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcGatewayEndpoint", request)
+	ret0, _ := ret[0].(*vpc.DeleteVpcGatewayEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpcGatewayEndpoint indicates an expected call of DeleteVpcGatewayEndpoint.
+func (mr *MockVPCMockRecorder) DeleteVpcGatewayEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcGatewayEndpoint", reflect.TypeOf((*MockVPC)(nil).DeleteVpcGatewayEndpoint), request)
+}
+
 // DescribeEipAddresses mocks base method.
 func (m *MockVPC) DescribeEipAddresses(request *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
 	m.ctrl.T.Helper()
@@ -974,6 +1231,21 @@ func (mr *MockVPCMockRecorder) DescribeEipAddresses(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEipAddresses", reflect.TypeOf((*MockVPC)(nil).DescribeEipAddresses), request)
 }
 
+// DescribeIpv6Gateways mocks base method.
+func (m *MockVPC) DescribeIpv6Gateways(request *vpc.DescribeIpv6GatewaysRequest) (*vpc.DescribeIpv6GatewaysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeIpv6Gateways", request)
+	ret0, _ := ret[0].(*vpc.DescribeIpv6GatewaysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeIpv6Gateways indicates an expected call of DescribeIpv6Gateways.
+func (mr *MockVPCMockRecorder) DescribeIpv6Gateways(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeIpv6Gateways", reflect.TypeOf((*MockVPC)(nil).DescribeIpv6Gateways), request)
+}
+
 // DescribeNatGateways mocks base method.
 func (m *MockVPC) DescribeNatGateways(request *vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error) {
 	m.ctrl.T.Helper()
@@ -989,6 +1261,21 @@ func (mr *MockVPCMockRecorder) DescribeNatGateways(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNatGateways", reflect.TypeOf((*MockVPC)(nil).DescribeNatGateways), request)
 }
 
+// DescribeRouteTableList mocks base method.
+func (m *MockVPC) DescribeRouteTableList(request *vpc.DescribeRouteTableListRequest) (*vpc.DescribeRouteTableListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRouteTableList", request)
+	ret0, _ := ret[0].(*vpc.DescribeRouteTableListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRouteTableList indicates an expected call of DescribeRouteTableList.
+func (mr *MockVPCMockRecorder) DescribeRouteTableList(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRouteTableList", reflect.TypeOf((*MockVPC)(nil).DescribeRouteTableList), request)
+}
+
 // DescribeSnatTableEntries mocks base method.
 func (m *MockVPC) DescribeSnatTableEntries(request *vpc.DescribeSnatTableEntriesRequest) (*vpc.DescribeSnatTableEntriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1004,6 +1291,21 @@ func (mr *MockVPCMockRecorder) DescribeSnatTableEntries(request any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSnatTableEntries", reflect.TypeOf((*MockVPC)(nil).DescribeSnatTableEntries), request)
 }
 
+// DescribeTagKeys mocks base method.
+func (m *MockVPC) DescribeTagKeys(request *vpc.DescribeTagKeysRequest) (*vpc.DescribeTagKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTagKeys", request)
+	ret0, _ := ret[0].(*vpc.DescribeTagKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTagKeys indicates an expected call of DescribeTagKeys.
+func (mr *MockVPCMockRecorder) DescribeTagKeys(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTagKeys", reflect.TypeOf((*MockVPC)(nil).DescribeTagKeys), request)
+}
+
 // DescribeVSwitches mocks base method.
 func (m *MockVPC) DescribeVSwitches(request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1034,6 +1336,21 @@ func (mr *MockVPCMockRecorder) DescribeVpcs(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcs", reflect.TypeOf((*MockVPC)(nil).DescribeVpcs), request)
 }
 
+// DissociateRouteTablesFromVpcGatewayEndpoint mocks base method.
+func (m *MockVPC) DissociateRouteTablesFromVpcGatewayEndpoint(request *vpc.DissociateRouteTablesFromVpcGatewayEndpointRequest) (*vpc.DissociateRouteTablesFromVpcGatewayEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DissociateRouteTablesFromVpcGatewayEndpoint", request)
+	ret0, _ := ret[0].(*vpc.DissociateRouteTablesFromVpcGatewayEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DissociateRouteTablesFromVpcGatewayEndpoint indicates an expected call of DissociateRouteTablesFromVpcGatewayEndpoint.
+func (mr *MockVPCMockRecorder) DissociateRouteTablesFromVpcGatewayEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DissociateRouteTablesFromVpcGatewayEndpoint", reflect.TypeOf((*MockVPC)(nil).DissociateRouteTablesFromVpcGatewayEndpoint), request)
+}
+
 // FetchEIPInternetChargeType mocks base method.
 func (m *MockVPC) FetchEIPInternetChargeType(ctx context.Context, natGateway *vpc.NatGateway, vpcID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1184,6 +1501,21 @@ func (mr *MockVPCMockRecorder) ListTagResources(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagResources", reflect.TypeOf((*MockVPC)(nil).ListTagResources), request)
 }
 
+// ListVpcGatewayEndpoints mocks base method.
+func (m *MockVPC) ListVpcGatewayEndpoints(request *vpc.ListVpcGatewayEndpointsRequest) (*vpc.ListVpcGatewayEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpcGatewayEndpoints", request)
+	ret0, _ := ret[0].(*vpc.ListVpcGatewayEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcGatewayEndpoints indicates an expected call of ListVpcGatewayEndpoints.
+func (mr *MockVPCMockRecorder) ListVpcGatewayEndpoints(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcGatewayEndpoints", reflect.TypeOf((*MockVPC)(nil).ListVpcGatewayEndpoints), request)
+}
+
 // ModifyEipAddressAttribute mocks base method.
 func (m *MockVPC) ModifyEipAddressAttribute(request *vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1199,6 +1531,66 @@ func (mr *MockVPCMockRecorder) ModifyEipAddressAttribute(request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyEipAddressAttribute", reflect.TypeOf((*MockVPC)(nil).ModifyEipAddressAttribute), request)
 }
 
+// ModifyNatGatewaySpec mocks base method.
+func (m *MockVPC) ModifyNatGatewaySpec(request *vpc.ModifyNatGatewaySpecRequest) (*vpc.ModifyNatGatewaySpecResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNatGatewaySpec", request)
+	ret0, _ := ret[0].(*vpc.ModifyNatGatewaySpecResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyNatGatewaySpec indicates an expected call of ModifyNatGatewaySpec.
+func (mr *MockVPCMockRecorder) ModifyNatGatewaySpec(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNatGatewaySpec", reflect.TypeOf((*MockVPC)(nil).ModifyNatGatewaySpec), request)
+}
+
+// ModifyVSwitchAttribute mocks base method.
+func (m *MockVPC) ModifyVSwitchAttribute(request *vpc.ModifyVSwitchAttributeRequest) (*vpc.ModifyVSwitchAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVSwitchAttribute", request)
+	ret0, _ := ret[0].(*vpc.ModifyVSwitchAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVSwitchAttribute indicates an expected call of ModifyVSwitchAttribute.
+func (mr *MockVPCMockRecorder) ModifyVSwitchAttribute(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVSwitchAttribute", reflect.TypeOf((*MockVPC)(nil).ModifyVSwitchAttribute), request)
+}
+
+// ModifyVpcAttribute mocks base method.
+func (m *MockVPC) ModifyVpcAttribute(request *vpc.ModifyVpcAttributeRequest) (*vpc.ModifyVpcAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVpcAttribute", request)
+	ret0, _ := ret[0].(*vpc.ModifyVpcAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVpcAttribute indicates an expected call of ModifyVpcAttribute.
+func (mr *MockVPCMockRecorder) ModifyVpcAttribute(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVpcAttribute", reflect.TypeOf((*MockVPC)(nil).ModifyVpcAttribute), request)
+}
+
+// MoveResourceGroup mocks base method.
+func (m *MockVPC) MoveResourceGroup(request *vpc.MoveResourceGroupRequest) (*vpc.MoveResourceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveResourceGroup", request)
+	ret0, _ := ret[0].(*vpc.MoveResourceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveResourceGroup indicates an expected call of MoveResourceGroup.
+func (mr *MockVPCMockRecorder) MoveResourceGroup(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveResourceGroup", reflect.TypeOf((*MockVPC)(nil).MoveResourceGroup), request)
+}
+
 // ReleaseEipAddress mocks base method.
 func (m *MockVPC) ReleaseEipAddress(request *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -1214,6 +1606,21 @@ func (mr *MockVPCMockRecorder) ReleaseEipAddress(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEipAddress", reflect.TypeOf((*MockVPC)(nil).ReleaseEipAddress), request)
 }
 
+// RemoveCommonBandwidthPackageIp mocks base method.
+func (m *MockVPC) RemoveCommonBandwidthPackageIp(request *vpc.RemoveCommonBandwidthPackageIpRequest) (*vpc.RemoveCommonBandwidthPackageIpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCommonBandwidthPackageIp", request)
+	ret0, _ := ret[0].(*vpc.RemoveCommonBandwidthPackageIpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCommonBandwidthPackageIp indicates an expected call of RemoveCommonBandwidthPackageIp.
+func (mr *MockVPCMockRecorder) RemoveCommonBandwidthPackageIp(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCommonBandwidthPackageIp", reflect.TypeOf((*MockVPC)(nil).RemoveCommonBandwidthPackageIp), request)
+}
+
 // TagResources mocks base method.
 func (m *MockVPC) TagResources(request *vpc.TagResourcesRequest) (*vpc.TagResourcesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1578,3 +1985,435 @@ func (mr *MockROSMockRecorder) ListStacks(request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStacks", reflect.TypeOf((*MockROS)(nil).ListStacks), request)
 }
+
+// MockCBN is a mock of CBN interface.
+type MockCBN struct {
+	ctrl     *gomock.Controller
+	recorder *MockCBNMockRecorder
+	isgomock struct{}
+}
+
+// MockCBNMockRecorder is the mock recorder for MockCBN.
+type MockCBNMockRecorder struct {
+	mock *MockCBN
+}
+
+// NewMockCBN creates a new mock instance.
+func NewMockCBN(ctrl *gomock.Controller) *MockCBN {
+	mock := &MockCBN{ctrl: ctrl}
+	mock.recorder = &MockCBNMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCBN) EXPECT() *MockCBNMockRecorder {
+	return m.recorder
+}
+
+// AttachCenChildInstance mocks base method.
+func (m *MockCBN) AttachCenChildInstance(request *cbn.AttachCenChildInstanceRequest) (*cbn.AttachCenChildInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachCenChildInstance", request)
+	ret0, _ := ret[0].(*cbn.AttachCenChildInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachCenChildInstance indicates an expected call of AttachCenChildInstance.
+func (mr *MockCBNMockRecorder) AttachCenChildInstance(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachCenChildInstance", reflect.TypeOf((*MockCBN)(nil).AttachCenChildInstance), request)
+}
+
+// CreateTransitRouterRouteEntry mocks base method.
+func (m *MockCBN) CreateTransitRouterRouteEntry(request *cbn.CreateTransitRouterRouteEntryRequest) (*cbn.CreateTransitRouterRouteEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitRouterRouteEntry", request)
+	ret0, _ := ret[0].(*cbn.CreateTransitRouterRouteEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransitRouterRouteEntry indicates an expected call of CreateTransitRouterRouteEntry.
+func (mr *MockCBNMockRecorder) CreateTransitRouterRouteEntry(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitRouterRouteEntry", reflect.TypeOf((*MockCBN)(nil).CreateTransitRouterRouteEntry), request)
+}
+
+// CreateTransitRouterVpcAttachment mocks base method.
+func (m *MockCBN) CreateTransitRouterVpcAttachment(request *cbn.CreateTransitRouterVpcAttachmentRequest) (*cbn.CreateTransitRouterVpcAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitRouterVpcAttachment", request)
+	ret0, _ := ret[0].(*cbn.CreateTransitRouterVpcAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransitRouterVpcAttachment indicates an expected call of CreateTransitRouterVpcAttachment.
+func (mr *MockCBNMockRecorder) CreateTransitRouterVpcAttachment(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitRouterVpcAttachment", reflect.TypeOf((*MockCBN)(nil).CreateTransitRouterVpcAttachment), request)
+}
+
+// DeleteTransitRouterRouteEntry mocks base method.
+func (m *MockCBN) DeleteTransitRouterRouteEntry(request *cbn.DeleteTransitRouterRouteEntryRequest) (*cbn.DeleteTransitRouterRouteEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitRouterRouteEntry", request)
+	ret0, _ := ret[0].(*cbn.DeleteTransitRouterRouteEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransitRouterRouteEntry indicates an expected call of DeleteTransitRouterRouteEntry.
+func (mr *MockCBNMockRecorder) DeleteTransitRouterRouteEntry(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitRouterRouteEntry", reflect.TypeOf((*MockCBN)(nil).DeleteTransitRouterRouteEntry), request)
+}
+
+// DeleteTransitRouterVpcAttachment mocks base method.
+func (m *MockCBN) DeleteTransitRouterVpcAttachment(request *cbn.DeleteTransitRouterVpcAttachmentRequest) (*cbn.DeleteTransitRouterVpcAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitRouterVpcAttachment", request)
+	ret0, _ := ret[0].(*cbn.DeleteTransitRouterVpcAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransitRouterVpcAttachment indicates an expected call of DeleteTransitRouterVpcAttachment.
+func (mr *MockCBNMockRecorder) DeleteTransitRouterVpcAttachment(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitRouterVpcAttachment", reflect.TypeOf((*MockCBN)(nil).DeleteTransitRouterVpcAttachment), request)
+}
+
+// DescribeCenAttachedChildInstances mocks base method.
+func (m *MockCBN) DescribeCenAttachedChildInstances(request *cbn.DescribeCenAttachedChildInstancesRequest) (*cbn.DescribeCenAttachedChildInstancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCenAttachedChildInstances", request)
+	ret0, _ := ret[0].(*cbn.DescribeCenAttachedChildInstancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCenAttachedChildInstances indicates an expected call of DescribeCenAttachedChildInstances.
+func (mr *MockCBNMockRecorder) DescribeCenAttachedChildInstances(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCenAttachedChildInstances", reflect.TypeOf((*MockCBN)(nil).DescribeCenAttachedChildInstances), request)
+}
+
+// DetachCenChildInstance mocks base method.
+func (m *MockCBN) DetachCenChildInstance(request *cbn.DetachCenChildInstanceRequest) (*cbn.DetachCenChildInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachCenChildInstance", request)
+	ret0, _ := ret[0].(*cbn.DetachCenChildInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachCenChildInstance indicates an expected call of DetachCenChildInstance.
+func (mr *MockCBNMockRecorder) DetachCenChildInstance(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachCenChildInstance", reflect.TypeOf((*MockCBN)(nil).DetachCenChildInstance), request)
+}
+
+// ListTransitRouterRouteEntries mocks base method.
+func (m *MockCBN) ListTransitRouterRouteEntries(request *cbn.ListTransitRouterRouteEntriesRequest) (*cbn.ListTransitRouterRouteEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransitRouterRouteEntries", request)
+	ret0, _ := ret[0].(*cbn.ListTransitRouterRouteEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransitRouterRouteEntries indicates an expected call of ListTransitRouterRouteEntries.
+func (mr *MockCBNMockRecorder) ListTransitRouterRouteEntries(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitRouterRouteEntries", reflect.TypeOf((*MockCBN)(nil).ListTransitRouterRouteEntries), request)
+}
+
+// ListTransitRouterVpcAttachments mocks base method.
+func (m *MockCBN) ListTransitRouterVpcAttachments(request *cbn.ListTransitRouterVpcAttachmentsRequest) (*cbn.ListTransitRouterVpcAttachmentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransitRouterVpcAttachments", request)
+	ret0, _ := ret[0].(*cbn.ListTransitRouterVpcAttachmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransitRouterVpcAttachments indicates an expected call of ListTransitRouterVpcAttachments.
+func (mr *MockCBNMockRecorder) ListTransitRouterVpcAttachments(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitRouterVpcAttachments", reflect.TypeOf((*MockCBN)(nil).ListTransitRouterVpcAttachments), request)
+}
+
+// UpdateTransitRouterVpcAttachmentZones mocks base method.
+func (m *MockCBN) UpdateTransitRouterVpcAttachmentZones(request *cbn.UpdateTransitRouterVpcAttachmentZonesRequest) (*cbn.UpdateTransitRouterVpcAttachmentZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransitRouterVpcAttachmentZones", request)
+	ret0, _ := ret[0].(*cbn.UpdateTransitRouterVpcAttachmentZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransitRouterVpcAttachmentZones indicates an expected call of UpdateTransitRouterVpcAttachmentZones.
+func (mr *MockCBNMockRecorder) UpdateTransitRouterVpcAttachmentZones(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransitRouterVpcAttachmentZones", reflect.TypeOf((*MockCBN)(nil).UpdateTransitRouterVpcAttachmentZones), request)
+}
+
+// MockPrivateLink is a mock of PrivateLink interface.
+type MockPrivateLink struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateLinkMockRecorder
+	isgomock struct{}
+}
+
+// MockPrivateLinkMockRecorder is the mock recorder for MockPrivateLink.
+type MockPrivateLinkMockRecorder struct {
+	mock *MockPrivateLink
+}
+
+// NewMockPrivateLink creates a new mock instance.
+func NewMockPrivateLink(ctrl *gomock.Controller) *MockPrivateLink {
+	mock := &MockPrivateLink{ctrl: ctrl}
+	mock.recorder = &MockPrivateLinkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivateLink) EXPECT() *MockPrivateLinkMockRecorder {
+	return m.recorder
+}
+
+// AddZoneToVpcEndpoint mocks base method.
+func (m *MockPrivateLink) AddZoneToVpcEndpoint(request *privatelink.AddZoneToVpcEndpointRequest) (*privatelink.AddZoneToVpcEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddZoneToVpcEndpoint", request)
+	ret0, _ := ret[0].(*privatelink.AddZoneToVpcEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddZoneToVpcEndpoint indicates an expected call of AddZoneToVpcEndpoint.
+func (mr *MockPrivateLinkMockRecorder) AddZoneToVpcEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddZoneToVpcEndpoint", reflect.TypeOf((*MockPrivateLink)(nil).AddZoneToVpcEndpoint), request)
+}
+
+// CreateVpcEndpoint mocks base method.
+func (m *MockPrivateLink) CreateVpcEndpoint(request *privatelink.CreateVpcEndpointRequest) (*privatelink.CreateVpcEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpcEndpoint", request)
+	ret0, _ := ret[0].(*privatelink.CreateVpcEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpcEndpoint indicates an expected call of CreateVpcEndpoint.
+func (mr *MockPrivateLinkMockRecorder) CreateVpcEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcEndpoint", reflect.TypeOf((*MockPrivateLink)(nil).CreateVpcEndpoint), request)
+}
+
+// DeleteVpcEndpoint mocks base method.
+func (m *MockPrivateLink) DeleteVpcEndpoint(request *privatelink.DeleteVpcEndpointRequest) (*privatelink.DeleteVpcEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcEndpoint", request)
+	ret0, _ := ret[0].(*privatelink.DeleteVpcEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpcEndpoint indicates an expected call of DeleteVpcEndpoint.
+func (mr *MockPrivateLinkMockRecorder) DeleteVpcEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpoint", reflect.TypeOf((*MockPrivateLink)(nil).DeleteVpcEndpoint), request)
+}
+
+// ListVpcEndpointZones mocks base method.
+func (m *MockPrivateLink) ListVpcEndpointZones(request *privatelink.ListVpcEndpointZonesRequest) (*privatelink.ListVpcEndpointZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpcEndpointZones", request)
+	ret0, _ := ret[0].(*privatelink.ListVpcEndpointZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcEndpointZones indicates an expected call of ListVpcEndpointZones.
+func (mr *MockPrivateLinkMockRecorder) ListVpcEndpointZones(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcEndpointZones", reflect.TypeOf((*MockPrivateLink)(nil).ListVpcEndpointZones), request)
+}
+
+// ListVpcEndpoints mocks base method.
+func (m *MockPrivateLink) ListVpcEndpoints(request *privatelink.ListVpcEndpointsRequest) (*privatelink.ListVpcEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpcEndpoints", request)
+	ret0, _ := ret[0].(*privatelink.ListVpcEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcEndpoints indicates an expected call of ListVpcEndpoints.
+func (mr *MockPrivateLinkMockRecorder) ListVpcEndpoints(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcEndpoints", reflect.TypeOf((*MockPrivateLink)(nil).ListVpcEndpoints), request)
+}
+
+// MockPVTZ is a mock of PVTZ interface.
+type MockPVTZ struct {
+	ctrl     *gomock.Controller
+	recorder *MockPVTZMockRecorder
+	isgomock struct{}
+}
+
+// MockPVTZMockRecorder is the mock recorder for MockPVTZ.
+type MockPVTZMockRecorder struct {
+	mock *MockPVTZ
+}
+
+// NewMockPVTZ creates a new mock instance.
+func NewMockPVTZ(ctrl *gomock.Controller) *MockPVTZ {
+	mock := &MockPVTZ{ctrl: ctrl}
+	mock.recorder = &MockPVTZMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPVTZ) EXPECT() *MockPVTZMockRecorder {
+	return m.recorder
+}
+
+// AddZone mocks base method.
+func (m *MockPVTZ) AddZone(request *pvtz.AddZoneRequest) (*pvtz.AddZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddZone", request)
+	ret0, _ := ret[0].(*pvtz.AddZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddZone indicates an expected call of AddZone.
+func (mr *MockPVTZMockRecorder) AddZone(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddZone", reflect.TypeOf((*MockPVTZ)(nil).AddZone), request)
+}
+
+// AddZoneRecord mocks base method.
+func (m *MockPVTZ) AddZoneRecord(request *pvtz.AddZoneRecordRequest) (*pvtz.AddZoneRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddZoneRecord", request)
+	ret0, _ := ret[0].(*pvtz.AddZoneRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddZoneRecord indicates an expected call of AddZoneRecord.
+func (mr *MockPVTZMockRecorder) AddZoneRecord(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddZoneRecord", reflect.TypeOf((*MockPVTZ)(nil).AddZoneRecord), request)
+}
+
+// BindZoneVpc mocks base method.
+func (m *MockPVTZ) BindZoneVpc(request *pvtz.BindZoneVpcRequest) (*pvtz.BindZoneVpcResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindZoneVpc", request)
+	ret0, _ := ret[0].(*pvtz.BindZoneVpcResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BindZoneVpc indicates an expected call of BindZoneVpc.
+func (mr *MockPVTZMockRecorder) BindZoneVpc(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindZoneVpc", reflect.TypeOf((*MockPVTZ)(nil).BindZoneVpc), request)
+}
+
+// DeleteZone mocks base method.
+func (m *MockPVTZ) DeleteZone(request *pvtz.DeleteZoneRequest) (*pvtz.DeleteZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteZone", request)
+	ret0, _ := ret[0].(*pvtz.DeleteZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteZone indicates an expected call of DeleteZone.
+func (mr *MockPVTZMockRecorder) DeleteZone(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockPVTZ)(nil).DeleteZone), request)
+}
+
+// DescribeZoneInfo mocks base method.
+func (m *MockPVTZ) DescribeZoneInfo(request *pvtz.DescribeZoneInfoRequest) (*pvtz.DescribeZoneInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeZoneInfo", request)
+	ret0, _ := ret[0].(*pvtz.DescribeZoneInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeZoneInfo indicates an expected call of DescribeZoneInfo.
+func (mr *MockPVTZMockRecorder) DescribeZoneInfo(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeZoneInfo", reflect.TypeOf((*MockPVTZ)(nil).DescribeZoneInfo), request)
+}
+
+// DescribeZoneRecords mocks base method.
+func (m *MockPVTZ) DescribeZoneRecords(request *pvtz.DescribeZoneRecordsRequest) (*pvtz.DescribeZoneRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeZoneRecords", request)
+	ret0, _ := ret[0].(*pvtz.DescribeZoneRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeZoneRecords indicates an expected call of DescribeZoneRecords.
+func (mr *MockPVTZMockRecorder) DescribeZoneRecords(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeZoneRecords", reflect.TypeOf((*MockPVTZ)(nil).DescribeZoneRecords), request)
+}
+
+// DescribeZones mocks base method.
+func (m *MockPVTZ) DescribeZones(request *pvtz.DescribeZonesRequest) (*pvtz.DescribeZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeZones", request)
+	ret0, _ := ret[0].(*pvtz.DescribeZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeZones indicates an expected call of DescribeZones.
+func (mr *MockPVTZMockRecorder) DescribeZones(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeZones", reflect.TypeOf((*MockPVTZ)(nil).DescribeZones), request)
+}
+
+// TagResources mocks base method.
+func (m *MockPVTZ) TagResources(request *pvtz.TagResourcesRequest) (*pvtz.TagResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResources", request)
+	ret0, _ := ret[0].(*pvtz.TagResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResources indicates an expected call of TagResources.
+func (mr *MockPVTZMockRecorder) TagResources(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResources", reflect.TypeOf((*MockPVTZ)(nil).TagResources), request)
+}
+
+// UpdateZoneRecord mocks base method.
+func (m *MockPVTZ) UpdateZoneRecord(request *pvtz.UpdateZoneRecordRequest) (*pvtz.UpdateZoneRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateZoneRecord", request)
+	ret0, _ := ret[0].(*pvtz.UpdateZoneRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateZoneRecord indicates an expected call of UpdateZoneRecord.
+func (mr *MockPVTZMockRecorder) UpdateZoneRecord(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZoneRecord", reflect.TypeOf((*MockPVTZ)(nil).UpdateZoneRecord), request)
+}
@@ -0,0 +1,388 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const (
+	// ResourceTypeSLB is the type of the load balancers created by the Alicloud CCM of a shoot.
+	ResourceTypeSLB = "SLB"
+	// ResourceTypePrivateZone is the type of PrivateZones.
+	ResourceTypePrivateZone = "PrivateZone"
+	// ResourceTypeVPCEndpoint is the type of VPC endpoints.
+	ResourceTypeVPCEndpoint = "VPCEndpoint"
+	// ResourceTypeVPCGatewayEndpoint is the type of VPC gateway endpoints.
+	ResourceTypeVPCGatewayEndpoint = "VPCGatewayEndpoint"
+	// ResourceTypeSNATEntry is the type of SNAT entries.
+	ResourceTypeSNATEntry = "SNATEntry"
+	// ResourceTypeEIP is the type of elastic IPs.
+	ResourceTypeEIP = "EIP"
+	// ResourceTypeNatGateway is the type of NAT gateways.
+	ResourceTypeNatGateway = "NatGateway"
+	// ResourceTypeSecurityGroup is the type of security groups.
+	ResourceTypeSecurityGroup = "SecurityGroup"
+	// ResourceTypeVSwitch is the type of vswitches.
+	ResourceTypeVSwitch = "VSwitch"
+	// ResourceTypeIPv6Gateway is the type of IPv6 gateways.
+	ResourceTypeIPv6Gateway = "IPv6Gateway"
+	// ResourceTypeVPC is the type of VPCs.
+	ResourceTypeVPC = "VPC"
+)
+
+// TagKeyOrphanedSince is the key of the tag the sweeper sets on the resources of a namespace when it finds them orphaned
+// for the first time. The value is the time in RFC 3339 format. The infrastructure reconciliation removes the tag if the
+// shoot is still alive, as it removes all unknown tags.
+const TagKeyOrphanedSince = "gardener.cloud/orphaned-since"
+
+// clusterTagKeyPrefix is the prefix of the cluster tag keys, it is followed by the namespace of the shoot.
+var clusterTagKeyPrefix = fmt.Sprintf(infraflow.TagKeyClusterTemplate, "")
+
+// tagKeyResourceTypes are the resource types whose tag keys are used to discover the namespaces of shoots.
+var tagKeyResourceTypes = []string{"VPC", "VSWITCH", "NATGATEWAY", "EIP", "IPV6GATEWAY"}
+
+// tagResourceTypes maps the types of the resources which can carry the TagKeyOrphanedSince tag to their resource types
+// as used for tags.
+var tagResourceTypes = map[string]string{
+	ResourceTypeEIP:           "EIP",
+	ResourceTypeNatGateway:    "NATGATEWAY",
+	ResourceTypeSecurityGroup: "securitygroup",
+	ResourceTypeVSwitch:       "VSWITCH",
+	ResourceTypeIPv6Gateway:   "IPV6GATEWAY",
+	ResourceTypeVPC:           "VPC",
+}
+
+// Resource is a resource of a shoot found by the Sweeper.
+type Resource struct {
+	// Namespace is the namespace of the shoot in the seed.
+	Namespace string
+	// Type is the type of the resource.
+	Type string
+	// ID is the id of the resource.
+	ID string
+	// Name is the name of the resource.
+	Name string
+	// ParentID is the id of the resource the resource is part of, i.e. the SNAT table of a SNAT entry.
+	ParentID string
+	// OrphanedSince is the time of the TagKeyOrphanedSince tag of the resource. It is nil if the resource does not carry
+	// the tag yet or cannot be tagged.
+	OrphanedSince *time.Time
+}
+
+// Sweeper finds the resources of shoots which have been left behind after the shoots have been deleted and deletes
+// them. The resources are found by the cluster tag the infrastructure reconciliation sets on all resources it creates,
+// the load balancers created by the Alicloud CCM of a shoot are found by the name of their first VServer group.
+type Sweeper struct {
+	log       logr.Logger
+	actor     aliclient.Actor
+	slbClient alicloudclient.SLB
+	region    string
+}
+
+// New creates a sweeper for the given region.
+func New(log logr.Logger, actor aliclient.Actor, slbClient alicloudclient.SLB, region string) *Sweeper {
+	return &Sweeper{
+		log:       log,
+		actor:     actor,
+		slbClient: slbClient,
+		region:    region,
+	}
+}
+
+// FindOrphanedResources returns the resources of all shoots whose namespace is not contained in the given existing
+// namespaces. They are sorted by namespace and, per namespace, in the order they have to be deleted. As being absent from
+// the existing namespaces is not proof enough for a shoot being deleted, the resources should only be deleted after
+// they have been marked with Mark and have been orphaned for a while, see FilterExpired.
+func (s *Sweeper) FindOrphanedResources(ctx context.Context, existingNamespaces sets.Set[string]) ([]Resource, error) {
+	namespaces := sets.New[string]()
+	for _, resourceType := range tagKeyResourceTypes {
+		keys, err := s.actor.ListTagKeys(ctx, resourceType, clusterTagKeyPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tag keys of resource type %s: %w", resourceType, err)
+		}
+		for _, key := range keys {
+			if namespace, ok := strings.CutPrefix(key, clusterTagKeyPrefix); ok {
+				namespaces.Insert(namespace)
+			}
+		}
+	}
+	loadBalancers, err := s.findLoadBalancers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for namespace := range loadBalancers {
+		namespaces.Insert(namespace)
+	}
+
+	var orphaned []Resource
+	for _, namespace := range sets.List(namespaces) {
+		// only shoot namespaces are considered, other clusters may use the same tags
+		if !strings.HasPrefix(namespace, v1beta1constants.TechnicalIDPrefix) || existingNamespaces.Has(namespace) {
+			continue
+		}
+		resources, err := s.findResources(ctx, namespace, loadBalancers[namespace])
+		if err != nil {
+			return nil, fmt.Errorf("failed to find resources of namespace %s: %w", namespace, err)
+		}
+		orphaned = append(orphaned, resources...)
+	}
+	return orphaned, nil
+}
+
+// findLoadBalancers returns the ids of the load balancers of shoots keyed by the namespaces of the shoots.
+func (s *Sweeper) findLoadBalancers(ctx context.Context) (map[string][]string, error) {
+	loadBalancerIDs, err := s.slbClient.GetLoadBalancerIDs(ctx, s.region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load balancers: %w", err)
+	}
+	result := map[string][]string{}
+	for _, loadBalancerID := range loadBalancerIDs {
+		vServerGroupName, err := s.slbClient.GetFirstVServerGroupName(ctx, s.region, loadBalancerID)
+		if err != nil {
+			return nil, err
+		}
+		if vServerGroupName == "" {
+			continue
+		}
+		// the last part of the VServer group name is the cluster id, i.e. the namespace of the shoot
+		parts := strings.Split(vServerGroupName, "/")
+		namespace := parts[len(parts)-1]
+		result[namespace] = append(result[namespace], loadBalancerID)
+	}
+	return result, nil
+}
+
+// findResources returns the resources of the given namespace in the order they have to be deleted.
+func (s *Sweeper) findResources(ctx context.Context, namespace string, loadBalancerIDs []string) ([]Resource, error) {
+	tags := aliclient.Tags{fmt.Sprintf(infraflow.TagKeyClusterTemplate, namespace): infraflow.TagValueCluster}
+
+	var resources []Resource
+	for _, id := range loadBalancerIDs {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeSLB, ID: id})
+	}
+
+	zones, err := s.actor.FindPrivateZonesByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, zone := range zones {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypePrivateZone, ID: zone.ZoneId, Name: zone.Name})
+	}
+
+	endpoints, err := s.actor.FindVpcEndpointsByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, endpoint := range endpoints {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeVPCEndpoint, ID: endpoint.EndpointId, Name: endpoint.Name})
+	}
+
+	gwEndpoints, err := s.actor.FindVpcGatewayEndpointsByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, endpoint := range gwEndpoints {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeVPCGatewayEndpoint, ID: endpoint.EndpointId, Name: endpoint.Name})
+	}
+
+	natGateways, err := s.actor.FindNatGatewayByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, natGateway := range natGateways {
+		entries, err := s.actor.FindSNatEntriesByNatGateway(ctx, natGateway.NatGatewayId)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeSNATEntry, ID: entry.SnatEntryId, Name: entry.Name, ParentID: entry.SnatTableId})
+		}
+	}
+
+	eips, err := s.actor.FindEIPsByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, eip := range eips {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeEIP, ID: eip.EipId, Name: eip.Name, OrphanedSince: orphanedSince(eip.Tags)})
+	}
+
+	for _, natGateway := range natGateways {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeNatGateway, ID: natGateway.NatGatewayId, Name: natGateway.Name, OrphanedSince: orphanedSince(natGateway.Tags)})
+	}
+
+	securityGroups, err := s.actor.FindSecurityGroupsByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, sg := range securityGroups {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeSecurityGroup, ID: sg.SecurityGroupId, Name: sg.Name, OrphanedSince: orphanedSince(sg.Tags)})
+	}
+
+	vswitches, err := s.actor.FindVSwitchesByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, vsw := range vswitches {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeVSwitch, ID: vsw.VSwitchId, Name: vsw.Name, OrphanedSince: orphanedSince(vsw.Tags)})
+	}
+
+	ipv6Gateways, err := s.actor.FindIPv6GatewaysByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, gw := range ipv6Gateways {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeIPv6Gateway, ID: gw.IPv6GatewayId, Name: gw.Name, OrphanedSince: orphanedSince(gw.Tags)})
+	}
+
+	vpcs, err := s.actor.FindVpcsByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, vpc := range vpcs {
+		resources = append(resources, Resource{Namespace: namespace, Type: ResourceTypeVPC, ID: vpc.VpcId, Name: vpc.Name, OrphanedSince: orphanedSince(vpc.Tags)})
+	}
+	return resources, nil
+}
+
+// orphanedSince returns the time of the TagKeyOrphanedSince tag. An invalid time is treated like a missing tag, so that
+// the resource is tagged again.
+func orphanedSince(tags aliclient.Tags) *time.Time {
+	value, ok := tags[TagKeyOrphanedSince]
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// Mark sets the TagKeyOrphanedSince tag with the given time on all given resources which can be tagged and do not carry
+// the tag yet. The OrphanedSince field of these resources is updated accordingly.
+func (s *Sweeper) Mark(ctx context.Context, resources []Resource, now time.Time) error {
+	tags := aliclient.Tags{TagKeyOrphanedSince: now.UTC().Format(time.RFC3339)}
+	for i, resource := range resources {
+		tagResourceType, ok := tagResourceTypes[resource.Type]
+		if !ok || resource.OrphanedSince != nil {
+			continue
+		}
+		if err := s.actor.CreateTags(ctx, []string{resource.ID}, tags, tagResourceType); err != nil {
+			return fmt.Errorf("failed to mark %s %s of namespace %s as orphaned: %w", resource.Type, resource.ID, resource.Namespace, err)
+		}
+		resources[i].OrphanedSince = ptr.To(now.UTC().Truncate(time.Second))
+	}
+	return nil
+}
+
+// FilterExpired returns the resources of the namespaces whose resources have been orphaned for at least the given
+// minimum age, i.e. all resources of the namespace which can be tagged carry a TagKeyOrphanedSince tag older than the
+// minimum age. Namespaces without resources which can be tagged are never returned.
+func FilterExpired(resources []Resource, minAge time.Duration, now time.Time) []Resource {
+	expired := map[string]bool{}
+	for _, resource := range resources {
+		if _, ok := tagResourceTypes[resource.Type]; !ok {
+			continue
+		}
+		if _, ok := expired[resource.Namespace]; !ok {
+			expired[resource.Namespace] = true
+		}
+		if resource.OrphanedSince == nil || now.Sub(*resource.OrphanedSince) < minAge {
+			expired[resource.Namespace] = false
+		}
+	}
+
+	var result []Resource
+	for _, resource := range resources {
+		if expired[resource.Namespace] {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+// Delete deletes the given resources in the given order. If a resource cannot be deleted, the remaining resources of
+// its namespace are skipped, as they may depend on it, but the resources of the other namespaces are still deleted.
+func (s *Sweeper) Delete(ctx context.Context, resources []Resource) error {
+	var (
+		errs   []error
+		failed = sets.New[string]()
+	)
+	for _, resource := range resources {
+		if failed.Has(resource.Namespace) {
+			continue
+		}
+		s.log.Info("Deleting orphaned resource", "namespace", resource.Namespace, "type", resource.Type, "id", resource.ID)
+		if err := s.deleteResource(ctx, resource); err != nil {
+			failed.Insert(resource.Namespace)
+			errs = append(errs, fmt.Errorf("failed to delete %s %s of namespace %s: %w", resource.Type, resource.ID, resource.Namespace, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Sweeper) deleteResource(ctx context.Context, resource Resource) error {
+	switch resource.Type {
+	case ResourceTypeSLB:
+		if err := s.slbClient.SetLoadBalancerDeleteProtection(ctx, s.region, resource.ID, false); err != nil {
+			return err
+		}
+		return s.slbClient.DeleteLoadBalancer(ctx, s.region, resource.ID)
+	case ResourceTypePrivateZone:
+		return s.actor.DeletePrivateZone(ctx, resource.ID)
+	case ResourceTypeVPCEndpoint:
+		return s.actor.DeleteVpcEndpoint(ctx, resource.ID)
+	case ResourceTypeVPCGatewayEndpoint:
+		return s.actor.DeleteVpcGatewayEndpoint(ctx, resource.ID)
+	case ResourceTypeSNATEntry:
+		return s.actor.DeleteSNatEntry(ctx, resource.ID, resource.ParentID)
+	case ResourceTypeEIP:
+		eip, err := s.actor.GetEIP(ctx, resource.ID)
+		if err != nil || eip == nil {
+			return err
+		}
+		if ptr.Deref(eip.Status, "") == "InUse" {
+			if err := s.actor.UnAssociateEIP(ctx, eip); err != nil {
+				return err
+			}
+		}
+		return s.actor.DeleteEIP(ctx, resource.ID)
+	case ResourceTypeNatGateway:
+		return s.actor.DeleteNatGateway(ctx, resource.ID)
+	case ResourceTypeSecurityGroup:
+		sg, err := s.actor.GetSecurityGroup(ctx, resource.ID)
+		if err != nil || sg == nil {
+			return err
+		}
+		for _, rule := range sg.Rules {
+			if err := s.actor.RevokeSecurityGroupRule(ctx, sg.SecurityGroupId, rule.SecurityGroupRuleId, rule.Direction); err != nil {
+				return err
+			}
+		}
+		return s.actor.DeleteSecurityGroup(ctx, resource.ID)
+	case ResourceTypeVSwitch:
+		return s.actor.DeleteVSwitch(ctx, resource.ID)
+	case ResourceTypeIPv6Gateway:
+		return s.actor.DeleteIPv6Gateway(ctx, resource.ID)
+	case ResourceTypeVPC:
+		return s.actor.DeleteVpc(ctx, resource.ID)
+	}
+	return fmt.Errorf("unknown resource type %s", resource.Type)
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sweeper_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/fake"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+	mockalicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/sweeper"
+)
+
+var _ = Describe("Sweeper", func() {
+	const (
+		region          = "eu-central-1"
+		liveNamespace   = "shoot--foo--live"
+		orphanNamespace = "shoot--foo--orphan"
+	)
+
+	var (
+		ctx       context.Context
+		ctrl      *gomock.Controller
+		backend   *fake.Backend
+		slbClient *mockalicloudclient.MockSLB
+		sweeper   *Sweeper
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		backend = fake.NewBackend(region)
+		slbClient = mockalicloudclient.NewMockSLB(ctrl)
+		sweeper = New(logr.Discard(), fake.NewActor(backend), slbClient, region)
+
+		slbClient.EXPECT().GetLoadBalancerIDs(ctx, region).Return([]string{"lb-live", "lb-orphan"}, nil).AnyTimes()
+		slbClient.EXPECT().GetFirstVServerGroupName(ctx, region, "lb-live").Return("k8s/80/svc/default/"+liveNamespace, nil).AnyTimes()
+		slbClient.EXPECT().GetFirstVServerGroupName(ctx, region, "lb-orphan").Return("k8s/80/svc/default/"+orphanNamespace, nil).AnyTimes()
+	})
+
+	reconcile := func(namespace, cidr string, endpoints ...aliapi.VPCEndpoint) {
+		infra := &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: region},
+		}
+		config := &aliapi.InfrastructureConfig{
+			Networks: aliapi.Networks{
+				VPC:          aliapi.VPC{CIDR: ptr.To(cidr)},
+				Zones:        []aliapi.Zone{{Name: region + "a", Workers: cidr}},
+				VPCEndpoints: endpoints,
+			},
+		}
+		persistor := func(_ context.Context, _ shared.FlatMap) error {
+			return nil
+		}
+		credentials := &alicloud.Credentials{AccessKeyID: "ak", AccessKeySecret: "sk"}
+		flowContext, err := infraflow.NewFlowContext(logr.Discard(), fake.NewFactory(backend), credentials, infra, config, nil, persistor, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flowContext.Reconcile(ctx)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		reconcile(liveNamespace, "10.250.0.0/16")
+		reconcile(orphanNamespace, "10.180.0.0/16",
+			aliapi.VPCEndpoint{ServiceName: "com.aliyuncs.privatelink." + region + ".kms", PrivateDNSName: ptr.To("kms." + region + ".aliyuncs.com")},
+			aliapi.VPCEndpoint{Type: ptr.To(aliapi.VPCEndpointTypeGateway), ServiceName: "com.aliyun." + region + ".oss"})
+	})
+
+	It("should find the resources of namespaces without infrastructure in deletion order", func() {
+		resources, err := sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(resources).To(HaveEach(HaveField("Namespace", orphanNamespace)))
+		var types []string
+		for _, resource := range resources {
+			types = append(types, resource.Type)
+		}
+		Expect(types).To(Equal([]string{
+			ResourceTypeSLB,
+			ResourceTypePrivateZone,
+			ResourceTypeVPCEndpoint,
+			ResourceTypeVPCGatewayEndpoint,
+			ResourceTypeSNATEntry,
+			ResourceTypeEIP,
+			ResourceTypeNatGateway,
+			ResourceTypeSecurityGroup,
+			ResourceTypeVSwitch,
+			ResourceTypeVPC,
+		}))
+		Expect(resources[0].ID).To(Equal("lb-orphan"))
+		Expect(backend.VPCs()).To(HaveLen(2))
+	})
+
+	It("should only delete the orphaned resources", func() {
+		resources, err := sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+		Expect(err).NotTo(HaveOccurred())
+
+		gomock.InOrder(
+			slbClient.EXPECT().SetLoadBalancerDeleteProtection(ctx, region, "lb-orphan", false),
+			slbClient.EXPECT().DeleteLoadBalancer(ctx, region, "lb-orphan"),
+		)
+		Expect(sweeper.Delete(ctx, resources)).To(Succeed())
+
+		Expect(backend.VPCs()).To(ConsistOf(HaveField("CidrBlock", "10.250.0.0/16")))
+		Expect(backend.VSwitches()).To(HaveLen(1))
+		Expect(backend.NatGateways()).To(HaveLen(1))
+		Expect(backend.EIPs()).To(HaveLen(1))
+		Expect(backend.SNATEntries()).To(HaveLen(1))
+		Expect(backend.SecurityGroups()).To(HaveLen(1))
+		Expect(backend.PrivateZones()).To(BeEmpty())
+		Expect(backend.VPCEndpoints()).To(BeEmpty())
+		Expect(backend.VPCGatewayEndpoints()).To(BeEmpty())
+
+		resources, err = sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(ConsistOf(HaveField("Type", ResourceTypeSLB)))
+	})
+
+	It("should not find resources of namespaces with an infrastructure", func() {
+		resources, err := sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace, orphanNamespace))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(BeEmpty())
+	})
+
+	It("should skip the remaining resources of a namespace after a failed deletion", func() {
+		resources, err := sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+		Expect(err).NotTo(HaveOccurred())
+
+		slbClient.EXPECT().SetLoadBalancerDeleteProtection(ctx, region, "lb-orphan", false).Return(errors.New("throttled"))
+		Expect(sweeper.Delete(ctx, resources)).To(MatchError(ContainSubstring("failed to delete SLB lb-orphan of namespace " + orphanNamespace)))
+		Expect(backend.VPCs()).To(HaveLen(2))
+		Expect(backend.EIPs()).To(HaveLen(2))
+	})
+
+	Describe("#Mark", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		})
+
+		It("should tag the resources which can be tagged with the time they were found orphaned", func() {
+			resources, err := sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(HaveEach(HaveField("OrphanedSince", BeNil())))
+
+			Expect(sweeper.Mark(ctx, resources, now)).To(Succeed())
+			for _, resource := range resources {
+				if sets.New(ResourceTypeSLB, ResourceTypePrivateZone, ResourceTypeVPCEndpoint, ResourceTypeVPCGatewayEndpoint, ResourceTypeSNATEntry).Has(resource.Type) {
+					Expect(resource.OrphanedSince).To(BeNil())
+				} else {
+					Expect(resource.OrphanedSince).To(HaveValue(Equal(now)), resource.Type)
+				}
+			}
+
+			// the tag is kept if the resources are found again
+			resources, err = sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sweeper.Mark(ctx, resources, now.Add(time.Hour))).To(Succeed())
+			Expect(resources).To(ContainElement(And(HaveField("Type", ResourceTypeVPC), HaveField("OrphanedSince", HaveValue(Equal(now))))))
+		})
+
+		It("should remove the tag if the infrastructure is reconciled again", func() {
+			resources, err := sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sweeper.Mark(ctx, resources, now)).To(Succeed())
+
+			reconcile(orphanNamespace, "10.180.0.0/16")
+
+			resources, err = sweeper.FindOrphanedResources(ctx, sets.New(liveNamespace))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(HaveEach(HaveField("OrphanedSince", BeNil())))
+		})
+	})
+
+	Describe("#FilterExpired", func() {
+		var (
+			now   = time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC)
+			old   = now.Add(-48 * time.Hour)
+			young = now.Add(-time.Hour)
+		)
+
+		It("should only return the namespaces whose resources have all been orphaned for the minimum age", func() {
+			resources := []Resource{
+				{Namespace: "shoot--foo--old", Type: ResourceTypeSLB, ID: "lb-1"},
+				{Namespace: "shoot--foo--old", Type: ResourceTypeEIP, ID: "eip-1", OrphanedSince: &old},
+				{Namespace: "shoot--foo--old", Type: ResourceTypeVPC, ID: "vpc-1", OrphanedSince: &old},
+				{Namespace: "shoot--foo--young", Type: ResourceTypeEIP, ID: "eip-2", OrphanedSince: &old},
+				{Namespace: "shoot--foo--young", Type: ResourceTypeVPC, ID: "vpc-2", OrphanedSince: &young},
+				{Namespace: "shoot--foo--unmarked", Type: ResourceTypeVPC, ID: "vpc-3"},
+				{Namespace: "shoot--foo--untaggable", Type: ResourceTypeSLB, ID: "lb-2"},
+			}
+
+			Expect(FilterExpired(resources, 24*time.Hour, now)).To(Equal(resources[:3]))
+		})
+	})
+})
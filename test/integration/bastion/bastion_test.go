@@ -475,7 +475,7 @@ func createBastion(cluster *controller.Cluster, name string) (*extensionsv1alpha
 }
 
 func prepareVPCandShootSecurityGroup(ctx context.Context, clientFactory alicloudclient.ClientFactory, name, vpcName, region, vpcCIDR, natGatewayCIDR string) infrastructureIdentifiers {
-	vpcClient, err := clientFactory.NewVPCClient(region, *accessKeyID, *accessKeySecret)
+	vpcClient, err := clientFactory.NewVPCClient(region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	// vpc
@@ -556,7 +556,7 @@ func prepareVPCandShootSecurityGroup(ctx context.Context, clientFactory alicloud
 	Expect(err).NotTo(HaveOccurred())
 
 	// shoot security group
-	ecsClient, err := clientFactory.NewECSClient(region, *accessKeyID, *accessKeySecret)
+	ecsClient, err := clientFactory.NewECSClient(region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	createSecurityGroupsResp, err := ecsClient.CreateSecurityGroups(createVPCsResp.VpcId, name+securityGroupSuffix)
@@ -572,9 +572,9 @@ func prepareVPCandShootSecurityGroup(ctx context.Context, clientFactory alicloud
 }
 
 func cleanupVPC(ctx context.Context, clientFactory alicloudclient.ClientFactory, identifiers infrastructureIdentifiers) {
-	vpcClient, err := clientFactory.NewVPCClient(*region, *accessKeyID, *accessKeySecret)
+	vpcClient, err := clientFactory.NewVPCClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
-	ecsClient, err := clientFactory.NewECSClient(*region, *accessKeyID, *accessKeySecret)
+	ecsClient, err := clientFactory.NewECSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	// cleanup - natGateWay
@@ -677,7 +677,7 @@ func teardownBastion(ctx context.Context, logger logr.Logger, c client.Client, b
 }
 
 func verifyDeletion(clientFactory alicloudclient.ClientFactory, options *bastionctrl.Options) {
-	ecsClient, err := clientFactory.NewECSClient(*region, *accessKeyID, *accessKeySecret)
+	ecsClient, err := clientFactory.NewECSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	By("bastion instance should be gone")
@@ -692,7 +692,7 @@ func verifyDeletion(clientFactory alicloudclient.ClientFactory, options *bastion
 }
 
 func verifyCreation(clientFactory alicloudclient.ClientFactory, options *bastionctrl.Options) {
-	ecsClient, err := clientFactory.NewECSClient(*region, *accessKeyID, *accessKeySecret)
+	ecsClient, err := clientFactory.NewECSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	By("checking bastion instance")
@@ -12,12 +12,14 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/ros"
 	alicloudapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
@@ -134,13 +136,13 @@ func newCluster(namespace string) (*extensionsv1alpha1.Cluster, error) {
 }
 
 func deleteEncryptedImageStackIfExists(ctx context.Context, clientFactory alicloudclient.ClientFactory) error {
-	stackName := common.GetEncryptImageStackName(imageName, imageVersion, *region)
+	stackName := common.GetEncryptImageStackName(imageName, imageVersion, *region, "")
 	listRequest := ros.CreateListStacksRequest()
 	listRequest.StackName = &[]string{stackName}
 	listRequest.RegionId = *region
 	listRequest.SetScheme("HTTPS")
 
-	rosClient, err := clientFactory.NewROSClient(*region, *accessKeyID, *accessKeySecret)
+	rosClient, err := clientFactory.NewROSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	if err != nil {
 		return err
 	}
@@ -175,13 +177,13 @@ func deleteEncryptedImageStackIfExists(ctx context.Context, clientFactory aliclo
 }
 
 func verifyStackExists(ctx context.Context, clientFactory alicloudclient.ClientFactory) error {
-	stackName := common.GetEncryptImageStackName(imageName, imageVersion, *region)
+	stackName := common.GetEncryptImageStackName(imageName, imageVersion, *region, "")
 	listRequest := ros.CreateListStacksRequest()
 	listRequest.StackName = &[]string{stackName}
 	listRequest.RegionId = *region
 	listRequest.SetScheme("HTTPS")
 
-	rosClient, err := clientFactory.NewROSClient(*region, *accessKeyID, *accessKeySecret)
+	rosClient, err := clientFactory.NewROSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	if err != nil {
 		return err
 	}
@@ -239,6 +241,6 @@ func verifyImageInfraStatus(status *alicloudv1alpha1.InfrastructureStatus) error
 		machineImages = append(machineImages, *converted)
 	}
 
-	_, err := helper.FindMachineImage(machineImages, imageName, imageVersion, *enableEncryptedImage)
+	_, err := helper.FindMachineImage(machineImages, imageName, imageVersion, *enableEncryptedImage, "", v1beta1constants.ArchitectureAMD64)
 	return err
 }
@@ -716,10 +716,10 @@ func verifyCreation(
 		securityGroupSuffix = "-sg"
 	)
 
-	vpcClient, err := clientFactory.NewVPCClient(*region, *accessKeyID, *accessKeySecret)
+	vpcClient, err := clientFactory.NewVPCClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
-	ecsClient, err := clientFactory.NewECSClient(*region, *accessKeyID, *accessKeySecret)
+	ecsClient, err := clientFactory.NewECSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	// vpc
@@ -863,10 +863,10 @@ func verifyCreation(
 }
 
 func verifyDeletion(clientFactory alicloudclient.ClientFactory, infrastructureIdentifier infrastructureIdentifiers) {
-	vpcClient, err := clientFactory.NewVPCClient(*region, *accessKeyID, *accessKeySecret)
+	vpcClient, err := clientFactory.NewVPCClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
-	ecsClient, err := clientFactory.NewECSClient(*region, *accessKeyID, *accessKeySecret)
+	ecsClient, err := clientFactory.NewECSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	// vpc
@@ -927,7 +927,7 @@ func verifyDeletion(clientFactory alicloudclient.ClientFactory, infrastructureId
 }
 
 func prepareVPC(ctx context.Context, clientFactory alicloudclient.ClientFactory, region, vpcCIDR, natGatewayCIDR string) infrastructureIdentifiers {
-	vpcClient, err := clientFactory.NewVPCClient(region, *accessKeyID, *accessKeySecret)
+	vpcClient, err := clientFactory.NewVPCClient(region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 	createVpcReq := vpc.CreateCreateVpcRequest()
 	createVpcReq.VpcName = "provider-alicloud-infra-test"
@@ -1008,9 +1008,9 @@ func prepareVPC(ctx context.Context, clientFactory alicloudclient.ClientFactory,
 }
 
 func cleanupVPC(ctx context.Context, clientFactory alicloudclient.ClientFactory, identifiers infrastructureIdentifiers) {
-	vpcClient, err := clientFactory.NewVPCClient(*region, *accessKeyID, *accessKeySecret)
+	vpcClient, err := clientFactory.NewVPCClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
-	ecsClient, err := clientFactory.NewECSClient(*region, *accessKeyID, *accessKeySecret)
+	ecsClient, err := clientFactory.NewECSClient(*region, &alicloud.Credentials{AccessKeyID: *accessKeyID, AccessKeySecret: *accessKeySecret})
 	Expect(err).NotTo(HaveOccurred())
 
 	deleteNatGatewayReq := vpc.CreateDeleteNatGatewayRequest()